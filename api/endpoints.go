@@ -41,6 +41,10 @@ func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request
 		i.POSTSettings(w, r)
 	case strings.HasPrefix(path, "/ob/inventory"):
 		i.POSTInventory(w, r)
+	case strings.HasPrefix(path, "/ob/licensekeys"):
+		i.POSTLicenseKeys(w, r)
+	case strings.HasPrefix(path, "/ob/digitalfile"):
+		i.POSTDigitalFile(w, r)
 	case strings.HasPrefix(path, "/ob/avatar"):
 		i.POSTAvatar(w, r)
 	case strings.HasPrefix(path, "/ob/header"):
@@ -61,6 +65,8 @@ func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request
 		i.POSTResyncBlockchain(w, r)
 	case strings.HasPrefix(path, "/wallet/bumpfee"):
 		i.POSTBumpFee(w, r)
+	case strings.HasPrefix(path, "/wallet/approvespend"):
+		i.POSTApproveSpend(w, r)
 	case strings.HasPrefix(path, "/ob/opendispute"):
 		blockingStartupMiddleware(i, w, r, i.POSTOpenDispute)
 	case strings.HasPrefix(path, "/ob/closedispute"):
@@ -89,18 +95,32 @@ func post(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request
 		i.POSTBlockNode(w, r)
 	case strings.HasPrefix(path, "/ob/shutdown"):
 		i.POSTShutdown(w, r)
+	case strings.HasPrefix(path, "/ob/changepassword"):
+		i.POSTChangePassword(w, r)
 	case strings.HasPrefix(path, "/ob/estimatetotal"):
 		i.POSTEstimateTotal(w, r)
 	case strings.HasPrefix(path, "/ob/checkoutbreakdown"):
 		i.POSTCheckoutBreakdown(w, r)
 	case strings.HasPrefix(path, "/ob/fetchratings"):
 		i.POSTFetchRatings(w, r)
+	case strings.HasPrefix(path, "/ob/ratingresponse"):
+		i.POSTRatingResponse(w, r)
 	case strings.HasPrefix(path, "/ob/sales"):
 		i.POSTSales(w, r)
 	case strings.HasPrefix(path, "/ob/purchases"):
 		i.POSTPurchases(w, r)
 	case strings.HasPrefix(path, "/ob/purchase"):
 		blockingStartupMiddleware(i, w, r, i.POSTPurchase)
+	case strings.HasPrefix(path, "/ob/pricequote"):
+		blockingStartupMiddleware(i, w, r, i.POSTPriceQuote)
+	case strings.HasPrefix(path, "/ob/subscribe"):
+		blockingStartupMiddleware(i, w, r, i.POSTSubscribe)
+	case strings.HasPrefix(path, "/ob/releasebackorder"):
+		blockingStartupMiddleware(i, w, r, i.POSTReleaseBackorder)
+	case strings.HasPrefix(path, "/ob/confirmappointment"):
+		blockingStartupMiddleware(i, w, r, i.POSTConfirmAppointment)
+	case strings.HasPrefix(path, "/ob/rescheduleappointment"):
+		blockingStartupMiddleware(i, w, r, i.POSTRescheduleAppointment)
 	case strings.HasPrefix(path, "/ob/cases"):
 		i.POSTCases(w, r)
 	case strings.HasPrefix(path, "/ob/publish"):
@@ -187,6 +207,8 @@ func get(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request)
 		i.GETPurchases(w, r)
 	case strings.HasPrefix(path, "/ob/sales"):
 		i.GETSales(w, r)
+	case strings.HasPrefix(path, "/ob/accounting"):
+		i.GETAccounting(w, r)
 	case strings.HasPrefix(path, "/ob/cases"):
 		i.GETCases(w, r)
 	case strings.HasPrefix(path, "/ob/case"):
@@ -195,12 +217,34 @@ func get(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Request)
 		i.GETEstimateFee(w, r)
 	case strings.HasPrefix(path, "/wallet/fees"):
 		i.GETFees(w, r)
+	case strings.HasPrefix(path, "/ob/ratingstats"):
+		i.GETRatingStats(w, r)
 	case strings.HasPrefix(path, "/ob/ratings"):
 		i.GETRatings(w, r)
 	case strings.HasPrefix(path, "/ob/rating"):
 		i.GETRating(w, r)
 	case strings.HasPrefix(path, "/ob/healthcheck"):
 		i.GETHealthCheck(w, r)
+	case strings.HasPrefix(path, "/ob/metrics"):
+		i.GETMetrics(w, r)
+	case strings.HasPrefix(path, "/ob/outbox"):
+		i.GETOutbox(w, r)
+	case strings.HasPrefix(path, "/wallet/pendingspends"):
+		i.GETPendingSpends(w, r)
+	case strings.HasPrefix(path, "/ob/backorders"):
+		i.GETBackorders(w, r)
+	case strings.HasPrefix(path, "/ob/appointments"):
+		i.GETAppointments(w, r)
+	case strings.HasPrefix(path, "/ob/licensekeys"):
+		i.GETLicenseKeys(w, r)
+	case strings.HasPrefix(path, "/ob/digitalfiles"):
+		i.GETDigitalFiles(w, r)
+	case strings.HasPrefix(path, "/ob/digitaldownload"):
+		i.GETDigitalDownload(w, r)
+	case strings.HasPrefix(path, "/ob/subscriptions"):
+		i.GETSubscriptions(w, r)
+	case strings.HasPrefix(path, "/ob/subscription"):
+		i.GETSubscription(w, r)
 	case strings.HasPrefix(path, "/wallet/status"):
 		i.GETWalletStatus(w, r)
 	case strings.HasPrefix(path, "/ob/ipns"):
@@ -247,6 +291,12 @@ func deleter(i *jsonAPIHandler, path string, w http.ResponseWriter, r *http.Requ
 		i.DELETEBlockNode(w, r)
 	case strings.HasPrefix(path, "/ob/post"):
 		i.DELETEPost(w, r)
+	case strings.HasPrefix(path, "/ob/ratingresponse"):
+		i.DELETERatingResponse(w, r)
+	case strings.HasPrefix(path, "/wallet/pendingspends"):
+		i.DELETEPendingSpend(w, r)
+	case strings.HasPrefix(path, "/ob/subscription"):
+		blockingStartupMiddleware(i, w, r, i.DELETESubscription)
 	default:
 		ErrorResponse(w, http.StatusNotFound, "Not Found")
 	}
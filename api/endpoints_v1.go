@@ -0,0 +1,367 @@
+package api
+
+import (
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+var (
+	usecacheQuery = queryParam{"usecache", "Return the cached copy of the resource if one is available"}
+	limitQuery    = queryParam{"limit", "Maximum number of results to return"}
+	offsetIDQuery = queryParam{"offsetId", "Return results following the entry with this ID"}
+	asyncQuery    = queryParam{"async", "Stream results over the websocket instead of the response"}
+	searchQueries = []queryParam{
+		{"state", "Comma separated list of order states to include"},
+		{"search", "Search term"},
+		{"sortByAscending", "Sort by ascending timestamp"},
+		{"sortByRead", "Sort unread records first"},
+		limitQuery,
+	}
+
+	// Rating responses are read from the cached root of the vendor unless
+	// usecache is false
+	responsesCacheQuery = queryParam{"usecache", "Read the vendor responses from the cached root of the vendor. Defaults to true"}
+)
+
+// v1Routes returns the routes served under the versioned API prefix. Every
+// route is matched on the exact path and method.
+func v1Routes() []*route {
+	return []*route{
+		// Node
+		{Method: "GET", Pattern: "/openapi.json", Handler: (*jsonAPIHandler).GETOpenAPI, Gateway: true,
+			Doc: routeDoc{Tag: "node", Summary: "Get the OpenAPI document describing this API"}},
+		{Method: "GET", Pattern: "/ob/config", Handler: (*jsonAPIHandler).GETConfig,
+			Doc: routeDoc{Tag: "node", Summary: "Get the node configuration"}},
+		{Method: "GET", Pattern: "/ob/peers", Handler: (*jsonAPIHandler).GETPeers,
+			Doc: routeDoc{Tag: "node", Summary: "List connected peers", Response: []string{}}},
+		{Method: "GET", Pattern: "/ob/closestpeers/{peerID}", Handler: (*jsonAPIHandler).GETClosestPeers,
+			Doc: routeDoc{Tag: "node", Summary: "List the peers closest to a peer ID", Response: []string{}}},
+		{Method: "GET", Pattern: "/ob/status/{peerID}", Handler: (*jsonAPIHandler).GETStatus,
+			Doc: routeDoc{Tag: "node", Summary: "Get the online status of a peer"}},
+		{Method: "GET", Pattern: "/ob/peerinfo/{peerID}", Handler: (*jsonAPIHandler).GETPeerInfo,
+			Doc: routeDoc{Tag: "node", Summary: "Get the addresses known for a peer"}},
+		{Method: "GET", Pattern: "/ob/healthcheck", Handler: (*jsonAPIHandler).GETHealthCheck,
+			Doc: routeDoc{Tag: "node", Summary: "Check the health of the node"}},
+		{Method: "GET", Pattern: "/ob/metrics", Handler: (*jsonAPIHandler).GETMetrics,
+			Doc: routeDoc{Tag: "node", Summary: "Get the node metrics in the Prometheus text format"}},
+		{Method: "GET", Pattern: "/ob/outbox", Handler: (*jsonAPIHandler).GETOutbox,
+			Doc: routeDoc{Tag: "node", Summary: "List the offline messages sent by the node", Query: []queryParam{
+				{"status", "Only include messages with this status: pending, acked, expired or failed"},
+			}, Response: []repo.OutboxMessage{}}},
+		{Method: "GET", Pattern: "/ob/outbox/{pointerID}", Handler: (*jsonAPIHandler).GETOutbox,
+			Doc: routeDoc{Tag: "node", Summary: "Get the delivery status of an offline message", Response: repo.OutboxMessage{}}},
+		{Method: "GET", Pattern: "/ob/ipns/{peerID}", Handler: (*jsonAPIHandler).GETIPNS, Gateway: true,
+			Doc: routeDoc{Tag: "node", Summary: "Get the cached IPNS record of a peer"}},
+		{Method: "GET", Pattern: "/ob/resolveipns", Handler: (*jsonAPIHandler).GETResolveIPNS,
+			Doc: routeDoc{Tag: "node", Summary: "Resolve the IPNS record of this node"}},
+		{Method: "GET", Pattern: "/ob/resolveipns/{peerID}", Handler: (*jsonAPIHandler).GETResolveIPNS,
+			Doc: routeDoc{Tag: "node", Summary: "Resolve the IPNS record of a peer"}},
+		{Method: "GET", Pattern: "/ob/scanofflinemessages", Handler: (*jsonAPIHandler).GETScanOfflineMessages,
+			Doc: routeDoc{Tag: "node", Summary: "Scan the network for offline messages"}},
+		{Method: "POST", Pattern: "/ob/publish", Handler: (*jsonAPIHandler).POSTPublish,
+			Doc: routeDoc{Tag: "node", Summary: "Publish the node's data to the network"}},
+		{Method: "POST", Pattern: "/ob/purgecache", Handler: (*jsonAPIHandler).POSTPurgeCache,
+			Doc: routeDoc{Tag: "node", Summary: "Purge cached data of other peers"}},
+		{Method: "POST", Pattern: "/ob/shutdown", Handler: (*jsonAPIHandler).POSTShutdown,
+			Doc: routeDoc{Tag: "node", Summary: "Shut down the node"}},
+		{Method: "POST", Pattern: "/ob/changepassword", Handler: (*jsonAPIHandler).POSTChangePassword,
+			Doc: routeDoc{Tag: "node", Summary: "Change the password of the encrypted database", Request: changePasswordRequest{}}},
+		{Method: "POST", Pattern: "/ob/blocknode/{peerID}", Handler: (*jsonAPIHandler).POSTBlockNode,
+			Doc: routeDoc{Tag: "node", Summary: "Block a peer"}},
+		{Method: "DELETE", Pattern: "/ob/blocknode/{peerID}", Handler: (*jsonAPIHandler).DELETEBlockNode,
+			Doc: routeDoc{Tag: "node", Summary: "Unblock a peer"}},
+		{Method: "POST", Pattern: "/ob/signmessage", Handler: (*jsonAPIHandler).POSTSignMessage,
+			Doc: routeDoc{Tag: "node", Summary: "Sign a message with the node's identity key", Request: signMessageRequest{}}},
+		{Method: "POST", Pattern: "/ob/verifymessage", Handler: (*jsonAPIHandler).POSTVerifyMessage,
+			Doc: routeDoc{Tag: "node", Summary: "Verify a signed message", Request: verifyMessageRequest{}}},
+		{Method: "POST", Pattern: "/ob/hashmessage", Handler: (*jsonAPIHandler).POSTHashMessage,
+			Doc: routeDoc{Tag: "node", Summary: "Hash a message into a content identifier", Request: hashMessageRequest{}}},
+
+		// Settings
+		{Method: "GET", Pattern: "/ob/settings", Handler: (*jsonAPIHandler).GETSettings,
+			Doc: routeDoc{Tag: "settings", Summary: "Get the node settings", Response: repo.SettingsData{}}},
+		{Method: "POST", Pattern: "/ob/settings", Handler: (*jsonAPIHandler).POSTSettings,
+			Doc: routeDoc{Tag: "settings", Summary: "Create the node settings", Request: repo.SettingsData{}, Response: repo.SettingsData{}}},
+		{Method: "PUT", Pattern: "/ob/settings", Handler: (*jsonAPIHandler).PUTSettings,
+			Doc: routeDoc{Tag: "settings", Summary: "Replace the node settings", Request: repo.SettingsData{}}},
+		{Method: "PATCH", Pattern: "/ob/settings", Handler: (*jsonAPIHandler).PATCHSettings,
+			Doc: routeDoc{Tag: "settings", Summary: "Update individual node settings", Request: repo.SettingsData{}}},
+		{Method: "POST", Pattern: "/ob/testemailnotifications", Handler: (*jsonAPIHandler).POSTTestEmailNotifications,
+			Doc: routeDoc{Tag: "settings", Summary: "Send a test email notification", Request: repo.SMTPSettings{}}},
+
+		// Profile
+		{Method: "GET", Pattern: "/ob/profile", Handler: (*jsonAPIHandler).GETProfile, Gateway: true,
+			Doc: routeDoc{Tag: "profile", Summary: "Get the node's profile", Response: pb.Profile{}}},
+		{Method: "GET", Pattern: "/ob/profile/{peerID}", Handler: (*jsonAPIHandler).GETProfile, Gateway: true,
+			Doc: routeDoc{Tag: "profile", Summary: "Get the profile of a peer", Query: []queryParam{usecacheQuery}, Response: pb.Profile{}}},
+		{Method: "POST", Pattern: "/ob/profile", Handler: (*jsonAPIHandler).POSTProfile,
+			Doc: routeDoc{Tag: "profile", Summary: "Create the node's profile", Request: pb.Profile{}, Response: pb.Profile{}}},
+		{Method: "PUT", Pattern: "/ob/profile", Handler: (*jsonAPIHandler).PUTProfile,
+			Doc: routeDoc{Tag: "profile", Summary: "Replace the node's profile", Request: pb.Profile{}, Response: pb.Profile{}}},
+		{Method: "PATCH", Pattern: "/ob/profile", Handler: (*jsonAPIHandler).PATCHProfile,
+			Doc: routeDoc{Tag: "profile", Summary: "Update individual profile fields", Request: pb.Profile{}}},
+		{Method: "POST", Pattern: "/ob/fetchprofiles", Handler: (*jsonAPIHandler).POSTFetchProfiles, Gateway: true,
+			Doc: routeDoc{Tag: "profile", Summary: "Fetch the profiles of several peers", Query: []queryParam{asyncQuery, usecacheQuery}, Request: []string{}, Response: []pb.Profile{}}},
+		{Method: "POST", Pattern: "/ob/avatar", Handler: (*jsonAPIHandler).POSTAvatar,
+			Doc: routeDoc{Tag: "profile", Summary: "Set the profile avatar", Request: avatarRequest{}, Response: pb.Profile_Image{}}},
+		{Method: "GET", Pattern: "/ob/avatar/{peerID}/{size}", Handler: (*jsonAPIHandler).GETAvatar, Gateway: true,
+			Doc: routeDoc{Tag: "profile", Summary: "Get the avatar of a peer", Query: []queryParam{usecacheQuery}}},
+		{Method: "POST", Pattern: "/ob/header", Handler: (*jsonAPIHandler).POSTHeader,
+			Doc: routeDoc{Tag: "profile", Summary: "Set the profile header image", Request: headerRequest{}, Response: pb.Profile_Image{}}},
+		{Method: "GET", Pattern: "/ob/header/{peerID}/{size}", Handler: (*jsonAPIHandler).GETHeader, Gateway: true,
+			Doc: routeDoc{Tag: "profile", Summary: "Get the header image of a peer", Query: []queryParam{usecacheQuery}}},
+		{Method: "POST", Pattern: "/ob/images", Handler: (*jsonAPIHandler).POSTImage,
+			Doc: routeDoc{Tag: "profile", Summary: "Add images to the node", Request: []imageRequest{}, Response: []imageResponse{}}},
+		{Method: "GET", Pattern: "/ob/image/{imageHash}", Handler: (*jsonAPIHandler).GETImage, Gateway: true,
+			Doc: routeDoc{Tag: "profile", Summary: "Get an image by hash"}},
+		{Method: "PUT", Pattern: "/ob/moderator", Handler: (*jsonAPIHandler).PUTModerator,
+			Doc: routeDoc{Tag: "profile", Summary: "Become a moderator", Request: pb.Moderator{}}},
+		{Method: "DELETE", Pattern: "/ob/moderator", Handler: (*jsonAPIHandler).DELETEModerator,
+			Doc: routeDoc{Tag: "profile", Summary: "Stop moderating"}},
+		{Method: "GET", Pattern: "/ob/moderators", Handler: (*jsonAPIHandler).GETModerators,
+			Doc: routeDoc{Tag: "profile", Summary: "Find moderators on the network", Query: []queryParam{asyncQuery, {"include", "Set to 'profile' to include moderator profiles"}}}},
+
+		// Social
+		{Method: "GET", Pattern: "/ob/followers", Handler: (*jsonAPIHandler).GETFollowers, Gateway: true,
+			Doc: routeDoc{Tag: "social", Summary: "List the node's followers", Query: []queryParam{offsetIDQuery, limitQuery}, Response: []repo.Follower{}}},
+		{Method: "GET", Pattern: "/ob/followers/{peerID}", Handler: (*jsonAPIHandler).GETFollowers, Gateway: true,
+			Doc: routeDoc{Tag: "social", Summary: "List the followers of a peer", Query: []queryParam{usecacheQuery}, Response: []string{}}},
+		{Method: "GET", Pattern: "/ob/following", Handler: (*jsonAPIHandler).GETFollowing, Gateway: true,
+			Doc: routeDoc{Tag: "social", Summary: "List the peers the node follows", Query: []queryParam{offsetIDQuery, limitQuery}, Response: []string{}}},
+		{Method: "GET", Pattern: "/ob/following/{peerID}", Handler: (*jsonAPIHandler).GETFollowing, Gateway: true,
+			Doc: routeDoc{Tag: "social", Summary: "List the peers a peer follows", Query: []queryParam{usecacheQuery}, Response: []string{}}},
+		{Method: "GET", Pattern: "/ob/followsme/{peerID}", Handler: (*jsonAPIHandler).GETFollowsMe,
+			Doc: routeDoc{Tag: "social", Summary: "Check whether a peer follows the node"}},
+		{Method: "GET", Pattern: "/ob/isfollowing/{peerID}", Handler: (*jsonAPIHandler).GETIsFollowing,
+			Doc: routeDoc{Tag: "social", Summary: "Check whether the node follows a peer"}},
+		{Method: "POST", Pattern: "/ob/follow", Handler: (*jsonAPIHandler).POSTFollow, Blocking: true,
+			Doc: routeDoc{Tag: "social", Summary: "Follow a peer", Request: followRequest{}}},
+		{Method: "POST", Pattern: "/ob/unfollow", Handler: (*jsonAPIHandler).POSTUnfollow, Blocking: true,
+			Doc: routeDoc{Tag: "social", Summary: "Unfollow a peer", Request: followRequest{}}},
+
+		// Listings
+		{Method: "GET", Pattern: "/ob/listings", Handler: (*jsonAPIHandler).GETListings, Gateway: true,
+			Doc: routeDoc{Tag: "listings", Summary: "Get the node's listing index", Response: []repo.ListingIndexData{}}},
+		{Method: "GET", Pattern: "/ob/listings/{peerID}", Handler: (*jsonAPIHandler).GETListings, Gateway: true,
+			Doc: routeDoc{Tag: "listings", Summary: "Get the listing index of a peer", Query: []queryParam{usecacheQuery, {"max-age", "Cache-Control max-age of the response in seconds"}}, Response: []repo.ListingIndexData{}}},
+		{Method: "GET", Pattern: "/ob/listing/{listingID}", Handler: (*jsonAPIHandler).GETListing, Gateway: true,
+			Doc: routeDoc{Tag: "listings", Summary: "Get one of the node's listings by slug or hash", Response: pb.SignedListing{}}},
+		{Method: "GET", Pattern: "/ob/listing/{peerID}/{listingID}", Handler: (*jsonAPIHandler).GETListing, Gateway: true,
+			Doc: routeDoc{Tag: "listings", Summary: "Get a listing of a peer by slug or hash", Query: []queryParam{usecacheQuery}, Response: pb.SignedListing{}}},
+		{Method: "POST", Pattern: "/ob/listing", Handler: (*jsonAPIHandler).POSTListing,
+			Doc: routeDoc{Tag: "listings", Summary: "Create a listing", Request: pb.Listing{}}},
+		{Method: "PUT", Pattern: "/ob/listing", Handler: (*jsonAPIHandler).PUTListing,
+			Doc: routeDoc{Tag: "listings", Summary: "Replace a listing", Request: pb.Listing{}}},
+		{Method: "DELETE", Pattern: "/ob/listing/{slug}", Handler: (*jsonAPIHandler).DELETEListing,
+			Doc: routeDoc{Tag: "listings", Summary: "Delete a listing"}},
+		{Method: "POST", Pattern: "/ob/importlistings", Handler: (*jsonAPIHandler).POSTImportListings,
+			Doc: routeDoc{Tag: "listings", Summary: "Import listings from a CSV file"}},
+		{Method: "POST", Pattern: "/ob/bulkupdatecurrency", Handler: (*jsonAPIHandler).POSTBulkUpdateCurrency,
+			Doc: routeDoc{Tag: "listings", Summary: "Set the accepted currencies of all listings", Request: bulkUpdateCurrencyRequest{}}},
+		{Method: "POST", Pattern: "/ob/bulkupdateprices", Handler: (*jsonAPIHandler).POSTBulkUpdatePrices,
+			Doc: routeDoc{Tag: "listings", Summary: "Adjust the price of all listings by a percentage", Request: bulkUpdatePriceRequest{}}},
+		{Method: "GET", Pattern: "/ob/inventory", Handler: (*jsonAPIHandler).GETInventory, Gateway: true,
+			Doc: routeDoc{Tag: "listings", Summary: "Get the node's inventory"}},
+		{Method: "GET", Pattern: "/ob/inventory/{peerID}", Handler: (*jsonAPIHandler).GETInventory, Gateway: true,
+			Doc: routeDoc{Tag: "listings", Summary: "Get the inventory of a peer", Query: []queryParam{usecacheQuery}}},
+		{Method: "GET", Pattern: "/ob/inventory/{peerID}/{slug}", Handler: (*jsonAPIHandler).GETInventory, Gateway: true,
+			Doc: routeDoc{Tag: "listings", Summary: "Get the inventory of a peer's listing", Query: []queryParam{usecacheQuery}}},
+		{Method: "POST", Pattern: "/ob/inventory", Handler: (*jsonAPIHandler).POSTInventory,
+			Doc: routeDoc{Tag: "listings", Summary: "Set inventory counts", Request: []inventoryUpdate{}}},
+		{Method: "POST", Pattern: "/ob/licensekeys", Handler: (*jsonAPIHandler).POSTLicenseKeys,
+			Doc: routeDoc{Tag: "listings", Summary: "Add license keys to the pool of a digital good variant", Request: licenseKeysRequest{}, Response: repo.LicenseKeyPool{}}},
+		{Method: "GET", Pattern: "/ob/licensekeys/{slug}", Handler: (*jsonAPIHandler).GETLicenseKeys,
+			Doc: routeDoc{Tag: "listings", Summary: "Count the license keys of a listing by variant", Response: []repo.LicenseKeyPool{}}},
+		{Method: "POST", Pattern: "/ob/digitalfile", Handler: (*jsonAPIHandler).POSTDigitalFile,
+			Doc: routeDoc{Tag: "listings", Summary: "Set the file sent to buyers of a digital good variant", Request: digitalFileRequest{}, Response: repo.DigitalFile{}}},
+		{Method: "GET", Pattern: "/ob/digitalfiles/{slug}", Handler: (*jsonAPIHandler).GETDigitalFiles,
+			Doc: routeDoc{Tag: "listings", Summary: "List the files of a listing by variant", Response: []repo.DigitalFile{}}},
+
+		// Posts
+		{Method: "GET", Pattern: "/ob/posts", Handler: (*jsonAPIHandler).GETPosts, Gateway: true,
+			Doc: routeDoc{Tag: "posts", Summary: "Get the node's post index"}},
+		{Method: "GET", Pattern: "/ob/posts/{peerID}", Handler: (*jsonAPIHandler).GETPosts, Gateway: true,
+			Doc: routeDoc{Tag: "posts", Summary: "Get the post index of a peer", Query: []queryParam{usecacheQuery}}},
+		{Method: "GET", Pattern: "/ob/post/{postID}", Handler: (*jsonAPIHandler).GETPost, Gateway: true,
+			Doc: routeDoc{Tag: "posts", Summary: "Get one of the node's posts by slug or hash", Response: pb.SignedPost{}}},
+		{Method: "GET", Pattern: "/ob/post/{peerID}/{postID}", Handler: (*jsonAPIHandler).GETPost, Gateway: true,
+			Doc: routeDoc{Tag: "posts", Summary: "Get a post of a peer by slug or hash", Query: []queryParam{usecacheQuery}, Response: pb.SignedPost{}}},
+		{Method: "POST", Pattern: "/ob/post", Handler: (*jsonAPIHandler).POSTPost,
+			Doc: routeDoc{Tag: "posts", Summary: "Create a post", Request: pb.Post{}}},
+		{Method: "PUT", Pattern: "/ob/post", Handler: (*jsonAPIHandler).PUTPost,
+			Doc: routeDoc{Tag: "posts", Summary: "Replace a post", Request: pb.Post{}}},
+		{Method: "DELETE", Pattern: "/ob/post/{slug}", Handler: (*jsonAPIHandler).DELETEPost,
+			Doc: routeDoc{Tag: "posts", Summary: "Delete a post"}},
+
+		// Ratings
+		{Method: "GET", Pattern: "/ob/ratings/{peerID}", Handler: (*jsonAPIHandler).GETRatings, Gateway: true,
+			Doc: routeDoc{Tag: "ratings", Summary: "Get the rating index of a peer", Query: []queryParam{usecacheQuery}}},
+		{Method: "GET", Pattern: "/ob/ratings/{peerID}/{slug}", Handler: (*jsonAPIHandler).GETRatings, Gateway: true,
+			Doc: routeDoc{Tag: "ratings", Summary: "Get the ratings of a peer's listing", Query: []queryParam{usecacheQuery}, Response: core.SavedRating{}}},
+		{Method: "GET", Pattern: "/ob/rating/{ratingID}", Handler: (*jsonAPIHandler).GETRating, Gateway: true,
+			Doc: routeDoc{Tag: "ratings", Summary: "Get a rating by hash with the vendor's response", Query: []queryParam{responsesCacheQuery}, Response: pb.Rating{}}},
+		{Method: "POST", Pattern: "/ob/fetchratings", Handler: (*jsonAPIHandler).POSTFetchRatings, Gateway: true,
+			Doc: routeDoc{Tag: "ratings", Summary: "Fetch several ratings by hash with the vendor's responses", Query: []queryParam{asyncQuery, responsesCacheQuery}, Request: []string{}, Response: []pb.Rating{}}},
+		{Method: "GET", Pattern: "/ob/ratingstats", Handler: (*jsonAPIHandler).GETRatingStats,
+			Doc: routeDoc{Tag: "ratings", Summary: "Get the rating averages and histograms of the store and each listing", Response: core.RatingStats{}}},
+		{Method: "GET", Pattern: "/ob/ratingstats/{slug}", Handler: (*jsonAPIHandler).GETRatingStats,
+			Doc: routeDoc{Tag: "ratings", Summary: "Get the rating averages and histograms of a listing", Response: core.RatingSummary{}}},
+		{Method: "POST", Pattern: "/ob/ratingresponse", Handler: (*jsonAPIHandler).POSTRatingResponse,
+			Doc: routeDoc{Tag: "ratings", Summary: "Publicly respond to a rating of one of the node's listings", Request: ratingResponseRequest{}, Response: pb.RatingResponse{}}},
+		{Method: "DELETE", Pattern: "/ob/ratingresponse/{ratingID}", Handler: (*jsonAPIHandler).DELETERatingResponse,
+			Doc: routeDoc{Tag: "ratings", Summary: "Delete the response to a rating"}},
+
+		// Orders
+		{Method: "POST", Pattern: "/ob/purchase", Handler: (*jsonAPIHandler).POSTPurchase, Blocking: true,
+			Doc: routeDoc{Tag: "orders", Summary: "Purchase a listing", Request: repo.PurchaseData{}}},
+		{Method: "POST", Pattern: "/ob/pricequote", Handler: (*jsonAPIHandler).POSTPriceQuote, Blocking: true,
+			Doc: routeDoc{Tag: "orders", Summary: "Request a quote from the vendor fixing the amount of an order", Request: repo.PurchaseData{}, Response: pb.SignedPriceQuote{}}},
+		{Method: "POST", Pattern: "/ob/subscribe", Handler: (*jsonAPIHandler).POSTSubscribe, Blocking: true,
+			Doc: routeDoc{Tag: "orders", Summary: "Subscribe to a listing and place its first order", Request: repo.SubscriptionData{}}},
+		{Method: "GET", Pattern: "/ob/subscriptions", Handler: (*jsonAPIHandler).GETSubscriptions,
+			Doc: routeDoc{Tag: "orders", Summary: "List the subscriptions of the node as buyer and vendor", Response: []repo.Subscription{}}},
+		{Method: "GET", Pattern: "/ob/subscription/{id}", Handler: (*jsonAPIHandler).GETSubscription,
+			Doc: routeDoc{Tag: "orders", Summary: "Get a subscription", Response: repo.Subscription{}}},
+		{Method: "DELETE", Pattern: "/ob/subscription/{id}", Handler: (*jsonAPIHandler).DELETESubscription, Blocking: true,
+			Doc: routeDoc{Tag: "orders", Summary: "Cancel a subscription", Response: repo.Subscription{}}},
+		{Method: "POST", Pattern: "/ob/estimatetotal", Handler: (*jsonAPIHandler).POSTEstimateTotal,
+			Doc: routeDoc{Tag: "orders", Summary: "Estimate the total of an order", Request: repo.PurchaseData{}}},
+		{Method: "POST", Pattern: "/ob/checkoutbreakdown", Handler: (*jsonAPIHandler).POSTCheckoutBreakdown,
+			Doc: routeDoc{Tag: "orders", Summary: "Get the price breakdown of an order", Request: repo.PurchaseData{}}},
+		{Method: "GET", Pattern: "/ob/order/{orderID}", Handler: (*jsonAPIHandler).GETOrder,
+			Doc: routeDoc{Tag: "orders", Summary: "Get an order", Response: pb.OrderRespApi{}}},
+		{Method: "GET", Pattern: "/ob/purchases", Handler: (*jsonAPIHandler).GETPurchases,
+			Doc: routeDoc{Tag: "orders", Summary: "List purchases", Query: searchQueries, Response: []repo.Purchase{}}},
+		{Method: "POST", Pattern: "/ob/purchases", Handler: (*jsonAPIHandler).POSTPurchases,
+			Doc: routeDoc{Tag: "orders", Summary: "Search purchases", Request: TransactionQuery{}}},
+		{Method: "GET", Pattern: "/ob/sales", Handler: (*jsonAPIHandler).GETSales,
+			Doc: routeDoc{Tag: "orders", Summary: "List sales", Query: searchQueries, Response: []repo.Sale{}}},
+		{Method: "POST", Pattern: "/ob/sales", Handler: (*jsonAPIHandler).POSTSales,
+			Doc: routeDoc{Tag: "orders", Summary: "Search sales", Request: TransactionQuery{}}},
+		{Method: "GET", Pattern: "/ob/accounting", Handler: (*jsonAPIHandler).GETAccounting,
+			Doc: routeDoc{Tag: "orders", Summary: "Export sales, purchases and wallet transactions for bookkeeping", Query: []queryParam{
+				{"from", "Include entries at or after this RFC 3339 time or date"},
+				{"to", "Include entries before this RFC 3339 time or date"},
+				{"coin", "Only include entries paid in this coin"},
+				{"currency", "Fiat currency of the fiat values, defaults to the local currency setting"},
+				{"format", "json or csv, defaults to json"},
+			}, Response: []core.AccountingEntry{}}},
+		{Method: "POST", Pattern: "/ob/orderconfirmation", Handler: (*jsonAPIHandler).POSTOrderConfirmation, Blocking: true,
+			Doc: routeDoc{Tag: "orders", Summary: "Confirm or reject an order", Request: orderConfirmationRequest{}}},
+		{Method: "POST", Pattern: "/ob/ordercancel", Handler: (*jsonAPIHandler).POSTOrderCancel, Blocking: true,
+			Doc: routeDoc{Tag: "orders", Summary: "Cancel an order", Request: orderIDRequest{}}},
+		{Method: "GET", Pattern: "/ob/backorders", Handler: (*jsonAPIHandler).GETBackorders,
+			Doc: routeDoc{Tag: "orders", Summary: "List the backordered and preordered sales awaiting fulfillment, oldest first", Response: []core.Backorder{}}},
+		{Method: "POST", Pattern: "/ob/releasebackorder", Handler: (*jsonAPIHandler).POSTReleaseBackorder, Blocking: true,
+			Doc: routeDoc{Tag: "orders", Summary: "Tell the buyer a backordered sale is in stock and moving to fulfillment", Request: orderIDRequest{}}},
+		{Method: "GET", Pattern: "/ob/appointments", Handler: (*jsonAPIHandler).GETAppointments,
+			Doc: routeDoc{Tag: "orders", Summary: "List the slots booked by sales and purchases, by start time", Query: []queryParam{
+				{"format", "json or ics, defaults to json"},
+			}, Response: []repo.Appointment{}}},
+		{Method: "POST", Pattern: "/ob/confirmappointment", Handler: (*jsonAPIHandler).POSTConfirmAppointment, Blocking: true,
+			Doc: routeDoc{Tag: "orders", Summary: "Confirm the time of the slot booked by a sale to the buyer", Request: orderIDRequest{}}},
+		{Method: "POST", Pattern: "/ob/rescheduleappointment", Handler: (*jsonAPIHandler).POSTRescheduleAppointment, Blocking: true,
+			Doc: routeDoc{Tag: "orders", Summary: "Move the booking of a sale to another slot and tell the buyer", Request: appointmentRescheduleRequest{}}},
+		{Method: "POST", Pattern: "/ob/orderfulfillment", Handler: (*jsonAPIHandler).POSTOrderFulfill, Blocking: true,
+			Doc: routeDoc{Tag: "orders", Summary: "Fulfill an order", Request: pb.OrderFulfillment{}}},
+		{Method: "GET", Pattern: "/ob/digitaldownload/{cid}", Handler: (*jsonAPIHandler).GETDigitalDownload,
+			Doc: routeDoc{Tag: "orders", Summary: "Download and decrypt a file sent with an order fulfillment", Query: []queryParam{{"filename", "Name to save the file as"}}}},
+		{Method: "POST", Pattern: "/ob/ordercompletion", Handler: (*jsonAPIHandler).POSTOrderComplete, Blocking: true,
+			Doc: routeDoc{Tag: "orders", Summary: "Complete an order and leave ratings", Request: core.OrderRatings{}}},
+		{Method: "POST", Pattern: "/ob/orderspend", Handler: (*jsonAPIHandler).POSTSpendCoinsForOrder, Blocking: true,
+			Doc: routeDoc{Tag: "orders", Summary: "Pay for an order from the node's wallet", Request: core.SpendRequest{}, Response: core.SpendResponse{}}},
+		{Method: "POST", Pattern: "/ob/refund", Handler: (*jsonAPIHandler).POSTRefund, Blocking: true,
+			Doc: routeDoc{Tag: "orders", Summary: "Refund an order", Request: orderIDRequest{}}},
+		{Method: "POST", Pattern: "/ob/releaseescrow", Handler: (*jsonAPIHandler).POSTReleaseEscrow, Blocking: true,
+			Doc: routeDoc{Tag: "orders", Summary: "Release escrowed funds after the timeout", Request: orderIDRequest{}}},
+		{Method: "POST", Pattern: "/ob/resendordermessage", Handler: (*jsonAPIHandler).POSTResendOrderMessage,
+			Doc: routeDoc{Tag: "orders", Summary: "Resend an order message to the counterparty", Request: resendOrderMessageRequest{}}},
+
+		// Disputes
+		{Method: "GET", Pattern: "/ob/cases", Handler: (*jsonAPIHandler).GETCases,
+			Doc: routeDoc{Tag: "disputes", Summary: "List dispute cases", Query: searchQueries, Response: []repo.Case{}}},
+		{Method: "POST", Pattern: "/ob/cases", Handler: (*jsonAPIHandler).POSTCases,
+			Doc: routeDoc{Tag: "disputes", Summary: "Search dispute cases", Request: TransactionQuery{}}},
+		{Method: "GET", Pattern: "/ob/case/{orderID}", Handler: (*jsonAPIHandler).GETCase,
+			Doc: routeDoc{Tag: "disputes", Summary: "Get a dispute case", Response: pb.CaseRespApi{}}},
+		{Method: "POST", Pattern: "/ob/opendispute", Handler: (*jsonAPIHandler).POSTOpenDispute, Blocking: true,
+			Doc: routeDoc{Tag: "disputes", Summary: "Open a dispute", Request: openDisputeRequest{}}},
+		{Method: "POST", Pattern: "/ob/closedispute", Handler: (*jsonAPIHandler).POSTCloseDispute, Blocking: true,
+			Doc: routeDoc{Tag: "disputes", Summary: "Close a dispute as the moderator", Request: closeDisputeRequest{}}},
+		{Method: "POST", Pattern: "/ob/releasefunds", Handler: (*jsonAPIHandler).POSTReleaseFunds, Blocking: true,
+			Doc: routeDoc{Tag: "disputes", Summary: "Accept a dispute resolution and release the funds", Request: orderIDRequest{}}},
+
+		// Chat
+		{Method: "POST", Pattern: "/ob/chat", Handler: (*jsonAPIHandler).POSTChat, Blocking: true,
+			Doc: routeDoc{Tag: "chat", Summary: "Send a chat message", Request: repo.ChatMessage{}}},
+		{Method: "POST", Pattern: "/ob/groupchat", Handler: (*jsonAPIHandler).POSTGroupChat, Blocking: true,
+			Doc: routeDoc{Tag: "chat", Summary: "Send a chat message to a group", Request: repo.GroupChatMessage{}}},
+		{Method: "GET", Pattern: "/ob/chatmessages/{peerID}", Handler: (*jsonAPIHandler).GETChatMessages,
+			Doc: routeDoc{Tag: "chat", Summary: "List the chat messages exchanged with a peer", Query: []queryParam{{"subject", "Order ID the messages relate to"}, offsetIDQuery, limitQuery}, Response: []repo.ChatMessage{}}},
+		{Method: "GET", Pattern: "/ob/chatconversations", Handler: (*jsonAPIHandler).GETChatConversations,
+			Doc: routeDoc{Tag: "chat", Summary: "List chat conversations", Response: []repo.ChatConversation{}}},
+		{Method: "POST", Pattern: "/ob/markchatasread/{peerID}", Handler: (*jsonAPIHandler).POSTMarkChatAsRead, Blocking: true,
+			Doc: routeDoc{Tag: "chat", Summary: "Mark the chat messages of a peer as read", Query: []queryParam{{"subject", "Order ID the messages relate to"}}}},
+		{Method: "DELETE", Pattern: "/ob/chatmessage/{messageID}", Handler: (*jsonAPIHandler).DELETEChatMessage,
+			Doc: routeDoc{Tag: "chat", Summary: "Delete a chat message"}},
+		{Method: "DELETE", Pattern: "/ob/chatconversation/{peerID}", Handler: (*jsonAPIHandler).DELETEChatConversation,
+			Doc: routeDoc{Tag: "chat", Summary: "Delete a chat conversation"}},
+
+		// Notifications
+		{Method: "GET", Pattern: "/ob/notifications", Handler: (*jsonAPIHandler).GETNotifications,
+			Doc: routeDoc{Tag: "notifications", Summary: "List notifications", Query: []queryParam{offsetIDQuery, limitQuery, {"filter", "Comma separated list of notification types"}}}},
+		{Method: "POST", Pattern: "/ob/marknotificationasread/{notificationID}", Handler: (*jsonAPIHandler).POSTMarkNotificationAsRead,
+			Doc: routeDoc{Tag: "notifications", Summary: "Mark a notification as read"}},
+		{Method: "POST", Pattern: "/ob/marknotificationsasread", Handler: (*jsonAPIHandler).POSTMarkNotificationsAsRead,
+			Doc: routeDoc{Tag: "notifications", Summary: "Mark all notifications as read"}},
+		{Method: "DELETE", Pattern: "/ob/notifications/{notificationID}", Handler: (*jsonAPIHandler).DELETENotification,
+			Doc: routeDoc{Tag: "notifications", Summary: "Delete a notification"}},
+
+		// Wallet
+		{Method: "GET", Pattern: "/wallet/currencies", Handler: (*jsonAPIHandler).GETWalletCurrencyDictionary,
+			Doc: routeDoc{Tag: "wallet", Summary: "List all known currency definitions", Response: map[string]repo.CurrencyDefinition{}}},
+		{Method: "GET", Pattern: "/wallet/currencies/{currencyCode}", Handler: (*jsonAPIHandler).GETWalletCurrencyDictionary,
+			Doc: routeDoc{Tag: "wallet", Summary: "Get a currency definition", Response: map[string]repo.CurrencyDefinition{}}},
+		{Method: "GET", Pattern: "/wallet/address", Handler: (*jsonAPIHandler).GETAddress,
+			Doc: routeDoc{Tag: "wallet", Summary: "Get the current receiving address of every wallet", Response: map[string]string{}}},
+		{Method: "GET", Pattern: "/wallet/address/{coinType}", Handler: (*jsonAPIHandler).GETAddress,
+			Doc: routeDoc{Tag: "wallet", Summary: "Get the current receiving address of a wallet"}},
+		{Method: "GET", Pattern: "/wallet/mnemonic", Handler: (*jsonAPIHandler).GETMnemonic,
+			Doc: routeDoc{Tag: "wallet", Summary: "Get the wallet mnemonic seed"}},
+		{Method: "GET", Pattern: "/wallet/balance", Handler: (*jsonAPIHandler).GETBalance,
+			Doc: routeDoc{Tag: "wallet", Summary: "Get the balance of every wallet"}},
+		{Method: "GET", Pattern: "/wallet/balance/{coinType}", Handler: (*jsonAPIHandler).GETBalance,
+			Doc: routeDoc{Tag: "wallet", Summary: "Get the balance of a wallet"}},
+		{Method: "GET", Pattern: "/wallet/transactions/{coinType}", Handler: (*jsonAPIHandler).GETTransactions,
+			Doc: routeDoc{Tag: "wallet", Summary: "List the transactions of a wallet", Query: []queryParam{offsetIDQuery, limitQuery}}},
+		{Method: "GET", Pattern: "/wallet/status", Handler: (*jsonAPIHandler).GETWalletStatus,
+			Doc: routeDoc{Tag: "wallet", Summary: "Get the chain tip of every wallet"}},
+		{Method: "GET", Pattern: "/wallet/status/{coinType}", Handler: (*jsonAPIHandler).GETWalletStatus,
+			Doc: routeDoc{Tag: "wallet", Summary: "Get the chain tip of a wallet"}},
+		{Method: "GET", Pattern: "/wallet/estimatefee/{coinType}", Handler: (*jsonAPIHandler).GETEstimateFee,
+			Doc: routeDoc{Tag: "wallet", Summary: "Estimate the fee of a spend", Query: []queryParam{{"feeLevel", "PRIORITY, NORMAL, ECONOMIC or SUPER_ECONOMIC"}, {"amount", "Amount to spend"}}}},
+		{Method: "GET", Pattern: "/wallet/fees", Handler: (*jsonAPIHandler).GETFees,
+			Doc: routeDoc{Tag: "wallet", Summary: "Get the fee levels of every wallet"}},
+		{Method: "GET", Pattern: "/wallet/fees/{coinType}", Handler: (*jsonAPIHandler).GETFees,
+			Doc: routeDoc{Tag: "wallet", Summary: "Get the fee levels of a wallet"}},
+		{Method: "POST", Pattern: "/wallet/spend", Handler: (*jsonAPIHandler).POSTSpendCoins,
+			Doc: routeDoc{Tag: "wallet", Summary: "Send coins to an address", Request: core.SpendRequest{}, Response: core.SpendResponse{}}},
+		{Method: "GET", Pattern: "/wallet/pendingspends", Handler: (*jsonAPIHandler).GETPendingSpends,
+			Doc: routeDoc{Tag: "wallet", Summary: "List the spends awaiting approval", Response: []repo.PendingSpend{}}},
+		{Method: "POST", Pattern: "/wallet/approvespend", Handler: (*jsonAPIHandler).POSTApproveSpend,
+			Doc: routeDoc{Tag: "wallet", Summary: "Approve and send a pending spend", Request: spendApproval{}, Response: core.SpendResponse{}}},
+		{Method: "DELETE", Pattern: "/wallet/pendingspends/{id}", Handler: (*jsonAPIHandler).DELETEPendingSpend,
+			Doc: routeDoc{Tag: "wallet", Summary: "Cancel a pending spend"}},
+		{Method: "POST", Pattern: "/wallet/bumpfee/{txid}", Handler: (*jsonAPIHandler).POSTBumpFee,
+			Doc: routeDoc{Tag: "wallet", Summary: "Bump the fee of an unconfirmed transaction"}},
+		{Method: "POST", Pattern: "/wallet/resyncblockchain", Handler: (*jsonAPIHandler).POSTResyncBlockchain,
+			Doc: routeDoc{Tag: "wallet", Summary: "Rescan the blockchain for every wallet"}},
+		{Method: "POST", Pattern: "/wallet/resyncblockchain/{coinType}", Handler: (*jsonAPIHandler).POSTResyncBlockchain,
+			Doc: routeDoc{Tag: "wallet", Summary: "Rescan the blockchain for a wallet"}},
+		{Method: "GET", Pattern: "/ob/exchangerate/{coinType}", Handler: (*jsonAPIHandler).GETExchangeRate,
+			Doc: routeDoc{Tag: "wallet", Summary: "Get all exchange rates of a coin", Response: map[string]float64{}}},
+		{Method: "GET", Pattern: "/ob/exchangerate/{coinType}/{currencyCode}", Handler: (*jsonAPIHandler).GETExchangeRate,
+			Doc: routeDoc{Tag: "wallet", Summary: "Get the exchange rate of a coin for a currency"}},
+	}
+}
@@ -13,9 +13,12 @@ var log = logging.MustGetLogger("api")
 
 // Gateway represents an HTTP API gateway
 type Gateway struct {
-	listener net.Listener
-	handler  http.Handler
-	config   schema.APIConfig
+	listener      net.Listener
+	handler       http.Handler
+	config        schema.APIConfig
+	node          *core.OpenBazaarNode
+	authCookie    http.Cookie
+	notifications *notificationStreams
 }
 
 // NewGateway instantiates a new `Gateway`
@@ -26,10 +29,19 @@ func NewGateway(n *core.OpenBazaarNode, authCookie http.Cookie, l net.Listener,
 
 	jsonAPI := newJSONAPIHandler(n, authCookie, config)
 	wsAPI := newWSAPIHandler(n, authCookie, config)
-	n.Broadcast = manageNotifications(n, wsAPI.h.Broadcast)
+	notifications := newNotificationStreams()
+	n.Broadcast = manageNotifications(n, wsAPI.h.Broadcast, notifications)
 
-	topMux.Handle("/ob/", jsonAPI)
-	topMux.Handle("/wallet/", jsonAPI)
+	// Only the public gateway endpoints are served while the API is
+	// disabled. These are rate limited as they are open to anyone.
+	var apiHandler http.Handler = jsonAPI
+	if !config.Enabled {
+		apiHandler = newGatewayLimiter(config.PublicGateway, n.IPFSIdentityString()).wrap(jsonAPI)
+	}
+
+	topMux.Handle("/ob/", apiHandler)
+	topMux.Handle("/wallet/", apiHandler)
+	topMux.Handle(apiVersionPrefix+"/", apiHandler)
 	topMux.Handle("/ws", wsAPI)
 
 	var (
@@ -44,9 +56,12 @@ func NewGateway(n *core.OpenBazaarNode, authCookie http.Cookie, l net.Listener,
 	}
 
 	return &Gateway{
-		listener: l,
-		handler:  topMux,
-		config:   config,
+		listener:      l,
+		handler:       topMux,
+		config:        config,
+		node:          n,
+		authCookie:    authCookie,
+		notifications: notifications,
 	}, nil
 }
 
@@ -0,0 +1,137 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCServer serves the gRPC API. It shares the TLS, authentication and
+// allowed IP settings of the JSON API.
+type GRPCServer struct {
+	listener net.Listener
+	server   *grpc.Server
+}
+
+// grpcAPI implements the gRPC services defined in pb/protos/rpc.proto
+type grpcAPI struct {
+	config        JSONAPIConfig
+	node          *core.OpenBazaarNode
+	notifications *notificationStreams
+}
+
+// NewGRPCServer instantiates a new `GRPCServer` for the node served by the
+// gateway. Notifications are streamed from the gateway's broadcast channel.
+func NewGRPCServer(g *Gateway, l net.Listener) (*GRPCServer, error) {
+	api := &grpcAPI{
+		config:        newJSONAPIConfig(g.authCookie, g.config),
+		node:          g.node,
+		notifications: g.notifications,
+	}
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(api.unaryInterceptor),
+		grpc.StreamInterceptor(api.streamInterceptor),
+	}
+	if g.config.SSL {
+		creds, err := credentials.NewServerTLSFromFile(g.config.SSLCert, g.config.SSLKey)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+
+	server := grpc.NewServer(opts...)
+	pb.RegisterListingServiceServer(server, api)
+	pb.RegisterOrderServiceServer(server, api)
+	pb.RegisterCaseServiceServer(server, api)
+	pb.RegisterChatServiceServer(server, api)
+	pb.RegisterWalletServiceServer(server, api)
+	pb.RegisterNotificationServiceServer(server, api)
+
+	return &GRPCServer{
+		listener: l,
+		server:   server,
+	}, nil
+}
+
+// Serve begins accepting connections on the listener
+func (g *GRPCServer) Serve() error {
+	return g.server.Serve(g.listener)
+}
+
+// Close stops the server and closes all open connections
+func (g *GRPCServer) Close() error {
+	log.Infof("gRPC server at %s terminating...", g.listener.Addr())
+	g.server.Stop()
+	return nil
+}
+
+func (a *grpcAPI) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.authorize(ctx); err != nil {
+		log.Errorf("refused gRPC call %s: %s", info.FullMethod, err)
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *grpcAPI) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.authorize(ss.Context()); err != nil {
+		log.Errorf("refused gRPC stream %s: %s", info.FullMethod, err)
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// authorize applies the JSON API access rules to the call. Credentials are
+// read from the `cookie` and `authorization` metadata using the same formats
+// as the corresponding HTTP headers.
+func (a *grpcAPI) authorize(ctx context.Context) error {
+	if !a.config.Enabled {
+		return status.Error(codes.PermissionDenied, "api is disabled")
+	}
+	if len(a.config.AllowedIPs) > 0 {
+		p, ok := peer.FromContext(ctx)
+		if !ok {
+			return status.Error(codes.PermissionDenied, "unknown remote address")
+		}
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil || !a.config.AllowedIPs[host] {
+			return status.Error(codes.PermissionDenied, "remote address not allowed")
+		}
+	}
+	if !a.config.Authenticated {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	r := &http.Request{Header: http.Header{
+		"Cookie":        md.Get("cookie"),
+		"Authorization": md.Get("authorization"),
+	}}
+	if a.config.Username == "" || a.config.Password == "" {
+		cookie, err := r.Cookie("OpenBazaar_Auth_Cookie")
+		if err != nil || a.config.Cookie.Value != cookie.Value {
+			return status.Error(codes.Unauthenticated, "invalid cookie")
+		}
+		return nil
+	}
+	username, password, ok := r.BasicAuth()
+	h := sha256.Sum256([]byte(password))
+	password = hex.EncodeToString(h[:])
+	if !ok || username != a.config.Username || !strings.EqualFold(password, a.config.Password) {
+		return status.Error(codes.Unauthenticated, "invalid username and/or password")
+	}
+	return nil
+}
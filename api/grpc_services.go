@@ -0,0 +1,566 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"path"
+	"time"
+
+	ipnspath "gx/ipfs/QmQAgv6Gaoe2tQpcabqwKXKChp2MZ7i3UXv9DqTTaxCaTR/go-path"
+	cid "gx/ipfs/QmTbxNB1NwDesLmKTscr4udL2tVP7MaxvXnD1D9yX7g3PN/go-cid"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (a *grpcAPI) GetListings(ctx context.Context, req *pb.ListingsRequest) (*pb.ListingIndex, error) {
+	var (
+		index []byte
+		err   error
+	)
+	if req.PeerID == "" || req.PeerID == a.node.IPFSIdentityString() {
+		index, err = a.node.GetListings()
+	} else {
+		index, err = ipfs.ResolveThenCat(a.node.IpfsNode, ipnspath.FromString(path.Join(req.PeerID, "listings.json")), time.Minute, a.node.IPNSQuorumSize, req.UseCache)
+	}
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	listings, err := repo.UnmarshalJSONSignedListingIndex(index)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to parse listing index: %s", err)
+	}
+
+	resp := new(pb.ListingIndex)
+	for _, l := range listings {
+		resp.Listings = append(resp.Listings, &pb.ListingIndex_Item{
+			Hash:         l.Hash,
+			Slug:         l.Slug,
+			Title:        l.Title,
+			Categories:   l.Categories,
+			Nsfw:         l.NSFW,
+			ContractType: l.ContractType,
+			Description:  l.Description,
+			Thumbnail: &pb.ListingIndex_Thumbnail{
+				Tiny:   l.Thumbnail.Tiny,
+				Small:  l.Thumbnail.Small,
+				Medium: l.Thumbnail.Medium,
+			},
+			Price:              currencyValueProto(l.Price),
+			Modifier:           l.Modifier,
+			ShipsTo:            l.ShipsTo,
+			FreeShipping:       l.FreeShipping,
+			Language:           l.Language,
+			AverageRating:      l.AverageRating,
+			RatingCount:        l.RatingCount,
+			Moderators:         l.ModeratorIDs,
+			AcceptedCurrencies: l.AcceptedCurrencies,
+			CoinType:           l.CryptoCurrencyCode,
+		})
+	}
+	return resp, nil
+}
+
+func (a *grpcAPI) GetListing(ctx context.Context, req *pb.ListingRequest) (*pb.SignedListing, error) {
+	var (
+		sl        *pb.SignedListing
+		_, cidErr = cid.Decode(req.ListingID)
+		isHash    = cidErr == nil
+		err       error
+	)
+	if req.PeerID == "" || req.PeerID == a.node.IPFSIdentityString() {
+		if isHash {
+			sl, err = a.node.GetListingFromHash(req.ListingID)
+			if err != nil {
+				return nil, status.Error(codes.NotFound, "Listing not found.")
+			}
+			sl.Hash = req.ListingID
+		} else {
+			sl, err = a.node.GetListingFromSlug(req.ListingID)
+			if err != nil {
+				return nil, status.Error(codes.NotFound, "Listing not found.")
+			}
+			sl.Hash, err = ipfs.GetHashOfFile(a.node.IpfsNode, path.Join(a.node.RepoPath, "root", "listings", req.ListingID+".json"))
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+		rsl := repo.NewSignedListingFromProtobuf(sl)
+		if err := rsl.GetListing().UpdateCouponsFromDatastore(a.node.Datastore.Coupons()); err != nil {
+			log.Warningf("updating coupons for listing (%s): %s", rsl.GetSlug(), err.Error())
+		}
+		if err := rsl.Normalize(); err != nil {
+			return nil, status.Errorf(codes.Internal, "normalizing listing: %s", err)
+		}
+		return sl, nil
+	}
+
+	var (
+		listingBytes []byte
+		hash         string
+	)
+	if isHash {
+		listingBytes, err = ipfs.Cat(a.node.IpfsNode, req.ListingID, time.Minute)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		hash = req.ListingID
+	} else {
+		listingBytes, err = ipfs.ResolveThenCat(a.node.IpfsNode, ipnspath.FromString(path.Join(req.PeerID, "listings", req.ListingID+".json")), time.Minute, a.node.IPNSQuorumSize, req.UseCache)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		hash, err = ipfs.GetHash(a.node.IpfsNode, bytes.NewReader(listingBytes))
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	sl = new(pb.SignedListing)
+	if err := jsonpb.UnmarshalString(string(listingBytes), sl); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	sl.Hash = hash
+	rsl := repo.NewSignedListingFromProtobuf(sl)
+	if err := rsl.Normalize(); err != nil {
+		return nil, status.Errorf(codes.Internal, "normalizing listing: %s", err)
+	}
+	return sl, nil
+}
+
+func (a *grpcAPI) CreateListing(ctx context.Context, listing *pb.Listing) (*pb.ListingSlug, error) {
+	listingData, err := new(jsonpb.Marshaler).MarshalToString(listing)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	slug, err := a.node.CreateListing([]byte(listingData))
+	if err != nil {
+		if err == repo.ErrListingAlreadyExists {
+			return nil, status.Error(codes.AlreadyExists, "Listing already exists. Use UpdateListing.")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.ListingSlug{Slug: slug}, nil
+}
+
+func (a *grpcAPI) UpdateListing(ctx context.Context, listing *pb.Listing) (*empty.Empty, error) {
+	listingData, err := new(jsonpb.Marshaler).MarshalToString(listing)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := a.node.UpdateListing([]byte(listingData), true); err != nil {
+		if err == repo.ErrListingDoesNotExist {
+			return nil, status.Error(codes.NotFound, "Listing not found.")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return new(empty.Empty), nil
+}
+
+func (a *grpcAPI) DeleteListing(ctx context.Context, req *pb.ListingSlug) (*empty.Empty, error) {
+	if _, err := a.node.GetListingFromSlug(req.Slug); err != nil {
+		return nil, status.Error(codes.NotFound, "Listing not found.")
+	}
+	if err := a.node.DeleteListing(req.Slug); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := a.node.UpdateFollow(); err != nil {
+		return nil, status.Error(codes.Internal, "File Write Error: "+err.Error())
+	}
+	if err := a.node.SeedNode(); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return new(empty.Empty), nil
+}
+
+func (a *grpcAPI) GetOrder(ctx context.Context, req *pb.OrderID) (*pb.OrderRespApi, error) {
+	resp, err := a.node.GetOrder(req.OrderID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "Order not found")
+	}
+	return resp, nil
+}
+
+func (a *grpcAPI) GetSales(ctx context.Context, req *pb.OrderQuery) (*pb.OrderList, error) {
+	sales, queryCount, err := a.node.Datastore.Sales().GetAll(req.States, req.SearchTerm, req.SortByAscending, req.SortByRead, orderQueryLimit(req), req.Exclude)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.OrderList{QueryCount: uint64(queryCount)}
+	for _, s := range sales {
+		resp.Orders = append(resp.Orders, &pb.OrderList_Order{
+			OrderID:            s.OrderId,
+			Slug:               s.Slug,
+			Timestamp:          timestampProto(s.Timestamp),
+			Title:              s.Title,
+			Thumbnail:          s.Thumbnail,
+			Total:              currencyValueProto(&s.Total),
+			PeerID:             s.BuyerId,
+			Handle:             s.BuyerHandle,
+			ShippingName:       s.ShippingName,
+			ShippingAddress:    s.ShippingAddress,
+			CoinType:           s.CoinType,
+			PaymentCoin:        s.PaymentCoin,
+			State:              pb.OrderState(pb.OrderState_value[s.State]),
+			Read:               s.Read,
+			Moderated:          s.Moderated,
+			UnreadChatMessages: a.unreadChatMessages(s.OrderId),
+		})
+	}
+	return resp, nil
+}
+
+func (a *grpcAPI) GetPurchases(ctx context.Context, req *pb.OrderQuery) (*pb.OrderList, error) {
+	purchases, queryCount, err := a.node.Datastore.Purchases().GetAll(req.States, req.SearchTerm, req.SortByAscending, req.SortByRead, orderQueryLimit(req), req.Exclude)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.OrderList{QueryCount: uint64(queryCount)}
+	for _, p := range purchases {
+		resp.Orders = append(resp.Orders, &pb.OrderList_Order{
+			OrderID:            p.OrderId,
+			Slug:               p.Slug,
+			Timestamp:          timestampProto(p.Timestamp),
+			Title:              p.Title,
+			Thumbnail:          p.Thumbnail,
+			Total:              currencyValueProto(&p.Total),
+			PeerID:             p.VendorId,
+			Handle:             p.VendorHandle,
+			ShippingName:       p.ShippingName,
+			ShippingAddress:    p.ShippingAddress,
+			CoinType:           p.CoinType,
+			PaymentCoin:        p.PaymentCoin,
+			State:              pb.OrderState(pb.OrderState_value[p.State]),
+			Read:               p.Read,
+			Moderated:          p.Moderated,
+			UnreadChatMessages: a.unreadChatMessages(p.OrderId),
+		})
+	}
+	return resp, nil
+}
+
+func (a *grpcAPI) ConfirmOrder(ctx context.Context, req *pb.OrderConfirmationRequest) (*empty.Empty, error) {
+	contract, state, funded, records, _, _, err := a.node.Datastore.Sales().GetByOrderId(req.OrderID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if state != pb.OrderState_PENDING {
+		return nil, status.Error(codes.FailedPrecondition, "order has already been confirmed")
+	}
+	if !funded && !req.Reject {
+		return nil, status.Error(codes.FailedPrecondition, "payment address must be funded before confirmation")
+	}
+	if req.Reject {
+		err = a.node.RejectOfflineOrder(contract, records)
+	} else {
+		err = a.node.ConfirmOfflineOrder(state, contract, records)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return new(empty.Empty), nil
+}
+
+func (a *grpcAPI) FulfillOrder(ctx context.Context, fulfill *pb.OrderFulfillment) (*empty.Empty, error) {
+	contract, state, _, records, _, _, err := a.node.Datastore.Sales().GetByOrderId(fulfill.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+	if state != pb.OrderState_AWAITING_FULFILLMENT && state != pb.OrderState_PARTIALLY_FULFILLED {
+		return nil, status.Error(codes.FailedPrecondition, "order must be in state AWAITING_FULFILLMENT or PARTIALLY_FULFILLED to fulfill")
+	}
+	if err := a.node.FulfillOrder(fulfill, contract, records); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return new(empty.Empty), nil
+}
+
+func (a *grpcAPI) CancelOrder(ctx context.Context, req *pb.OrderID) (*empty.Empty, error) {
+	contract, state, _, records, _, _, err := a.node.Datastore.Purchases().GetByOrderId(req.OrderID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+	if !(state == pb.OrderState_PENDING || state == pb.OrderState_PROCESSING_ERROR) || len(records) == 0 || contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
+		return nil, status.Error(codes.FailedPrecondition, "order must be PENDING or PROCESSING_ERROR and only a direct payment to cancel")
+	}
+	if err := a.node.CancelOfflineOrder(contract, records); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return new(empty.Empty), nil
+}
+
+func (a *grpcAPI) GetCase(ctx context.Context, req *pb.OrderID) (*pb.CaseRespApi, error) {
+	resp, err := getCase(a.node, req.OrderID)
+	if err != nil {
+		if err == errCaseNotFound {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := a.node.Datastore.Cases().MarkAsRead(req.OrderID); err != nil {
+		log.Error(err)
+	}
+	return resp, nil
+}
+
+func (a *grpcAPI) GetCases(ctx context.Context, req *pb.OrderQuery) (*pb.CaseList, error) {
+	cases, queryCount, err := a.node.Datastore.Cases().GetAll(req.States, req.SearchTerm, req.SortByAscending, req.SortByRead, orderQueryLimit(req), req.Exclude)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &pb.CaseList{QueryCount: uint64(queryCount)}
+	for _, c := range cases {
+		resp.Cases = append(resp.Cases, &pb.CaseList_Case{
+			CaseID:             c.CaseId,
+			Slug:               c.Slug,
+			Timestamp:          timestampProto(c.Timestamp),
+			Title:              c.Title,
+			Thumbnail:          c.Thumbnail,
+			Total:              currencyValueProto(&c.Total),
+			BuyerID:            c.BuyerId,
+			BuyerHandle:        c.BuyerHandle,
+			VendorID:           c.VendorId,
+			VendorHandle:       c.VendorHandle,
+			CoinType:           c.CoinType,
+			PaymentCoin:        c.PaymentCoin,
+			BuyerOpened:        c.BuyerOpened,
+			State:              pb.OrderState(pb.OrderState_value[c.State]),
+			Read:               c.Read,
+			UnreadChatMessages: a.unreadChatMessages(c.CaseId),
+		})
+	}
+	return resp, nil
+}
+
+func (a *grpcAPI) CloseDispute(ctx context.Context, req *pb.CloseDisputeRequest) (*empty.Empty, error) {
+	disputeCase, err := a.node.Datastore.Cases().GetByCaseID(req.OrderID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	err = a.node.CloseDispute(disputeCase.CaseID, req.BuyerPercentage, req.VendorPercentage, req.Resolution, disputeCase.PaymentCoin)
+	switch err {
+	case nil:
+		return new(empty.Empty), nil
+	case core.ErrCaseNotFound:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case core.ErrCloseFailureCaseExpired:
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+}
+
+func (a *grpcAPI) SendMessage(ctx context.Context, req *pb.ChatMessageRequest) (*pb.ChatMessageID, error) {
+	msgID, err := sendChatMessage(a.node, req.PeerID, req.Subject, req.Message)
+	if err != nil {
+		if err == errChatSubjectTooLong || err == errChatMessageTooLong {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &pb.ChatMessageID{MessageID: msgID}, nil
+}
+
+func (a *grpcAPI) GetMessages(ctx context.Context, req *pb.ChatMessagesRequest) (*pb.ChatMessageList, error) {
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = -1
+	}
+	resp := new(pb.ChatMessageList)
+	for _, m := range a.node.Datastore.Chat().GetMessages(req.PeerID, req.Subject, req.OffsetID, limit) {
+		msg := &pb.ChatMessageList_Message{
+			MessageID: m.MessageId,
+			PeerID:    m.PeerId,
+			Subject:   m.Subject,
+			Message:   m.Message,
+			Read:      m.Read,
+			Outgoing:  m.Outgoing,
+		}
+		if m.Timestamp != nil {
+			msg.Timestamp = timestampProto(m.Timestamp.Time)
+		}
+		resp.Messages = append(resp.Messages, msg)
+	}
+	return resp, nil
+}
+
+func (a *grpcAPI) GetConversations(ctx context.Context, _ *empty.Empty) (*pb.ChatConversationList, error) {
+	resp := new(pb.ChatConversationList)
+	for _, c := range a.node.Datastore.Chat().GetConversations() {
+		conversation := &pb.ChatConversationList_Conversation{
+			PeerID:      c.PeerId,
+			Unread:      uint32(c.Unread),
+			LastMessage: c.Last,
+			Outgoing:    c.Outgoing,
+		}
+		if c.Timestamp != nil {
+			conversation.Timestamp = timestampProto(c.Timestamp.Time)
+		}
+		resp.Conversations = append(resp.Conversations, conversation)
+	}
+	return resp, nil
+}
+
+func (a *grpcAPI) MarkAsRead(ctx context.Context, req *pb.ChatMarkAsReadRequest) (*empty.Empty, error) {
+	lastID, updated, err := a.node.Datastore.Chat().MarkAsRead(req.PeerID, req.Subject, false, "")
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if updated && req.PeerID != "" {
+		chatPb := &pb.Chat{
+			MessageId: lastID,
+			Subject:   req.Subject,
+			Flag:      pb.Chat_READ,
+		}
+		if err := a.node.SendChat(req.PeerID, chatPb); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	if req.Subject != "" {
+		if err := a.node.Datastore.Purchases().MarkAsRead(req.Subject); err != nil {
+			log.Error(err)
+		}
+		if err := a.node.Datastore.Sales().MarkAsRead(req.Subject); err != nil {
+			log.Error(err)
+		}
+		if err := a.node.Datastore.Cases().MarkAsRead(req.Subject); err != nil {
+			log.Error(err)
+		}
+	}
+	return new(empty.Empty), nil
+}
+
+func (a *grpcAPI) GetBalance(ctx context.Context, req *pb.CoinRequest) (*pb.WalletBalance, error) {
+	wal, err := a.node.Multiwallet.WalletForCurrencyCode(req.Coin)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "unknown wallet type")
+	}
+	defn, err := a.node.LookupCurrency(req.Coin)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	height, _ := wal.ChainTip()
+	confirmed, unconfirmed := wal.Balance()
+	return &pb.WalletBalance{
+		Currency:    currencyDefinitionProto(&defn),
+		Confirmed:   confirmed.Value.String(),
+		Unconfirmed: unconfirmed.Value.String(),
+		Height:      height,
+	}, nil
+}
+
+func (a *grpcAPI) GetAddress(ctx context.Context, req *pb.CoinRequest) (*pb.WalletAddress, error) {
+	wal, err := a.node.Multiwallet.WalletForCurrencyCode(req.Coin)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "unknown wallet type")
+	}
+	return &pb.WalletAddress{Address: wal.CurrentAddress(wallet.EXTERNAL).String()}, nil
+}
+
+func (a *grpcAPI) Spend(ctx context.Context, req *pb.SpendRequest) (*pb.SpendResponse, error) {
+	result, err := a.node.Spend(&core.SpendRequest{
+		Amount:                 req.Amount,
+		CurrencyCode:           req.CurrencyCode,
+		Address:                req.Address,
+		FeeLevel:               req.FeeLevel,
+		Memo:                   req.Memo,
+		OrderID:                req.OrderID,
+		RequireAssociatedOrder: req.RequireOrder,
+		SpendAll:               req.SpendAll,
+	})
+	if pending, ok := err.(core.ErrSpendPendingApproval); ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s: %s", err, pending.Spend.ID)
+	}
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &pb.SpendResponse{
+		Txid:               result.Txid,
+		Amount:             result.Amount,
+		ConfirmedBalance:   result.ConfirmedBalance,
+		UnconfirmedBalance: result.UnconfirmedBalance,
+		Currency:           currencyDefinitionProto(result.Currency),
+		Memo:               result.Memo,
+		OrderID:            result.OrderID,
+		Timestamp:          timestampProto(result.Timestamp),
+	}, nil
+}
+
+func (a *grpcAPI) Subscribe(_ *empty.Empty, stream pb.NotificationService_SubscribeServer) error {
+	notifications := a.notifications.subscribe()
+	defer a.notifications.unsubscribe(notifications)
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case n, ok := <-notifications:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "notification stream fell behind")
+			}
+			data, err := n.WebsocketData()
+			if err != nil {
+				log.Error("marshal notification:", err)
+				continue
+			}
+			err = stream.Send(&pb.Notification{
+				Id:   n.GetID(),
+				Type: string(n.GetType()),
+				Data: data,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (a *grpcAPI) unreadChatMessages(orderID string) uint64 {
+	unread, err := a.node.Datastore.Chat().GetUnreadCount(orderID)
+	if err != nil {
+		return 0
+	}
+	return uint64(unread)
+}
+
+func orderQueryLimit(q *pb.OrderQuery) int {
+	if q.Limit == 0 {
+		return -1
+	}
+	return int(q.Limit)
+}
+
+func timestampProto(t time.Time) *timestamp.Timestamp {
+	ts, err := ptypes.TimestampProto(t)
+	if err != nil {
+		return nil
+	}
+	return ts
+}
+
+func currencyDefinitionProto(def *repo.CurrencyDefinition) *pb.CurrencyDefinition {
+	if def == nil {
+		return nil
+	}
+	return &pb.CurrencyDefinition{
+		Code:         def.Code.String(),
+		Divisibility: uint32(def.Divisibility),
+	}
+}
+
+func currencyValueProto(v *repo.CurrencyValue) *pb.CurrencyValue {
+	if v == nil {
+		return nil
+	}
+	return &pb.CurrencyValue{
+		Currency: currencyDefinitionProto(&v.Currency),
+		Amount:   v.AmountString(),
+	}
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newTestGRPCClient(t *testing.T) (*grpc.ClientConn, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, err := NewGRPCServer(testGateway, listener)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go server.Serve()
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn, func() {
+		conn.Close()
+		server.Close()
+	}
+}
+
+func authenticatedContext(ctx context.Context, username, password string) context.Context {
+	auth := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Basic "+auth)
+}
+
+func TestGRPCAuthentication(t *testing.T) {
+	conn, closeConn := newTestGRPCClient(t)
+	defer closeConn()
+	client := pb.NewChatServiceClient(conn)
+
+	_, err := client.GetConversations(context.Background(), new(empty.Empty))
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected unauthenticated error, got %v", err)
+	}
+	_, err = client.GetConversations(authenticatedContext(context.Background(), "test", "wrong"), new(empty.Empty))
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("expected unauthenticated error, got %v", err)
+	}
+	_, err = client.GetConversations(authenticatedContext(context.Background(), "test", "test"), new(empty.Empty))
+	if err != nil {
+		t.Errorf("expected authenticated call to succeed, got %v", err)
+	}
+}
+
+func TestGRPCCaseNotFound(t *testing.T) {
+	conn, closeConn := newTestGRPCClient(t)
+	defer closeConn()
+
+	ctx := authenticatedContext(context.Background(), "test", "test")
+	_, err := pb.NewCaseServiceClient(conn).GetCase(ctx, &pb.OrderID{OrderID: "QmUnknownCase"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestGRPCNotificationStream(t *testing.T) {
+	conn, closeConn := newTestGRPCClient(t)
+	defer closeConn()
+
+	ctx, cancel := context.WithTimeout(authenticatedContext(context.Background(), "test", "test"), 10*time.Second)
+	defer cancel()
+	stream, err := pb.NewNotificationServiceClient(conn).Subscribe(ctx, new(empty.Empty))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	received := make(chan *pb.Notification)
+	go func() {
+		n, err := stream.Recv()
+		if err != nil {
+			t.Error(err)
+			close(received)
+			return
+		}
+		received <- n
+	}()
+
+	// The subscription is registered asynchronously so keep broadcasting
+	// until the notification arrives
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case n, ok := <-received:
+			if !ok {
+				return
+			}
+			if n.Type != string(repo.NotifierTypeStatusUpdateNotification) {
+				t.Errorf("unexpected notification type %s", n.Type)
+			}
+			if string(n.Data) != "{\n    \"status\": \"testing\"\n}" {
+				t.Errorf("unexpected notification data %s", n.Data)
+			}
+			return
+		case <-ticker.C:
+			testGateway.node.Broadcast <- repo.StatusNotification{Status: "testing"}
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for notification")
+		}
+	}
+}
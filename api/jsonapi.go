@@ -7,6 +7,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/big"
@@ -62,8 +63,10 @@ type JSONAPIConfig struct {
 }
 
 type jsonAPIHandler struct {
-	config JSONAPIConfig
-	node   *core.OpenBazaarNode
+	config  JSONAPIConfig
+	node    *core.OpenBazaarNode
+	router  *apiRouter
+	metrics http.Handler
 }
 
 type APIError struct {
@@ -75,23 +78,29 @@ var lastManualScan time.Time
 
 const OfflineMessageScanInterval = 1 * time.Minute
 
-func newJSONAPIHandler(node *core.OpenBazaarNode, authCookie http.Cookie, config schema.APIConfig) *jsonAPIHandler {
+func newJSONAPIConfig(authCookie http.Cookie, config schema.APIConfig) JSONAPIConfig {
 	allowedIPs := make(map[string]bool)
 	for _, ip := range config.AllowedIPs {
 		allowedIPs[ip] = true
 	}
+	return JSONAPIConfig{
+		Enabled:       config.Enabled,
+		Cors:          config.CORS,
+		Headers:       config.HTTPHeaders,
+		Authenticated: config.Authenticated,
+		AllowedIPs:    allowedIPs,
+		Cookie:        authCookie,
+		Username:      config.Username,
+		Password:      config.Password,
+	}
+}
+
+func newJSONAPIHandler(node *core.OpenBazaarNode, authCookie http.Cookie, config schema.APIConfig) *jsonAPIHandler {
 	i := &jsonAPIHandler{
-		config: JSONAPIConfig{
-			Enabled:       config.Enabled,
-			Cors:          config.CORS,
-			Headers:       config.HTTPHeaders,
-			Authenticated: config.Authenticated,
-			AllowedIPs:    allowedIPs,
-			Cookie:        authCookie,
-			Username:      config.Username,
-			Password:      config.Password,
-		},
-		node: node,
+		config:  newJSONAPIConfig(authCookie, config),
+		node:    node,
+		router:  newAPIRouter(v1Routes()),
+		metrics: newMetricsHandler(node),
 	}
 	return i
 }
@@ -102,16 +111,21 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		log.Error(err)
 		return
 	}
-	if !i.config.Enabled && !gatewayAllowedPath(u.Path, r.Method) {
-		w.WriteHeader(http.StatusForbidden)
-		fmt.Fprint(w, "403 - Forbidden")
-		return
+	versioned := strings.HasPrefix(u.Path, apiVersionPrefix+"/")
+	if !i.config.Enabled {
+		allowed := gatewayAllowedPath(u.Path, r.Method)
+		if versioned {
+			allowed = i.router.gatewayAllowed(r.Method, u.Path)
+		}
+		if !allowed {
+			forbiddenResponse(w, versioned)
+			return
+		}
 	}
 	if len(i.config.AllowedIPs) > 0 {
 		remoteAddr := strings.Split(r.RemoteAddr, ":")
 		if !i.config.AllowedIPs[remoteAddr[0]] {
-			w.WriteHeader(http.StatusForbidden)
-			fmt.Fprint(w, "403 - Forbidden")
+			forbiddenResponse(w, versioned)
 			return
 		}
 	}
@@ -130,13 +144,11 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		if i.config.Username == "" || i.config.Password == "" {
 			cookie, err := r.Cookie("OpenBazaar_Auth_Cookie")
 			if err != nil {
-				w.WriteHeader(http.StatusForbidden)
-				fmt.Fprint(w, "403 - Forbidden")
+				forbiddenResponse(w, versioned)
 				return
 			}
 			if i.config.Cookie.Value != cookie.Value {
-				w.WriteHeader(http.StatusForbidden)
-				fmt.Fprint(w, "403 - Forbidden")
+				forbiddenResponse(w, versioned)
 				return
 			}
 		} else {
@@ -151,8 +163,7 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			h := sha256.Sum256([]byte(password))
 			password = hex.EncodeToString(h[:])
 			if !ok || username != i.config.Username || !strings.EqualFold(password, i.config.Password) {
-				w.WriteHeader(http.StatusForbidden)
-				fmt.Fprint(w, "403 - Forbidden")
+				forbiddenResponse(w, versioned)
 				return
 			}
 		}
@@ -177,7 +188,15 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}()
 
+	rec := &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+	defer observeRequest(r.Method, i.routeLabel(r.Method, u.Path), rec, time.Now())
+	w = rec
+
 	w.Header().Add("Content-Type", "application/json")
+	if versioned {
+		i.router.serve(i, u.Path, w, r)
+		return
+	}
 	switch r.Method {
 	case "GET":
 		get(i, u.String(), w, r)
@@ -194,6 +213,17 @@ func (i *jsonAPIHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// forbiddenResponse rejects the request. Requests to the versioned API receive
+// the same JSON error body as any other error.
+func forbiddenResponse(w http.ResponseWriter, versioned bool) {
+	if versioned {
+		ErrorResponse(w, http.StatusForbidden, "Forbidden")
+		return
+	}
+	w.WriteHeader(http.StatusForbidden)
+	fmt.Fprint(w, "403 - Forbidden")
+}
+
 func ErrorResponse(w http.ResponseWriter, errorCode int, reason string) {
 	reason = strings.Replace(reason, `"`, `'`, -1)
 	err := APIError{false, reason}
@@ -409,12 +439,13 @@ func (i *jsonAPIHandler) PATCHProfile(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, `{}`)
 }
 
+type avatarRequest struct {
+	Avatar string `json:"avatar"`
+}
+
 func (i *jsonAPIHandler) POSTAvatar(w http.ResponseWriter, r *http.Request) {
-	type ImgData struct {
-		Avatar string `json:"avatar"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	data := new(ImgData)
+	data := new(avatarRequest)
 	err := decoder.Decode(&data)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -446,12 +477,13 @@ func (i *jsonAPIHandler) POSTAvatar(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, string(jsonHashes))
 }
 
+type headerRequest struct {
+	Header string `json:"header"`
+}
+
 func (i *jsonAPIHandler) POSTHeader(w http.ResponseWriter, r *http.Request) {
-	type ImgData struct {
-		Header string `json:"header"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	data := new(ImgData)
+	data := new(headerRequest)
 	err := decoder.Decode(&data)
 
 	if err != nil {
@@ -483,30 +515,32 @@ func (i *jsonAPIHandler) POSTHeader(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, string(jsonHashes))
 }
 
+type imageRequest struct {
+	Filename string `json:"filename"`
+	Image    string `json:"image"`
+}
+
+type imageResponse struct {
+	Filename string           `json:"filename"`
+	Hashes   pb.Profile_Image `json:"hashes"`
+}
+
 func (i *jsonAPIHandler) POSTImage(w http.ResponseWriter, r *http.Request) {
-	type ImgData struct {
-		Filename string `json:"filename"`
-		Image    string `json:"image"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var images []ImgData
+	var images []imageRequest
 	err := decoder.Decode(&images)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	type retImage struct {
-		Filename string           `json:"filename"`
-		Hashes   pb.Profile_Image `json:"hashes"`
-	}
-	var retData []retImage
+	var retData []imageResponse
 	for _, img := range images {
 		hashes, err := i.node.SetProductImages(img.Image, img.Filename)
 		if err != nil {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
-		rtimg := retImage{img.Filename, *hashes}
+		rtimg := imageResponse{img.Filename, *hashes}
 		retData = append(retData, rtimg)
 	}
 	jsonHashes, err := json.MarshalIndent(retData, "", "    ")
@@ -635,13 +669,13 @@ func (i *jsonAPIHandler) GETPeers(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, string(peerJSON))
 }
 
-func (i *jsonAPIHandler) POSTFollow(w http.ResponseWriter, r *http.Request) {
-	type PeerID struct {
-		ID string `json:"id"`
-	}
+type followRequest struct {
+	ID string `json:"id"`
+}
 
+func (i *jsonAPIHandler) POSTFollow(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
-	var pid PeerID
+	var pid followRequest
 	err := decoder.Decode(&pid)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -655,11 +689,8 @@ func (i *jsonAPIHandler) POSTFollow(w http.ResponseWriter, r *http.Request) {
 }
 
 func (i *jsonAPIHandler) POSTUnfollow(w http.ResponseWriter, r *http.Request) {
-	type PeerID struct {
-		ID string `json:"id"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var pid PeerID
+	var pid followRequest
 	err := decoder.Decode(&pid)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -811,6 +842,10 @@ func (i *jsonAPIHandler) POSTSpendCoinsForOrder(w http.ResponseWriter, r *http.R
 
 	spendArgs.RequireAssociatedOrder = true
 	result, err := i.node.Spend(&spendArgs)
+	if pending, ok := err.(core.ErrSpendPendingApproval); ok {
+		pendingSpendResponse(w, pending.Spend)
+		return
+	}
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
@@ -840,11 +875,80 @@ func (i *jsonAPIHandler) POSTSpendCoins(w http.ResponseWriter, r *http.Request)
 	}
 
 	result, err := i.node.Spend(&spendArgs)
+	if pending, ok := err.(core.ErrSpendPendingApproval); ok {
+		pendingSpendResponse(w, pending.Spend)
+		return
+	}
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ser, err := json.MarshalIndent(result, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
+// pendingSpendResponse tells the client the spend is held until approved
+func pendingSpendResponse(w http.ResponseWriter, spend repo.PendingSpend) {
+	ser, err := json.MarshalIndent(spend, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+	SanitizedResponse(w, string(ser))
+}
+
+func (i *jsonAPIHandler) GETPendingSpends(w http.ResponseWriter, r *http.Request) {
+	spends, err := i.node.GetPendingSpends()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if spends == nil {
+		spends = []repo.PendingSpend{}
+	}
+	ser, err := json.MarshalIndent(spends, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
+// spendApproval is the request body of POST /wallet/approvespend. TOTP is
+// only required when the wallet's spending policy has a TOTP secret.
+type spendApproval struct {
+	ID   string `json:"id"`
+	TOTP string `json:"totp"`
+}
+
+func (i *jsonAPIHandler) POSTApproveSpend(w http.ResponseWriter, r *http.Request) {
+	var approval spendApproval
+	decoder := json.NewDecoder(r.Body)
+	err := decoder.Decode(&approval)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
+	result, err := i.node.ApprovePendingSpend(approval.ID, approval.TOTP)
+	switch {
+	case err == core.ErrPendingSpendNotFound:
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	case err == core.ErrInvalidTOTP:
+		ErrorResponse(w, http.StatusUnauthorized, err.Error())
+		return
+	case err != nil:
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	ser, err := json.MarshalIndent(result, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -853,6 +957,19 @@ func (i *jsonAPIHandler) POSTSpendCoins(w http.ResponseWriter, r *http.Request)
 	SanitizedResponse(w, string(ser))
 }
 
+func (i *jsonAPIHandler) DELETEPendingSpend(w http.ResponseWriter, r *http.Request) {
+	_, id := path.Split(r.URL.Path)
+	if err := i.node.CancelPendingSpend(id); err != nil {
+		if err == core.ErrPendingSpendNotFound {
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
 func (i *jsonAPIHandler) GETConfig(w http.ResponseWriter, r *http.Request) {
 	var usingTor bool
 	if i.node.TorDialer != nil {
@@ -893,6 +1010,10 @@ func (i *jsonAPIHandler) POSTSettings(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	if err = validateStoreTaxes(settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	if err = i.node.ValidateMultiwalletHasPreferredCurrencies(settings); err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
@@ -933,6 +1054,16 @@ func (i *jsonAPIHandler) POSTSettings(w http.ResponseWriter, r *http.Request) {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		}
 	}
+	if settings.StoreTaxes != nil {
+		if err := i.node.SetStoreTaxesOnListings(repo.StoreTaxesToProtobuf(*settings.StoreTaxes)); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if err := i.node.SeedNode(); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
 	err = i.node.Datastore.Settings().Put(settings)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -959,6 +1090,10 @@ func (i *jsonAPIHandler) PUTSettings(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	if err = validateStoreTaxes(settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	if err = i.node.ValidateMultiwalletHasPreferredCurrencies(settings); err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
@@ -994,6 +1129,16 @@ func (i *jsonAPIHandler) PUTSettings(w http.ResponseWriter, r *http.Request) {
 			ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		}
 	}
+	if settings.StoreTaxes != nil {
+		if err := i.node.SetStoreTaxesOnListings(repo.StoreTaxesToProtobuf(*settings.StoreTaxes)); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if err := i.node.SeedNode(); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
 
 	err = i.node.Datastore.Settings().Put(settings)
 	if err != nil {
@@ -1040,6 +1185,10 @@ func (i *jsonAPIHandler) PATCHSettings(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	if err = validateStoreTaxes(settings); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
 	if err = i.node.ValidateMultiwalletHasPreferredCurrencies(settings); err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
@@ -1077,6 +1226,16 @@ func (i *jsonAPIHandler) PATCHSettings(w http.ResponseWriter, r *http.Request) {
 			}
 		}(modsToAdd, modsToDelete)
 	}
+	if settings.StoreTaxes != nil {
+		if err := i.node.SetStoreTaxesOnListings(repo.StoreTaxesToProtobuf(*settings.StoreTaxes)); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if err := i.node.SeedNode(); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+	}
 	SanitizedResponse(w, `{}`)
 }
 
@@ -1317,14 +1476,15 @@ func (i *jsonAPIHandler) GETInventory(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, string(inventoryBytes))
 }
 
+type inventoryUpdate struct {
+	Slug     string `json:"slug"`
+	Variant  int    `json:"variant"`
+	Quantity string `json:"quantity"`
+}
+
 func (i *jsonAPIHandler) POSTInventory(w http.ResponseWriter, r *http.Request) {
-	type inv struct {
-		Slug     string `json:"slug"`
-		Variant  int    `json:"variant"`
-		Quantity string `json:"quantity"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var invList []inv
+	var invList []inventoryUpdate
 	err := decoder.Decode(&invList)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -1609,13 +1769,14 @@ func (i *jsonAPIHandler) GETIsFollowing(w http.ResponseWriter, r *http.Request)
 	SanitizedResponse(w, fmt.Sprintf(`{"isFollowing": %t}`, i.node.Datastore.Following().IsFollowing(peerID)))
 }
 
+type orderConfirmationRequest struct {
+	OrderID string `json:"orderId"`
+	Reject  bool   `json:"reject"`
+}
+
 func (i *jsonAPIHandler) POSTOrderConfirmation(w http.ResponseWriter, r *http.Request) {
-	type orderConf struct {
-		OrderID string `json:"orderId"`
-		Reject  bool   `json:"reject"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var conf orderConf
+	var conf orderConfirmationRequest
 	err := decoder.Decode(&conf)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -1667,12 +1828,14 @@ func (i *jsonAPIHandler) POSTOrderConfirmation(w http.ResponseWriter, r *http.Re
 	SanitizedResponse(w, `{}`)
 }
 
+// orderIDRequest is the body of the endpoints acting on a single order
+type orderIDRequest struct {
+	OrderID string `json:"orderId"`
+}
+
 func (i *jsonAPIHandler) POSTOrderCancel(w http.ResponseWriter, r *http.Request) {
-	type orderCancel struct {
-		OrderID string `json:"orderId"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var can orderCancel
+	var can orderIDRequest
 	err := decoder.Decode(&can)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -1773,12 +1936,39 @@ func (i *jsonAPIHandler) POSTShutdown(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, `{}`)
 }
 
-func (i *jsonAPIHandler) POSTRefund(w http.ResponseWriter, r *http.Request) {
-	type orderCancel struct {
-		OrderID string `json:"orderId"`
+type changePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+func (i *jsonAPIHandler) POSTChangePassword(w http.ResponseWriter, r *http.Request) {
+	var req changePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if req.CurrentPassword == "" {
+		ErrorResponse(w, http.StatusBadRequest, "the database is not encrypted")
+		return
+	}
+	if len(req.NewPassword) < repo.DatabasePasswordMinLength {
+		ErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("the new password must be at least %d characters", repo.DatabasePasswordMinLength))
+		return
+	}
+	err := i.node.Datastore.Config().Rekey(req.CurrentPassword, req.NewPassword)
+	if err == repo.ErrIncorrectPassword {
+		ErrorResponse(w, http.StatusForbidden, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
 	}
+	SanitizedResponse(w, `{}`)
+}
+
+func (i *jsonAPIHandler) POSTRefund(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
-	var can orderCancel
+	var can orderIDRequest
 	err := decoder.Decode(&can)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -2021,6 +2211,10 @@ func (i *jsonAPIHandler) POSTOrderFulfill(w http.ResponseWriter, r *http.Request
 		return
 	}
 	err = i.node.FulfillOrder(&fulfill, contract, records)
+	if err == core.ErrCrowdfundNotFunded {
+		ErrorResponse(w, http.StatusConflict, err.Error())
+		return
+	}
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -2114,13 +2308,14 @@ func (i *jsonAPIHandler) POSTOrderComplete(w http.ResponseWriter, r *http.Reques
 	SanitizedResponse(w, `{}`)
 }
 
+type openDisputeRequest struct {
+	OrderID string `json:"orderId"`
+	Claim   string `json:"claim"`
+}
+
 func (i *jsonAPIHandler) POSTOpenDispute(w http.ResponseWriter, r *http.Request) {
-	type dispute struct {
-		OrderID string `json:"orderId"`
-		Claim   string `json:"claim"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var d dispute
+	var d openDisputeRequest
 	err := decoder.Decode(&d)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -2184,15 +2379,16 @@ func (i *jsonAPIHandler) POSTOpenDispute(w http.ResponseWriter, r *http.Request)
 	SanitizedResponse(w, `{}`)
 }
 
+type closeDisputeRequest struct {
+	OrderID          string  `json:"orderId"`
+	Resolution       string  `json:"resolution"`
+	BuyerPercentage  float32 `json:"buyerPercentage"`
+	VendorPercentage float32 `json:"vendorPercentage"`
+}
+
 func (i *jsonAPIHandler) POSTCloseDispute(w http.ResponseWriter, r *http.Request) {
-	type disputeParams struct {
-		OrderID          string  `json:"orderId"`
-		Resolution       string  `json:"resolution"`
-		BuyerPercentage  float32 `json:"buyerPercentage"`
-		VendorPercentage float32 `json:"vendorPercentage"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var d disputeParams
+	var d closeDisputeRequest
 	err := decoder.Decode(&d)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -2221,24 +2417,54 @@ func (i *jsonAPIHandler) POSTCloseDispute(w http.ResponseWriter, r *http.Request
 
 func (i *jsonAPIHandler) GETCase(w http.ResponseWriter, r *http.Request) {
 	_, orderID := path.Split(r.URL.Path)
-	buyerContract, vendorContract, buyerErrors, vendorErrors, state, read, date, buyerOpened, claim, resolution, err := i.node.Datastore.Cases().GetCaseMetadata(orderID)
+	resp, err := getCase(i.node, orderID)
 	if err != nil {
-		ErrorResponse(w, http.StatusNotFound, err.Error())
+		if err == errCaseNotFound {
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	resp := new(pb.CaseRespApi)
-	ts, err := ptypes.TimestampProto(date)
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(resp)
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
+	err = i.node.Datastore.Cases().MarkAsRead(orderID)
+	if err != nil {
+		log.Error(err)
+	}
+	SanitizedResponseM(w, out, new(pb.CaseRespApi))
+}
+
+var errCaseNotFound = errors.New("case not found")
+
+// getCase returns the case with its contracts migrated to the current schema
+func getCase(node *core.OpenBazaarNode, orderID string) (*pb.CaseRespApi, error) {
+	buyerContract, vendorContract, buyerErrors, vendorErrors, state, read, date, buyerOpened, claim, resolution, err := node.Datastore.Cases().GetCaseMetadata(orderID)
+	if err != nil {
+		return nil, errCaseNotFound
+	}
+
+	resp := new(pb.CaseRespApi)
+	ts, err := ptypes.TimestampProto(date)
+	if err != nil {
+		return nil, err
+	}
+
 	if buyerContract.BuyerOrder.Payment.BigAmount == "" {
 		v5order, err := repo.ToV5Order(buyerContract.BuyerOrder, nil)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
+			return nil, err
 		}
 		buyerContract.BuyerOrder = v5order
 	}
@@ -2254,38 +2480,17 @@ func (i *jsonAPIHandler) GETCase(w http.ResponseWriter, r *http.Request) {
 	resp.Resolution = resolution
 	resp.Timestamp = ts
 
-	unread, err := i.node.Datastore.Chat().GetUnreadCount(orderID)
+	unread, err := node.Datastore.Chat().GetUnreadCount(orderID)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		return nil, err
 	}
 	resp.UnreadChatMessages = uint64(unread)
-
-	m := jsonpb.Marshaler{
-		EnumsAsInts:  false,
-		EmitDefaults: true,
-		Indent:       "    ",
-		OrigName:     false,
-	}
-	out, err := m.MarshalToString(resp)
-	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
-	}
-
-	err = i.node.Datastore.Cases().MarkAsRead(orderID)
-	if err != nil {
-		log.Error(err)
-	}
-	SanitizedResponseM(w, out, new(pb.CaseRespApi))
+	return resp, nil
 }
 
 func (i *jsonAPIHandler) POSTReleaseFunds(w http.ResponseWriter, r *http.Request) {
-	type release struct {
-		OrderID string `json:"orderId"`
-	}
 	decoder := json.NewDecoder(r.Body)
-	var rel release
+	var rel orderIDRequest
 	err := decoder.Decode(&rel)
 	if err != nil {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -2335,9 +2540,7 @@ func (i *jsonAPIHandler) POSTReleaseFunds(w http.ResponseWriter, r *http.Request
 
 func (i *jsonAPIHandler) POSTReleaseEscrow(w http.ResponseWriter, r *http.Request) {
 	var (
-		rel struct {
-			OrderID string `json:"orderId"`
-		}
+		rel      orderIDRequest
 		contract *pb.RicardianContract
 		state    pb.OrderState
 		records  []*wallet.TransactionRecord
@@ -2415,12 +2618,13 @@ func (i *jsonAPIHandler) POSTReleaseEscrow(w http.ResponseWriter, r *http.Reques
 	SanitizedResponse(w, `{}`)
 }
 
+type signMessageRequest struct {
+	Content string `json:"content"`
+}
+
 func (i *jsonAPIHandler) POSTSignMessage(w http.ResponseWriter, r *http.Request) {
-	type signRequest struct {
-		Content string `json:"content"`
-	}
 	var (
-		req signRequest
+		req signMessageRequest
 		err = json.NewDecoder(r.Body).Decode(&req)
 	)
 	if err != nil {
@@ -2440,14 +2644,15 @@ func (i *jsonAPIHandler) POSTSignMessage(w http.ResponseWriter, r *http.Request)
 		i.node.IpfsNode.Identity.Pretty()))
 }
 
+type verifyMessageRequest struct {
+	Content   string `json:"content"`
+	Signature string `json:"signature"`
+	Pubkey    string `json:"pubkey"`
+	PeerId    string `json:"peerId"`
+}
+
 func (i *jsonAPIHandler) POSTVerifyMessage(w http.ResponseWriter, r *http.Request) {
-	type ciphertext struct {
-		Content   string `json:"content"`
-		Signature string `json:"signature"`
-		Pubkey    string `json:"pubkey"`
-		PeerId    string `json:"peerId"`
-	}
-	var msg ciphertext
+	var msg verifyMessageRequest
 	decoder := json.NewDecoder(r.Body)
 	err := decoder.Decode(&msg)
 	if err != nil {
@@ -2488,60 +2693,73 @@ func (i *jsonAPIHandler) POSTChat(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	if len(chat.Subject) > 500 {
-		ErrorResponse(w, http.StatusBadRequest, "Subject line is too long")
+	msgID, err := sendChatMessage(i.node, chat.PeerId, chat.Subject, chat.Message)
+	if err != nil {
+		if err == errChatSubjectTooLong || err == errChatMessageTooLong {
+			ErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	if len(chat.Message) > 20000 {
-		ErrorResponse(w, http.StatusBadRequest, "Message is too long")
-		return
+	SanitizedResponse(w, fmt.Sprintf(`{"messageId": "%s"}`, msgID))
+}
+
+var (
+	errChatSubjectTooLong = errors.New("Subject line is too long")
+	errChatMessageTooLong = errors.New("Message is too long")
+)
+
+// sendChatMessage sends a chat message to the peer and saves it to the
+// database. An empty message is sent as a typing indicator.
+func sendChatMessage(node *core.OpenBazaarNode, peerID, subject, message string) (string, error) {
+	if len(subject) > 500 {
+		return "", errChatSubjectTooLong
+	}
+	if len(message) > 20000 {
+		return "", errChatMessageTooLong
 	}
 
 	t := time.Now()
 	ts, err := ptypes.TimestampProto(t)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
-		return
+		return "", err
 	}
 	var flag pb.Chat_Flag
-	if chat.Message == "" {
+	if message == "" {
 		flag = pb.Chat_TYPING
 	} else {
 		flag = pb.Chat_MESSAGE
 	}
-	h := sha256.Sum256([]byte(chat.Message + chat.Subject + ptypes.TimestampString(ts)))
+	h := sha256.Sum256([]byte(message + subject + ptypes.TimestampString(ts)))
 	encoded, err := mh.Encode(h[:], mh.SHA2_256)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
-		return
+		return "", err
 	}
 	msgID, err := mh.Cast(encoded)
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
-		return
+		return "", err
 	}
 
 	chatPb := &pb.Chat{
 		MessageId: msgID.B58String(),
-		Subject:   chat.Subject,
-		Message:   chat.Message,
+		Subject:   subject,
+		Message:   message,
 		Timestamp: ts,
 		Flag:      flag,
 	}
-	err = i.node.SendChat(chat.PeerId, chatPb)
+	err = node.SendChat(peerID, chatPb)
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		return "", err
 	}
 	// Put to database
 	if chatPb.Flag == pb.Chat_MESSAGE {
-		err = i.node.Datastore.Chat().Put(msgID.B58String(), chat.PeerId, chat.Subject, chat.Message, t, false, true)
+		err = node.Datastore.Chat().Put(msgID.B58String(), peerID, subject, message, t, false, true)
 		if err != nil {
-			ErrorResponse(w, http.StatusInternalServerError, err.Error())
-			return
+			return "", err
 		}
 	}
-	SanitizedResponse(w, fmt.Sprintf(`{"messageId": "%s"}`, msgID.B58String()))
+	return msgID.B58String(), nil
 }
 
 func (i *jsonAPIHandler) POSTGroupChat(w http.ResponseWriter, r *http.Request) {
@@ -3151,22 +3369,91 @@ func (i *jsonAPIHandler) GETSales(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, string(ret))
 }
 
-func (i *jsonAPIHandler) GETCases(w http.ResponseWriter, r *http.Request) {
-	orderStates, searchTerm, sortByAscending, sortByRead, limit, err := parseSearchTerms(r.URL.Query())
+func (i *jsonAPIHandler) GETAccounting(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	from, err := parseAccountingTime(query.Get("from"))
 	if err != nil {
-		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, "from must be an RFC 3339 time or a date")
 		return
 	}
-	cases, queryCount, err := i.node.Datastore.Cases().GetAll(orderStates, searchTerm, sortByAscending, sortByRead, limit, []string{})
+	to, err := parseAccountingTime(query.Get("to"))
 	if err != nil {
-		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		ErrorResponse(w, http.StatusBadRequest, "to must be an RFC 3339 time or a date")
 		return
 	}
-	for n, c := range cases {
-		unread, err := i.node.Datastore.Chat().GetUnreadCount(c.CaseId)
-		if err != nil {
-			continue
-		}
+	format := strings.ToLower(query.Get("format"))
+	if format != "" && format != "json" && format != "csv" {
+		ErrorResponse(w, http.StatusBadRequest, "format must be json or csv")
+		return
+	}
+	filter := core.AccountingFilter{
+		From:         from,
+		To:           to,
+		Coin:         query.Get("coin"),
+		FiatCurrency: query.Get("currency"),
+	}
+	if filter.Coin != "" {
+		if _, err := i.node.Multiwallet.WalletForCurrencyCode(filter.Coin); err != nil {
+			ErrorResponse(w, http.StatusBadRequest, "Unknown wallet type")
+			return
+		}
+	}
+	entries, err := i.node.AccountingExport(filter)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	if format == "csv" {
+		var buf bytes.Buffer
+		if err := core.WriteAccountingCSV(&buf, entries); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="accounting.csv"`)
+		w.Write(buf.Bytes())
+		return
+	}
+	ret, err := json.MarshalIndent(entries, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if isNullJSON(ret) {
+		ret = []byte("[]")
+	}
+	SanitizedResponse(w, string(ret))
+}
+
+// parseAccountingTime accepts an RFC 3339 timestamp or a date. An empty
+// string returns the zero time.
+func parseAccountingTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", s)
+}
+
+func (i *jsonAPIHandler) GETCases(w http.ResponseWriter, r *http.Request) {
+	orderStates, searchTerm, sortByAscending, sortByRead, limit, err := parseSearchTerms(r.URL.Query())
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	cases, queryCount, err := i.node.Datastore.Cases().GetAll(orderStates, searchTerm, sortByAscending, sortByRead, limit, []string{})
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	for n, c := range cases {
+		unread, err := i.node.Datastore.Chat().GetUnreadCount(c.CaseId)
+		if err != nil {
+			continue
+		}
 		cases[n].UnreadChatMessages = unread
 	}
 	type casesResponse struct {
@@ -3601,6 +3888,346 @@ func (i *jsonAPIHandler) POSTCheckoutBreakdown(w http.ResponseWriter, r *http.Re
 	SanitizedResponse(w, string(out))
 }
 
+func (i *jsonAPIHandler) POSTPriceQuote(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var data repo.PurchaseData
+	err := decoder.Decode(&data)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	quote, err := i.node.RequestPriceQuote(&data)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: true,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(quote)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponseM(w, out, new(pb.SignedPriceQuote))
+}
+
+func (i *jsonAPIHandler) POSTSubscribe(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var data repo.SubscriptionData
+	err := decoder.Decode(&data)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	sub, order, err := i.node.Subscribe(&data)
+	if err != nil {
+		RenderJSONOrStringError(w, http.StatusInternalServerError, err)
+		return
+	}
+	type subscribeReturn struct {
+		Subscription *repo.Subscription      `json:"subscription"`
+		Order        *core.SubscriptionOrder `json:"order"`
+	}
+	b, err := json.MarshalIndent(subscribeReturn{sub, order}, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(b))
+}
+
+func (i *jsonAPIHandler) GETSubscriptions(w http.ResponseWriter, r *http.Request) {
+	subs, err := i.node.GetSubscriptions()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if subs == nil {
+		subs = []repo.Subscription{}
+	}
+	ser, err := json.MarshalIndent(subs, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
+func (i *jsonAPIHandler) GETSubscription(w http.ResponseWriter, r *http.Request) {
+	_, id := path.Split(r.URL.Path)
+	sub, err := i.node.GetSubscription(id)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+	ser, err := json.MarshalIndent(sub, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
+func (i *jsonAPIHandler) DELETESubscription(w http.ResponseWriter, r *http.Request) {
+	_, id := path.Split(r.URL.Path)
+	sub, err := i.node.CancelSubscription(id)
+	switch {
+	case err == core.ErrSubscriptionNotFound:
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	case err == core.ErrSubscriptionInactive:
+		ErrorResponse(w, http.StatusConflict, err.Error())
+		return
+	case err != nil:
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ser, err := json.MarshalIndent(sub, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
+func (i *jsonAPIHandler) GETBackorders(w http.ResponseWriter, r *http.Request) {
+	backorders, err := i.node.GetBackorders()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ser, err := json.MarshalIndent(backorders, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
+func (i *jsonAPIHandler) POSTReleaseBackorder(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var rel orderIDRequest
+	err := decoder.Decode(&rel)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	err = i.node.ReleaseBackorder(rel.OrderID)
+	switch {
+	case err == core.ErrOrderNotFound:
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	case err == core.ErrNotBackordered || err == core.ErrBackorderReleased:
+		ErrorResponse(w, http.StatusConflict, err.Error())
+		return
+	case err != nil:
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
+type licenseKeysRequest struct {
+	Slug    string   `json:"slug"`
+	Variant int      `json:"variant"`
+	Keys    []string `json:"keys"`
+}
+
+func (i *jsonAPIHandler) POSTLicenseKeys(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var keys licenseKeysRequest
+	err := decoder.Decode(&keys)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	pool, err := i.node.AddLicenseKeys(keys.Slug, keys.Variant, keys.Keys)
+	if err != nil {
+		digitalGoodsErrorResponse(w, err)
+		return
+	}
+	ser, err := json.MarshalIndent(pool, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
+func (i *jsonAPIHandler) GETLicenseKeys(w http.ResponseWriter, r *http.Request) {
+	_, slug := path.Split(r.URL.Path)
+	pools, err := i.node.Datastore.DigitalGoods().GetLicenseKeyPools(slug)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if pools == nil {
+		pools = []repo.LicenseKeyPool{}
+	}
+	ser, err := json.MarshalIndent(pools, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
+type digitalFileRequest struct {
+	Slug     string `json:"slug"`
+	Variant  int    `json:"variant"`
+	Filename string `json:"filename"`
+	File     []byte `json:"file"`
+}
+
+func (i *jsonAPIHandler) POSTDigitalFile(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var f digitalFileRequest
+	err := decoder.Decode(&f)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	file, err := i.node.PutDigitalFile(f.Slug, f.Variant, f.Filename, f.File)
+	if err != nil {
+		digitalGoodsErrorResponse(w, err)
+		return
+	}
+	ser, err := json.MarshalIndent(file, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
+func (i *jsonAPIHandler) GETDigitalFiles(w http.ResponseWriter, r *http.Request) {
+	_, slug := path.Split(r.URL.Path)
+	files, err := i.node.Datastore.DigitalGoods().GetFiles(slug)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if files == nil {
+		files = []repo.DigitalFile{}
+	}
+	ser, err := json.MarshalIndent(files, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
+func (i *jsonAPIHandler) GETDigitalDownload(w http.ResponseWriter, r *http.Request) {
+	_, cid := path.Split(r.URL.Path)
+	b, err := i.node.GetDigitalFile(cid)
+	if err != nil {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if filename := r.URL.Query().Get("filename"); filename != "" {
+		w.Header().Set("Content-Disposition", `attachment; filename="`+path.Base(filename)+`"`)
+	}
+	w.Write(b)
+}
+
+func digitalGoodsErrorResponse(w http.ResponseWriter, err error) {
+	switch {
+	case os.IsNotExist(err):
+		ErrorResponse(w, http.StatusNotFound, "listing not found")
+	case err == core.ErrListingNotDigital || err == core.ErrUnknownVariant || err == core.ErrNoLicenseKeys || err == core.ErrDigitalFileRequired:
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+func (i *jsonAPIHandler) GETAppointments(w http.ResponseWriter, r *http.Request) {
+	format := strings.ToLower(r.URL.Query().Get("format"))
+	if format != "" && format != "json" && format != "ics" {
+		ErrorResponse(w, http.StatusBadRequest, "format must be json or ics")
+		return
+	}
+	appointments, err := i.node.GetAppointments()
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if format == "ics" {
+		var buf bytes.Buffer
+		if err := core.WriteICalendar(&buf, appointments, time.Now()); err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="appointments.ics"`)
+		w.Write(buf.Bytes())
+		return
+	}
+	ser, err := json.MarshalIndent(appointments, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
+func (i *jsonAPIHandler) POSTConfirmAppointment(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var conf orderIDRequest
+	err := decoder.Decode(&conf)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	appointmentResponse(w, i.node.ConfirmAppointment(conf.OrderID))
+}
+
+type appointmentRescheduleRequest struct {
+	OrderID string `json:"orderId"`
+	SlotID  string `json:"slotId"`
+	Note    string `json:"note"`
+}
+
+func (i *jsonAPIHandler) POSTRescheduleAppointment(w http.ResponseWriter, r *http.Request) {
+	decoder := json.NewDecoder(r.Body)
+	var res appointmentRescheduleRequest
+	err := decoder.Decode(&res)
+	if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	appointmentResponse(w, i.node.RescheduleAppointment(res.OrderID, res.SlotID, res.Note))
+}
+
+// appointmentResponse writes the result of confirming or rescheduling an
+// appointment
+func appointmentResponse(w http.ResponseWriter, err error) {
+	switch err.(type) {
+	case nil:
+		SanitizedResponse(w, `{}`)
+		return
+	case core.ErrOutOfInventory:
+		ErrorResponse(w, http.StatusConflict, err.Error())
+		return
+	}
+	switch err {
+	case core.ErrOrderNotFound, core.ErrAppointmentNotFound:
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+	case core.ErrAppointmentConfirmed, core.ErrAppointmentCancelled:
+		ErrorResponse(w, http.StatusConflict, err.Error())
+	case core.ErrUnknownSlot, core.ErrSlotPassed:
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
 func (i *jsonAPIHandler) POSTEstimateTotal(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
 	var data repo.PurchaseData
@@ -3617,6 +4244,32 @@ func (i *jsonAPIHandler) POSTEstimateTotal(w http.ResponseWriter, r *http.Reques
 	fmt.Fprintf(w, "%s", amount.String())
 }
 
+func (i *jsonAPIHandler) GETRatingStats(w http.ResponseWriter, r *http.Request) {
+	stats, err := i.node.GetRatingStats()
+	if os.IsNotExist(err) {
+		stats = core.SummarizeRatings(nil)
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	_, slug := path.Split(r.URL.Path)
+	var ret []byte
+	if slug != "" && slug != "ratingstats" {
+		summary, ok := stats.Listings[slug]
+		if !ok {
+			summary = core.SummarizeRatings(nil).Store
+		}
+		ret, err = json.MarshalIndent(summary, "", "    ")
+	} else {
+		ret, err = json.MarshalIndent(stats, "", "    ")
+	}
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ret))
+}
+
 func (i *jsonAPIHandler) GETRatings(w http.ResponseWriter, r *http.Request) {
 	urlPath, slug := path.Split(r.URL.Path)
 	_, peerID := path.Split(urlPath[:len(urlPath)-1])
@@ -3691,8 +4344,16 @@ func (i *jsonAPIHandler) GETRatings(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// ratingResponsesUseCache returns whether the vendor responses to ratings
+// are fetched from the cached root of the vendor, which is the default
+func ratingResponsesUseCache(r *http.Request) bool {
+	useCache, err := strconv.ParseBool(r.URL.Query().Get("usecache"))
+	return useCache || err != nil
+}
+
 func (i *jsonAPIHandler) GETRating(w http.ResponseWriter, r *http.Request) {
 	_, ratingID := path.Split(r.URL.Path)
+	useCache := ratingResponsesUseCache(r)
 
 	ratingBytes, err := ipfs.Cat(i.node.IpfsNode, ratingID, time.Minute)
 	if err != nil {
@@ -3711,6 +4372,7 @@ func (i *jsonAPIHandler) GETRating(w http.ResponseWriter, r *http.Request) {
 		ErrorResponse(w, http.StatusExpectationFailed, err.Error())
 		return
 	}
+	rating.VendorResponse, _ = i.node.NewRatingResponses(useCache).Get(ratingID, rating)
 	ret, err := json.MarshalIndent(rating, "", "    ")
 	if err != nil {
 		ErrorResponse(w, http.StatusInternalServerError, err.Error())
@@ -3719,6 +4381,60 @@ func (i *jsonAPIHandler) GETRating(w http.ResponseWriter, r *http.Request) {
 	SanitizedResponse(w, string(ret))
 }
 
+type ratingResponseRequest struct {
+	RatingID string `json:"ratingId"`
+	Response string `json:"response"`
+}
+
+func (i *jsonAPIHandler) POSTRatingResponse(w http.ResponseWriter, r *http.Request) {
+	var req ratingResponseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	response, err := i.node.RespondToRating(req.RatingID, req.Response)
+	if err == core.ErrRatingNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(response)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, out)
+}
+
+func (i *jsonAPIHandler) DELETERatingResponse(w http.ResponseWriter, r *http.Request) {
+	_, ratingID := path.Split(r.URL.Path)
+	err := i.node.DeleteRatingResponse(ratingID)
+	if err == core.ErrRatingResponseNotFound {
+		ErrorResponse(w, http.StatusNotFound, err.Error())
+		return
+	} else if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err := i.node.SeedNode(); err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, `{}`)
+}
+
 func (i *jsonAPIHandler) POSTFetchRatings(w http.ResponseWriter, r *http.Request) {
 	decoder := json.NewDecoder(r.Body)
 	var rp []string
@@ -3730,6 +4446,7 @@ func (i *jsonAPIHandler) POSTFetchRatings(w http.ResponseWriter, r *http.Request
 
 	query := r.URL.Query().Get("async")
 	async, _ := strconv.ParseBool(query)
+	responses := i.node.NewRatingResponses(ratingResponsesUseCache(r))
 
 	if !async {
 		var wg sync.WaitGroup
@@ -3750,6 +4467,7 @@ func (i *jsonAPIHandler) POSTFetchRatings(w http.ResponseWriter, r *http.Request
 				if !valid || err != nil {
 					return
 				}
+				rating.VendorResponse, _ = responses.Get(rid, rating)
 				m := jsonpb.Marshaler{
 					EnumsAsInts:  false,
 					EmitDefaults: true,
@@ -3833,6 +4551,7 @@ func (i *jsonAPIHandler) POSTFetchRatings(w http.ResponseWriter, r *http.Request
 					respondWithError(err.Error())
 					return
 				}
+				rating.VendorResponse, _ = responses.Get(rid, rating)
 				resp := new(pb.RatingWithID)
 				resp.Id = id
 				resp.RatingId = rid
@@ -3877,6 +4596,11 @@ func (i *jsonAPIHandler) POSTImportListings(w http.ResponseWriter, r *http.Reque
 	SanitizedResponse(w, "{}")
 }
 
+// GETMetrics serves the node metrics in the Prometheus text format
+func (i *jsonAPIHandler) GETMetrics(w http.ResponseWriter, r *http.Request) {
+	i.metrics.ServeHTTP(w, r)
+}
+
 func (i *jsonAPIHandler) GETHealthCheck(w http.ResponseWriter, r *http.Request) {
 	type resp struct {
 		Database bool `json:"database"`
@@ -3905,6 +4629,49 @@ func (i *jsonAPIHandler) GETHealthCheck(w http.ResponseWriter, r *http.Request)
 	SanitizedResponse(w, "{}")
 }
 
+func (i *jsonAPIHandler) GETOutbox(w http.ResponseWriter, r *http.Request) {
+	_, pointerID := path.Split(r.URL.Path)
+	if pointerID != "" && pointerID != "outbox" {
+		msg, err := i.node.GetOutboxMessage(pointerID)
+		if err == core.ErrOutboxMessageNotFound {
+			ErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		} else if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		ser, err := json.MarshalIndent(msg, "", "    ")
+		if err != nil {
+			ErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		SanitizedResponse(w, string(ser))
+		return
+	}
+
+	status := repo.OutboxStatus(r.URL.Query().Get("status"))
+	switch status {
+	case "", repo.OutboxPending, repo.OutboxAcked, repo.OutboxExpired, repo.OutboxFailed:
+	default:
+		ErrorResponse(w, http.StatusBadRequest, "status must be pending, acked, expired or failed")
+		return
+	}
+	msgs, err := i.node.GetOutboxMessages(status)
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if msgs == nil {
+		msgs = []repo.OutboxMessage{}
+	}
+	ser, err := json.MarshalIndent(msgs, "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	SanitizedResponse(w, string(ser))
+}
+
 func (i *jsonAPIHandler) POSTPublish(w http.ResponseWriter, r *http.Request) {
 	// Republish to IPNS
 	if err := i.node.SeedNode(); err != nil {
@@ -4146,12 +4913,12 @@ func (i *jsonAPIHandler) GETPeerInfo(w http.ResponseWriter, r *http.Request) {
 }
 
 // Enable bulk updating prices for your listings by percentage
-func (i *jsonAPIHandler) POSTBulkUpdatePrices(w http.ResponseWriter, r *http.Request) {
-	type BulkUpdatePriceRequest struct {
-		Percentage float64 `json:"percentage"`
-	}
+type bulkUpdatePriceRequest struct {
+	Percentage float64 `json:"percentage"`
+}
 
-	var bulkUpdate BulkUpdatePriceRequest
+func (i *jsonAPIHandler) POSTBulkUpdatePrices(w http.ResponseWriter, r *http.Request) {
+	var bulkUpdate bulkUpdatePriceRequest
 	err := json.NewDecoder(r.Body).Decode(&bulkUpdate)
 	if err != nil {
 		http.Error(w, err.Error(), 400)
@@ -4174,13 +4941,14 @@ func (i *jsonAPIHandler) POSTBulkUpdatePrices(w http.ResponseWriter, r *http.Req
 	SanitizedResponse(w, `{"success": "true"}`)
 }
 
+type bulkUpdateCurrencyRequest struct {
+	Currencies []string `json:"currencies"`
+}
+
 func (i *jsonAPIHandler) POSTBulkUpdateCurrency(w http.ResponseWriter, r *http.Request) {
 	// Retrieve attribute and values to update
-	type BulkUpdateRequest struct {
-		Currencies []string `json:"currencies"`
-	}
 
-	var bulkUpdate BulkUpdateRequest
+	var bulkUpdate bulkUpdateCurrencyRequest
 	err := json.NewDecoder(r.Body).Decode(&bulkUpdate)
 	if err != nil {
 		http.Error(w, err.Error(), 400)
@@ -4483,13 +5251,13 @@ func (i *jsonAPIHandler) GETPost(w http.ResponseWriter, r *http.Request) {
 }
 
 // POSTSendOrderMessage - used to manually send an order message
-func (i *jsonAPIHandler) POSTResendOrderMessage(w http.ResponseWriter, r *http.Request) {
-	type sendRequest struct {
-		OrderID     string `json:"orderID"`
-		MessageType string `json:"messageType"`
-	}
+type resendOrderMessageRequest struct {
+	OrderID     string `json:"orderID"`
+	MessageType string `json:"messageType"`
+}
 
-	var args sendRequest
+func (i *jsonAPIHandler) POSTResendOrderMessage(w http.ResponseWriter, r *http.Request) {
+	var args resendOrderMessageRequest
 	decoder := json.NewDecoder(r.Body)
 	err := decoder.Decode(&args)
 	if err != nil {
@@ -4533,12 +5301,13 @@ func (i *jsonAPIHandler) GETScanOfflineMessages(w http.ResponseWriter, r *http.R
 	SanitizedResponse(w, `{}`)
 }
 
+type hashMessageRequest struct {
+	Content string `json:"content"`
+}
+
 func (i *jsonAPIHandler) POSTHashMessage(w http.ResponseWriter, r *http.Request) {
-	type hashRequest struct {
-		Content string `json:"content"`
-	}
 	var (
-		req hashRequest
+		req hashMessageRequest
 		err = json.NewDecoder(r.Body).Decode(&req)
 	)
 	if err != nil {
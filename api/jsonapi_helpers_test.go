@@ -30,6 +30,9 @@ var testHTTPClient = &http.Client{
 	Timeout: 10 * time.Second,
 }
 
+// testGateway is the gateway started by TestMain
+var testGateway *Gateway
+
 // newTestGateway starts a new API gateway listening on the default test interface
 func newTestGateway() (*Gateway, error) {
 	// Create a test node, cookie, and config
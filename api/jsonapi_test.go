@@ -7,6 +7,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"github.com/OpenBazaar/openbazaar-go/schema"
 	"github.com/OpenBazaar/openbazaar-go/test"
 	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	"github.com/golang/protobuf/ptypes/timestamp"
 )
 
 func TestMain(m *testing.M) {
@@ -26,6 +28,7 @@ func TestMain(m *testing.M) {
 		log.Fatal(err)
 	}
 	defer gateway.Close()
+	testGateway = gateway
 
 	go func() {
 		err = gateway.Serve()
@@ -83,6 +86,7 @@ func TestSettingsSetModerator(t *testing.T) {
 	"localCurrency": "USD",
 	"mispaymentBuffer": 1,
 	"paymentDataInQR": true,
+	"priceQuoteMinutes": 15,
 	"refundPolicy": "Refund policy.",
 	"shippingAddresses": [
 			{
@@ -111,6 +115,7 @@ func TestSettingsSetModerator(t *testing.T) {
 	"storeModerators": [
 			"QmeRfQcEiefLYgEFRsNqn1WjjrLjrJVAddt85htU1Up32y"
 	],
+	"storeTaxes": [],
 	"termsAndConditions": "Terms and Conditions",
 	"version": ""
 }`
@@ -754,6 +759,54 @@ func TestListingsQuantity(t *testing.T) {
 	})
 }
 
+func TestCrowdfundListings(t *testing.T) {
+	listing := factory.NewListing("crowdfund")
+	listing.Metadata.ContractType = pb.Listing_Metadata_CROWD_FUND
+	runAPITest(t, apiTest{
+		"POST", "/ob/listing", jsonFor(t, listing), 500, errorResponseJSON(errors.New("validate sellable listing (crowdfund): crowdfund listings must set a funding goal and deadline")),
+	})
+
+	listing.Crowdfund = &pb.Listing_Crowdfund{
+		BigGoal:  "0",
+		Deadline: &timestamp.Timestamp{Seconds: time.Now().Add(time.Hour).Unix()},
+	}
+	listing.Moderators = []string{"QmVisrQ9apmvTLnq9FSNKbP8dYvBvkP4AeeysHZg89oB9q"}
+	runAPITest(t, apiTest{
+		"POST", "/ob/listing", jsonFor(t, listing), 500, errorResponseJSON(errors.New("validate sellable listing (crowdfund): crowdfund goal must be a positive amount")),
+	})
+
+	listing.Crowdfund.BigGoal = "1000000"
+	runAPITests(t, apiTests{
+		{"POST", "/ob/listing", jsonFor(t, listing), 200, `{"slug": "crowdfund"}`},
+		{"GET", "/ob/listing/crowdfund", "", 200, anyResponseJSON},
+	})
+}
+
+func TestServiceListings(t *testing.T) {
+	start := &timestamp.Timestamp{Seconds: time.Now().Add(24 * time.Hour).Unix()}
+	listing := factory.NewListing("repair")
+	listing.Availability = &pb.Listing_Availability{
+		Slots: []*pb.Listing_Availability_Slot{
+			{Id: "mon", Start: start, DurationMinutes: 60, Capacity: 1},
+			{Id: "mon", Start: start, DurationMinutes: 60, Capacity: 1},
+		},
+	}
+	runAPITest(t, apiTest{
+		"POST", "/ob/listing", jsonFor(t, listing), 500, errorResponseJSON(errors.New("validate sellable listing (repair): only service listings can have bookable slots")),
+	})
+
+	listing.Metadata.ContractType = pb.Listing_Metadata_SERVICE
+	runAPITest(t, apiTest{
+		"POST", "/ob/listing", jsonFor(t, listing), 500, errorResponseJSON(errors.New("validate sellable listing (repair): duplicate slot id mon")),
+	})
+
+	listing.Availability.Slots[1].Id = "tue"
+	runAPITests(t, apiTests{
+		{"POST", "/ob/listing", jsonFor(t, listing), 200, `{"slug": "repair"}`},
+		{"GET", "/ob/listing/repair", "", 200, anyResponseJSON},
+	})
+}
+
 func TestCryptoListingsQuantity(t *testing.T) {
 	listing := factory.NewCryptoListing("crypto")
 	runAPITest(t, apiTest{
@@ -917,6 +970,129 @@ func TestWalletSpendFailures(t *testing.T) {
 	})
 }
 
+func TestWalletPendingSpends(t *testing.T) {
+	notFound := APIError{Reason: core.ErrPendingSpendNotFound.Error()}
+	runAPITests(t, apiTests{
+		{"GET", "/wallet/pendingspends", "", 200, `[]`},
+		{"POST", "/wallet/approvespend", `{"id": "unknown"}`, 404, notFound},
+		{"DELETE", "/wallet/pendingspends/unknown", "", 404, notFound},
+	})
+}
+
+func TestSubscriptions(t *testing.T) {
+	notFound := APIError{Reason: core.ErrSubscriptionNotFound.Error()}
+	runAPITests(t, apiTests{
+		{"GET", "/ob/subscriptions", "", 200, `[]`},
+		{"GET", "/ob/subscription/unknown", "", 404, notFound},
+		{"DELETE", "/ob/subscription/unknown", "", 404, notFound},
+	})
+}
+
+func TestBackorders(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/backorders", "", 200, `[]`},
+		{"POST", "/ob/releasebackorder", `{"orderId": "unknown"}`, 404, APIError{Reason: core.ErrOrderNotFound.Error()}},
+	})
+}
+
+func TestDigitalGoods(t *testing.T) {
+	ebook := factory.NewListing("ebook")
+	ebook.Metadata.ContractType = pb.Listing_Metadata_DIGITAL_GOOD
+	runAPITests(t, apiTests{
+		{"POST", "/ob/listing", jsonFor(t, factory.NewListing("tshirt")), 200, `{"slug": "tshirt"}`},
+		{"POST", "/ob/listing", jsonFor(t, ebook), 200, `{"slug": "ebook"}`},
+		{"POST", "/ob/licensekeys", `{"slug": "tshirt", "keys": ["KEY-1"]}`, 400, APIError{Reason: core.ErrListingNotDigital.Error()}},
+		{"POST", "/ob/licensekeys", `{"slug": "unknown", "keys": ["KEY-1"]}`, 404, APIError{Reason: "listing not found"}},
+		{"POST", "/ob/licensekeys", `{"slug": "ebook", "variant": 9, "keys": ["KEY-1"]}`, 400, APIError{Reason: core.ErrUnknownVariant.Error()}},
+		{"POST", "/ob/licensekeys", `{"slug": "ebook", "keys": ["KEY-1", "KEY-2"]}`, 200, `{"slug": "ebook", "variant": 0, "available": 2, "assigned": 0}`},
+		{"GET", "/ob/licensekeys/ebook", "", 200, `[{"slug": "ebook", "variant": 0, "available": 2, "assigned": 0}]`},
+		{"POST", "/ob/digitalfile", `{"slug": "ebook", "filename": "ebook.epub"}`, 400, APIError{Reason: core.ErrDigitalFileRequired.Error()}},
+		{"POST", "/ob/digitalfile", `{"slug": "ebook", "filename": "ebook.epub", "file": "Y2hhcHRlciBvbmU="}`, 200, anyResponseJSON},
+	})
+}
+
+func TestAppointments(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/appointments", "", 200, `[]`},
+		{"GET", "/ob/appointments?format=xml", "", 400, APIError{Reason: "format must be json or ics"}},
+		{"POST", "/ob/confirmappointment", `{"orderId": "unknown"}`, 404, APIError{Reason: core.ErrOrderNotFound.Error()}},
+		{"POST", "/ob/rescheduleappointment", `{"orderId": "unknown", "slotId": "mon"}`, 404, APIError{Reason: core.ErrOrderNotFound.Error()}},
+	})
+}
+
+func TestAccountingExport(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/accounting?format=xml", "", 400, APIError{Reason: "format must be json or csv"}},
+		{"GET", "/ob/accounting?from=yesterday", "", 400, APIError{Reason: "from must be an RFC 3339 time or a date"}},
+		{"GET", "/ob/accounting?coin=XYZ", "", 400, APIError{Reason: "Unknown wallet type"}},
+	})
+}
+
+func TestOutbox(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"GET", "/ob/outbox?status=sent", "", 400, APIError{Reason: "status must be pending, acked, expired or failed"}},
+		{"GET", "/ob/outbox/QmUnknown", "", 404, APIError{Reason: core.ErrOutboxMessageNotFound.Error()}},
+	})
+}
+
+func TestRatingResponse(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/ob/ratingresponse", `{"ratingId": "QmUnknown", "response": ""}`, 400, APIError{Reason: "response is empty"}},
+		{"POST", "/ob/ratingresponse", `{"ratingId": "QmUnknown", "response": "Thanks!"}`, 404, APIError{Reason: core.ErrRatingNotFound.Error()}},
+		{"DELETE", "/ob/ratingresponse/QmUnknown", "", 404, APIError{Reason: core.ErrRatingResponseNotFound.Error()}},
+	})
+}
+
+func TestRatingStats(t *testing.T) {
+	empty := core.SummarizeRatings(nil)
+	stats, err := json.MarshalIndent(empty, "", "    ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	summary, err := json.MarshalIndent(empty.Store, "", "    ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	runAPITests(t, apiTests{
+		{"GET", "/ob/ratingstats", "", 200, string(stats)},
+		{"GET", "/ob/ratingstats/unrated-listing", "", 200, string(summary)},
+	})
+}
+
+func TestChangePassword(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/ob/changepassword", `{"currentPassword": "", "newPassword": "new password"}`, 400, APIError{Reason: "the database is not encrypted"}},
+		{"POST", "/ob/changepassword", `{"currentPassword": "old password", "newPassword": "short"}`, 400, APIError{Reason: "the new password must be at least 8 characters"}},
+	})
+}
+
+func TestMetrics(t *testing.T) {
+	sale := factory.NewSaleRecord()
+	dbSetup := func(testRepo *test.Repository) error {
+		return testRepo.DB.Sales().Put(sale.OrderID, *sale.Contract, sale.OrderState, false)
+	}
+	runAPITestsWithSetup(t, apiTests{
+		{"GET", "/ob/peers", "", 200, anyResponseJSON},
+		{"GET", "/v1/ob/peers", "", 200, anyResponseJSON},
+	}, dbSetup, nil)
+
+	respBytes, err := httpGet("/ob/metrics")
+	if err != nil {
+		t.Fatal(err)
+	}
+	metrics := string(respBytes)
+	for _, expected := range []string{
+		fmt.Sprintf(`openbazaar_orders{role="sale",state="%s"} 1`, strings.ToLower(sale.OrderState.String())),
+		`openbazaar_orders{role="purchase",state="disputed"}`,
+		`openbazaar_api_request_duration_seconds_count{code="200",method="GET",route="/ob/peers"} 2`,
+		"openbazaar_peers_connected",
+	} {
+		if !strings.Contains(metrics, expected) {
+			t.Errorf("expected the metrics to contain %q, got:\n%s", expected, metrics)
+		}
+	}
+}
+
 func TestWalletCurrencyDictionary(t *testing.T) {
 	var expectedResponse, err = json.MarshalIndent(repo.AllCurrencies().AsMap(), "", "    ")
 	if err != nil {
@@ -978,6 +1154,17 @@ func Test404(t *testing.T) {
 	})
 }
 
+func TestV1Routes(t *testing.T) {
+	runAPITests(t, apiTests{
+		{"POST", "/v1/ob/settings", settingsJSON, 200, settingsJSON},
+		{"GET", "/v1/ob/settings", "", 200, settingsJSON},
+		{"GET", "/v1/ob/settingsx", "", 404, notFoundJSON},
+		{"DELETE", "/v1/ob/settings", "", 405, errorResponseJSON(errors.New("Method Not Allowed"))},
+		{"GET", "/v1/wallet/currencies", "", 200, anyResponseJSON},
+		{"GET", "/v1/openapi.json", "", 200, anyResponseJSON},
+	})
+}
+
 func TestPosts(t *testing.T) {
 	runAPITests(t, apiTests{
 		{"GET", "/ob/posts", "", 200, `[]`},
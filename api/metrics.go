@@ -0,0 +1,66 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	prometheus "gx/ipfs/QmTQuFQWHAWy4wMH6ZyPfGiawA5u9T8rs79FENoV8yXaoS/client_golang/prometheus"
+	promhttp "gx/ipfs/QmTQuFQWHAWy4wMH6ZyPfGiawA5u9T8rs79FENoV8yXaoS/client_golang/prometheus/promhttp"
+)
+
+// apiRequestDuration measures the requests served by the JSON API. Requests
+// are labelled with the pattern of the matching versioned route so that
+// path parameters do not create new series; the unversioned API serves the
+// same paths.
+var apiRequestDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: "openbazaar",
+		Subsystem: "api",
+		Name:      "request_duration_seconds",
+		Help:      "Time taken to serve JSON API requests.",
+	},
+	[]string{"method", "route", "code"},
+)
+
+func init() {
+	prometheus.MustRegister(apiRequestDuration)
+}
+
+// newMetricsHandler serves the registered metrics together with those read
+// from the node when scraped
+func newMetricsHandler(node *core.OpenBazaarNode) http.Handler {
+	nodeMetrics := prometheus.NewRegistry()
+	nodeMetrics.MustRegister(core.NewMetricsCollector(node))
+	return promhttp.HandlerFor(
+		prometheus.Gatherers{prometheus.DefaultGatherer, nodeMetrics},
+		promhttp.HandlerOpts{ErrorHandling: promhttp.ContinueOnError},
+	)
+}
+
+// routeLabel returns the route pattern used to label the metrics of a request
+func (i *jsonAPIHandler) routeLabel(method, p string) string {
+	rt, _ := i.router.lookup(method, strings.TrimPrefix(p, apiVersionPrefix))
+	if rt == nil {
+		return "other"
+	}
+	return rt.Pattern
+}
+
+// statusRecorder keeps the status code written by a handler
+type statusRecorder struct {
+	http.ResponseWriter
+	code int
+}
+
+func (s *statusRecorder) WriteHeader(code int) {
+	s.code = code
+	s.ResponseWriter.WriteHeader(code)
+}
+
+// observeRequest records the duration of a request once it has been served
+func observeRequest(method, route string, w *statusRecorder, start time.Time) {
+	apiRequestDuration.WithLabelValues(method, route, strconv.Itoa(w.code)).Observe(time.Since(start).Seconds())
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/smtp"
 	"strings"
+	"sync"
 
 	"github.com/OpenBazaar/openbazaar-go/core"
 	"github.com/OpenBazaar/openbazaar-go/repo"
@@ -18,7 +19,7 @@ type notificationManager struct {
 	node *core.OpenBazaarNode
 }
 
-func manageNotifications(node *core.OpenBazaarNode, out chan []byte) chan repo.Notifier {
+func manageNotifications(node *core.OpenBazaarNode, out chan []byte, streams *notificationStreams) chan repo.Notifier {
 	manager := &notificationManager{node: node}
 	nodeBroadcast := make(chan repo.Notifier)
 	go func() {
@@ -28,6 +29,7 @@ func manageNotifications(node *core.OpenBazaarNode, out chan []byte) chan repo.N
 			// enough to let us send any data to the websocket. You can technically do that by
 			// sending over a []byte as the serialize function ignores []bytes but it's kind of hacky.
 			manager.sendNotification(n)
+			streams.broadcast(n)
 			data, err := n.WebsocketData()
 			if err != nil {
 				log.Error("marshal notification:", err)
@@ -44,6 +46,48 @@ func manageNotifications(node *core.OpenBazaarNode, out chan []byte) chan repo.N
 	return nodeBroadcast
 }
 
+// notificationStreams fans the notifications out to the subscribed gRPC
+// streams. Subscribers which fall behind are dropped rather than blocking
+// the broadcast.
+type notificationStreams struct {
+	sync.Mutex
+	subscribers map[chan repo.Notifier]bool
+}
+
+func newNotificationStreams() *notificationStreams {
+	return &notificationStreams{subscribers: make(map[chan repo.Notifier]bool)}
+}
+
+func (s *notificationStreams) subscribe() chan repo.Notifier {
+	s.Lock()
+	defer s.Unlock()
+	c := make(chan repo.Notifier, 256)
+	s.subscribers[c] = true
+	return c
+}
+
+func (s *notificationStreams) unsubscribe(c chan repo.Notifier) {
+	s.Lock()
+	defer s.Unlock()
+	if s.subscribers[c] {
+		delete(s.subscribers, c)
+		close(c)
+	}
+}
+
+func (s *notificationStreams) broadcast(n repo.Notifier) {
+	s.Lock()
+	defer s.Unlock()
+	for c := range s.subscribers {
+		select {
+		case c <- n:
+		default:
+			delete(s.subscribers, c)
+			close(c)
+		}
+	}
+}
+
 type notifier interface {
 	notify(n repo.Notifier) error
 }
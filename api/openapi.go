@@ -0,0 +1,325 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/proto"
+)
+
+// currencyValueSchema mirrors the JSON encoding of repo.CurrencyValue
+type currencyValueSchema struct {
+	Amount   string                  `json:"amount"`
+	Currency repo.CurrencyDefinition `json:"currency"`
+}
+
+var (
+	protoMessageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+	bigIntType       = reflect.TypeOf(big.Int{})
+	rawMessageType   = reflect.TypeOf(json.RawMessage{})
+
+	// schemaAliases maps types with custom JSON encodings onto types
+	// which describe their encoded shape
+	schemaAliases = map[reflect.Type]reflect.Type{
+		reflect.TypeOf(repo.CurrencyValue{}): reflect.TypeOf(currencyValueSchema{}),
+	}
+	// dateTimeTypes are encoded as RFC3339 strings
+	dateTimeTypes = map[string]bool{
+		"time.Time":           true,
+		"repo.APITime":        true,
+		"timestamp.Timestamp": true,
+	}
+)
+
+// openAPISchemas collects the component schemas referenced by the document
+type openAPISchemas map[string]interface{}
+
+func schemaName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if n := strings.LastIndex(pkg, "/"); n >= 0 {
+		pkg = pkg[n+1:]
+	}
+	return pkg + "." + t.Name()
+}
+
+// schemaFor returns the schema of the JSON encoding of values of type t.
+// Named structs are added to the components and referenced.
+func (s openAPISchemas) schemaFor(t reflect.Type, isProto bool) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Name() != "" && dateTimeTypes[schemaName(t)] {
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	}
+	switch t {
+	case bigIntType:
+		return map[string]interface{}{"type": "string"}
+	case rawMessageType:
+		return map[string]interface{}{}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Int64, reflect.Uint64:
+		// jsonpb encodes 64 bit integers as strings
+		if isProto {
+			return map[string]interface{}{"type": "string", "format": "int64"}
+		}
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{"type": "array", "items": s.schemaFor(t.Elem(), isProto)}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": s.schemaFor(t.Elem(), isProto)}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.structSchema(t)
+		}
+		name := schemaName(t)
+		if _, ok := s[name]; !ok {
+			// reserve the name before descending so recursive types terminate
+			s[name] = map[string]interface{}{}
+			if alias, ok := schemaAliases[t]; ok {
+				s[name] = s.structSchema(alias)
+			} else {
+				s[name] = s.structSchema(t)
+			}
+		}
+		return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+	}
+	return map[string]interface{}{}
+}
+
+func (s openAPISchemas) structSchema(t reflect.Type) map[string]interface{} {
+	var (
+		isProto    = reflect.PtrTo(t).Implements(protoMessageType)
+		properties = make(map[string]interface{})
+	)
+	for n := 0; n < t.NumField(); n++ {
+		f := t.Field(n)
+		if f.PkgPath != "" || strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		if isProto {
+			s.addProtoField(properties, t, f)
+			continue
+		}
+		name := f.Name
+		if tag, ok := f.Tag.Lookup("json"); ok {
+			if tag == "-" {
+				continue
+			}
+			if v := strings.Split(tag, ",")[0]; v != "" {
+				name = v
+			}
+		}
+		if f.Anonymous && f.Type.Kind() == reflect.Struct && f.Tag.Get("json") == "" {
+			embedded := s.structSchema(f.Type)
+			for k, v := range embedded["properties"].(map[string]interface{}) {
+				properties[k] = v
+			}
+			continue
+		}
+		properties[name] = s.schemaFor(f.Type, false)
+	}
+	return map[string]interface{}{"type": "object", "properties": properties}
+}
+
+// addProtoField adds the properties for a protobuf message field as encoded
+// by jsonpb. Oneof fields are inlined into the parent message.
+func (s openAPISchemas) addProtoField(properties map[string]interface{}, msg reflect.Type, f reflect.StructField) {
+	if _, ok := f.Tag.Lookup("protobuf_oneof"); ok {
+		wrappers, ok := reflect.New(msg).Interface().(interface{ XXX_OneofWrappers() []interface{} })
+		if !ok {
+			return
+		}
+		for _, w := range wrappers.XXX_OneofWrappers() {
+			wt := reflect.TypeOf(w).Elem()
+			if wt.NumField() == 1 {
+				s.addProtoField(properties, msg, wt.Field(0))
+			}
+		}
+		return
+	}
+	var name, jsonName, enum string
+	for _, part := range strings.Split(f.Tag.Get("protobuf"), ",") {
+		switch {
+		case strings.HasPrefix(part, "name="):
+			name = strings.TrimPrefix(part, "name=")
+		case strings.HasPrefix(part, "json="):
+			jsonName = strings.TrimPrefix(part, "json=")
+		case strings.HasPrefix(part, "enum="):
+			enum = strings.TrimPrefix(part, "enum=")
+		}
+	}
+	if jsonName != "" {
+		name = jsonName
+	}
+	if name == "" {
+		name = f.Name
+	}
+	if enum == "" {
+		properties[name] = s.schemaFor(f.Type, true)
+		return
+	}
+	// jsonpb encodes enums by their value names
+	var (
+		values []string
+		schema = map[string]interface{}{"type": "string"}
+	)
+	for v := range proto.EnumValueMap(enum) {
+		values = append(values, v)
+	}
+	if len(values) > 0 {
+		sort.Strings(values)
+		schema["enum"] = values
+	}
+	if f.Type.Kind() == reflect.Slice {
+		schema = map[string]interface{}{"type": "array", "items": schema}
+	}
+	properties[name] = schema
+}
+
+// operationID derives a unique operation ID from the handler name and the
+// path parameters of the route
+func operationID(rt *route) string {
+	name := runtime.FuncForPC(reflect.ValueOf(rt.Handler).Pointer()).Name()
+	if n := strings.LastIndex(name, "."); n >= 0 {
+		name = name[n+1:]
+	}
+	var params []string
+	for _, s := range rt.segments {
+		if isParamSegment(s) {
+			p := s[1 : len(s)-1]
+			params = append(params, strings.ToUpper(p[:1])+p[1:])
+		}
+	}
+	if len(params) > 0 {
+		name += "By" + strings.Join(params, "And")
+	}
+	return name
+}
+
+// openAPIDocument builds an OpenAPI 3 document describing the routes
+func (a *apiRouter) openAPIDocument() map[string]interface{} {
+	var (
+		schemas    = openAPISchemas{}
+		paths      = make(map[string]interface{})
+		errorRef   = schemas.schemaFor(reflect.TypeOf(APIError{}), false)
+		jsonSchema = func(schema map[string]interface{}) map[string]interface{} {
+			return map[string]interface{}{
+				"application/json": map[string]interface{}{"schema": schema},
+			}
+		}
+	)
+	for _, rt := range a.routes {
+		var parameters []interface{}
+		for _, s := range rt.segments {
+			if isParamSegment(s) {
+				parameters = append(parameters, map[string]interface{}{
+					"name":     s[1 : len(s)-1],
+					"in":       "path",
+					"required": true,
+					"schema":   map[string]interface{}{"type": "string"},
+				})
+			}
+		}
+		for _, q := range rt.Doc.Query {
+			parameters = append(parameters, map[string]interface{}{
+				"name":        q.Name,
+				"in":          "query",
+				"description": q.Description,
+				"schema":      map[string]interface{}{"type": "string"},
+			})
+		}
+
+		success := map[string]interface{}{"description": "OK"}
+		if rt.Doc.Response != nil {
+			success["content"] = jsonSchema(schemas.schemaFor(reflect.TypeOf(rt.Doc.Response), false))
+		}
+		operation := map[string]interface{}{
+			"operationId": operationID(rt),
+			"summary":     rt.Doc.Summary,
+			"responses": map[string]interface{}{
+				"200": success,
+				"default": map[string]interface{}{
+					"description": "Error",
+					"content":     jsonSchema(errorRef),
+				},
+			},
+		}
+		if rt.Doc.Tag != "" {
+			operation["tags"] = []string{rt.Doc.Tag}
+		}
+		if len(parameters) > 0 {
+			operation["parameters"] = parameters
+		}
+		if rt.Doc.Request != nil {
+			operation["requestBody"] = map[string]interface{}{
+				"required": true,
+				"content":  jsonSchema(schemas.schemaFor(reflect.TypeOf(rt.Doc.Request), false)),
+			}
+		}
+
+		p := apiVersionPrefix + rt.Pattern
+		item, ok := paths[p].(map[string]interface{})
+		if !ok {
+			item = make(map[string]interface{})
+			paths[p] = item
+		}
+		item[strings.ToLower(rt.Method)] = operation
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.0",
+		"info": map[string]interface{}{
+			"title":   "OpenBazaar API",
+			"version": core.VERSION,
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": schemas,
+			"securitySchemes": map[string]interface{}{
+				"basicAuth": map[string]interface{}{
+					"type":   "http",
+					"scheme": "basic",
+				},
+				"cookieAuth": map[string]interface{}{
+					"type": "apiKey",
+					"in":   "cookie",
+					"name": "OpenBazaar_Auth_Cookie",
+				},
+			},
+		},
+		"security": []interface{}{
+			map[string]interface{}{"basicAuth": []string{}},
+			map[string]interface{}{"cookieAuth": []string{}},
+		},
+	}
+}
+
+func (i *jsonAPIHandler) GETOpenAPI(w http.ResponseWriter, r *http.Request) {
+	out, err := json.MarshalIndent(i.router.openAPIDocument(), "", "    ")
+	if err != nil {
+		ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	fmt.Fprint(w, string(out))
+}
@@ -0,0 +1,340 @@
+package api
+
+import (
+	"bytes"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	cid "gx/ipfs/QmTbxNB1NwDesLmKTscr4udL2tVP7MaxvXnD1D9yX7g3PN/go-cid"
+	prometheus "gx/ipfs/QmTQuFQWHAWy4wMH6ZyPfGiawA5u9T8rs79FENoV8yXaoS/client_golang/prometheus"
+)
+
+const (
+	// Responses larger than this are served but never cached
+	maxCachedResponseSize = 1 << 20
+
+	// How often idle token buckets are dropped
+	bucketSweepInterval = time.Minute
+)
+
+// gatewayRejectedRequests counts the public gateway requests refused by the
+// limiter, labelled with the reason. It is exposed alongside the IPFS
+// metrics at /debug/metrics/prometheus on the IPFS API.
+var gatewayRejectedRequests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "openbazaar",
+		Subsystem: "public_gateway",
+		Name:      "rejected_requests_total",
+		Help:      "Number of public gateway requests rejected by the rate limiter.",
+	},
+	[]string{"reason"},
+)
+
+// gatewayCacheHits counts the public gateway requests served from the
+// response cache
+var gatewayCacheHits = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Namespace: "openbazaar",
+		Subsystem: "public_gateway",
+		Name:      "cache_hits_total",
+		Help:      "Number of public gateway requests served from the response cache.",
+	},
+)
+
+func init() {
+	prometheus.MustRegister(gatewayRejectedRequests, gatewayCacheHits)
+}
+
+// gatewayLimiter protects the public gateway from abuse. Every remote IP is
+// given a token bucket, requests which may fetch data from other peers share
+// a fixed number of slots and successful GET responses are cached by path.
+type gatewayLimiter struct {
+	rate  float64
+	burst float64
+
+	mtx       sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+
+	fetchSlots chan struct{}
+	cache      *responseCache
+
+	// the node's own peer ID, which is served locally
+	self string
+
+	now func() time.Time
+}
+
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+func newGatewayLimiter(config schema.PublicGatewayConfig, self string) *gatewayLimiter {
+	l := &gatewayLimiter{
+		rate:    config.RequestsPerSecond,
+		burst:   float64(config.Burst),
+		buckets: make(map[string]*tokenBucket),
+		self:    self,
+		now:     time.Now,
+	}
+	if l.burst < 1 {
+		l.burst = 1
+	}
+	if config.MaxConcurrentFetches > 0 {
+		l.fetchSlots = make(chan struct{}, config.MaxConcurrentFetches)
+	}
+	ttl, _ := time.ParseDuration(config.CacheTTL)
+	if ttl > 0 && config.CacheSize > 0 {
+		l.cache = newResponseCache(ttl, config.CacheSize)
+	}
+	return l
+}
+
+// wrap returns a handler applying the limits before calling next
+func (l *gatewayLimiter) wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !l.allow(remoteIP(r)) {
+			gatewayRejectedRequests.WithLabelValues("rate_limited").Inc()
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Retry-After", strconv.Itoa(l.retryAfter()))
+			ErrorResponse(w, http.StatusTooManyRequests, "too many requests")
+			return
+		}
+
+		var key string
+		if l.cache != nil && r.Method == "GET" {
+			key = r.URL.Path + "?" + r.URL.RawQuery
+			if resp, ok := l.cache.get(key, l.now()); ok {
+				gatewayCacheHits.Inc()
+				resp.write(w)
+				return
+			}
+		}
+
+		if l.fetchSlots != nil && isNetworkFetch(r.Method, r.URL.Path, l.self) {
+			select {
+			case l.fetchSlots <- struct{}{}:
+				defer func() { <-l.fetchSlots }()
+			default:
+				gatewayRejectedRequests.WithLabelValues("fetch_limit").Inc()
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Retry-After", "1")
+				ErrorResponse(w, http.StatusServiceUnavailable, "too many concurrent requests")
+				return
+			}
+		}
+
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		rec := &responseRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+		if rec.status == http.StatusOK && !rec.overflow {
+			l.cache.put(key, &cachedResponse{
+				header:  rec.header,
+				body:    rec.body.Bytes(),
+				expires: l.now().Add(l.cache.ttl),
+			}, l.now())
+		}
+	})
+}
+
+// allow takes a token from the bucket of the given IP. A rate of zero
+// disables rate limiting.
+func (l *gatewayLimiter) allow(ip string) bool {
+	if l.rate <= 0 {
+		return true
+	}
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := l.now()
+	if l.lastSweep.IsZero() {
+		l.lastSweep = now
+	}
+	if now.Sub(l.lastSweep) > bucketSweepInterval {
+		l.sweep(now)
+	}
+	b, ok := l.buckets[ip]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, updated: now}
+		l.buckets[ip] = b
+	}
+	b.tokens += now.Sub(b.updated).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.updated = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// sweep drops the buckets which have refilled completely since they were
+// last used. They are indistinguishable from new buckets.
+func (l *gatewayLimiter) sweep(now time.Time) {
+	full := time.Duration(l.burst / l.rate * float64(time.Second))
+	for ip, b := range l.buckets {
+		if now.Sub(b.updated) >= full {
+			delete(l.buckets, ip)
+		}
+	}
+	l.lastSweep = now
+}
+
+// retryAfter returns the number of seconds until a token is available
+func (l *gatewayLimiter) retryAfter() int {
+	secs := int(1 / l.rate)
+	if secs < 1 {
+		secs = 1
+	}
+	return secs
+}
+
+// isNetworkFetch reports whether the public gateway request may need to
+// fetch data from another peer. This is the case for every POST and for GET
+// requests naming another peer's ID or a hash after the endpoint, which are
+// resolved through IPNS or IPFS. Slugs and the node's own ID are served
+// locally.
+func isNetworkFetch(method, path, self string) bool {
+	if method == "POST" {
+		return true
+	}
+	path = strings.TrimPrefix(path, apiVersionPrefix)
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) <= 2 {
+		return false
+	}
+	for _, part := range parts[2:] {
+		if part == "" || part == self {
+			continue
+		}
+		if _, err := cid.Decode(part); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+type cachedResponse struct {
+	header  http.Header
+	body    []byte
+	expires time.Time
+}
+
+func (c *cachedResponse) write(w http.ResponseWriter) {
+	for k, v := range c.header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(c.body)
+}
+
+// responseCache holds up to size responses for ttl each
+type responseCache struct {
+	ttl  time.Duration
+	size int
+
+	mtx     sync.Mutex
+	entries map[string]*cachedResponse
+}
+
+func newResponseCache(ttl time.Duration, size int) *responseCache {
+	return &responseCache{
+		ttl:     ttl,
+		size:    size,
+		entries: make(map[string]*cachedResponse),
+	}
+}
+
+func (c *responseCache) get(key string, now time.Time) (*cachedResponse, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	resp, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if now.After(resp.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return resp, true
+}
+
+// put stores the response. When the cache is full expired entries are
+// dropped first, then the entry closest to expiry.
+func (c *responseCache) put(key string, resp *cachedResponse, now time.Time) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.size {
+		var (
+			oldestKey string
+			oldest    *cachedResponse
+		)
+		for k, e := range c.entries {
+			if now.After(e.expires) {
+				delete(c.entries, k)
+				continue
+			}
+			if oldest == nil || e.expires.Before(oldest.expires) {
+				oldestKey, oldest = k, e
+			}
+		}
+		if len(c.entries) >= c.size {
+			delete(c.entries, oldestKey)
+		}
+	}
+	c.entries[key] = resp
+}
+
+// responseRecorder passes the response through while keeping a copy of it
+type responseRecorder struct {
+	http.ResponseWriter
+	status   int
+	header   http.Header
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (r *responseRecorder) WriteHeader(code int) {
+	if r.status == 0 {
+		r.status = code
+		r.header = make(http.Header)
+		for k, v := range r.ResponseWriter.Header() {
+			r.header[k] = append([]string(nil), v...)
+		}
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.WriteHeader(http.StatusOK)
+	}
+	if !r.overflow {
+		if r.body.Len()+len(b) > maxCachedResponseSize {
+			r.overflow = true
+			r.body.Reset()
+		} else {
+			r.body.Write(b)
+		}
+	}
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+const (
+	testLimiterSelf  = "QmVisrQ9apmvTLnq9FSNKbP8dYvBvkP4AeeysHZg89oB9q"
+	testLimiterSlow  = "QmSgv3UpDMeLJCw6fWXsZHPJspPxDGc2SjHkn5ZRJeJRVz"
+	testLimiterOther = "QmbJWAESqCsf4RFCqEY7jecCashj8usXiyDNfKtZCwwzGb"
+)
+
+func newTestLimiter(config schema.PublicGatewayConfig, now *time.Time) *gatewayLimiter {
+	l := newGatewayLimiter(config, testLimiterSelf)
+	l.now = func() time.Time { return *now }
+	return l
+}
+
+func serveLimited(h http.Handler, method, path, remoteAddr string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, path, nil)
+	r.RemoteAddr = remoteAddr
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func TestGatewayLimiterTokenBucket(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	l := newTestLimiter(schema.PublicGatewayConfig{RequestsPerSecond: 1, Burst: 2}, &now)
+	h := l.wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	for i := 0; i < 2; i++ {
+		if w := serveLimited(h, "GET", "/ob/profile", "1.2.3.4:1000"); w.Code != http.StatusOK {
+			t.Fatalf("request %d: expected 200, got %d", i, w.Code)
+		}
+	}
+	w := serveLimited(h, "GET", "/ob/profile", "1.2.3.4:1001")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("expected 429 after the burst, got %d", w.Code)
+	}
+	if w.Header().Get("Retry-After") == "" {
+		t.Error("expected a Retry-After header")
+	}
+	if w := serveLimited(h, "GET", "/ob/profile", "5.6.7.8:1000"); w.Code != http.StatusOK {
+		t.Errorf("expected other IPs to be unaffected, got %d", w.Code)
+	}
+
+	now = now.Add(time.Second)
+	if w := serveLimited(h, "GET", "/ob/profile", "1.2.3.4:1000"); w.Code != http.StatusOK {
+		t.Errorf("expected a token after refill, got %d", w.Code)
+	}
+
+	now = now.Add(2 * bucketSweepInterval)
+	l.allow("9.9.9.9")
+	if len(l.buckets) != 1 {
+		t.Errorf("expected idle buckets to be swept, have %d", len(l.buckets))
+	}
+}
+
+func TestGatewayLimiterConcurrentFetches(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	l := newTestLimiter(schema.PublicGatewayConfig{MaxConcurrentFetches: 1}, &now)
+
+	var (
+		started = make(chan struct{})
+		release = make(chan struct{})
+	)
+	h := l.wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ob/profile/"+testLimiterSlow {
+			close(started)
+			<-release
+		}
+	}))
+
+	done := make(chan struct{})
+	go func() {
+		serveLimited(h, "GET", "/ob/profile/"+testLimiterSlow, "1.2.3.4:1000")
+		close(done)
+	}()
+	<-started
+
+	if w := serveLimited(h, "GET", "/ob/listings/"+testLimiterOther, "1.2.3.4:1000"); w.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503 while the fetch slot is taken, got %d", w.Code)
+	}
+	if w := serveLimited(h, "POST", "/ob/fetchprofiles", "1.2.3.4:1000"); w.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected 503 for POST while the fetch slot is taken, got %d", w.Code)
+	}
+	for _, local := range []string{"/ob/profile", "/ob/listing/my-listing", "/ob/profile/" + testLimiterSelf, "/ob/listing/" + testLimiterSelf + "/my-listing"} {
+		if w := serveLimited(h, "GET", local, "1.2.3.4:1000"); w.Code != http.StatusOK {
+			t.Errorf("expected local request %s to bypass the fetch limit, got %d", local, w.Code)
+		}
+	}
+
+	close(release)
+	<-done
+	if w := serveLimited(h, "GET", "/v1/ob/listings/"+testLimiterOther, "1.2.3.4:1000"); w.Code != http.StatusOK {
+		t.Errorf("expected the fetch slot to be released, got %d", w.Code)
+	}
+}
+
+func TestGatewayLimiterResponseCache(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	l := newTestLimiter(schema.PublicGatewayConfig{CacheTTL: "1m", CacheSize: 1}, &now)
+
+	var calls int32
+	h := l.wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.URL.Path == "/ob/profile/QmMissing" {
+			ErrorResponse(w, http.StatusNotFound, "not found")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"peerID":"` + r.URL.Path + `"}`))
+	}))
+
+	first := serveLimited(h, "GET", "/ob/profile/QmPeer", "1.2.3.4:1000")
+	second := serveLimited(h, "GET", "/ob/profile/QmPeer", "5.6.7.8:1000")
+	if calls != 1 {
+		t.Errorf("expected the second request to be cached, handler called %d times", calls)
+	}
+	if second.Body.String() != first.Body.String() || second.Header().Get("Content-Type") != "application/json" {
+		t.Errorf("cached response differs: %q %v", second.Body.String(), second.Header())
+	}
+
+	serveLimited(h, "GET", "/ob/profile/QmPeer?usecache=true", "1.2.3.4:1000")
+	if calls != 2 {
+		t.Errorf("expected the query to be part of the cache key, handler called %d times", calls)
+	}
+
+	serveLimited(h, "GET", "/ob/profile/QmMissing", "1.2.3.4:1000")
+	serveLimited(h, "GET", "/ob/profile/QmMissing", "1.2.3.4:1000")
+	if calls != 4 {
+		t.Errorf("expected errors not to be cached, handler called %d times", calls)
+	}
+
+	serveLimited(h, "POST", "/ob/fetchprofiles", "1.2.3.4:1000")
+	serveLimited(h, "POST", "/ob/fetchprofiles", "1.2.3.4:1000")
+	if calls != 6 {
+		t.Errorf("expected POST requests not to be cached, handler called %d times", calls)
+	}
+
+	now = now.Add(2 * time.Minute)
+	serveLimited(h, "GET", "/ob/profile/QmPeer?usecache=true", "1.2.3.4:1000")
+	if calls != 7 {
+		t.Errorf("expected the cached response to expire, handler called %d times", calls)
+	}
+}
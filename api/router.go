@@ -0,0 +1,162 @@
+package api
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// apiVersionPrefix is the path prefix under which the versioned API is served
+const apiVersionPrefix = "/v1"
+
+// routeHandler is the signature shared by all jsonAPIHandler endpoint methods
+type routeHandler func(i *jsonAPIHandler, w http.ResponseWriter, r *http.Request)
+
+// route describes a single endpoint of the versioned API. The pattern is
+// matched exactly, segment by segment, where a segment of the form `{name}`
+// matches any non-empty path segment. Handlers parse their parameters from
+// the request path.
+type route struct {
+	Method  string
+	Pattern string
+	Handler routeHandler
+
+	// Blocking routes wait for the network service to be ready before
+	// the handler is invoked
+	Blocking bool
+	// Gateway routes are served publicly when the API is not enabled
+	Gateway bool
+
+	// Doc holds the data used to describe the route in the OpenAPI document
+	Doc routeDoc
+
+	segments []string
+}
+
+// routeDoc describes the request and response shapes of a route. Request and
+// Response hold zero values of the types which are (un)marshalled by the
+// handler and are only used to derive a schema.
+type routeDoc struct {
+	Summary  string
+	Tag      string
+	Query    []queryParam
+	Request  interface{}
+	Response interface{}
+}
+
+type queryParam struct {
+	Name        string
+	Description string
+}
+
+// apiRouter dispatches requests to routes matching the exact path and method
+type apiRouter struct {
+	routes []*route
+}
+
+func newAPIRouter(routes []*route) *apiRouter {
+	for _, rt := range routes {
+		rt.segments = splitPath(rt.Pattern)
+	}
+	return &apiRouter{routes: routes}
+}
+
+func splitPath(p string) []string {
+	return strings.Split(strings.Trim(p, "/"), "/")
+}
+
+func isParamSegment(s string) bool {
+	return strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}")
+}
+
+// match returns whether the path matches the pattern and the number of
+// literal segments matched, so that a literal match can be preferred over a
+// parameterized one.
+func (rt *route) match(segments []string) (int, bool) {
+	if len(segments) != len(rt.segments) {
+		return 0, false
+	}
+	var literals int
+	for n, s := range rt.segments {
+		if isParamSegment(s) {
+			if segments[n] == "" {
+				return 0, false
+			}
+			continue
+		}
+		if s != segments[n] {
+			return 0, false
+		}
+		literals++
+	}
+	return literals, true
+}
+
+// lookup finds the route for the method and path. The allowed methods for
+// the path are returned when the path is known but the method is not.
+func (a *apiRouter) lookup(method, p string) (*route, []string) {
+	if method == "HEAD" {
+		method = "GET"
+	}
+	var (
+		segments = splitPath(p)
+		found    *route
+		best     = -1
+		allowed  = make(map[string]bool)
+	)
+	for _, rt := range a.routes {
+		literals, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+		if rt.Method != method {
+			allowed[rt.Method] = true
+			continue
+		}
+		if literals > best {
+			found, best = rt, literals
+		}
+	}
+	if found != nil {
+		return found, nil
+	}
+	var methods []string
+	for m := range allowed {
+		methods = append(methods, m)
+	}
+	sort.Strings(methods)
+	return nil, methods
+}
+
+// gatewayAllowed returns whether the path is served when the API is disabled
+func (a *apiRouter) gatewayAllowed(method, p string) bool {
+	rt, _ := a.lookup(method, strings.TrimPrefix(p, apiVersionPrefix))
+	return rt != nil && rt.Gateway
+}
+
+// serve dispatches the request to the matching route. The version prefix is
+// stripped from the request path before the handler is called so handlers
+// parse their parameters the same way for both API versions.
+func (a *apiRouter) serve(i *jsonAPIHandler, p string, w http.ResponseWriter, r *http.Request) {
+	p = strings.TrimPrefix(p, apiVersionPrefix)
+	rt, allowed := a.lookup(r.Method, p)
+	if rt == nil {
+		if len(allowed) > 0 {
+			w.Header().Set("Allow", strings.Join(allowed, ", "))
+			ErrorResponse(w, http.StatusMethodNotAllowed, "Method Not Allowed")
+			return
+		}
+		ErrorResponse(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	u := *r.URL
+	u.Path = p
+	r.URL = &u
+	if rt.Blocking {
+		blockingStartupMiddleware(i, w, r, func(w http.ResponseWriter, r *http.Request) {
+			rt.Handler(i, w, r)
+		})
+		return
+	}
+	rt.Handler(i, w, r)
+}
@@ -0,0 +1,176 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestRouter(called *string) *apiRouter {
+	handler := func(name string) routeHandler {
+		return func(i *jsonAPIHandler, w http.ResponseWriter, r *http.Request) {
+			*called = name + ":" + r.URL.Path
+		}
+	}
+	return newAPIRouter([]*route{
+		{Method: "GET", Pattern: "/ob/listings", Handler: handler("listings"), Gateway: true},
+		{Method: "GET", Pattern: "/ob/listings/{peerID}", Handler: handler("listingsByPeer"), Gateway: true},
+		{Method: "GET", Pattern: "/ob/listing/{listingID}", Handler: handler("listing")},
+		{Method: "GET", Pattern: "/ob/listing/{peerID}/{listingID}", Handler: handler("listingByPeer")},
+		{Method: "GET", Pattern: "/ob/listing/mine/{listingID}", Handler: handler("literal")},
+		{Method: "POST", Pattern: "/ob/listing", Handler: handler("create")},
+	})
+}
+
+func TestAPIRouterMatchesExactPaths(t *testing.T) {
+	var called string
+	router := newTestRouter(&called)
+
+	tests := []struct {
+		method, path string
+		expected     string
+	}{
+		{"GET", "/v1/ob/listings", "listings:/ob/listings"},
+		{"GET", "/v1/ob/listings/", "listings:/ob/listings/"},
+		{"GET", "/v1/ob/listings/QmPeer", "listingsByPeer:/ob/listings/QmPeer"},
+		{"GET", "/v1/ob/listing/slug", "listing:/ob/listing/slug"},
+		{"HEAD", "/v1/ob/listing/slug", "listing:/ob/listing/slug"},
+		{"GET", "/v1/ob/listing/QmPeer/slug", "listingByPeer:/ob/listing/QmPeer/slug"},
+		{"GET", "/v1/ob/listing/mine/slug", "literal:/ob/listing/mine/slug"},
+		{"POST", "/v1/ob/listing", "create:/ob/listing"},
+	}
+	for _, test := range tests {
+		called = ""
+		r := httptest.NewRequest(test.method, test.path, nil)
+		w := httptest.NewRecorder()
+		router.serve(nil, r.URL.Path, w, r)
+		if w.Code != http.StatusOK {
+			t.Errorf("%s %s: expected status 200, got %d", test.method, test.path, w.Code)
+		}
+		if called != test.expected {
+			t.Errorf("%s %s: expected %q, got %q", test.method, test.path, test.expected, called)
+		}
+	}
+}
+
+func TestAPIRouterErrors(t *testing.T) {
+	var called string
+	router := newTestRouter(&called)
+
+	tests := []struct {
+		method, path string
+		status       int
+		reason       string
+		allow        string
+	}{
+		{"GET", "/v1/ob/listingsx", http.StatusNotFound, "Not Found", ""},
+		{"GET", "/v1/ob/listing", http.StatusMethodNotAllowed, "Method Not Allowed", "POST"},
+		{"GET", "/v1/ob/listing/a/b/c", http.StatusNotFound, "Not Found", ""},
+		{"DELETE", "/v1/ob/listings", http.StatusMethodNotAllowed, "Method Not Allowed", "GET"},
+		{"PUT", "/v1/ob/listing", http.StatusMethodNotAllowed, "Method Not Allowed", "POST"},
+	}
+	for _, test := range tests {
+		called = ""
+		r := httptest.NewRequest(test.method, test.path, nil)
+		w := httptest.NewRecorder()
+		router.serve(nil, r.URL.Path, w, r)
+		if called != "" {
+			t.Errorf("%s %s: unexpected call to %s", test.method, test.path, called)
+		}
+		if w.Code != test.status {
+			t.Errorf("%s %s: expected status %d, got %d", test.method, test.path, test.status, w.Code)
+		}
+		var resp APIError
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("%s %s: error body is not JSON: %s", test.method, test.path, err)
+		}
+		if resp.Success || resp.Reason != test.reason {
+			t.Errorf("%s %s: unexpected error body %s", test.method, test.path, w.Body.String())
+		}
+		if allow := w.Header().Get("Allow"); allow != test.allow {
+			t.Errorf("%s %s: expected Allow header %q, got %q", test.method, test.path, test.allow, allow)
+		}
+	}
+}
+
+func TestAPIRouterGatewayAllowed(t *testing.T) {
+	var called string
+	router := newTestRouter(&called)
+
+	if !router.gatewayAllowed("GET", "/v1/ob/listings/QmPeer") {
+		t.Error("expected public route to be allowed")
+	}
+	if router.gatewayAllowed("GET", "/v1/ob/listing/slug") {
+		t.Error("expected private route to be rejected")
+	}
+	if router.gatewayAllowed("GET", "/v1/ob/listingsx") {
+		t.Error("expected unknown route to be rejected")
+	}
+}
+
+func TestOpenAPIDocumentDescribesRoutes(t *testing.T) {
+	doc := newAPIRouter(v1Routes()).openAPIDocument()
+
+	out, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var parsed struct {
+		OpenAPI string `json:"openapi"`
+		Paths   map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+			Parameters  []struct {
+				Name string `json:"name"`
+				In   string `json:"in"`
+			} `json:"parameters"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]interface{} `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(out, &parsed); err != nil {
+		t.Fatal(err)
+	}
+
+	if parsed.OpenAPI != "3.0.0" {
+		t.Errorf("unexpected openapi version %s", parsed.OpenAPI)
+	}
+	op, ok := parsed.Paths["/v1/ob/listing/{peerID}/{listingID}"]["get"]
+	if !ok {
+		t.Fatal("expected listing path to be described")
+	}
+	if op.OperationID != "GETListingByPeerIDAndListingID" {
+		t.Errorf("unexpected operation ID %s", op.OperationID)
+	}
+	if len(op.Parameters) < 2 || op.Parameters[0].Name != "peerID" || op.Parameters[0].In != "path" {
+		t.Errorf("expected path parameters to be described, got %+v", op.Parameters)
+	}
+
+	ids := make(map[string]bool)
+	for p, item := range parsed.Paths {
+		for method, op := range item {
+			if ids[op.OperationID] {
+				t.Errorf("duplicate operation ID %s (%s %s)", op.OperationID, method, p)
+			}
+			ids[op.OperationID] = true
+		}
+	}
+
+	listing, ok := parsed.Components.Schemas["pb.Listing"]
+	if !ok {
+		t.Fatal("expected listing schema")
+	}
+	if _, ok := listing.Properties["slug"]; !ok {
+		t.Error("expected listing schema to contain slug")
+	}
+	coupon := parsed.Components.Schemas["pb.Listing_Coupon"]
+	if _, ok := coupon.Properties["discountCode"]; !ok {
+		t.Error("expected oneof fields to be inlined")
+	}
+	if _, ok := parsed.Components.Schemas["api.APIError"]; !ok {
+		t.Error("expected error schema")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
 )
 
 type TransactionQuery struct {
@@ -60,6 +61,14 @@ func convertOrderStates(states []int) []pb.OrderState {
 	return orderStates
 }
 
+// validateStoreTaxes checks the vendor's tax table, if set
+func validateStoreTaxes(s repo.SettingsData) error {
+	if s.StoreTaxes == nil {
+		return nil
+	}
+	return repo.ValidateTaxes(repo.StoreTaxesToProtobuf(*s.StoreTaxes))
+}
+
 func extractModeratorChanges(newModList []string, currentModList *[]string) (toAdd, toDelete []string) {
 	currentModMap := make(map[string]bool)
 	if currentModList != nil {
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"syscall"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/ipfs/go-ipfs/repo/fsrepo"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+type ChangePassword struct {
+	DataDir string `short:"d" long:"datadir" description:"specify the data directory to be used"`
+	Testnet bool   `short:"t" long:"testnet" description:"use the test network"`
+}
+
+func (x *ChangePassword) Execute(args []string) error {
+	repoPath, err := repo.GetRepoPath(x.Testnet, x.DataDir)
+	if err != nil {
+		fmt.Println(err)
+		return nil
+	}
+	if x.DataDir != "" {
+		repoPath = x.DataDir
+	}
+	filename := "mainnet.db"
+	if x.Testnet {
+		filename = "testnet.db"
+	}
+	// A lock file left behind by a daemon which crashed is not held
+	locked, err := fsrepo.LockedByOtherProcess(repoPath)
+	if err != nil {
+		fmt.Println(err)
+		return nil
+	}
+	if locked {
+		fmt.Println("Cannot change the password while the daemon is running. Use the API of the running node instead.")
+		return nil
+	}
+	if _, err := os.Stat(path.Join(repoPath, "datastore", filename)); os.IsNotExist(err) {
+		fmt.Println("Database does not exist. You may need to run the node at least once to initialize it.")
+		return nil
+	}
+
+	fmt.Print("Enter your current password: ")
+	// nolint:unconvert
+	bytePassword, _ := terminal.ReadPassword(int(syscall.Stdin))
+	fmt.Println("")
+	oldPassword := string(bytePassword)
+	if oldPassword == "" {
+		fmt.Println("The database is not encrypted. Use encryptdatabase to encrypt it.")
+		return nil
+	}
+
+	var newPassword string
+	for {
+		fmt.Print("Enter a new password: ")
+		// nolint:unconvert
+		bytePassword, _ := terminal.ReadPassword(int(syscall.Stdin))
+		fmt.Println("")
+		resp := string(bytePassword)
+		if len(resp) < repo.DatabasePasswordMinLength {
+			fmt.Printf("The password must be at least %d characters. Try again.\n", repo.DatabasePasswordMinLength)
+		} else if resp == oldPassword {
+			fmt.Println("That is the current password. Try again.")
+		} else {
+			newPassword = resp
+			break
+		}
+	}
+	for {
+		fmt.Print("Confirm your new password: ")
+		// nolint:unconvert
+		bytePassword, _ := terminal.ReadPassword(int(syscall.Stdin))
+		fmt.Println("")
+		if string(bytePassword) == newPassword {
+			break
+		}
+		fmt.Println("The passwords do not match. Try again.")
+	}
+
+	sqliteDB, err := db.Create(repoPath, oldPassword, x.Testnet, wallet.Bitcoin)
+	if err != nil {
+		fmt.Println(err)
+		return err
+	}
+	defer sqliteDB.Close()
+	if err := sqliteDB.Config().Rekey(oldPassword, newPassword); err != nil {
+		fmt.Println(err)
+		return err
+	}
+	fmt.Println("Success! You must now run openbazaard start with the new password.")
+	return nil
+}
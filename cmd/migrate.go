@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/ipfs/go-ipfs/repo/fsrepo"
+)
+
+// Migrate holds the options shared by the migrate subcommands
+type Migrate struct {
+	DataDir  string `short:"d" long:"datadir" description:"specify the data directory to be used"`
+	Testnet  bool   `short:"t" long:"testnet" description:"use the test network"`
+	Password string `short:"p" long:"password" description:"the encryption password if the database is encrypted"`
+}
+
+func (x *Migrate) repoPath() (string, error) {
+	if x.DataDir != "" {
+		return x.DataDir, nil
+	}
+	return repo.GetRepoPath(x.Testnet, "")
+}
+
+// MigrateStatus prints the schema version of the repo and the pending
+// migrations
+type MigrateStatus struct {
+	Migrate *Migrate `no-flag:"true"`
+}
+
+func (x *MigrateStatus) Execute(args []string) error {
+	repoPath, err := x.Migrate.repoPath()
+	if err != nil {
+		return err
+	}
+	version, err := repo.GetSchemaVersion(repoPath)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Schema version: %d\n", version)
+	fmt.Printf("Latest version: %d\n", len(repo.Migrations))
+	if version > len(repo.Migrations) {
+		fmt.Println("The schema is newer than this binary can migrate.")
+		return nil
+	}
+	steps, err := repo.PlanMigration(repoPath, len(repo.Migrations))
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Pending migrations: %d\n", len(steps))
+	for _, step := range steps {
+		fmt.Printf("  %s\n", step)
+	}
+	return nil
+}
+
+// MigrateUp runs the pending migrations
+type MigrateUp struct {
+	Migrate *Migrate `no-flag:"true"`
+	To      *int     `long:"to" description:"the schema version to migrate to, defaults to the latest version"`
+	DryRun  bool     `long:"dry-run" description:"print the migrations which would run without running them"`
+}
+
+func (x *MigrateUp) Execute(args []string) error {
+	target := len(repo.Migrations)
+	if x.To != nil {
+		target = *x.To
+	}
+	return x.Migrate.migrateTo(target, false, x.DryRun)
+}
+
+// MigrateDown reverts migrations
+type MigrateDown struct {
+	Migrate *Migrate `no-flag:"true"`
+	To      *int     `long:"to" description:"the schema version to revert to, defaults to the previous version"`
+	DryRun  bool     `long:"dry-run" description:"print the migrations which would be reverted without reverting them"`
+}
+
+func (x *MigrateDown) Execute(args []string) error {
+	repoPath, err := x.Migrate.repoPath()
+	if err != nil {
+		return err
+	}
+	version, err := repo.GetSchemaVersion(repoPath)
+	if err != nil {
+		return err
+	}
+	target := version - 1
+	if x.To != nil {
+		target = *x.To
+	}
+	return x.Migrate.migrateTo(target, true, x.DryRun)
+}
+
+func (x *Migrate) migrateTo(target int, down, dryRun bool) error {
+	repoPath, err := x.repoPath()
+	if err != nil {
+		return err
+	}
+	if !fsrepo.IsInitialized(repoPath) {
+		return errors.New("repo is not initialized")
+	}
+	// A lock file left behind by a daemon which crashed is not held
+	locked, err := fsrepo.LockedByOtherProcess(repoPath)
+	if err != nil {
+		return err
+	}
+	if locked {
+		return errors.New("cannot migrate while the daemon is running")
+	}
+	version, err := repo.GetSchemaVersion(repoPath)
+	if err != nil {
+		return err
+	}
+	if down && target > version {
+		return fmt.Errorf("schema is at version %d, use up to migrate to version %d", version, target)
+	} else if !down && target < version {
+		return fmt.Errorf("schema is at version %d, use down to revert to version %d", version, target)
+	}
+
+	steps, err := repo.PlanMigration(repoPath, target)
+	if err != nil {
+		return err
+	}
+	if len(steps) == 0 {
+		fmt.Printf("Schema is already at version %d\n", version)
+		return nil
+	}
+	if dryRun {
+		for _, step := range steps {
+			fmt.Printf("Would %s\n", step)
+		}
+		return nil
+	}
+	backupPath, err := repo.MigrateTo(repoPath, x.Password, x.Testnet, target)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Schema migrated to version %d. The previous files were backed up to %s\n", target, backupPath)
+	return nil
+}
@@ -37,9 +37,12 @@ import (
 	"github.com/OpenBazaar/openbazaar-go/repo/db"
 	"github.com/OpenBazaar/openbazaar-go/schema"
 	sto "github.com/OpenBazaar/openbazaar-go/storage"
+	"github.com/OpenBazaar/openbazaar-go/storage/composite"
 	"github.com/OpenBazaar/openbazaar-go/storage/dropbox"
+	"github.com/OpenBazaar/openbazaar-go/storage/s3"
 	"github.com/OpenBazaar/openbazaar-go/storage/selfhosted"
 	"github.com/OpenBazaar/openbazaar-go/wallet"
+	"github.com/OpenBazaar/openbazaar-go/wallet/exchangerates"
 	lis "github.com/OpenBazaar/openbazaar-go/wallet/listeners"
 	"github.com/OpenBazaar/openbazaar-go/wallet/resync"
 	wi "github.com/OpenBazaar/wallet-interface"
@@ -86,7 +89,7 @@ type Start struct {
 	DualStack            bool     `long:"dualstack" description:"Automatically configure the daemon to run as a Tor hidden service IN ADDITION to using the clear internet. Requires Tor to be running. WARNING: this mode is not private"`
 	DisableWallet        bool     `long:"disablewallet" description:"disable the wallet functionality of the node"`
 	DisableExchangeRates bool     `long:"disableexchangerates" description:"disable the exchange rate service to prevent api queries"`
-	Storage              string   `long:"storage" description:"set the outgoing message storage option [self-hosted, dropbox] default=self-hosted"`
+	Storage              string   `long:"storage" description:"set the outgoing message storage options separated by commas [self-hosted, dropbox, s3] default=self-hosted"`
 
 	ForceKeyCachePurge bool `long:"forcekeypurge" description:"repair test for issue OpenBazaar/openbazaar-go#1593; use as instructed only"`
 }
@@ -238,6 +241,11 @@ func (x *Start) Execute(args []string) error {
 		log.Error("scan ipns extra config:", err)
 		return err
 	}
+	exchangeRatesConfig, err := schema.GetExchangeRatesConfig(configFile)
+	if err != nil {
+		log.Error("scan exchange rates config:", err)
+		return err
+	}
 
 	// IPFS node setup
 	r, err := fsrepo.Open(repoPath)
@@ -456,6 +464,31 @@ func (x *Start) Execute(args []string) error {
 	}
 	resyncManager := resync.NewResyncManager(sqliteDB.Sales(), sqliteDB.Purchases(), mw)
 
+	// Exchange rates setup
+	reserveCode := "BTC"
+	if x.Testnet || x.Regtest {
+		reserveCode = "TBTC"
+	}
+	var builtinRates wi.ExchangeRates
+	if reserveWallet, err := mw.WalletForCurrencyCode(reserveCode); err == nil {
+		builtinRates = reserveWallet.ExchangeRates()
+	}
+	ratesClient := &http.Client{Timeout: time.Second * 30}
+	if torDialer != nil {
+		ratesClient.Transport = &http.Transport{Dial: torDialer.Dial}
+	}
+	var exchangeRates wi.ExchangeRates
+	aggregator, err := exchangerates.NewAggregatorFromConfig(exchangeRatesConfig, reserveCode, builtinRates, repoPath, ratesClient, sqliteDB.ExchangeRates())
+	switch {
+	case err == exchangerates.ErrNoProviders:
+		log.Warning("no exchange rate providers available")
+	case err != nil:
+		return fmt.Errorf("configuring exchange rates: %s", err.Error())
+	default:
+		exchangeRates = aggregator
+		go aggregator.Start()
+	}
+
 	// Master key setup
 	seed := bip39.NewSeed(mn, "")
 	mPrivKey, err := hdkeychain.NewMaster(seed, &params)
@@ -476,19 +509,16 @@ func (x *Start) Execute(args []string) error {
 	}
 
 	// Authenticated gateway
-	gatewayMaddr, err := ma.NewMultiaddr(cfg.Addresses.Gateway[0])
-	if err != nil {
-		log.Error(err)
-		return err
-	}
-	addr, err := gatewayMaddr.ValueForProtocol(ma.P_IP4)
-	if err != nil {
-		log.Error(err)
-		return err
-	}
 	// Override config file preference if this is Mainnet, open internet and API enabled
-	if addr != "127.0.0.1" && params.Name == chaincfg.MainNetParams.Name && apiConfig.Enabled {
-		apiConfig.Authenticated = true
+	if params.Name == chaincfg.MainNetParams.Name && apiConfig.Enabled {
+		public, err := publicAPIListener(cfg.Addresses.Gateway[0], apiConfig)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		if public {
+			apiConfig.Authenticated = true
+		}
 	}
 	apiConfig.AllowedIPs = append(apiConfig.AllowedIPs, x.AllowIP...)
 
@@ -583,6 +613,9 @@ func (x *Start) Execute(args []string) error {
 		TorDialer:                     torDialer,
 		UserAgent:                     core.USERAGENT,
 		IPNSQuorumSize:                uint(ipnsExtraConfig.DHTQuorumSize),
+		SpendingPolicies:              walletsConfig.SpendingPolicies(),
+		AutoFulfillPolicies:           walletsConfig.AutoFulfillPolicies(),
+		ExchangeRates:                 exchangeRates,
 	}
 	core.Node.PublishLock.Lock()
 
@@ -592,30 +625,63 @@ func (x *Start) Execute(args []string) error {
 		return fmt.Errorf("verifying reserve currency converter: %s", err.Error())
 	}
 
-	// Offline messaging storage
-	var storage sto.OfflineMessagingStorage
-	if x.Storage == "self-hosted" || x.Storage == "" {
-		storage = selfhosted.NewSelfHostedStorage(repoPath, core.Node.IpfsNode, pushNodes, core.Node.SendStore)
-	} else if x.Storage == "dropbox" {
-		if usingTor && !usingClearnet {
-			log.Error("dropbox can not be used with tor")
-			return errors.New("dropbox can not be used with tor")
-		}
+	// Offline messaging storage. Several options may be given separated by
+	// commas, in which case every message is stored with each of them.
+	var backends []composite.Backend
+	storageOptions := strings.Split(x.Storage, ",")
+	for _, option := range storageOptions {
+		var storage sto.OfflineMessagingStorage
+		switch strings.TrimSpace(option) {
+		case "self-hosted", "":
+			storage = selfhosted.NewSelfHostedStorage(repoPath, core.Node.IpfsNode, pushNodes, core.Node.SendStore)
+		case "dropbox":
+			if usingTor && !usingClearnet {
+				log.Error("dropbox can not be used with tor")
+				return errors.New("dropbox can not be used with tor")
+			}
 
-		if dropboxToken == "" {
-			err = errors.New("dropbox token not set in config file")
+			if dropboxToken == "" {
+				err = errors.New("dropbox token not set in config file")
+				log.Error(err)
+				return err
+			}
+			storage, err = dropbox.NewDropBoxStorage(dropboxToken)
+			if err != nil {
+				log.Error(err)
+				return err
+			}
+		case "s3":
+			if usingTor && !usingClearnet {
+				log.Error("s3 can not be used with tor")
+				return errors.New("s3 can not be used with tor")
+			}
+
+			s3Config, err := schema.GetS3StorageConfig(configFile)
+			if err != nil {
+				log.Error("scan s3 storage config:", err)
+				return errors.New("s3 storage not set in config file")
+			}
+			s3Storage, err := s3.NewS3Storage(*s3Config, nil)
+			if err != nil {
+				log.Error(err)
+				return err
+			}
+			go s3Storage.Run()
+			storage = s3Storage
+		default:
+			err = errors.New("invalid storage option")
 			log.Error(err)
 			return err
 		}
-		storage, err = dropbox.NewDropBoxStorage(dropboxToken)
+		backends = append(backends, composite.Backend{Name: strings.TrimSpace(option), Storage: storage})
+	}
+	storage := backends[0].Storage
+	if len(backends) > 1 {
+		storage, err = composite.NewCompositeStorage(backends...)
 		if err != nil {
 			log.Error(err)
 			return err
 		}
-	} else {
-		err = errors.New("invalid storage option")
-		log.Error(err)
-		return err
 	}
 	core.Node.MessageStorage = storage
 
@@ -632,6 +698,25 @@ func (x *Start) Execute(args []string) error {
 		return err
 	}
 
+	if apiConfig.Enabled && apiConfig.GRPCEnabled {
+		grpcServer, err := newGRPCServer(gateway, apiConfig.GRPCAddress)
+		if err != nil {
+			log.Error(err)
+			return err
+		}
+		// The gRPC server shuts down with the gateway
+		defer func() {
+			if err := grpcServer.Close(); err != nil {
+				log.Error(err)
+			}
+		}()
+		go func() {
+			if err := grpcServer.Serve(); err != nil {
+				log.Error(err)
+			}
+		}()
+	}
+
 	if len(cfg.Addresses.API) > 0 && cfg.Addresses.API[0] != "" {
 		if _, err := serveHTTPApi(&ctx); err != nil {
 			log.Error(err)
@@ -670,6 +755,9 @@ func (x *Start) Execute(args []string) error {
 		core.Node.StartMessageRetriever()
 		core.Node.StartPointerRepublisher()
 		core.Node.StartRecordAgingNotifier()
+		core.Node.StartSubscriptionScheduler()
+		core.Node.StartCrowdfundScheduler()
+		core.Node.StartAutoFulfillScheduler()
 		core.Node.StartInboundMsgScanner()
 
 		core.Node.PublishLock.Unlock()
@@ -821,6 +909,39 @@ func newHTTPGateway(node *core.OpenBazaarNode, ctx commands.Context, authCookie
 	return api.NewGateway(node, authCookie, manet.NetListener(gwLis), config, ml, opts...)
 }
 
+// publicAPIListener returns whether the gateway or, when enabled, the gRPC
+// server listens on an address which is not the loopback interface
+func publicAPIListener(gatewayAddress string, apiConfig *schema.APIConfig) (bool, error) {
+	addresses := []string{gatewayAddress}
+	if apiConfig.GRPCEnabled {
+		addresses = append(addresses, apiConfig.GRPCAddress)
+	}
+	for _, address := range addresses {
+		maddr, err := ma.NewMultiaddr(address)
+		if err != nil {
+			return false, fmt.Errorf("invalid API address: %q (err: %s)", address, err)
+		}
+		if !manet.IsIPLoopback(maddr) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func newGRPCServer(gateway *api.Gateway, address string) (*api.GRPCServer, error) {
+	grpcMaddr, err := ma.NewMultiaddr(address)
+	if err != nil {
+		return nil, fmt.Errorf("newGRPCServer: invalid gRPC address: %q (err: %s)", address, err)
+	}
+	grpcLis, err := manet.Listen(grpcMaddr)
+	if err != nil {
+		return nil, fmt.Errorf("newGRPCServer: manet.Listen(%s) failed: %s", grpcMaddr, err)
+	}
+	log.Infof("gRPC server listening on %s\n", grpcLis.Multiaddr())
+
+	return api.NewGRPCServer(gateway, manet.NetListener(grpcLis))
+}
+
 // serveHTTPApi collects options, creates listener, prints status message and starts serving requests
 func serveHTTPApi(cctx *commands.Context) (<-chan error, error) {
 	cfg, err := cctx.GetConfig()
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestPublicAPIListener(t *testing.T) {
+	tests := []struct {
+		name     string
+		gateway  string
+		config   schema.APIConfig
+		expected bool
+	}{
+		{"loopback gateway", "/ip4/127.0.0.1/tcp/4002", schema.APIConfig{}, false},
+		{"public gateway", "/ip4/0.0.0.0/tcp/4002", schema.APIConfig{}, true},
+		{"loopback ipv6 gateway", "/ip6/::1/tcp/4002", schema.APIConfig{}, false},
+		{"loopback gRPC", "/ip4/127.0.0.1/tcp/4002", schema.APIConfig{GRPCEnabled: true, GRPCAddress: "/ip4/127.0.0.1/tcp/4003"}, false},
+		{"public gRPC", "/ip4/127.0.0.1/tcp/4002", schema.APIConfig{GRPCEnabled: true, GRPCAddress: "/ip4/0.0.0.0/tcp/4003"}, true},
+		{"public gRPC disabled", "/ip4/127.0.0.1/tcp/4002", schema.APIConfig{GRPCAddress: "/ip4/0.0.0.0/tcp/4003"}, false},
+	}
+	for _, test := range tests {
+		public, err := publicAPIListener(test.gateway, &test.config)
+		if err != nil {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+		if public != test.expected {
+			t.Errorf("%s: expected public to be %t, got %t", test.name, test.expected, public)
+		}
+	}
+	if _, err := publicAPIListener("127.0.0.1:4002", &schema.APIConfig{}); err == nil {
+		t.Error("expected an invalid address to be rejected")
+	}
+}
@@ -1,19 +1,68 @@
 package cmd
 
 import (
+	"crypto/tls"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
 	"os"
+	"path"
+	"strings"
+	"time"
+
+	ma "gx/ipfs/QmTZBfrPJmjWsCvHEtX5FE6KimVJhsJg5sBbqEFYf4UZtL/go-multiaddr"
 
 	obnet "github.com/OpenBazaar/openbazaar-go/net"
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
 	"github.com/OpenBazaar/wallet-interface"
 	"github.com/ipfs/go-ipfs/repo/fsrepo"
 )
 
 type Status struct {
-	DataDir string `short:"d" long:"datadir" description:"specify the data directory to be used"`
-	Testnet bool   `short:"t" long:"testnet" description:"use the test network"`
+	DataDir  string `short:"d" long:"datadir" description:"specify the data directory to be used"`
+	Testnet  bool   `short:"t" long:"testnet" description:"use the test network"`
+	Password string `short:"p" long:"password" description:"the encryption password, needed to check the integrity of an encrypted database"`
+	JSON     bool   `long:"json" description:"print the status as JSON"`
+
+	APIPassword string `long:"apipassword" description:"the password of the API, needed to reach a daemon which uses basic authentication"`
+}
+
+// NodeStatus is the status of the repo and of the daemon using it
+type NodeStatus struct {
+	Initialized   bool           `json:"initialized"`
+	Encrypted     bool           `json:"encrypted"`
+	TorAvailable  bool           `json:"torAvailable"`
+	SchemaVersion int            `json:"schemaVersion"`
+	LatestSchema  int            `json:"latestSchemaVersion"`
+	Database      DatabaseStatus `json:"database"`
+	Config        ConfigStatus   `json:"config"`
+	Daemon        DaemonStatus   `json:"daemon"`
+}
+
+// DatabaseStatus is the result of the integrity check of the database
+type DatabaseStatus struct {
+	Checked   bool     `json:"checked"`
+	Integrity []string `json:"integrity,omitempty"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// ConfigStatus reports whether the config file can be parsed
+type ConfigStatus struct {
+	Valid bool   `json:"valid"`
+	Error string `json:"error,omitempty"`
+}
+
+// DaemonStatus reports whether a daemon holds the repo lock and answers
+// on its API
+type DaemonStatus struct {
+	Running      bool   `json:"running"`
+	APIReachable bool   `json:"apiReachable"`
+	PeerCount    int    `json:"peerCount"`
+	Error        string `json:"error,omitempty"`
 }
 
 func (x *Status) Execute(args []string) error {
@@ -25,45 +74,210 @@ func (x *Status) Execute(args []string) error {
 	if x.DataDir != "" {
 		repoPath = x.DataDir
 	}
-	torAvailable := false
-	_, err = obnet.GetTorControlPort()
-	if err == nil {
-		torAvailable = true
-	}
-	if fsrepo.IsInitialized(repoPath) {
-		sqliteDB, err := db.Create(repoPath, "", x.Testnet, wallet.Bitcoin)
+	status := x.getStatus(repoPath)
+
+	if x.JSON {
+		out, err := json.MarshalIndent(status, "", "    ")
 		if err != nil {
-			os.Exit(1)
+			return err
 		}
-		defer sqliteDB.Close()
-		if sqliteDB.Config().IsEncrypted() {
-			if !torAvailable {
-				fmt.Println("Initialized - Encrypted")
-				os.Exit(30)
-			} else {
-				fmt.Println("Initialized - Encrypted")
-				fmt.Println("Tor Available")
-				os.Exit(31)
-			}
+		fmt.Println(string(out))
+	} else {
+		switch {
+		case !status.Initialized:
+			fmt.Println("Not initialized")
+		case status.Encrypted:
+			fmt.Println("Initialized - Encrypted")
+		default:
+			fmt.Println("Initialized - Not Encrypted")
+		}
+		if status.TorAvailable {
+			fmt.Println("Tor Available")
+		}
+	}
+
+	// The exit code encodes the status for scripts which do not read the output
+	code := 10
+	if status.Initialized {
+		code = 20
+		if status.Encrypted {
+			code = 30
+		}
+	}
+	if status.TorAvailable {
+		code++
+	}
+	os.Exit(code)
+	return nil
+}
+
+func (x *Status) getStatus(repoPath string) NodeStatus {
+	var status NodeStatus
+	status.LatestSchema = len(repo.Migrations)
+	if _, err := obnet.GetTorControlPort(); err == nil {
+		status.TorAvailable = true
+	}
+	if !fsrepo.IsInitialized(repoPath) {
+		return status
+	}
+	status.Initialized = true
+	status.SchemaVersion, _ = repo.GetSchemaVersion(repoPath)
+
+	// The database is encrypted if it can't be read without a key
+	encrypted, err := databaseEncrypted(repoPath, x.Testnet)
+	status.Encrypted = encrypted
+	if err != nil {
+		status.Database.Error = err.Error()
+	} else if status.Encrypted && x.Password == "" {
+		status.Database.Error = "the database is encrypted, pass the password to check its integrity"
+	} else {
+		password := x.Password
+		if !status.Encrypted {
+			password = ""
+		}
+		sqliteDB, err := db.Create(repoPath, password, x.Testnet, wallet.Bitcoin)
+		if err != nil {
+			status.Database.Error = err.Error()
 		} else {
-			if !torAvailable {
-				fmt.Println("Initialized - Not Encrypted")
-				os.Exit(20)
+			defer sqliteDB.Close()
+			if sqliteDB.Config().IsEncrypted() {
+				status.Database.Error = "incorrect password"
+			} else if status.Database.Integrity, err = sqliteDB.IntegrityCheck(); err != nil {
+				status.Database.Error = err.Error()
 			} else {
-				fmt.Println("Initialized - Not Encrypted")
-				fmt.Println("Tor Available")
-				os.Exit(21)
+				status.Database.Checked = true
 			}
 		}
+	}
+
+	apiConfig, err := checkConfig(repoPath)
+	if err != nil {
+		status.Config.Error = err.Error()
 	} else {
-		if !torAvailable {
-			fmt.Println("Not initialized")
-			os.Exit(10)
+		status.Config.Valid = true
+	}
+
+	// A lock file left behind by a daemon which crashed is not held
+	running, err := fsrepo.LockedByOtherProcess(repoPath)
+	if err != nil {
+		status.Daemon.Error = err.Error()
+	} else if running {
+		status.Daemon.Running = true
+		if apiConfig == nil {
+			status.Daemon.Error = "the API address is unknown as the config is invalid"
+		} else if peers, err := getAPIPeerCount(repoPath, apiConfig, x.APIPassword); err != nil {
+			status.Daemon.Error = err.Error()
 		} else {
-			fmt.Println("Not initialized")
-			fmt.Println("Tor Available")
-			os.Exit(11)
+			status.Daemon.APIReachable = true
+			status.Daemon.PeerCount = peers
 		}
 	}
-	return nil
+	return status
+}
+
+// databaseEncrypted reports whether the database fails to open without a key
+func databaseEncrypted(repoPath string, testnet bool) (bool, error) {
+	sqliteDB, err := db.Create(repoPath, "", testnet, wallet.Bitcoin)
+	if err != nil {
+		return false, err
+	}
+	defer sqliteDB.Close()
+	return sqliteDB.Config().IsEncrypted(), nil
+}
+
+type statusAPIConfig struct {
+	schema.APIConfig
+	Gateway string
+}
+
+// checkConfig parses each section of the config file the node reads on
+// start and returns the API settings
+func checkConfig(repoPath string) (*statusAPIConfig, error) {
+	configFile, err := ioutil.ReadFile(path.Join(repoPath, "config"))
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := fsrepo.ConfigAt(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	apiConfig, err := schema.GetAPIConfig(configFile)
+	if err != nil {
+		return nil, fmt.Errorf("JSON-API: %s", err)
+	}
+	if _, err := schema.GetTorConfig(configFile); err != nil {
+		return nil, fmt.Errorf("Tor-config: %s", err)
+	}
+	if _, err := schema.GetDataSharing(configFile); err != nil {
+		return nil, fmt.Errorf("DataSharing: %s", err)
+	}
+	if _, err := schema.GetRepublishInterval(configFile); err != nil {
+		return nil, fmt.Errorf("RepublishInterval: %s", err)
+	}
+	if _, err := schema.GetWalletsConfig(configFile); err != nil {
+		return nil, fmt.Errorf("Wallets: %s", err)
+	}
+	if _, err := schema.GetIPNSExtraConfig(configFile); err != nil {
+		return nil, fmt.Errorf("IpnsExtra: %s", err)
+	}
+	if len(cfg.Addresses.Gateway) == 0 {
+		return nil, errors.New("no gateway address")
+	}
+	gatewayMaddr, err := ma.NewMultiaddr(cfg.Addresses.Gateway[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid gateway address: %s", err)
+	}
+	host, err := gatewayMaddr.ValueForProtocol(ma.P_IP4)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gateway address: %s", err)
+	}
+	port, err := gatewayMaddr.ValueForProtocol(ma.P_TCP)
+	if err != nil {
+		return nil, fmt.Errorf("invalid gateway address: %s", err)
+	}
+	if host == "0.0.0.0" {
+		host = "127.0.0.1"
+	}
+	return &statusAPIConfig{APIConfig: *apiConfig, Gateway: host + ":" + port}, nil
+}
+
+// getAPIPeerCount asks the daemon for its peers, authenticating with the
+// configured username and the given password, or else with the cookie the
+// daemon writes to the repo. The config only holds a hash of the password.
+func getAPIPeerCount(repoPath string, cfg *statusAPIConfig, password string) (int, error) {
+	if !cfg.Enabled {
+		return 0, errors.New("the API is disabled")
+	}
+	scheme := "http"
+	client := &http.Client{Timeout: 10 * time.Second}
+	if cfg.SSL {
+		scheme = "https"
+		// The certificate is usually self-signed for the node
+		client.Transport = &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}} // nolint:gosec
+	}
+	req, err := http.NewRequest("GET", scheme+"://"+cfg.Gateway+"/ob/peers", nil)
+	if err != nil {
+		return 0, err
+	}
+	if cfg.Authenticated && cfg.Username != "" && cfg.Password != "" {
+		if password == "" {
+			return 0, errors.New("the API uses basic authentication, pass the API password to reach it")
+		}
+		req.SetBasicAuth(cfg.Username, password)
+	} else if cookie, err := ioutil.ReadFile(path.Join(repoPath, ".cookie")); err == nil {
+		req.Header.Set("Cookie", strings.TrimSpace(string(cookie)))
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("the API returned %s", resp.Status)
+	}
+	var peers []string
+	if err := json.NewDecoder(resp.Body).Decode(&peers); err != nil {
+		return 0, err
+	}
+	return len(peers), nil
 }
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestGetAPIPeerCountBasicAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "user" || password != "secret" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprint(w, `["QmPeer1","QmPeer2"]`)
+	}))
+	defer server.Close()
+
+	cfg := &statusAPIConfig{
+		APIConfig: schema.APIConfig{Enabled: true, Authenticated: true, Username: "user", Password: "hash"},
+		Gateway:   strings.TrimPrefix(server.URL, "http://"),
+	}
+	peers, err := getAPIPeerCount("", cfg, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if peers != 2 {
+		t.Errorf("expected 2 peers, got %d", peers)
+	}
+	if _, err := getAPIPeerCount("", cfg, ""); err == nil {
+		t.Error("expected an error without the API password")
+	}
+	if _, err := getAPIPeerCount("", cfg, "wrong"); err == nil {
+		t.Error("expected an error with the wrong API password")
+	}
+}
@@ -0,0 +1,490 @@
+package core
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/wallet-interface"
+)
+
+const (
+	AccountingEntrySale        = "sale"
+	AccountingEntryPurchase    = "purchase"
+	AccountingEntryTransaction = "transaction"
+
+	// defaultAccountingCurrency is used when neither the request nor the
+	// settings name a fiat currency
+	defaultAccountingCurrency = "USD"
+)
+
+// HistoricalExchangeRates is implemented by exchange rate providers which
+// can report the rate of a past time. When the provider of a wallet does not
+// implement it the accounting export falls back to the current rate.
+type HistoricalExchangeRates interface {
+	GetHistoricalRate(currencyCode string, t time.Time) (float64, error)
+}
+
+// AccountingFilter selects the entries of the accounting export. From is
+// inclusive and To exclusive, a zero time leaves that end open. An empty
+// Coin selects every wallet.
+type AccountingFilter struct {
+	From         time.Time
+	To           time.Time
+	Coin         string
+	FiatCurrency string
+}
+
+// AccountingEntry is a row of the accounting export. Amounts are in the
+// smallest unit of their currency and are signed from the point of view of
+// this node, sales are positive and purchases negative. Fees and refunds are
+// always positive.
+type AccountingEntry struct {
+	Type           string     `json:"type"`
+	Timestamp      time.Time  `json:"timestamp"`
+	OrderID        string     `json:"orderId,omitempty"`
+	Title          string     `json:"title,omitempty"`
+	Counterparty   string     `json:"counterparty,omitempty"`
+	State          string     `json:"state,omitempty"`
+	Coin           string     `json:"coin"`
+	Divisibility   uint       `json:"divisibility"`
+	Amount         string     `json:"amount"`
+	NetworkFee     string     `json:"networkFee,omitempty"`
+	ModeratorFee   string     `json:"moderatorFee,omitempty"`
+	Refund         string     `json:"refund,omitempty"`
+	CouponDiscount string     `json:"couponDiscount,omitempty"`
+	CouponCurrency string     `json:"couponCurrency,omitempty"`
+	FiatCurrency   string     `json:"fiatCurrency,omitempty"`
+	FiatRate       float64    `json:"fiatRate,omitempty"`
+	FiatRateTime   *time.Time `json:"fiatRateTime,omitempty"`
+	FiatValue      string     `json:"fiatValue,omitempty"`
+	Txids          []string   `json:"txids"`
+	Address        string     `json:"address,omitempty"`
+	Memo           string     `json:"memo,omitempty"`
+}
+
+// accountingCSVHeader is the first row of the CSV export and matches the
+// order of the columns written by WriteAccountingCSV
+var accountingCSVHeader = []string{
+	"type", "timestamp", "orderId", "title", "counterparty", "state", "coin", "divisibility",
+	"amount", "networkFee", "moderatorFee", "refund", "couponDiscount", "couponCurrency",
+	"fiatCurrency", "fiatRate", "fiatRateTime", "fiatValue", "txids", "address", "memo",
+}
+
+// AccountingExport joins the sales, purchases and wallet transactions into a
+// single list for bookkeeping, oldest first. Orders are dated by their first
+// payment and orders which were never paid are left out. Wallet transactions
+// which paid for an order are listed with the order rather than on their own.
+func (n *OpenBazaarNode) AccountingExport(filter AccountingFilter) ([]AccountingEntry, error) {
+	var filterWallet wallet.Wallet
+	if filter.Coin != "" {
+		wal, err := n.Multiwallet.WalletForCurrencyCode(filter.Coin)
+		if err != nil {
+			return nil, fmt.Errorf("unknown coin %s", filter.Coin)
+		}
+		filterWallet = wal
+	}
+	if filter.FiatCurrency == "" {
+		filter.FiatCurrency = defaultAccountingCurrency
+		if settings, err := n.Datastore.Settings().Get(); err == nil && settings.LocalCurrency != nil && *settings.LocalCurrency != "" {
+			filter.FiatCurrency = *settings.LocalCurrency
+		}
+	}
+	filter.FiatCurrency = strings.ToUpper(filter.FiatCurrency)
+
+	// Wallet transactions are needed for every coin to tell which ones
+	// belong to orders and to work out the network fees of purchases
+	walletTxns := make(map[wallet.Wallet]map[string]wallet.Txn)
+	for _, wal := range n.Multiwallet {
+		txns, err := wal.Transactions()
+		if err != nil {
+			return nil, fmt.Errorf("reading %s transactions: %s", wal.CurrencyCode(), err)
+		}
+		byID := make(map[string]wallet.Txn, len(txns))
+		for _, txn := range txns {
+			byID[txn.Txid] = txn
+		}
+		walletTxns[wal] = byID
+	}
+
+	var (
+		entries   []AccountingEntry
+		orderIDs  = make(map[string]bool)
+		orderTxns = make(map[string]bool)
+	)
+	addOrder := func(kind, orderID, title, counterparty string, contract *pb.RicardianContract, state pb.OrderState, records []*wallet.TransactionRecord, total repo.CurrencyValue) {
+		orderIDs[orderID] = true
+		for _, r := range records {
+			orderTxns[r.Txid] = true
+		}
+		wal, err := n.Multiwallet.WalletForCurrencyCode(total.Currency.Code.String())
+		if err != nil {
+			log.Warningf("accounting export: skipping order %s: %s", orderID, err)
+			return
+		}
+		if filterWallet != nil && wal != filterWallet {
+			return
+		}
+		entry, ok := n.orderAccountingEntry(kind, contract, records, total, walletTxns[wal])
+		if !ok || !accountingFilterMatches(filter, entry.Timestamp) {
+			return
+		}
+		entry.OrderID = orderID
+		entry.Title = title
+		entry.Counterparty = counterparty
+		entry.State = state.String()
+		n.setAccountingFiatValue(&entry, wal, filter.FiatCurrency)
+		entries = append(entries, entry)
+	}
+
+	sales, _, err := n.Datastore.Sales().GetAll(nil, "", true, false, -1, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range sales {
+		contract, state, _, records, _, _, err := n.Datastore.Sales().GetByOrderId(s.OrderId)
+		if err != nil {
+			return nil, err
+		}
+		addOrder(AccountingEntrySale, s.OrderId, s.Title, s.BuyerId, contract, state, records, s.Total)
+	}
+
+	purchases, _, err := n.Datastore.Purchases().GetAll(nil, "", true, false, -1, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range purchases {
+		contract, state, _, records, _, _, err := n.Datastore.Purchases().GetByOrderId(p.OrderId)
+		if err != nil {
+			return nil, err
+		}
+		addOrder(AccountingEntryPurchase, p.OrderId, p.Title, p.VendorId, contract, state, records, p.Total)
+	}
+
+	metadata, err := n.Datastore.TxMetadata().GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for wal, txns := range walletTxns {
+		if filterWallet != nil && wal != filterWallet {
+			continue
+		}
+		def, err := n.LookupCurrency(wal.CurrencyCode())
+		if err != nil {
+			log.Warningf("accounting export: skipping %s transactions: %s", wal.CurrencyCode(), err)
+			continue
+		}
+		for _, txn := range txns {
+			m := metadata[txn.Txid]
+			if txn.WatchOnly || orderTxns[txn.Txid] || orderIDs[m.OrderId] || !accountingFilterMatches(filter, txn.Timestamp) {
+				continue
+			}
+			entry := AccountingEntry{
+				Type:         AccountingEntryTransaction,
+				Timestamp:    txn.Timestamp,
+				OrderID:      m.OrderId,
+				Coin:         strings.ToUpper(wal.CurrencyCode()),
+				Divisibility: def.Divisibility,
+				Amount:       txn.Value,
+				Txids:        []string{txn.Txid},
+				Address:      m.Address,
+				Memo:         m.Memo,
+			}
+			n.setAccountingFiatValue(&entry, wal, filter.FiatCurrency)
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+	return entries, nil
+}
+
+// orderAccountingEntry fills in the amounts of a sale or purchase. It returns
+// false if the order has not been paid.
+func (n *OpenBazaarNode) orderAccountingEntry(kind string, contract *pb.RicardianContract, records []*wallet.TransactionRecord, total repo.CurrencyValue, txns map[string]wallet.Txn) (AccountingEntry, bool) {
+	var (
+		paidAt time.Time
+		txids  []string
+		paid   = make(map[string]*big.Int)
+	)
+	for _, r := range records {
+		if r.Value.Sign() <= 0 {
+			continue
+		}
+		if paidAt.IsZero() || r.Timestamp.Before(paidAt) {
+			paidAt = r.Timestamp
+		}
+		if _, ok := paid[r.Txid]; !ok {
+			paid[r.Txid] = new(big.Int)
+			txids = append(txids, r.Txid)
+		}
+		paid[r.Txid].Add(paid[r.Txid], &r.Value)
+	}
+	if len(txids) == 0 {
+		return AccountingEntry{}, false
+	}
+
+	amount := new(big.Int)
+	if total.Amount != nil {
+		amount.Set(total.Amount)
+	}
+	if kind == AccountingEntryPurchase {
+		amount.Neg(amount)
+	}
+	entry := AccountingEntry{
+		Type:         kind,
+		Timestamp:    paidAt,
+		Coin:         total.Currency.Code.String(),
+		Divisibility: total.Currency.Divisibility,
+		Amount:       amount.String(),
+		Txids:        txids,
+	}
+
+	networkFee := new(big.Int)
+	if kind == AccountingEntryPurchase {
+		// The wallet value of a payment sent from this node includes the
+		// network fee on top of the amount received by the order address
+		for txid, received := range paid {
+			txn, ok := txns[txid]
+			if !ok {
+				continue
+			}
+			value, ok := new(big.Int).SetString(txn.Value, 10)
+			if !ok || value.Sign() >= 0 {
+				continue
+			}
+			fee := new(big.Int).Sub(new(big.Int).Neg(value), received)
+			if fee.Sign() > 0 {
+				networkFee.Add(networkFee, fee)
+			}
+		}
+	}
+
+	if contract != nil && contract.DisputeResolution != nil && contract.DisputeResolution.Payout != nil {
+		payout := repo.ToV5DisputeResolution(contract.DisputeResolution).Payout
+		inputs := new(big.Int)
+		for _, in := range payout.Inputs {
+			if v, ok := new(big.Int).SetString(in.BigValue, 10); ok {
+				inputs.Add(inputs, v)
+			}
+		}
+		outputs := new(big.Int)
+		for _, out := range []*pb.DisputeResolution_Payout_Output{payout.BuyerOutput, payout.VendorOutput, payout.ModeratorOutput} {
+			if out == nil {
+				continue
+			}
+			if v, ok := new(big.Int).SetString(out.BigAmount, 10); ok {
+				outputs.Add(outputs, v)
+			}
+		}
+		if payout.ModeratorOutput != nil {
+			if v, ok := new(big.Int).SetString(payout.ModeratorOutput.BigAmount, 10); ok && v.Sign() > 0 {
+				entry.ModeratorFee = v.String()
+			}
+		}
+		if inputs.Cmp(outputs) > 0 {
+			networkFee.Add(networkFee, inputs.Sub(inputs, outputs))
+		}
+	}
+	if networkFee.Sign() > 0 {
+		entry.NetworkFee = networkFee.String()
+	}
+
+	if contract != nil && contract.Refund != nil && contract.Refund.RefundTransaction != nil {
+		refund := repo.ToV5Refund(contract.Refund)
+		if v, ok := new(big.Int).SetString(refund.RefundTransaction.BigValue, 10); ok && v.Sign() > 0 {
+			entry.Refund = v.String()
+			entry.Txids = append(entry.Txids, refund.RefundTransaction.Txid)
+		}
+	}
+
+	if contract != nil {
+		if discount, currency := n.orderCouponDiscount(contract); discount != nil && discount.Sign() > 0 {
+			entry.CouponDiscount = discount.String()
+			entry.CouponCurrency = currency
+		}
+	}
+	return entry, true
+}
+
+// orderCouponDiscount returns the total coupon discount of the order in the
+// pricing currency of its listings. Nil is returned if there is no discount
+// or the listings are priced in more than one currency.
+func (n *OpenBazaarNode) orderCouponDiscount(contract *pb.RicardianContract) (*big.Int, string) {
+	if contract.BuyerOrder == nil {
+		return nil, ""
+	}
+	v5Order, err := repo.ToV5Order(contract.BuyerOrder, n.LookupCurrency)
+	if err != nil {
+		return nil, ""
+	}
+	var (
+		total    = new(big.Int)
+		currency string
+	)
+	for _, item := range v5Order.Items {
+		if len(item.CouponCodes) == 0 {
+			continue
+		}
+		l, err := ParseContractForListing(item.ListingHash, contract)
+		if err != nil {
+			return nil, ""
+		}
+		rl, err := repo.NewListingFromProtobuf(l)
+		if err != nil {
+			return nil, ""
+		}
+		nrl, err := rl.Normalize()
+		if err != nil {
+			return nil, ""
+		}
+		itemAmount, err := GetOriginalAmount(nrl, item)
+		if err != nil {
+			return nil, ""
+		}
+		surcharge, err := GetItemSurchargeAmount(nrl, item.Options)
+		if err != nil {
+			return nil, ""
+		}
+		itemAmount = itemAmount.AddBigInt(surcharge)
+		discount, err := GetTotalCouponCodeDiscount(nrl, item.CouponCodes, itemAmount)
+		if err != nil {
+			return nil, ""
+		}
+		if quantity := GetOrderQuantity(nrl.GetProtobuf(), item); quantity.Sign() > 0 {
+			discount.Mul(discount, quantity)
+		}
+		code := itemAmount.Currency.Code.String()
+		if currency != "" && currency != code {
+			return nil, ""
+		}
+		currency = code
+		total.Sub(total, discount)
+	}
+	return total, currency
+}
+
+// setAccountingFiatValue converts the amount of the entry into the fiat
+// currency. The rate at the time of the entry is used when the node's
+// exchange rate history or the wallet's provider keeps past rates, otherwise
+// the current rate. The fiat fields are left empty if no rate is available.
+func (n *OpenBazaarNode) setAccountingFiatValue(entry *AccountingEntry, wal wallet.Wallet, fiatCurrency string) {
+	var (
+		rate     float64
+		rateTime = entry.Timestamp
+		err      error
+	)
+	if rate, err = n.historicalCoinRate(entry.Coin, fiatCurrency, entry.Timestamp); err != nil {
+		rates := wal.ExchangeRates()
+		if rates == nil {
+			return
+		}
+		if historical, ok := rates.(HistoricalExchangeRates); ok {
+			rate, err = historical.GetHistoricalRate(fiatCurrency, entry.Timestamp)
+		}
+		if _, ok := rates.(HistoricalExchangeRates); !ok || err != nil {
+			rateTime = time.Now()
+			rate, err = rates.GetExchangeRate(fiatCurrency)
+		}
+	}
+	if err != nil || rate <= 0 {
+		return
+	}
+	amount, ok := new(big.Float).SetString(entry.Amount)
+	if !ok {
+		return
+	}
+	units := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(entry.Divisibility)), nil))
+	value := amount.Quo(amount, units)
+	value.Mul(value, big.NewFloat(rate))
+
+	entry.FiatCurrency = fiatCurrency
+	entry.FiatRate = rate
+	entry.FiatRateTime = &rateTime
+	entry.FiatValue = value.Text('f', 2)
+}
+
+// historicalCoinRate returns the price of one coin in the currency at the
+// time from the node's exchange rate history, which is kept per reserve coin
+func (n *OpenBazaarNode) historicalCoinRate(coin, currencyCode string, t time.Time) (float64, error) {
+	historical, ok := n.ExchangeRates.(HistoricalExchangeRates)
+	if !ok {
+		return 0, errors.New("exchange rate history unavailable")
+	}
+	currencyRate, err := historical.GetHistoricalRate(currencyCode, t)
+	if err != nil {
+		return 0, err
+	}
+	coinRate, err := historical.GetHistoricalRate(repo.MainnetCurrencyCode(coin), t)
+	if err != nil {
+		return 0, err
+	}
+	if coinRate <= 0 {
+		return 0, fmt.Errorf("rate for (%s) must be greater than zero", coin)
+	}
+	return currencyRate / coinRate, nil
+}
+
+func accountingFilterMatches(filter AccountingFilter, t time.Time) bool {
+	if !filter.From.IsZero() && t.Before(filter.From) {
+		return false
+	}
+	if !filter.To.IsZero() && !t.Before(filter.To) {
+		return false
+	}
+	return true
+}
+
+// WriteAccountingCSV writes the entries as CSV with a header row. Multiple
+// transaction IDs are separated by spaces.
+func WriteAccountingCSV(w io.Writer, entries []AccountingEntry) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(accountingCSVHeader); err != nil {
+		return err
+	}
+	for _, e := range entries {
+		var fiatRate, fiatRateTime string
+		if e.FiatRateTime != nil {
+			fiatRate = strconv.FormatFloat(e.FiatRate, 'f', -1, 64)
+			fiatRateTime = e.FiatRateTime.UTC().Format(time.RFC3339)
+		}
+		row := []string{
+			e.Type,
+			e.Timestamp.UTC().Format(time.RFC3339),
+			e.OrderID,
+			e.Title,
+			e.Counterparty,
+			e.State,
+			e.Coin,
+			strconv.FormatUint(uint64(e.Divisibility), 10),
+			e.Amount,
+			e.NetworkFee,
+			e.ModeratorFee,
+			e.Refund,
+			e.CouponDiscount,
+			e.CouponCurrency,
+			e.FiatCurrency,
+			fiatRate,
+			fiatRateTime,
+			e.FiatValue,
+			strings.Join(e.Txids, " "),
+			e.Address,
+			e.Memo,
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,160 @@
+package core_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/test"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	"github.com/OpenBazaar/wallet-interface"
+)
+
+func findAccountingEntry(entries []core.AccountingEntry, orderID string) *core.AccountingEntry {
+	for i := range entries {
+		if entries[i].OrderID == orderID {
+			return &entries[i]
+		}
+	}
+	return nil
+}
+
+func TestAccountingExportSale(t *testing.T) {
+	node, err := test.NewNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paidAt := time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+	contract := factory.NewDisputedContract()
+	contract.DisputeResolution = &pb.DisputeResolution{
+		Payout: &pb.DisputeResolution_Payout{
+			Inputs:          []*pb.Outpoint{{Hash: "aa", BigValue: "10"}},
+			BuyerOutput:     &pb.DisputeResolution_Payout_Output{BigAmount: "3"},
+			VendorOutput:    &pb.DisputeResolution_Payout_Output{BigAmount: "5"},
+			ModeratorOutput: &pb.DisputeResolution_Payout_Output{BigAmount: "1"},
+		},
+	}
+	contract.Refund = &pb.Refund{
+		RefundTransaction: &pb.Refund_TransactionInfo{Txid: "refundtx", BigValue: "2"},
+	}
+	if err := node.Datastore.Sales().Put("accountingsale", *contract, pb.OrderState_RESOLVED, false); err != nil {
+		t.Fatal(err)
+	}
+	records := []*wallet.TransactionRecord{
+		{Txid: "fundingtx", Value: *big.NewInt(10), Timestamp: paidAt},
+		{Txid: "payouttx", Value: *big.NewInt(-10), Timestamp: paidAt.Add(time.Hour)},
+	}
+	if err := node.Datastore.Sales().UpdateFunding("accountingsale", true, records); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.Datastore.Sales().Put("accountingunpaid", *factory.NewContract(), pb.OrderState_AWAITING_PAYMENT, false); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := node.AccountingExport(core.AccountingFilter{
+		From: paidAt.Add(-time.Hour),
+		To:   paidAt.Add(time.Hour),
+		Coin: "BTC",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if findAccountingEntry(entries, "accountingunpaid") != nil {
+		t.Error("expected unpaid orders to be left out")
+	}
+	e := findAccountingEntry(entries, "accountingsale")
+	if e == nil {
+		t.Fatalf("expected the sale to be exported, got %+v", entries)
+	}
+	if e.Type != core.AccountingEntrySale || !e.Timestamp.Equal(paidAt) || e.Coin != "BTC" || e.Amount != "10" {
+		t.Errorf("unexpected sale entry %+v", e)
+	}
+	if e.NetworkFee != "1" || e.ModeratorFee != "1" || e.Refund != "2" {
+		t.Errorf("unexpected fees and refund %+v", e)
+	}
+	if len(e.Txids) != 2 || e.Txids[0] != "fundingtx" || e.Txids[1] != "refundtx" {
+		t.Errorf("unexpected txids %v", e.Txids)
+	}
+	if e.State != pb.OrderState_RESOLVED.String() || e.Counterparty != "buyerID" {
+		t.Errorf("unexpected order details %+v", e)
+	}
+
+	entries, err = node.AccountingExport(core.AccountingFilter{From: paidAt.Add(time.Minute)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if findAccountingEntry(entries, "accountingsale") != nil {
+		t.Error("expected the sale to be filtered out by date")
+	}
+
+	if _, err := node.AccountingExport(core.AccountingFilter{Coin: "XYZ"}); err == nil {
+		t.Error("expected an error for an unknown coin")
+	}
+}
+
+func TestWriteAccountingCSV(t *testing.T) {
+	rateTime := time.Date(2019, 3, 1, 12, 0, 0, 0, time.UTC)
+	entries := []core.AccountingEntry{
+		{
+			Type:         core.AccountingEntryPurchase,
+			Timestamp:    rateTime,
+			OrderID:      "order1",
+			Title:        "Shirt, blue",
+			Coin:         "BTC",
+			Divisibility: 8,
+			Amount:       "-150000",
+			FiatCurrency: "USD",
+			FiatRate:     4000,
+			FiatRateTime: &rateTime,
+			FiatValue:    "-6.00",
+			Txids:        []string{"tx1", "tx2"},
+		},
+		{
+			Type:      core.AccountingEntryTransaction,
+			Timestamp: rateTime,
+			Coin:      "BTC",
+			Amount:    "5000",
+			Txids:     []string{"tx3"},
+		},
+	}
+	var buf bytes.Buffer
+	if err := core.WriteAccountingCSV(&buf, entries); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("expected a header and two rows, got %d", len(rows))
+	}
+	row := make(map[string]string)
+	for i, col := range rows[0] {
+		row[col] = rows[1][i]
+	}
+	expected := map[string]string{
+		"type":         "purchase",
+		"timestamp":    "2019-03-01T12:00:00Z",
+		"title":        "Shirt, blue",
+		"amount":       "-150000",
+		"fiatRate":     "4000",
+		"fiatRateTime": "2019-03-01T12:00:00Z",
+		"fiatValue":    "-6.00",
+		"txids":        "tx1 tx2",
+	}
+	for col, want := range expected {
+		if row[col] != want {
+			t.Errorf("expected %s to be %q, got %q", col, want, row[col])
+		}
+	}
+	for i, col := range rows[0] {
+		if col == "fiatRate" && rows[2][i] != "" {
+			t.Errorf("expected an empty fiat rate without a rate, got %q", rows[2][i])
+		}
+	}
+}
@@ -0,0 +1,432 @@
+package core
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	crypto "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+)
+
+// iCalendarTimeFormat is the UTC date-time format of iCalendar properties
+const iCalendarTimeFormat = "20060102T150405Z"
+
+// availabilitySlot returns the slot of the listing with the id, or nil
+func availabilitySlot(listing *pb.Listing, slotID string) *pb.Listing_Availability_Slot {
+	if listing.Availability == nil {
+		return nil
+	}
+	for _, slot := range listing.Availability.Slots {
+		if slot.Id == slotID {
+			return slot
+		}
+	}
+	return nil
+}
+
+// bookedItem returns the item of the order which books a slot, with its
+// listing, or nil if the order is not an appointment
+func bookedItem(contract *pb.RicardianContract) (*pb.Order_Item, *pb.Listing) {
+	for _, item := range contract.BuyerOrder.Items {
+		if item.SlotID == "" {
+			continue
+		}
+		listing, err := ParseContractForListing(item.ListingHash, contract)
+		if err != nil {
+			continue
+		}
+		return item, listing
+	}
+	return nil, nil
+}
+
+// validateAppointmentBooking checks the slots selected by the items of an
+// order are bookable at the time now. An order books at most one slot.
+func validateAppointmentBooking(contract *pb.RicardianContract, now time.Time) error {
+	booked := 0
+	for _, item := range contract.BuyerOrder.Items {
+		listing, err := ParseContractForListing(item.ListingHash, contract)
+		if err != nil {
+			return err
+		}
+		if listing.Availability == nil {
+			if item.SlotID != "" {
+				return ErrSlotNotBookable
+			}
+			continue
+		}
+		if item.SlotID == "" {
+			return ErrSlotRequired
+		}
+		slot := availabilitySlot(listing, item.SlotID)
+		if slot == nil || slot.Start == nil {
+			return ErrUnknownSlot
+		}
+		if !now.Before(time.Unix(slot.Start.Seconds, 0)) {
+			return ErrSlotPassed
+		}
+		booked++
+	}
+	if booked > 1 {
+		return ErrAppointmentMultipleSlots
+	}
+	return nil
+}
+
+// checkSlotCapacity rejects an order booking more of its slot than remains
+// after the reservations of the node's funded sales
+func (n *OpenBazaarNode) checkSlotCapacity(contract *pb.RicardianContract) error {
+	item, listing := bookedItem(contract)
+	if item == nil {
+		return nil
+	}
+	slot := availabilitySlot(listing, item.SlotID)
+	if slot == nil {
+		return ErrUnknownSlot
+	}
+	booked, err := n.Datastore.Appointments().GetBookedQuantity(listing.Slug, slot.Id)
+	if err != nil {
+		return err
+	}
+	remaining := slotRemaining(slot, booked)
+	quantity := GetOrderQuantity(listing, item)
+	if !quantity.IsUint64() || quantity.Uint64() > remaining {
+		return NewErrOutOfInventory(new(big.Int).SetUint64(remaining))
+	}
+	return nil
+}
+
+// slotRemaining returns the capacity of the slot left after the bookings
+func slotRemaining(slot *pb.Listing_Availability_Slot, booked uint64) uint64 {
+	if booked >= uint64(slot.Capacity) {
+		return 0
+	}
+	return uint64(slot.Capacity) - booked
+}
+
+// ReserveAppointment reserves the slot booked by a sale once it is funded.
+// Orders are checked against the capacity of the slot when they are
+// received, so a reservation beyond the capacity is only logged.
+func (n *OpenBazaarNode) ReserveAppointment(orderID string, contract *pb.RicardianContract) error {
+	item, listing := bookedItem(contract)
+	if item == nil {
+		return nil
+	}
+	if _, err := n.Datastore.Appointments().Get(orderID, true); err == nil {
+		return nil
+	}
+	slot := availabilitySlot(listing, item.SlotID)
+	if slot == nil || slot.Start == nil {
+		return ErrUnknownSlot
+	}
+	booked, err := n.Datastore.Appointments().GetBookedQuantity(listing.Slug, slot.Id)
+	if err != nil {
+		return err
+	}
+	quantity := GetOrderQuantity(listing, item).Uint64()
+	if booked+quantity > uint64(slot.Capacity) {
+		log.Warningf("Order %s booked slot %s of %s beyond its capacity", orderID, slot.Id, listing.Slug)
+	}
+	var title string
+	if listing.Item != nil {
+		title = listing.Item.Title
+	}
+	return n.Datastore.Appointments().Put(repo.Appointment{
+		OrderID:         orderID,
+		Vendor:          true,
+		Slug:            listing.Slug,
+		Title:           title,
+		SlotID:          slot.Id,
+		Start:           time.Unix(slot.Start.Seconds, 0),
+		DurationMinutes: slot.DurationMinutes,
+		Quantity:        quantity,
+		Location:        listing.Availability.Location,
+		PeerID:          contract.BuyerOrder.BuyerID.PeerID,
+		State:           repo.AppointmentReserved,
+		Timestamp:       time.Now(),
+	})
+}
+
+// CancelAppointment releases the slot booked by a refunded order. Orders
+// which did not book a slot are ignored.
+func (n *OpenBazaarNode) CancelAppointment(orderID string, vendor bool) error {
+	appointment, err := n.Datastore.Appointments().Get(orderID, vendor)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	appointment.State = repo.AppointmentCancelled
+	appointment.Timestamp = time.Now()
+	return n.Datastore.Appointments().Put(appointment)
+}
+
+// GetAppointments returns the appointments booked by the node's sales and
+// purchases by start time
+func (n *OpenBazaarNode) GetAppointments() ([]repo.Appointment, error) {
+	appointments, err := n.Datastore.Appointments().GetAll()
+	if err != nil {
+		return nil, err
+	}
+	if appointments == nil {
+		appointments = []repo.Appointment{}
+	}
+	return appointments, nil
+}
+
+// ConfirmAppointment tells the buyer of a sale the time of the slot it
+// booked is confirmed
+func (n *OpenBazaarNode) ConfirmAppointment(orderID string) error {
+	contract, state, appointment, err := n.saleAppointment(orderID)
+	if err != nil {
+		return err
+	}
+	if contract.VendorAppointment != nil {
+		return ErrAppointmentConfirmed
+	}
+	return n.sendAppointment(contract, state, appointment, false, "")
+}
+
+// RescheduleAppointment moves the booking of a sale to another slot of the
+// listing and tells the buyer the new time
+func (n *OpenBazaarNode) RescheduleAppointment(orderID, slotID, note string) error {
+	contract, state, appointment, err := n.saleAppointment(orderID)
+	if err != nil {
+		return err
+	}
+	if len(note) > repo.SentenceMaxCharacters {
+		return fmt.Errorf("note is longer than the max of %d", repo.SentenceMaxCharacters)
+	}
+	_, listing := bookedItem(contract)
+	slot := availabilitySlot(listing, slotID)
+	if slot == nil || slot.Start == nil {
+		return ErrUnknownSlot
+	}
+	if !time.Now().Before(time.Unix(slot.Start.Seconds, 0)) {
+		return ErrSlotPassed
+	}
+	if slot.Id != appointment.SlotID {
+		booked, err := n.Datastore.Appointments().GetBookedQuantity(listing.Slug, slot.Id)
+		if err != nil {
+			return err
+		}
+		if remaining := slotRemaining(slot, booked); appointment.Quantity > remaining {
+			return NewErrOutOfInventory(new(big.Int).SetUint64(remaining))
+		}
+	}
+	appointment.SlotID = slot.Id
+	appointment.Start = time.Unix(slot.Start.Seconds, 0)
+	appointment.DurationMinutes = slot.DurationMinutes
+	return n.sendAppointment(contract, state, appointment, true, note)
+}
+
+// saleAppointment loads a sale with the slot it reserved
+func (n *OpenBazaarNode) saleAppointment(orderID string) (*pb.RicardianContract, pb.OrderState, repo.Appointment, error) {
+	contract, state, _, _, _, _, err := n.Datastore.Sales().GetByOrderId(orderID)
+	if err != nil {
+		return nil, state, repo.Appointment{}, ErrOrderNotFound
+	}
+	appointment, err := n.Datastore.Appointments().Get(orderID, true)
+	if err == sql.ErrNoRows {
+		return nil, state, repo.Appointment{}, ErrAppointmentNotFound
+	} else if err != nil {
+		return nil, state, repo.Appointment{}, err
+	}
+	if appointment.State == repo.AppointmentCancelled {
+		return nil, state, repo.Appointment{}, ErrAppointmentCancelled
+	}
+	return contract, state, appointment, nil
+}
+
+// sendAppointment signs the appointment of a sale, replacing any earlier
+// one, and sends it to the buyer
+func (n *OpenBazaarNode) sendAppointment(contract *pb.RicardianContract, state pb.OrderState, appointment repo.Appointment, rescheduled bool, note string) error {
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	start, err := ptypes.TimestampProto(appointment.Start)
+	if err != nil {
+		return err
+	}
+	rc := &pb.RicardianContract{
+		VendorAppointment: &pb.Appointment{
+			OrderID:         appointment.OrderID,
+			SlotID:          appointment.SlotID,
+			Start:           start,
+			DurationMinutes: appointment.DurationMinutes,
+			Location:        appointment.Location,
+			Rescheduled:     rescheduled,
+			Note:            note,
+			Timestamp:       ts,
+		},
+	}
+	ser, err := proto.Marshal(rc.VendorAppointment)
+	if err != nil {
+		return err
+	}
+	sig, err := n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return err
+	}
+	rc.Signatures = []*pb.Signature{{Section: pb.Signature_APPOINTMENT, SignatureBytes: sig}}
+
+	contract.VendorAppointment = rc.VendorAppointment
+	contract.Signatures = replaceSignature(contract.Signatures, rc.Signatures[0])
+	if err := n.Datastore.Sales().Put(appointment.OrderID, *contract, state, false); err != nil {
+		return err
+	}
+	appointment.State = repo.AppointmentConfirmed
+	appointment.Timestamp = time.Now()
+	if err := n.Datastore.Appointments().Put(appointment); err != nil {
+		return err
+	}
+
+	buyerKey, err := crypto.UnmarshalPublicKey(contract.BuyerOrder.BuyerID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	return n.SendAppointment(contract.BuyerOrder.BuyerID.PeerID, &buyerKey, rc)
+}
+
+// replaceSignature returns the signatures with sig in place of any earlier
+// signature of its section
+func replaceSignature(sigs []*pb.Signature, sig *pb.Signature) []*pb.Signature {
+	ret := []*pb.Signature{}
+	for _, s := range sigs {
+		if s.Section != sig.Section {
+			ret = append(ret, s)
+		}
+	}
+	return append(ret, sig)
+}
+
+// ValidateAppointment checks the appointment of the contract is signed by
+// the vendor and books a slot of the listing
+func (n *OpenBazaarNode) ValidateAppointment(contract *pb.RicardianContract) error {
+	if err := verifyMessageSignature(
+		contract.VendorAppointment,
+		contract.VendorListings[0].VendorID.Pubkeys.Identity,
+		contract.Signatures,
+		pb.Signature_APPOINTMENT,
+		contract.VendorListings[0].VendorID.PeerID,
+	); err != nil {
+		switch err.(type) {
+		case noSigError:
+			return errors.New("contract does not contain a signature for the appointment")
+		case invalidSigError:
+			return errors.New("vendor's guid signature on appointment failed to verify")
+		case matchKeyError:
+			return errors.New("public key in appointment does not match reported vendor ID")
+		default:
+			return err
+		}
+	}
+	_, listing := bookedItem(contract)
+	if listing == nil || availabilitySlot(listing, contract.VendorAppointment.SlotID) == nil {
+		return ErrUnknownSlot
+	}
+	return nil
+}
+
+// RecordAppointment saves the appointment of a purchase sent by the vendor
+func (n *OpenBazaarNode) RecordAppointment(contract *pb.RicardianContract) error {
+	item, listing := bookedItem(contract)
+	appointment := contract.VendorAppointment
+	if item == nil || appointment == nil || appointment.Start == nil {
+		return ErrAppointmentNotFound
+	}
+	var title string
+	if listing.Item != nil {
+		title = listing.Item.Title
+	}
+	return n.Datastore.Appointments().Put(repo.Appointment{
+		OrderID:         appointment.OrderID,
+		Slug:            listing.Slug,
+		Title:           title,
+		SlotID:          appointment.SlotID,
+		Start:           time.Unix(appointment.Start.Seconds, 0),
+		DurationMinutes: appointment.DurationMinutes,
+		Quantity:        GetOrderQuantity(listing, item).Uint64(),
+		Location:        appointment.Location,
+		PeerID:          listing.VendorID.PeerID,
+		State:           repo.AppointmentConfirmed,
+		Timestamp:       time.Now(),
+	})
+}
+
+// WriteICalendar writes the appointments which are not cancelled as the
+// events of an iCalendar (RFC 5545). Reserved appointments are tentative.
+func WriteICalendar(w io.Writer, appointments []repo.Appointment, now time.Time) error {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//OpenBazaar//openbazaar-go//EN",
+		"CALSCALE:GREGORIAN",
+	}
+	for _, a := range appointments {
+		if a.State == repo.AppointmentCancelled {
+			continue
+		}
+		role := "purchase"
+		if a.Vendor {
+			role = "sale"
+		}
+		status := "CONFIRMED"
+		if a.State == repo.AppointmentReserved {
+			status = "TENTATIVE"
+		}
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+a.OrderID+"-"+role+"@openbazaar",
+			"DTSTAMP:"+now.UTC().Format(iCalendarTimeFormat),
+			"DTSTART:"+a.Start.UTC().Format(iCalendarTimeFormat),
+			"DTEND:"+a.End().UTC().Format(iCalendarTimeFormat),
+			"SUMMARY:"+iCalendarEscape(a.Title),
+			"DESCRIPTION:"+iCalendarEscape(fmt.Sprintf("OpenBazaar %s %s with %s", role, a.OrderID, a.PeerID)),
+		)
+		if a.Location != "" {
+			lines = append(lines, "LOCATION:"+iCalendarEscape(a.Location))
+		}
+		lines = append(lines, "STATUS:"+status, "END:VEVENT")
+	}
+	lines = append(lines, "END:VCALENDAR")
+	for _, line := range lines {
+		if _, err := io.WriteString(w, iCalendarFold(line)+"\r\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// iCalendarEscape escapes the special characters of an iCalendar text value
+func iCalendarEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// iCalendarFold splits a content line into lines of at most 75 octets,
+// continued with a space, without breaking UTF-8 characters
+func iCalendarFold(line string) string {
+	var (
+		b     strings.Builder
+		width = 0
+	)
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > 75 {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
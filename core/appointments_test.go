@@ -0,0 +1,169 @@
+package core
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	wi "github.com/OpenBazaar/wallet-interface"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+func newAppointmentOrder(t *testing.T, listing *pb.Listing, slotID, quantity string) *pb.RicardianContract {
+	ser, err := proto.Marshal(listing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listingID, err := ipfs.EncodeCID(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract := factory.NewContract()
+	contract.VendorListings = []*pb.Listing{listing}
+	contract.BuyerOrder.Items = []*pb.Order_Item{{ListingHash: listingID.String(), BigQuantity: quantity, SlotID: slotID}}
+	return contract
+}
+
+func newServiceListing(start time.Time) *pb.Listing {
+	listing := factory.NewListing("repair")
+	listing.Metadata.ContractType = pb.Listing_Metadata_SERVICE
+	listing.Availability = &pb.Listing_Availability{
+		Location: "Workshop",
+		Slots: []*pb.Listing_Availability_Slot{
+			{Id: "mon", Start: &timestamp.Timestamp{Seconds: start.Unix()}, DurationMinutes: 60, Capacity: 2},
+			{Id: "tue", Start: &timestamp.Timestamp{Seconds: start.Add(24 * time.Hour).Unix()}, DurationMinutes: 60, Capacity: 1},
+		},
+	}
+	return listing
+}
+
+func TestValidateAppointmentBooking(t *testing.T) {
+	var (
+		start   = time.Now().Add(time.Hour)
+		service = newServiceListing(start)
+		other   = factory.NewListing("mug")
+	)
+	if err := validateAppointmentBooking(newAppointmentOrder(t, other, "", "1"), time.Now()); err != nil {
+		t.Errorf("expected orders of other listings to be accepted, got %v", err)
+	}
+	if err := validateAppointmentBooking(newAppointmentOrder(t, service, "mon", "1"), time.Now()); err != nil {
+		t.Errorf("expected booking to be accepted, got %v", err)
+	}
+	if err := validateAppointmentBooking(newAppointmentOrder(t, other, "mon", "1"), time.Now()); err != ErrSlotNotBookable {
+		t.Errorf("expected slot of a listing without availability to be rejected, got %v", err)
+	}
+	if err := validateAppointmentBooking(newAppointmentOrder(t, service, "", "1"), time.Now()); err != ErrSlotRequired {
+		t.Errorf("expected order without a slot to be rejected, got %v", err)
+	}
+	if err := validateAppointmentBooking(newAppointmentOrder(t, service, "sun", "1"), time.Now()); err != ErrUnknownSlot {
+		t.Errorf("expected unknown slot to be rejected, got %v", err)
+	}
+	if err := validateAppointmentBooking(newAppointmentOrder(t, service, "mon", "1"), start); err != ErrSlotPassed {
+		t.Errorf("expected slot which has started to be rejected, got %v", err)
+	}
+}
+
+func TestSlotReservations(t *testing.T) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := &OpenBazaarNode{Datastore: db.NewSQLiteDatastore(database, new(sync.Mutex), wi.Bitcoin)}
+	listing := newServiceListing(time.Now().Add(time.Hour))
+
+	if err := node.checkSlotCapacity(newAppointmentOrder(t, listing, "mon", "3")); err == nil {
+		t.Error("expected booking beyond the slot capacity to be rejected")
+	}
+	first := newAppointmentOrder(t, listing, "mon", "2")
+	if err := node.checkSlotCapacity(first); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.ReserveAppointment("order1", first); err != nil {
+		t.Fatal(err)
+	}
+	// reserving a funded order again is harmless
+	if err := node.ReserveAppointment("order1", first); err != nil {
+		t.Fatal(err)
+	}
+	second := newAppointmentOrder(t, listing, "mon", "1")
+	if _, ok := node.checkSlotCapacity(second).(ErrOutOfInventory); !ok {
+		t.Error("expected booking of a full slot to be rejected")
+	}
+	if err := node.checkSlotCapacity(newAppointmentOrder(t, listing, "tue", "1")); err != nil {
+		t.Errorf("expected booking of another slot to be accepted, got %v", err)
+	}
+
+	if err := node.CancelAppointment("order1", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.checkSlotCapacity(second); err != nil {
+		t.Errorf("expected refunded booking to release the slot, got %v", err)
+	}
+	appointment, err := node.Datastore.Appointments().Get("order1", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if appointment.State != repo.AppointmentCancelled || appointment.PeerID != "buyerID" || appointment.Location != "Workshop" {
+		t.Errorf("unexpected appointment %+v", appointment)
+	}
+}
+
+func TestWriteICalendar(t *testing.T) {
+	start := time.Date(2020, 3, 2, 9, 30, 0, 0, time.UTC)
+	appointments := []repo.Appointment{
+		{OrderID: "order1", Vendor: true, Title: "Bike repair, tune-up; brakes", Start: start, DurationMinutes: 90,
+			Location: "Workshop", PeerID: "buyerID", State: repo.AppointmentReserved},
+		{OrderID: "order2", Title: "Consulting " + strings.Repeat("x", 80), Start: start, DurationMinutes: 30,
+			PeerID: "vendorID", State: repo.AppointmentConfirmed},
+		{OrderID: "order3", Vendor: true, Title: "Cancelled", Start: start, DurationMinutes: 30, State: repo.AppointmentCancelled},
+	}
+	var buf bytes.Buffer
+	if err := WriteICalendar(&buf, appointments, start); err != nil {
+		t.Fatal(err)
+	}
+	ics := buf.String()
+	for _, line := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"UID:order1-sale@openbazaar\r\n",
+		"DTSTART:20200302T093000Z\r\n",
+		"DTEND:20200302T110000Z\r\n",
+		`SUMMARY:Bike repair\, tune-up\; brakes` + "\r\n",
+		"LOCATION:Workshop\r\n",
+		"STATUS:TENTATIVE\r\n",
+		"UID:order2-purchase@openbazaar\r\n",
+		"STATUS:CONFIRMED\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(ics, line) {
+			t.Errorf("expected calendar to contain %q", line)
+		}
+	}
+	if strings.Contains(ics, "order3") {
+		t.Error("expected cancelled appointments to be left out")
+	}
+	for _, line := range strings.Split(ics, "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("expected long lines to be folded, got %q", line)
+		}
+	}
+}
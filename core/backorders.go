@@ -0,0 +1,180 @@
+package core
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	crypto "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+)
+
+// backorderStates are the states of the orders in the backorder queue
+var backorderStates = []pb.OrderState{
+	pb.OrderState_AWAITING_PAYMENT,
+	pb.OrderState_AWAITING_FULFILLMENT,
+	pb.OrderState_PARTIALLY_FULFILLED,
+}
+
+// Backorder is a backordered or preordered sale awaiting fulfillment
+type Backorder struct {
+	OrderID          string        `json:"orderId"`
+	Slug             string        `json:"slug"`
+	Title            string        `json:"title"`
+	BuyerID          string        `json:"buyerId"`
+	BuyerHandle      string        `json:"buyerHandle"`
+	State            string        `json:"state"`
+	Timestamp        time.Time     `json:"timestamp"`
+	ExpectedShipDate *repo.APITime `json:"expectedShipDate,omitempty"`
+	Released         bool          `json:"released"`
+}
+
+// GetBackorders returns the backorder queue of the node, oldest first
+func (n *OpenBazaarNode) GetBackorders() ([]Backorder, error) {
+	sales, _, err := n.Datastore.Sales().GetAll(backorderStates, "", true, false, -1, nil)
+	if err != nil {
+		return nil, err
+	}
+	queue := []Backorder{}
+	for _, sale := range sales {
+		contract, _, _, _, _, _, err := n.Datastore.Sales().GetByOrderId(sale.OrderId)
+		if err != nil {
+			continue
+		}
+		oc := contract.VendorOrderConfirmation
+		if oc == nil || !oc.Backordered {
+			continue
+		}
+		b := Backorder{
+			OrderID:     sale.OrderId,
+			Slug:        sale.Slug,
+			Title:       sale.Title,
+			BuyerID:     sale.BuyerId,
+			BuyerHandle: sale.BuyerHandle,
+			State:       sale.State,
+			Timestamp:   sale.Timestamp,
+			Released:    contract.VendorBackorderRelease != nil,
+		}
+		if oc.ExpectedShipDate != nil {
+			b.ExpectedShipDate = repo.NewAPITime(time.Unix(oc.ExpectedShipDate.Seconds, 0))
+		}
+		queue = append(queue, b)
+	}
+	return queue, nil
+}
+
+// ReleaseBackorder tells the buyer of a backordered sale that the order is
+// in stock and moving to fulfillment
+func (n *OpenBazaarNode) ReleaseBackorder(orderID string) error {
+	contract, state, _, _, _, _, err := n.Datastore.Sales().GetByOrderId(orderID)
+	if err != nil {
+		return ErrOrderNotFound
+	}
+	if contract.VendorOrderConfirmation == nil || !contract.VendorOrderConfirmation.Backordered {
+		return ErrNotBackordered
+	}
+	if contract.VendorBackorderRelease != nil {
+		return ErrBackorderReleased
+	}
+	if state != pb.OrderState_AWAITING_FULFILLMENT && state != pb.OrderState_PARTIALLY_FULFILLED {
+		return errors.New("order is not awaiting fulfillment")
+	}
+
+	ts, err := ptypes.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	rc := &pb.RicardianContract{
+		VendorBackorderRelease: &pb.BackorderRelease{OrderID: orderID, Timestamp: ts},
+	}
+	ser, err := proto.Marshal(rc.VendorBackorderRelease)
+	if err != nil {
+		return err
+	}
+	sig, err := n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return err
+	}
+	rc.Signatures = []*pb.Signature{{Section: pb.Signature_BACKORDER_RELEASE, SignatureBytes: sig}}
+
+	contract.VendorBackorderRelease = rc.VendorBackorderRelease
+	contract.Signatures = append(contract.Signatures, rc.Signatures...)
+	if err := n.Datastore.Sales().Put(orderID, *contract, state, false); err != nil {
+		return err
+	}
+
+	buyerKey, err := crypto.UnmarshalPublicKey(contract.BuyerOrder.BuyerID.Pubkeys.Identity)
+	if err != nil {
+		return err
+	}
+	return n.SendBackorderRelease(contract.BuyerOrder.BuyerID.PeerID, &buyerKey, rc)
+}
+
+// ValidateBackorderRelease checks the backorder release of the contract is
+// signed by the vendor
+func (n *OpenBazaarNode) ValidateBackorderRelease(contract *pb.RicardianContract) error {
+	if err := verifyMessageSignature(
+		contract.VendorBackorderRelease,
+		contract.VendorListings[0].VendorID.Pubkeys.Identity,
+		contract.Signatures,
+		pb.Signature_BACKORDER_RELEASE,
+		contract.VendorListings[0].VendorID.PeerID,
+	); err != nil {
+		switch err.(type) {
+		case noSigError:
+			return errors.New("contract does not contain a signature for the backorder release")
+		case invalidSigError:
+			return errors.New("vendor's guid signature on backorder release failed to verify")
+		case matchKeyError:
+			return errors.New("public key in backorder release does not match reported vendor ID")
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// orderBackorder indicates whether an order being confirmed is a preorder
+// or takes a backordered listing below its stock, and returns the latest
+// expected ship date of its backordered items.
+func (n *OpenBazaarNode) orderBackorder(orderID string, contract *pb.RicardianContract) (bool, *timestamp.Timestamp) {
+	// The units of funded orders have already been taken from the stock
+	_, _, funded, _, _, _, err := n.Datastore.Sales().GetByOrderId(orderID)
+	reserved := err == nil && funded
+
+	var (
+		backordered bool
+		shipDate    *timestamp.Timestamp
+	)
+	for _, item := range contract.BuyerOrder.Items {
+		listing, err := ParseContractForListing(item.ListingHash, contract)
+		if err != nil || listing.Backorder == nil {
+			continue
+		}
+		if !listing.Backorder.Preorder {
+			variant, err := GetSelectedSku(listing, item.Options)
+			if err != nil {
+				continue
+			}
+			stock, err := n.Datastore.Inventory().GetSpecific(listing.Slug, variant)
+			if err != nil {
+				continue
+			}
+			if !reserved {
+				stock = new(big.Int).Sub(stock, GetOrderQuantity(listing, item))
+			}
+			if stock.Sign() >= 0 {
+				continue
+			}
+		}
+		backordered = true
+		if d := listing.Backorder.ExpectedShipDate; d != nil && (shipDate == nil || d.Seconds > shipDate.Seconds) {
+			shipDate = d
+		}
+	}
+	return backordered, shipDate
+}
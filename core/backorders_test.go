@@ -0,0 +1,73 @@
+package core_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	wi "github.com/OpenBazaar/wallet-interface"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+func TestOpenBazaarNode_GetBackorders(t *testing.T) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := &core.OpenBazaarNode{Datastore: db.NewSQLiteDatastore(database, new(sync.Mutex), wi.Bitcoin)}
+
+	shipDate := time.Unix(1600000000, 0)
+	for _, sale := range []struct {
+		orderID     string
+		backordered bool
+		state       pb.OrderState
+	}{
+		{"backordered", true, pb.OrderState_AWAITING_FULFILLMENT},
+		{"instock", false, pb.OrderState_AWAITING_FULFILLMENT},
+		{"shipped", true, pb.OrderState_FULFILLED},
+	} {
+		contract := factory.NewContract()
+		contract.VendorOrderConfirmation = &pb.OrderConfirmation{
+			OrderID:          sale.orderID,
+			Backordered:      sale.backordered,
+			ExpectedShipDate: &timestamp.Timestamp{Seconds: shipDate.Unix()},
+		}
+		if err := node.Datastore.Sales().Put(sale.orderID, *contract, sale.state, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	queue, err := node.GetBackorders()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queue) != 1 || queue[0].OrderID != "backordered" {
+		t.Fatalf("expected only the backordered sale awaiting fulfillment, got %v", queue)
+	}
+	if queue[0].Released || queue[0].ExpectedShipDate == nil || !queue[0].ExpectedShipDate.Equal(shipDate) {
+		t.Errorf("expected an unreleased backorder shipping at %s, got %v", shipDate, queue[0])
+	}
+
+	if err := node.ReleaseBackorder("instock"); err != core.ErrNotBackordered {
+		t.Errorf("expected %v, got %v", core.ErrNotBackordered, err)
+	}
+	if err := node.ReleaseBackorder("unknown"); err != core.ErrOrderNotFound {
+		t.Errorf("expected %v, got %v", core.ErrOrderNotFound, err)
+	}
+}
@@ -457,6 +457,9 @@ func (n *OpenBazaarNode) ValidateAndSaveRating(contract *pb.RicardianContract) (
 			continue
 		}
 
+		// Only the vendor can respond to the rating
+		rating.VendorResponse = nil
+
 		m := jsonpb.Marshaler{
 			EnumsAsInts:  false,
 			EmitDefaults: false,
@@ -489,6 +492,7 @@ func (n *OpenBazaarNode) ValidateAndSaveRating(contract *pb.RicardianContract) (
 			continue
 		}
 		f.Close()
+		n.addRatingToStats(rating)
 
 		if err := n.updateRatingIndex(rating, ratingPath); err != nil {
 			retErr = err
@@ -90,6 +90,7 @@ func (n *OpenBazaarNode) NewOrderConfirmation(contract *pb.RicardianContract, ad
 	}
 
 	oc.BigRequestedAmount = order.Payment.BigAmount
+	oc.Backordered, oc.ExpectedShipDate = n.orderBackorder(orderID, contract)
 	contract.VendorOrderConfirmation = oc
 	contract, err = n.SignOrderConfirmation(contract)
 	if err != nil {
@@ -188,6 +189,9 @@ func (n *OpenBazaarNode) ConfirmOfflineOrder(oldState pb.OrderState, contract *p
 		log.Errorf("failed sending confirmation for order (%s): %s", confirmedContract.VendorOrderConfirmation.OrderID, err.Error())
 		return nil
 	}
+	if err := n.AutoFulfillOrder(confirmedContract.VendorOrderConfirmation.OrderID); err != nil {
+		log.Errorf("failed automatically fulfilling order (%s): %s", confirmedContract.VendorOrderConfirmation.OrderID, err.Error())
+	}
 	return nil
 }
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"path"
+	"strings"
 	"sync"
 	"time"
 
@@ -22,7 +23,9 @@ import (
 	ret "github.com/OpenBazaar/openbazaar-go/net/retriever"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/schema"
 	sto "github.com/OpenBazaar/openbazaar-go/storage"
+	"github.com/OpenBazaar/wallet-interface"
 	"github.com/btcsuite/btcutil/hdkeychain"
 	"github.com/ipfs/go-ipfs/core"
 	logging "github.com/op/go-logging"
@@ -111,6 +114,19 @@ type OpenBazaarNode struct {
 	// The master private key derived from the mnemonic
 	MasterPrivateKey *hdkeychain.ExtendedKey
 
+	// The spending policy of each wallet keyed by mainnet currency code
+	SpendingPolicies map[string]*schema.SpendingPolicy
+
+	// The automatic fulfillment policy of each wallet keyed by mainnet
+	// currency code. Cryptocurrency listings selling other coins are
+	// fulfilled by the vendor.
+	AutoFulfillPolicies map[string]*schema.AutoFulfillPolicy
+
+	// ExchangeRates aggregates the configured exchange rate providers. The
+	// rates are per reserve coin. When nil the reserve wallet's exchange
+	// rates are used.
+	ExchangeRates wallet.ExchangeRates
+
 	// The number of DHT records to collect before returning. The larger the number
 	// the slower the query but the less likely we will get an old record.
 	IPNSQuorumSize uint
@@ -118,8 +134,36 @@ type OpenBazaarNode struct {
 	TestnetEnable        bool
 	RegressionTestEnable bool
 
-	PublishLock sync.Mutex
-	seedLock    sync.Mutex
+	PublishLock      sync.Mutex
+	seedLock         sync.Mutex
+	subscriptionLock sync.Mutex
+	crowdfundLock    sync.Mutex
+	pendingSpendLock sync.Mutex
+
+	// processSubscriptionsLock is held while due subscriptions are ordered
+	processSubscriptionsLock sync.Mutex
+
+	// autoFulfillLock is held from the limit checks of automatic payouts
+	// until they are recorded, so that concurrent orders can't pass the
+	// daily limit together
+	autoFulfillLock sync.Mutex
+
+	// autoFulfillSpend sends automatic payouts. Spend is used when nil.
+	autoFulfillSpend func(*SpendRequest) (*SpendResponse, error)
+
+	// ratingTallies caches the scores of the saved ratings for the rating
+	// summaries. It is read on first use and updated as ratings are saved.
+	ratingTalliesLock sync.Mutex
+	ratingTallies     *ratingTallies
+
+	// totpLock is held while an authenticator code is checked against the
+	// last code accepted for the secret and recorded
+	totpLock sync.Mutex
+
+	// spendLocks serialize the spending policy checks and the spends of each
+	// wallet, so that concurrent spends can't pass the daily limit together
+	spendLocksLock sync.Mutex
+	spendLocks     map[string]*sync.Mutex
 
 	InitalPublishComplete bool
 
@@ -136,7 +180,40 @@ func (n *OpenBazaarNode) RegressionNetworkEnabled() bool { return n.RegressionTe
 
 // SeedNode - publish to IPNS
 func (n *OpenBazaarNode) SeedNode() error {
+	rootHash, err := n.addRootDirectory()
+	if err != nil {
+		return err
+	}
+	n.InitalPublishComplete = true
+	go n.publish(rootHash)
+	return nil
+}
+
+// PublishChanges publishes the root directory if it changed since it was
+// last added and returns once the publish is done. It returns whether the
+// directory was published.
+func (n *OpenBazaarNode) PublishChanges() (bool, error) {
+	// The root hash loaded from the cached IPNS record is a path
+	previous := strings.TrimPrefix(n.RootHash, "/ipfs/")
+	rootHash, err := n.addRootDirectory()
+	if err != nil {
+		return false, err
+	}
+	if rootHash == previous {
+		return false, nil
+	}
+	n.InitalPublishComplete = true
+	if err := n.publish(rootHash); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// addRootDirectory adds the root directory to IPFS in place of the
+// previous one and returns its hash
+func (n *OpenBazaarNode) addRootDirectory() (string, error) {
 	n.seedLock.Lock()
+	defer n.seedLock.Unlock()
 	err := ipfs.UnPinDir(n.IpfsNode, n.RootHash)
 	if err != nil {
 		log.Errorf("unpinning old root: %s", err.Error())
@@ -153,24 +230,19 @@ func (n *OpenBazaarNode) SeedNode() error {
 		time.Sleep(time.Millisecond * 500)
 	}
 	if aerr != nil {
-		n.seedLock.Unlock()
-		return aerr
+		return "", aerr
 	}
 	n.RootHash = rootHash
-
-	n.seedLock.Unlock()
-	n.InitalPublishComplete = true
-	go n.publish(rootHash)
-	return nil
+	return rootHash, nil
 }
 
-func (n *OpenBazaarNode) publish(hash string) {
+func (n *OpenBazaarNode) publish(hash string) error {
 	// Multiple publishes may have been queued
 	// We only need to publish the most recent
 	n.PublishLock.Lock()
 	defer n.PublishLock.Unlock()
 	if hash != n.RootHash {
-		return
+		return nil
 	}
 
 	if inflightPublishRequests == 0 {
@@ -180,7 +252,7 @@ func (n *OpenBazaarNode) publish(hash string) {
 	err := n.sendToPushNodes(hash)
 	if err != nil {
 		log.Error(err)
-		return
+		return err
 	}
 
 	go func() {
@@ -205,7 +277,15 @@ func (n *OpenBazaarNode) publish(hash string) {
 	}()
 
 	inflightPublishRequests++
+	publishInflight.Inc()
+	start := time.Now()
 	err = ipfs.Publish(n.IpfsNode, hash)
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	publishDuration.WithLabelValues(result).Observe(time.Since(start).Seconds())
+	publishInflight.Dec()
 
 	inflightPublishRequests--
 	if inflightPublishRequests == 0 {
@@ -216,6 +296,7 @@ func (n *OpenBazaarNode) publish(hash string) {
 			n.Broadcast <- repo.StatusNotification{Status: "publish complete"}
 		}
 	}
+	return err
 }
 
 func (n *OpenBazaarNode) sendToPushNodes(hash string) error {
@@ -0,0 +1,337 @@
+package core
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// crowdfundPledgeStates are the states of the pledges of a crowdfund,
+// including those the vendor has not confirmed yet. Pledges which are not
+// confirmed only count once they are funded.
+var crowdfundPledgeStates = []pb.OrderState{
+	pb.OrderState_PENDING,
+	pb.OrderState_AWAITING_FULFILLMENT,
+	pb.OrderState_PARTIALLY_FULFILLED,
+	pb.OrderState_FULFILLED,
+	pb.OrderState_COMPLETED,
+}
+
+// validateCrowdfundPledge checks an order of a crowdfund listing is a pledge
+// the campaign can accept at the time now
+func validateCrowdfundPledge(contract *pb.RicardianContract, now time.Time) error {
+	for _, listing := range contract.VendorListings {
+		if listing.Metadata.GetContractType() != pb.Listing_Metadata_CROWD_FUND {
+			continue
+		}
+		if len(contract.VendorListings) > 1 {
+			return ErrCrowdfundMixedOrder
+		}
+		if contract.BuyerOrder.Payment.Method != pb.Order_Payment_MODERATED {
+			return ErrCrowdfundPledgeNotModerated
+		}
+		if listing.Crowdfund == nil || listing.Crowdfund.Deadline == nil {
+			return errors.New("crowdfund listing is missing its goal")
+		}
+		if !now.Before(time.Unix(listing.Crowdfund.Deadline.Seconds, 0)) {
+			return ErrCrowdfundEnded
+		}
+	}
+	return nil
+}
+
+// nextCrowdfundState returns the state of a campaign from its previous state.
+// Reaching the goal before the deadline is final: the pledges are not
+// refunded if some are cancelled afterwards.
+func nextCrowdfundState(previous repo.CrowdfundState, goalReached bool, deadline, now time.Time) repo.CrowdfundState {
+	ended := !now.Before(deadline)
+	switch {
+	case previous.Ended():
+		return previous
+	case (goalReached || previous.GoalReached()) && ended:
+		return repo.CrowdfundSuccessful
+	case goalReached || previous.GoalReached():
+		return repo.CrowdfundFunded
+	case ended:
+		return repo.CrowdfundFailed
+	default:
+		return repo.CrowdfundFunding
+	}
+}
+
+// crowdfundTierName names the tier of a sku after its variants
+func crowdfundTierName(listing *pb.Listing, sku *pb.Listing_Item_Sku) string {
+	var names []string
+	for i, v := range sku.VariantCombo {
+		if i < len(listing.Item.Options) && int(v) < len(listing.Item.Options[i].Variants) {
+			names = append(names, listing.Item.Options[i].Variants[v].Name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// crowdfundProgress adds up the funded pledges of the node's crowdfund
+// listing. The state follows on from the previous progress, if any.
+func (n *OpenBazaarNode) crowdfundProgress(l *repo.Listing, previous *repo.CrowdfundProgress, now time.Time) (*repo.CrowdfundProgress, error) {
+	crowdfund := l.GetCrowdfund()
+	if crowdfund == nil || crowdfund.Deadline == nil {
+		return nil, errors.New("listing is not a crowdfund")
+	}
+	price, err := l.GetPrice()
+	if err != nil {
+		return nil, err
+	}
+	goal, err := repo.NewCurrencyValue(crowdfund.BigGoal, price.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	listing := l.GetProtobuf()
+	tiers := make([]repo.CrowdfundTier, len(listing.Item.Skus))
+	for i, sku := range listing.Item.Skus {
+		tiers[i].Name = crowdfundTierName(listing, sku)
+	}
+
+	sales, _, err := n.Datastore.Sales().GetAll(crowdfundPledgeStates, "", true, false, -1, nil)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		pledged = big.NewInt(0)
+		backers uint32
+	)
+	for _, sale := range sales {
+		if sale.Slug != l.GetSlug() {
+			continue
+		}
+		contract, state, funded, _, _, _, err := n.Datastore.Sales().GetByOrderId(sale.OrderId)
+		if err != nil || (state == pb.OrderState_PENDING && !funded) {
+			continue
+		}
+		counted := false
+		for _, item := range contract.BuyerOrder.Items {
+			pl, err := ParseContractForListing(item.ListingHash, contract)
+			if err != nil || pl.Slug != l.GetSlug() {
+				continue
+			}
+			rl, err := repo.NewListingFromProtobuf(pl)
+			if err != nil {
+				continue
+			}
+			nrl, err := rl.Normalize()
+			if err != nil {
+				continue
+			}
+			amount, err := getItemUnitAmount(nrl, item)
+			if err != nil || !amount.Currency.Equal(goal.Currency) {
+				log.Warningf("not counting pledge %s towards crowdfund %s", sale.OrderId, l.GetSlug())
+				continue
+			}
+			quantity := getItemLineQuantity(nrl, item)
+			pledged.Add(pledged, amount.MulBigInt(quantity).AmountBigInt())
+			counted = true
+			if variant, err := GetSelectedSku(pl, item.Options); err == nil && variant < len(tiers) {
+				tiers[variant].Pledges += quantity.Uint64()
+			}
+		}
+		if counted {
+			backers++
+		}
+	}
+
+	var state repo.CrowdfundState
+	if previous != nil {
+		state = previous.State
+	}
+	deadline := time.Unix(crowdfund.Deadline.Seconds, 0)
+	return &repo.CrowdfundProgress{
+		Goal:     goal,
+		Pledged:  repo.NewCurrencyValueFromBigInt(pledged, price.Currency),
+		Backers:  backers,
+		Deadline: deadline,
+		State:    nextCrowdfundState(state, pledged.Cmp(goal.AmountBigInt()) >= 0, deadline, now),
+		Tiers:    tiers,
+	}, nil
+}
+
+// listingIndexCrowdfund returns the crowdfund progress of the listing in
+// the listing index, or nil if there is none
+func (n *OpenBazaarNode) listingIndexCrowdfund(slug string) *repo.CrowdfundProgress {
+	index, err := n.getListingIndex()
+	if err != nil {
+		return nil
+	}
+	for _, ld := range index {
+		if ld.Slug == slug {
+			return ld.Crowdfund
+		}
+	}
+	return nil
+}
+
+// checkCrowdfundFulfillment rejects the fulfillment of pledges to the
+// node's crowdfunds before they reach their goal, as fulfilling a
+// moderated order releases the escrow to the vendor
+func (n *OpenBazaarNode) checkCrowdfundFulfillment(contract *pb.RicardianContract) error {
+	for _, listing := range contract.VendorListings {
+		if listing.Metadata.GetContractType() != pb.Listing_Metadata_CROWD_FUND {
+			continue
+		}
+		progress := n.listingIndexCrowdfund(listing.Slug)
+		if progress == nil || !progress.State.GoalReached() {
+			return ErrCrowdfundNotFunded
+		}
+	}
+	return nil
+}
+
+// StartCrowdfundScheduler updates the progress of the node's crowdfunds now
+// and then at every notifier interval
+func (n *OpenBazaarNode) StartCrowdfundScheduler() {
+	go func() {
+		t := time.NewTicker(n.intervalDelay())
+		for ; true; <-t.C {
+			if n.ProcessCrowdfunds(time.Now()) {
+				if err := n.SeedNode(); err != nil {
+					log.Errorf("publishing crowdfund progress: %s", err)
+				}
+			}
+		}
+	}()
+}
+
+// ProcessCrowdfunds updates the progress of the node's crowdfunds in the
+// listing index and refunds the pledges of the campaigns which failed. It
+// returns whether the listing index changed and needs publishing.
+func (n *OpenBazaarNode) ProcessCrowdfunds(now time.Time) bool {
+	index, err := n.getListingIndex()
+	if err != nil {
+		log.Errorf("loading listing index: %s", err)
+		return false
+	}
+	changed := false
+	for _, ld := range index {
+		if ld.ContractType != pb.Listing_Metadata_CROWD_FUND.String() {
+			continue
+		}
+		c, err := n.updateCrowdfund(ld.Slug, now)
+		if err != nil {
+			log.Errorf("updating crowdfund %s: %s", ld.Slug, err)
+			continue
+		}
+		changed = changed || c
+	}
+	return changed
+}
+
+// UpdateCrowdfundProgress updates the progress of a crowdfund in the
+// listing index, after a pledge is funded, and publishes it
+func (n *OpenBazaarNode) UpdateCrowdfundProgress(slug string) error {
+	changed, err := n.updateCrowdfund(slug, time.Now())
+	if err != nil || !changed {
+		return err
+	}
+	return n.SeedNode()
+}
+
+func (n *OpenBazaarNode) updateCrowdfund(slug string, now time.Time) (bool, error) {
+	n.crowdfundLock.Lock()
+	defer n.crowdfundLock.Unlock()
+
+	sl, err := n.GetListingFromSlug(slug)
+	if err != nil {
+		return false, err
+	}
+	l, err := repo.NewListingFromProtobuf(sl.Listing)
+	if err != nil {
+		return false, err
+	}
+	index, err := n.getListingIndex()
+	if err != nil {
+		return false, err
+	}
+	var (
+		ld     repo.ListingIndexData
+		exists bool
+	)
+	for _, d := range index {
+		if d.Slug == slug {
+			ld, exists = d, true
+			break
+		}
+	}
+	if !exists {
+		return false, errors.New("listing does not exist in index")
+	}
+
+	progress, err := n.crowdfundProgress(l, ld.Crowdfund, now)
+	if err != nil {
+		return false, err
+	}
+	if progress.State == repo.CrowdfundFailed {
+		if n.refundCrowdfundPledges(slug) {
+			if progress, err = n.crowdfundProgress(l, progress, now); err != nil {
+				return false, err
+			}
+		}
+	}
+	if crowdfundProgressEqual(ld.Crowdfund, progress) {
+		return false, nil
+	}
+	ld.Crowdfund = progress
+	return true, n.updateListingOnDisk(index, ld, true)
+}
+
+// refundCrowdfundPledges refunds the pledges of a failed crowdfund which
+// are awaiting fulfillment and indicates whether any were refunded. Funded
+// pledges the vendor has not confirmed are rejected, which refunds them too.
+func (n *OpenBazaarNode) refundCrowdfundPledges(slug string) bool {
+	states := []pb.OrderState{pb.OrderState_PENDING, pb.OrderState_AWAITING_FULFILLMENT, pb.OrderState_PARTIALLY_FULFILLED}
+	sales, _, err := n.Datastore.Sales().GetAll(states, "", true, false, -1, nil)
+	if err != nil {
+		log.Errorf("loading pledges of crowdfund %s: %s", slug, err)
+		return false
+	}
+	refunded := false
+	for _, sale := range sales {
+		if sale.Slug != slug {
+			continue
+		}
+		contract, state, _, records, _, _, err := n.Datastore.Sales().GetByOrderId(sale.OrderId)
+		if err != nil {
+			continue
+		}
+		if state == pb.OrderState_PENDING {
+			err = n.RejectOfflineOrder(contract, records)
+		} else {
+			err = n.RefundOrder(contract, records)
+		}
+		if err != nil {
+			log.Errorf("refunding pledge %s of crowdfund %s: %s", sale.OrderId, slug, err)
+			continue
+		}
+		log.Infof("Refunded pledge %s of failed crowdfund %s", sale.OrderId, slug)
+		refunded = true
+	}
+	return refunded
+}
+
+func crowdfundProgressEqual(a, b *repo.CrowdfundProgress) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.State != b.State || a.Backers != b.Backers || !a.Deadline.Equal(b.Deadline) ||
+		!a.Goal.Equal(b.Goal) || !a.Pledged.Equal(b.Pledged) || len(a.Tiers) != len(b.Tiers) {
+		return false
+	}
+	for i := range a.Tiers {
+		if a.Tiers[i] != b.Tiers[i] {
+			return false
+		}
+	}
+	return true
+}
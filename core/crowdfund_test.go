@@ -0,0 +1,148 @@
+package core
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	wi "github.com/OpenBazaar/wallet-interface"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+func TestValidateCrowdfundPledge(t *testing.T) {
+	deadline := time.Now().Add(24 * time.Hour)
+	crowdfund := &pb.Listing{
+		Slug:      "campaign",
+		Metadata:  &pb.Listing_Metadata{ContractType: pb.Listing_Metadata_CROWD_FUND},
+		Crowdfund: &pb.Listing_Crowdfund{BigGoal: "100000", Deadline: &timestamp.Timestamp{Seconds: deadline.Unix()}},
+	}
+	other := &pb.Listing{
+		Slug:     "mug",
+		Metadata: &pb.Listing_Metadata{ContractType: pb.Listing_Metadata_PHYSICAL_GOOD},
+	}
+	pledge := func(method pb.Order_Payment_Method, listings ...*pb.Listing) *pb.RicardianContract {
+		return &pb.RicardianContract{
+			VendorListings: listings,
+			BuyerOrder:     &pb.Order{Payment: &pb.Order_Payment{Method: method}},
+		}
+	}
+
+	if err := validateCrowdfundPledge(pledge(pb.Order_Payment_ADDRESS_REQUEST, other), time.Now()); err != nil {
+		t.Errorf("expected orders of other listings to be accepted, got %v", err)
+	}
+	if err := validateCrowdfundPledge(pledge(pb.Order_Payment_MODERATED, crowdfund), time.Now()); err != nil {
+		t.Errorf("expected moderated pledge to be accepted, got %v", err)
+	}
+	if err := validateCrowdfundPledge(pledge(pb.Order_Payment_ADDRESS_REQUEST, crowdfund), time.Now()); err != ErrCrowdfundPledgeNotModerated {
+		t.Errorf("expected direct pledge to be rejected, got %v", err)
+	}
+	if err := validateCrowdfundPledge(pledge(pb.Order_Payment_MODERATED, crowdfund, other), time.Now()); err != ErrCrowdfundMixedOrder {
+		t.Errorf("expected pledge ordered with another listing to be rejected, got %v", err)
+	}
+	if err := validateCrowdfundPledge(pledge(pb.Order_Payment_MODERATED, crowdfund), deadline); err != ErrCrowdfundEnded {
+		t.Errorf("expected pledge at the deadline to be rejected, got %v", err)
+	}
+}
+
+func TestNextCrowdfundState(t *testing.T) {
+	var (
+		deadline = time.Now()
+		before   = deadline.Add(-time.Hour)
+		after    = deadline.Add(time.Hour)
+	)
+	tests := []struct {
+		previous    repo.CrowdfundState
+		goalReached bool
+		now         time.Time
+		expected    repo.CrowdfundState
+	}{
+		{"", false, before, repo.CrowdfundFunding},
+		{repo.CrowdfundFunding, true, before, repo.CrowdfundFunded},
+		{repo.CrowdfundFunding, false, after, repo.CrowdfundFailed},
+		{repo.CrowdfundFunding, true, after, repo.CrowdfundSuccessful},
+		// pledges cancelled after reaching the goal don't fail the campaign
+		{repo.CrowdfundFunded, false, before, repo.CrowdfundFunded},
+		{repo.CrowdfundFunded, false, after, repo.CrowdfundSuccessful},
+		// ended campaigns keep their outcome
+		{repo.CrowdfundFailed, true, after, repo.CrowdfundFailed},
+		{repo.CrowdfundSuccessful, false, after, repo.CrowdfundSuccessful},
+	}
+	for i, test := range tests {
+		if state := nextCrowdfundState(test.previous, test.goalReached, deadline, test.now); state != test.expected {
+			t.Errorf("test %d: expected %s, got %s", i, test.expected, state)
+		}
+	}
+}
+
+func TestCrowdfundProgressCountsPendingPledges(t *testing.T) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := &OpenBazaarNode{Datastore: db.NewSQLiteDatastore(database, new(sync.Mutex), wi.Bitcoin)}
+
+	listing := factory.NewListing("campaign")
+	listing.Metadata.ContractType = pb.Listing_Metadata_CROWD_FUND
+	listing.Crowdfund = &pb.Listing_Crowdfund{BigGoal: "100000", Deadline: &timestamp.Timestamp{Seconds: time.Now().Add(24 * time.Hour).Unix()}}
+	ser, err := proto.Marshal(listing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listingID, err := ipfs.EncodeCID(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := []*pb.Order_Item_Option{{Name: "Size", Value: "Small"}, {Name: "Color", Value: "Red"}}
+	for _, sale := range []struct {
+		orderID string
+		state   pb.OrderState
+		funded  bool
+	}{
+		{"unconfirmed", pb.OrderState_PENDING, true},
+		{"unconfirmedUnfunded", pb.OrderState_PENDING, false},
+		{"confirmed", pb.OrderState_AWAITING_FULFILLMENT, true},
+		{"unfunded", pb.OrderState_AWAITING_PAYMENT, false},
+	} {
+		contract := factory.NewContract()
+		contract.VendorListings = []*pb.Listing{listing}
+		contract.BuyerOrder.Items = []*pb.Order_Item{{ListingHash: listingID.String(), BigQuantity: "1", Options: options}}
+		if err := node.Datastore.Sales().Put(sale.orderID, *contract, sale.state, false); err != nil {
+			t.Fatal(err)
+		}
+		if sale.funded {
+			if err := node.Datastore.Sales().UpdateFunding(sale.orderID, true, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	rl, err := repo.NewListingFromProtobuf(listing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	progress, err := node.crowdfundProgress(rl, nil, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if progress.Backers != 2 || progress.Pledged.AmountBigInt().String() != "4000" {
+		t.Errorf("expected the confirmed and funded unconfirmed pledges to count, got %d backers pledging %s", progress.Backers, progress.Pledged.Amount)
+	}
+}
@@ -0,0 +1,220 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// autoFulfillPolicy returns the automatic fulfillment policy configured for
+// the coin or nil. Testnet coins use the policy of the corresponding mainnet
+// coin.
+func (n *OpenBazaarNode) autoFulfillPolicy(currencyCode string) *schema.AutoFulfillPolicy {
+	code := strings.ToUpper(currencyCode)
+	if policy, ok := n.AutoFulfillPolicies[code]; ok {
+		return policy
+	}
+	if n.TestNetworkEnabled() || n.RegressionNetworkEnabled() {
+		return n.AutoFulfillPolicies[repo.MainnetCurrencyCode(code)]
+	}
+	return nil
+}
+
+// cryptocurrencyDeliveries pays the buyer the coins ordered from the listing
+// and returns a delivery for each payment. Items paid out before are not paid
+// again. Nothing is returned if the coin has no automatic fulfillment policy.
+func (n *OpenBazaarNode) cryptocurrencyDeliveries(orderID string, listing *pb.Listing, contract *pb.RicardianContract) ([]*pb.OrderFulfillment_CryptocurrencyDelivery, error) {
+	coin := strings.ToUpper(listing.Metadata.CryptoCurrencyCode)
+	policy := n.autoFulfillPolicy(coin)
+	if policy == nil {
+		return nil, nil
+	}
+
+	n.autoFulfillLock.Lock()
+	defer n.autoFulfillLock.Unlock()
+
+	payouts, err := n.Datastore.CryptoPayouts().GetByOrderID(orderID)
+	if err != nil {
+		return nil, err
+	}
+	paid := make(map[int]repo.CryptoPayout)
+	for _, payout := range payouts {
+		paid[payout.Item] = payout
+	}
+
+	type payment struct {
+		item    int
+		address string
+		amount  *big.Int
+	}
+	var (
+		items      []int
+		payments   []payment
+		orderTotal = new(big.Int)
+		unpaid     = new(big.Int)
+	)
+	for i, item := range contract.BuyerOrder.Items {
+		itemListing, err := ParseContractForListing(item.ListingHash, contract)
+		if err != nil || itemListing.Slug != listing.Slug {
+			continue
+		}
+		amount := GetOrderQuantity(listing, item)
+		if amount == nil || amount.Sign() <= 0 {
+			return nil, errors.New("invalid quantity")
+		}
+		items = append(items, i)
+		orderTotal.Add(orderTotal, amount)
+		if _, ok := paid[i]; !ok {
+			payments = append(payments, payment{item: i, address: item.PaymentAddress, amount: amount})
+			unpaid.Add(unpaid, amount)
+		}
+	}
+
+	if policy.OrderLimit != "" {
+		limit, _ := new(big.Int).SetString(policy.OrderLimit, 10)
+		if orderTotal.Cmp(limit) > 0 {
+			return nil, ErrAutoFulfillOrderLimitExceeded
+		}
+	}
+	if policy.DailyLimit != "" && len(payments) > 0 {
+		limit, _ := new(big.Int).SetString(policy.DailyLimit, 10)
+		recent, err := n.Datastore.CryptoPayouts().GetSince(coin, time.Now().Add(-24*time.Hour))
+		if err != nil {
+			return nil, err
+		}
+		total := new(big.Int).Set(unpaid)
+		for _, payout := range recent {
+			if amount, ok := new(big.Int).SetString(payout.Amount, 10); ok {
+				total.Add(total, amount)
+			}
+		}
+		if total.Cmp(limit) > 0 {
+			return nil, ErrAutoFulfillDailyLimitExceeded
+		}
+	}
+
+	for _, p := range payments {
+		// The payout is recorded before it is sent so that it counts towards
+		// the daily limit and is never sent twice
+		payout := repo.CryptoPayout{
+			OrderID:   orderID,
+			Item:      p.item,
+			Coin:      coin,
+			Amount:    p.amount.String(),
+			Address:   p.address,
+			Timestamp: time.Now(),
+		}
+		if err := n.Datastore.CryptoPayouts().Put(payout); err != nil {
+			return nil, err
+		}
+		// The automatic fulfillment limits stand in for approving the spend
+		// and the buyer's address can't be on the allowed addresses
+		resp, err := n.sendAutoFulfillPayout(&SpendRequest{
+			CurrencyCode: coin,
+			Amount:       p.amount.String(),
+			Address:      p.address,
+			FeeLevel:     "NORMAL",
+			Memo:         fmt.Sprintf("Fulfillment of order %s", orderID),
+			approved:     true,
+			payout:       true,
+		})
+		if err != nil {
+			if derr := n.Datastore.CryptoPayouts().Delete(orderID, p.item); derr != nil {
+				log.Errorf("failed removing unsent payout of order %s: %s", orderID, derr.Error())
+			}
+			return nil, err
+		}
+		payout.Txid = resp.Txid
+		if err := n.Datastore.CryptoPayouts().Put(payout); err != nil {
+			log.Errorf("failed recording payout %s of order %s: %s", resp.Txid, orderID, err.Error())
+			return nil, err
+		}
+		paid[p.item] = payout
+	}
+
+	var deliveries []*pb.OrderFulfillment_CryptocurrencyDelivery
+	for _, i := range items {
+		if paid[i].Txid == "" {
+			return nil, fmt.Errorf("payout of item %d was not recorded as sent", i)
+		}
+		deliveries = append(deliveries, &pb.OrderFulfillment_CryptocurrencyDelivery{TransactionID: paid[i].Txid})
+	}
+	return deliveries, nil
+}
+
+func (n *OpenBazaarNode) sendAutoFulfillPayout(args *SpendRequest) (*SpendResponse, error) {
+	if n.autoFulfillSpend != nil {
+		return n.autoFulfillSpend(args)
+	}
+	return n.Spend(args)
+}
+
+// paymentConfirmed returns whether every payment received for the order has
+// at least the number of confirmations the policy requires
+func (n *OpenBazaarNode) paymentConfirmed(policy *schema.AutoFulfillPolicy, contract *pb.RicardianContract, records []*wallet.TransactionRecord) (bool, error) {
+	wal, err := n.Multiwallet.WalletForCurrencyCode(contract.BuyerOrder.Payment.AmountCurrency.Code)
+	if err != nil {
+		return false, err
+	}
+	received := false
+	for _, r := range records {
+		if r.Value.Sign() <= 0 {
+			continue
+		}
+		hash, err := chainhash.NewHashFromStr(strings.TrimPrefix(r.Txid, "0x"))
+		if err != nil {
+			return false, err
+		}
+		confirms, _, err := wal.GetConfirmations(*hash)
+		if err != nil {
+			return false, err
+		}
+		if confirms < policy.RequiredConfirmations() {
+			return false, nil
+		}
+		received = true
+	}
+	return received, nil
+}
+
+// StartAutoFulfillScheduler pays out the orders of cryptocurrency listings
+// whose payments got confirmed now and then at every notifier interval
+func (n *OpenBazaarNode) StartAutoFulfillScheduler() {
+	go func() {
+		t := time.NewTicker(n.intervalDelay())
+		for ; true; <-t.C {
+			n.ProcessAutoFulfillments()
+		}
+	}()
+}
+
+// ProcessAutoFulfillments automatically fulfills the funded direct sales of
+// cryptocurrency listings with an automatic fulfillment policy. Their coins
+// are paid out once the payment has the confirmations the policy requires.
+func (n *OpenBazaarNode) ProcessAutoFulfillments() {
+	if len(n.AutoFulfillPolicies) == 0 {
+		return
+	}
+	states := []pb.OrderState{pb.OrderState_AWAITING_FULFILLMENT, pb.OrderState_PARTIALLY_FULFILLED}
+	sales, _, err := n.Datastore.Sales().GetAll(states, "", true, false, -1, nil)
+	if err != nil {
+		log.Errorf("loading sales awaiting fulfillment: %s", err)
+		return
+	}
+	for _, sale := range sales {
+		if sale.Moderated || sale.CoinType == "" || n.autoFulfillPolicy(sale.CoinType) == nil {
+			continue
+		}
+		if err := n.AutoFulfillOrder(sale.OrderId); err != nil {
+			log.Errorf("automatically fulfilling order %s: %s", sale.OrderId, err)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package core
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/multiwallet"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	wi "github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/golang/protobuf/proto"
+)
+
+func newCryptoFulfillmentNode(t *testing.T, policy *schema.AutoFulfillPolicy) (*OpenBazaarNode, func()) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := &OpenBazaarNode{
+		Datastore:           db.NewSQLiteDatastore(database, new(sync.Mutex), wi.Bitcoin),
+		TestnetEnable:       true,
+		AutoFulfillPolicies: map[string]*schema.AutoFulfillPolicy{"BTC": policy},
+	}
+	return node, appSchema.DestroySchemaDirectories
+}
+
+func newCryptoOrder(t *testing.T, coin, quantity string) (*pb.Listing, *pb.RicardianContract) {
+	listing := factory.NewCryptoListing("coins")
+	listing.Metadata.CryptoCurrencyCode = coin
+	ser, err := proto.Marshal(listing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listingID, err := ipfs.EncodeCID(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract := factory.NewContract()
+	contract.VendorListings = []*pb.Listing{listing}
+	contract.BuyerOrder.Items = []*pb.Order_Item{{ListingHash: listingID.String(), BigQuantity: quantity, PaymentAddress: "buyerAddress"}}
+	return listing, contract
+}
+
+func TestCryptocurrencyDeliveries(t *testing.T) {
+	node, teardown := newCryptoFulfillmentNode(t, &schema.AutoFulfillPolicy{OrderLimit: "1000", DailyLimit: "1500"})
+	defer teardown()
+
+	listing, contract := newCryptoOrder(t, "TLTC", "100")
+	if deliveries, err := node.cryptocurrencyDeliveries("order1", listing, contract); err != nil || deliveries != nil {
+		t.Errorf("expected coins without a policy to be left to the vendor, got %v, %v", deliveries, err)
+	}
+
+	listing, contract = newCryptoOrder(t, "TBTC", "2000")
+	if _, err := node.cryptocurrencyDeliveries("order2", listing, contract); err != ErrAutoFulfillOrderLimitExceeded {
+		t.Errorf("expected %v, got %v", ErrAutoFulfillOrderLimitExceeded, err)
+	}
+
+	if err := node.Datastore.CryptoPayouts().Put(repo.CryptoPayout{OrderID: "order3", Coin: "TBTC", Amount: "800", Txid: "paid", Timestamp: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+	listing, contract = newCryptoOrder(t, "TBTC", "800")
+	if _, err := node.cryptocurrencyDeliveries("order4", listing, contract); err != ErrAutoFulfillDailyLimitExceeded {
+		t.Errorf("expected %v, got %v", ErrAutoFulfillDailyLimitExceeded, err)
+	}
+
+	deliveries, err := node.cryptocurrencyDeliveries("order3", listing, contract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 || deliveries[0].TransactionID != "paid" {
+		t.Errorf("expected the earlier payout to be delivered again, got %v", deliveries)
+	}
+}
+
+func TestCryptocurrencyDeliveriesDailyLimitOfConcurrentOrders(t *testing.T) {
+	node, teardown := newCryptoFulfillmentNode(t, &schema.AutoFulfillPolicy{DailyLimit: "1500"})
+	defer teardown()
+	node.autoFulfillSpend = func(args *SpendRequest) (*SpendResponse, error) {
+		time.Sleep(50 * time.Millisecond)
+		return &SpendResponse{Txid: "tx-" + args.Memo}, nil
+	}
+
+	var (
+		wg     sync.WaitGroup
+		lock   sync.Mutex
+		paid   int
+		orders = 10
+	)
+	for i := 0; i < orders; i++ {
+		listing, contract := newCryptoOrder(t, "TBTC", "800")
+		wg.Add(1)
+		go func(orderID string) {
+			defer wg.Done()
+			_, err := node.cryptocurrencyDeliveries(orderID, listing, contract)
+			lock.Lock()
+			defer lock.Unlock()
+			switch err {
+			case nil:
+				paid++
+			case ErrAutoFulfillDailyLimitExceeded:
+			default:
+				t.Errorf("order %s: %v", orderID, err)
+			}
+		}(fmt.Sprintf("order%d", i))
+	}
+	wg.Wait()
+
+	if paid != 1 {
+		t.Errorf("expected one order to be paid and the others to exceed the daily limit, got %d paid", paid)
+	}
+	payouts, err := node.Datastore.CryptoPayouts().GetSince("TBTC", time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(payouts) != 1 || payouts[0].Txid == "" {
+		t.Errorf("expected a single payout, got %v", payouts)
+	}
+}
+
+func TestCryptocurrencyDeliveriesFailedPayout(t *testing.T) {
+	node, teardown := newCryptoFulfillmentNode(t, &schema.AutoFulfillPolicy{})
+	defer teardown()
+	node.autoFulfillSpend = func(args *SpendRequest) (*SpendResponse, error) {
+		payouts, err := node.Datastore.CryptoPayouts().GetByOrderID("order1")
+		if err != nil || len(payouts) != 1 || payouts[0].Txid != "" {
+			t.Errorf("expected the payout to be recorded before it is sent, got %v, %v", payouts, err)
+		}
+		return nil, ErrInsufficientFunds
+	}
+
+	listing, contract := newCryptoOrder(t, "TBTC", "800")
+	if _, err := node.cryptocurrencyDeliveries("order1", listing, contract); err != ErrInsufficientFunds {
+		t.Errorf("expected %v, got %v", ErrInsufficientFunds, err)
+	}
+	payouts, err := node.Datastore.CryptoPayouts().GetByOrderID("order1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(payouts) != 0 {
+		t.Errorf("expected the failed payout to be removed, got %v", payouts)
+	}
+}
+
+// confirmedWallet reports every transaction as confirmed
+type confirmedWallet struct {
+	wi.Wallet
+}
+
+func (confirmedWallet) CurrencyCode() string {
+	return "TBTC"
+}
+
+func (confirmedWallet) GetConfirmations(txid chainhash.Hash) (uint32, uint32, error) {
+	return 6, 0, nil
+}
+
+func TestAutoFulfillOrderLimitsExceeded(t *testing.T) {
+	node, teardown := newCryptoFulfillmentNode(t, &schema.AutoFulfillPolicy{OrderLimit: "1000", DailyLimit: "1500"})
+	defer teardown()
+	node.Multiwallet = multiwallet.MultiWallet{wi.TestnetBitcoin: confirmedWallet{}}
+	spends := 0
+	node.autoFulfillSpend = func(args *SpendRequest) (*SpendResponse, error) {
+		spends++
+		return &SpendResponse{Txid: "tx-" + args.Memo}, nil
+	}
+	if err := node.Datastore.CryptoPayouts().Put(repo.CryptoPayout{OrderID: "earlier", Coin: "TBTC", Amount: "800", Txid: "paid", Timestamp: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		orderID  string
+		quantity string
+	}{
+		{"overOrderLimit", "2000"},
+		{"overDailyLimit", "800"},
+	}
+	for _, test := range tests {
+		_, contract := newCryptoOrder(t, "TBTC", test.quantity)
+		if err := node.Datastore.Sales().Put(test.orderID, *contract, pb.OrderState_AWAITING_FULFILLMENT, false); err != nil {
+			t.Fatal(err)
+		}
+		records := []*wi.TransactionRecord{{Txid: strings.Repeat("ab", 32), Value: *big.NewInt(100000)}}
+		if err := node.Datastore.Sales().UpdateFunding(test.orderID, true, records); err != nil {
+			t.Fatal(err)
+		}
+		if confirmed, err := node.paymentConfirmed(node.AutoFulfillPolicies["BTC"], contract, records); err != nil || !confirmed {
+			t.Fatalf("%s: expected the payment to be confirmed, got %t, %v", test.orderID, confirmed, err)
+		}
+
+		if err := node.AutoFulfillOrder(test.orderID); err != nil {
+			t.Fatalf("%s: %s", test.orderID, err)
+		}
+		_, state, _, _, _, _, err := node.Datastore.Sales().GetByOrderId(test.orderID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if state != pb.OrderState_AWAITING_FULFILLMENT {
+			t.Errorf("%s: expected the order to be left for the vendor, got %s", test.orderID, state)
+		}
+		payouts, err := node.Datastore.CryptoPayouts().GetByOrderID(test.orderID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(payouts) != 0 {
+			t.Errorf("%s: expected no payout to be recorded, got %v", test.orderID, payouts)
+		}
+	}
+	if spends != 0 {
+		t.Errorf("expected no coins to be sent, got %d spends", spends)
+	}
+}
@@ -0,0 +1,78 @@
+package core
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	wi "github.com/OpenBazaar/wallet-interface"
+	"github.com/golang/protobuf/proto"
+)
+
+func TestDigitalDeliveriesOfRepeatedVariant(t *testing.T) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := &OpenBazaarNode{Datastore: db.NewSQLiteDatastore(database, new(sync.Mutex), wi.Bitcoin)}
+
+	listing := factory.NewListing("app")
+	listing.Metadata.ContractType = pb.Listing_Metadata_DIGITAL_GOOD
+	if err := node.Datastore.DigitalGoods().AddLicenseKeys("app", 0, []string{"KEY-1", "KEY-2", "KEY-3", "KEY-4"}); err != nil {
+		t.Fatal(err)
+	}
+
+	ser, err := proto.Marshal(listing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listingID, err := ipfs.EncodeCID(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	options := []*pb.Order_Item_Option{{Name: "Size", Value: "Small"}, {Name: "Color", Value: "Red"}}
+	contract := factory.NewContract()
+	contract.VendorListings = []*pb.Listing{listing}
+	contract.BuyerOrder.Items = []*pb.Order_Item{
+		{ListingHash: listingID.String(), BigQuantity: "1", Options: options},
+		{ListingHash: listingID.String(), BigQuantity: "2", Options: options},
+	}
+
+	// Two items of the same variant get different keys, also when the
+	// order is fulfilled again
+	for i := 0; i < 2; i++ {
+		deliveries, err := node.digitalDeliveries("order1", listing, contract)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys := make(map[string]bool)
+		for _, d := range deliveries {
+			keys[d.LicenseKey] = true
+		}
+		if len(deliveries) != 3 || len(keys) != 3 {
+			t.Errorf("expected three different keys, got %v", deliveries)
+		}
+	}
+	pools, err := node.Datastore.DigitalGoods().GetLicenseKeyPools("app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pools) != 1 || pools[0].Assigned != 3 || pools[0].Available != 1 {
+		t.Errorf("expected three keys to be assigned, got %v", pools)
+	}
+}
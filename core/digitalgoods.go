@@ -0,0 +1,219 @@
+package core
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/net"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	crypto "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+)
+
+// AddLicenseKeys adds keys to the pool of a listing variant. The inventory of
+// the variant is set to the number of keys left.
+func (n *OpenBazaarNode) AddLicenseKeys(slug string, variant int, keys []string) (*repo.LicenseKeyPool, error) {
+	if err := n.validateDigitalVariant(slug, variant); err != nil {
+		return nil, err
+	}
+	var trimmed []string
+	for _, key := range keys {
+		if key = strings.TrimSpace(key); key != "" {
+			trimmed = append(trimmed, key)
+		}
+	}
+	if len(trimmed) == 0 {
+		return nil, ErrNoLicenseKeys
+	}
+	if err := n.Datastore.DigitalGoods().AddLicenseKeys(slug, variant, trimmed); err != nil {
+		return nil, err
+	}
+	pools, err := n.syncLicenseKeyInventory(slug)
+	if err != nil {
+		return nil, err
+	}
+	for _, pool := range pools {
+		if pool.Variant == variant {
+			return &pool, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+// PutDigitalFile sets the file sent to every buyer of a listing variant,
+// replacing the previous one
+func (n *OpenBazaarNode) PutDigitalFile(slug string, variant int, filename string, content []byte) (*repo.DigitalFile, error) {
+	if err := n.validateDigitalVariant(slug, variant); err != nil {
+		return nil, err
+	}
+	if filename == "" || len(content) == 0 {
+		return nil, ErrDigitalFileRequired
+	}
+	file := repo.DigitalFile{
+		Slug:      slug,
+		Variant:   variant,
+		Filename:  filename,
+		Size:      len(content),
+		Content:   content,
+		Timestamp: time.Now(),
+	}
+	if err := n.Datastore.DigitalGoods().PutFile(file); err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+// GetDigitalFile downloads a file sent by a vendor and decrypts it with the
+// node's identity key
+func (n *OpenBazaarNode) GetDigitalFile(cid string) ([]byte, error) {
+	ciphertext, err := ipfs.Cat(n.IpfsNode, cid, time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	return net.Decrypt(n.IpfsNode.PrivateKey, ciphertext)
+}
+
+// digitalDeliveries returns a delivery for each license key and file ordered
+// from the listing. Nothing is returned unless every item of the listing can
+// be delivered.
+func (n *OpenBazaarNode) digitalDeliveries(orderID string, listing *pb.Listing, contract *pb.RicardianContract) ([]*pb.OrderFulfillment_DigitalDelivery, error) {
+	type orderedVariant struct {
+		variant  int
+		quantity int
+		file     *repo.DigitalFile
+	}
+	pools, err := n.Datastore.DigitalGoods().GetLicenseKeyPools(listing.Slug)
+	if err != nil {
+		return nil, err
+	}
+	hasPool := make(map[int]bool)
+	for _, pool := range pools {
+		hasPool[pool.Variant] = true
+	}
+
+	// Items of the same variant are delivered together, as keys are
+	// assigned per order and variant
+	var (
+		ordered []orderedVariant
+		index   = make(map[int]int)
+	)
+	for _, item := range contract.BuyerOrder.Items {
+		itemListing, err := ParseContractForListing(item.ListingHash, contract)
+		if err != nil || itemListing.Slug != listing.Slug {
+			continue
+		}
+		variant, err := GetSelectedSku(listing, item.Options)
+		if err != nil {
+			return nil, nil
+		}
+		quantity := GetOrderQuantity(listing, item)
+		if !quantity.IsInt64() || quantity.Sign() <= 0 {
+			return nil, nil
+		}
+		if i, ok := index[variant]; ok {
+			ordered[i].quantity += int(quantity.Int64())
+			continue
+		}
+		ov := orderedVariant{variant: variant, quantity: int(quantity.Int64())}
+		if !hasPool[variant] {
+			ov.file, err = n.Datastore.DigitalGoods().GetFile(listing.Slug, variant)
+			if err == sql.ErrNoRows {
+				return nil, nil
+			} else if err != nil {
+				return nil, err
+			}
+		}
+		index[variant] = len(ordered)
+		ordered = append(ordered, ov)
+	}
+
+	var (
+		deliveries []*pb.OrderFulfillment_DigitalDelivery
+		buyerKey   crypto.PubKey
+	)
+	for _, ov := range ordered {
+		if ov.file == nil {
+			keys, err := n.Datastore.DigitalGoods().AssignLicenseKeys(listing.Slug, ov.variant, orderID, ov.quantity)
+			if err != nil {
+				return nil, err
+			}
+			for _, key := range keys {
+				deliveries = append(deliveries, &pb.OrderFulfillment_DigitalDelivery{LicenseKey: key})
+			}
+			continue
+		}
+		if buyerKey == nil {
+			if buyerKey, err = crypto.UnmarshalPublicKey(contract.BuyerOrder.BuyerID.Pubkeys.Identity); err != nil {
+				return nil, err
+			}
+		}
+		cid, err := n.addEncryptedFile(buyerKey, ov.file.Content)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, &pb.OrderFulfillment_DigitalDelivery{Cid: cid, Filename: ov.file.Filename})
+	}
+	if len(pools) > 0 {
+		if _, err := n.syncLicenseKeyInventory(listing.Slug); err != nil {
+			log.Errorf("failed updating inventory for listing (%s): %s", listing.Slug, err.Error())
+		}
+	}
+	return deliveries, nil
+}
+
+// addEncryptedFile encrypts the content to the key and adds it to IPFS
+func (n *OpenBazaarNode) addEncryptedFile(key crypto.PubKey, content []byte) (string, error) {
+	ciphertext, err := net.Encrypt(key, content)
+	if err != nil {
+		return "", err
+	}
+	f, err := ioutil.TempFile("", "digitalgood")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(ciphertext); err != nil {
+		f.Close()
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", err
+	}
+	return ipfs.AddFile(n.IpfsNode, f.Name())
+}
+
+// syncLicenseKeyInventory sets the inventory of the variants with a license
+// key pool to the number of keys left
+func (n *OpenBazaarNode) syncLicenseKeyInventory(slug string) ([]repo.LicenseKeyPool, error) {
+	pools, err := n.Datastore.DigitalGoods().GetLicenseKeyPools(slug)
+	if err != nil {
+		return nil, err
+	}
+	for _, pool := range pools {
+		if err := n.Datastore.Inventory().Put(slug, pool.Variant, big.NewInt(int64(pool.Available))); err != nil {
+			return nil, err
+		}
+	}
+	return pools, nil
+}
+
+// validateDigitalVariant checks the variant is one of the skus of a digital
+// good listing
+func (n *OpenBazaarNode) validateDigitalVariant(slug string, variant int) error {
+	sl, err := n.GetListingFromSlug(slug)
+	if err != nil {
+		return err
+	}
+	if sl.Listing.Metadata.ContractType != pb.Listing_Metadata_DIGITAL_GOOD {
+		return ErrListingNotDigital
+	}
+	if variant < 0 || (variant > 0 && variant >= len(sl.Listing.Item.Skus)) {
+		return ErrUnknownVariant
+	}
+	return nil
+}
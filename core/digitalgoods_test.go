@@ -0,0 +1,99 @@
+package core_test
+
+import (
+	"io/ioutil"
+	"path"
+	"sync"
+	"testing"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/openbazaar-go/test/factory"
+	wi "github.com/OpenBazaar/wallet-interface"
+)
+
+func TestOpenBazaarNode_AddLicenseKeys(t *testing.T) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := &core.OpenBazaarNode{
+		Datastore: db.NewSQLiteDatastore(database, new(sync.Mutex), wi.Bitcoin),
+		RepoPath:  appSchema.DataPath(),
+	}
+
+	for _, listing := range []*pb.Listing{factory.NewListing("tshirt"), factory.NewListing("app")} {
+		if listing.Slug == "app" {
+			listing.Metadata.ContractType = pb.Listing_Metadata_DIGITAL_GOOD
+		}
+		out, err := new(jsonpb.Marshaler).MarshalToString(&pb.SignedListing{Listing: listing})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path.Join(node.RepoPath, "root", "listings", listing.Slug+".json"), []byte(out), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := node.AddLicenseKeys("tshirt", 0, []string{"KEY-1"}); err != core.ErrListingNotDigital {
+		t.Errorf("expected %v, got %v", core.ErrListingNotDigital, err)
+	}
+	if _, err := node.AddLicenseKeys("app", 9, []string{"KEY-1"}); err != core.ErrUnknownVariant {
+		t.Errorf("expected %v, got %v", core.ErrUnknownVariant, err)
+	}
+	if _, err := node.AddLicenseKeys("app", 0, []string{" "}); err != core.ErrNoLicenseKeys {
+		t.Errorf("expected %v, got %v", core.ErrNoLicenseKeys, err)
+	}
+
+	pool, err := node.AddLicenseKeys("app", 0, []string{"KEY-1", " KEY-2 ", "KEY-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pool.Available != 2 || pool.Assigned != 0 {
+		t.Errorf("expected two available keys, got %v", pool)
+	}
+	inventory, err := node.Datastore.Inventory().GetSpecific("app", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inventory.Int64() != 2 {
+		t.Errorf("expected the inventory to be the number of keys left, got %s", inventory)
+	}
+
+	if _, err := node.Datastore.DigitalGoods().AssignLicenseKeys("app", 0, "order1", 1); err != nil {
+		t.Fatal(err)
+	}
+	sl, err := node.GetListingFromSlug("app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	listing, err := repo.NewListingFromProtobuf(sl.Listing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := node.SetListingInventory(*listing); err != nil {
+		t.Fatal(err)
+	}
+	inventory, err = node.Datastore.Inventory().GetSpecific("app", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inventory.Int64() != 1 {
+		t.Errorf("expected saving the listing to keep the inventory of the pool, got %s", inventory)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
 
 	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/golang/protobuf/ptypes"
 )
 
@@ -20,6 +21,11 @@ var (
 	// ErrPriceCalculationRequiresExchangeRates - exchange rates dependency err
 	ErrPriceCalculationRequiresExchangeRates = errors.New("can't calculate price with exchange rates disabled")
 
+	// ErrPriceQuoteExpired is returned when an order uses a price quote past its expiry
+	ErrPriceQuoteExpired = errors.New("price quote has expired")
+	// ErrPriceQuoteMismatch is returned when the quoted rates price an order differently than the quote
+	ErrPriceQuoteMismatch = errors.New("order does not match the price quote")
+
 	// ErrCryptocurrencyListingCoinTypeRequired - missing coinType err
 	ErrCryptocurrencyListingCoinTypeRequired = errors.New("cryptocurrency listings require a coinType")
 	// ErrCryptocurrencyPurchasePaymentAddressRequired - missing payment address err
@@ -51,8 +57,111 @@ var (
 
 	// ErrUnknownOrder is returned when the requested amount to spend is unable to be associated with the appropriate order
 	ErrOrderNotFound = errors.New("ERROR_ORDER_NOT_FOUND")
+
+	// ErrSpendAddressNotAllowed is returned when the spending policy of the wallet does not allow paying the address
+	ErrSpendAddressNotAllowed = errors.New("ERROR_ADDRESS_NOT_ALLOWED")
+
+	// ErrSpendDailyLimitExceeded is returned when the spend would take the total spent over the last 24 hours past the daily limit of the wallet
+	ErrSpendDailyLimitExceeded = errors.New("ERROR_DAILY_LIMIT_EXCEEDED")
+
+	// ErrInvalidTOTP is returned when a spend is approved with a missing or incorrect authenticator code
+	ErrInvalidTOTP = errors.New("ERROR_INVALID_TOTP")
+
+	// ErrPendingSpendNotFound is returned when there is no pending spend with the requested ID
+	ErrPendingSpendNotFound = errors.New("ERROR_PENDING_SPEND_NOT_FOUND")
+
+	// ErrOutboxMessageNotFound is returned when there is no outbox message with the requested pointer ID
+	ErrOutboxMessageNotFound = errors.New("ERROR_OUTBOX_MESSAGE_NOT_FOUND")
+
+	// ErrRatingNotFound is returned when the rating is not in the ratings index of the node
+	ErrRatingNotFound = errors.New("ERROR_RATING_NOT_FOUND")
+
+	// ErrRatingResponseNotFound is returned when the vendor has not responded to the rating
+	ErrRatingResponseNotFound = errors.New("ERROR_RATING_RESPONSE_NOT_FOUND")
+
+	// ErrSubscriptionNotFound is returned when there is no subscription with the requested ID
+	ErrSubscriptionNotFound = errors.New("ERROR_SUBSCRIPTION_NOT_FOUND")
+
+	// ErrSubscriptionInactive is returned when a subscription has been cancelled or completed
+	ErrSubscriptionInactive = errors.New("ERROR_SUBSCRIPTION_INACTIVE")
+
+	// ErrListingNotSubscription is returned when subscribing to a listing which is not sold as a subscription
+	ErrListingNotSubscription = errors.New("listing is not sold as a subscription")
+
+	// ErrSubscriptionPriceChanged is returned when the listing price is above the price agreed in a subscription
+	ErrSubscriptionPriceChanged = errors.New("listing price is above the subscription price")
+
+	// ErrNotBackordered is returned when releasing an order which was not backordered
+	ErrNotBackordered = errors.New("ERROR_NOT_BACKORDERED")
+
+	// ErrBackorderReleased is returned when releasing a backorder twice
+	ErrBackorderReleased = errors.New("ERROR_BACKORDER_RELEASED")
+
+	// ErrListingNotDigital is returned when adding license keys or files to a listing which is not a digital good
+	ErrListingNotDigital = errors.New("listing is not a digital good")
+
+	// ErrUnknownVariant is returned when a variant index is not one of the listing's skus
+	ErrUnknownVariant = errors.New("listing has no such variant")
+
+	// ErrNoLicenseKeys is returned when adding an empty list of license keys
+	ErrNoLicenseKeys = errors.New("no license keys to add")
+
+	// ErrDigitalFileRequired is returned when a digital file is missing its name or content
+	ErrDigitalFileRequired = errors.New("a filename and file content are required")
+
+	// ErrAutoFulfillOrderLimitExceeded is returned when the coins ordered are above the order limit for automatic fulfillment
+	ErrAutoFulfillOrderLimitExceeded = errors.New("order is above the automatic fulfillment order limit")
+
+	// ErrAutoFulfillDailyLimitExceeded is returned when paying out the coins ordered would take the total paid out over the last 24 hours past the daily limit
+	ErrAutoFulfillDailyLimitExceeded = errors.New("order would exceed the automatic fulfillment daily limit")
+
+	// ErrCrowdfundPledgeNotModerated is returned when a pledge to a crowdfund is not paid into moderated escrow
+	ErrCrowdfundPledgeNotModerated = errors.New("crowdfund pledges must be paid with a moderator")
+
+	// ErrCrowdfundMixedOrder is returned when a pledge to a crowdfund is ordered with other listings
+	ErrCrowdfundMixedOrder = errors.New("crowdfund pledges cannot be ordered with other listings")
+
+	// ErrCrowdfundEnded is returned when pledging to a crowdfund past its deadline
+	ErrCrowdfundEnded = errors.New("crowdfund deadline has passed")
+
+	// ErrCrowdfundNotFunded is returned when fulfilling a pledge to a crowdfund which has not reached its goal
+	ErrCrowdfundNotFunded = errors.New("ERROR_CROWDFUND_NOT_FUNDED")
+
+	// ErrSlotRequired is returned when ordering a listing with bookable slots without selecting one
+	ErrSlotRequired = errors.New("a slot must be selected to book the listing")
+
+	// ErrSlotNotBookable is returned when selecting a slot of a listing which has no bookable slots
+	ErrSlotNotBookable = errors.New("listing has no bookable slots")
+
+	// ErrUnknownSlot is returned when a slot id is not one of the listing's slots
+	ErrUnknownSlot = errors.New("listing has no such slot")
+
+	// ErrSlotPassed is returned when booking a slot which has already started
+	ErrSlotPassed = errors.New("slot has already started")
+
+	// ErrAppointmentMultipleSlots is returned when an order books more than one slot
+	ErrAppointmentMultipleSlots = errors.New("an order can book a single slot")
+
+	// ErrAppointmentNotFound is returned when an order has not reserved a slot
+	ErrAppointmentNotFound = errors.New("ERROR_APPOINTMENT_NOT_FOUND")
+
+	// ErrAppointmentConfirmed is returned when confirming an appointment twice
+	ErrAppointmentConfirmed = errors.New("ERROR_APPOINTMENT_CONFIRMED")
+
+	// ErrAppointmentCancelled is returned when confirming or rescheduling the appointment of a refunded order
+	ErrAppointmentCancelled = errors.New("ERROR_APPOINTMENT_CANCELLED")
 )
 
+// ErrSpendPendingApproval is returned when the spending policy of the wallet
+// holds the spend until it is approved
+type ErrSpendPendingApproval struct {
+	Spend repo.PendingSpend
+}
+
+func (err ErrSpendPendingApproval) Error() string {
+	return "ERROR_SPEND_PENDING_APPROVAL"
+}
+
 // CodedError is an error that is machine readable
 type CodedError struct {
 	Reason string `json:"reason,omitempty"`
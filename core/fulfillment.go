@@ -30,6 +30,9 @@ func (n *OpenBazaarNode) FulfillOrder(fulfillment *pb.OrderFulfillment, contract
 	} else if fulfillment.Slug == "" && len(contract.VendorListings) > 1 {
 		return errors.New("slug must be specified when an order contains multiple items")
 	}
+	if err := n.checkCrowdfundFulfillment(contract); err != nil {
+		return err
+	}
 	rc := new(pb.RicardianContract)
 	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
 		payout := new(pb.OrderFulfillment_Payout)
@@ -178,6 +181,71 @@ func (n *OpenBazaarNode) FulfillOrder(fulfillment *pb.OrderFulfillment, contract
 	return nil
 }
 
+// AutoFulfillOrder fulfills the listings of a funded direct order which the
+// node can deliver itself: digital goods with a file or a license key pool,
+// and cryptocurrency listings of coins with an automatic fulfillment policy
+// once the payment has the confirmations the policy requires. Other listings
+// are left for the vendor to fulfill.
+func (n *OpenBazaarNode) AutoFulfillOrder(orderID string) error {
+	contract, state, _, records, _, _, err := n.Datastore.Sales().GetByOrderId(orderID)
+	if err != nil {
+		return err
+	}
+	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
+		return nil
+	}
+	if state != pb.OrderState_AWAITING_FULFILLMENT && state != pb.OrderState_PARTIALLY_FULFILLED {
+		return nil
+	}
+
+	fulfilled := make(map[string]bool)
+	for _, f := range contract.VendorOrderFulfillment {
+		fulfilled[f.Slug] = true
+	}
+	for _, listing := range contract.VendorListings {
+		if fulfilled[listing.Slug] {
+			continue
+		}
+		fulfillment := &pb.OrderFulfillment{
+			OrderId: orderID,
+			Slug:    listing.Slug,
+		}
+		switch listing.Metadata.ContractType {
+		case pb.Listing_Metadata_DIGITAL_GOOD:
+			fulfillment.DigitalDelivery, err = n.digitalDeliveries(orderID, listing, contract)
+		case pb.Listing_Metadata_CRYPTOCURRENCY:
+			policy := n.autoFulfillPolicy(listing.Metadata.CryptoCurrencyCode)
+			if policy == nil {
+				continue
+			}
+			// Coins are paid out by the scheduler once the payment is confirmed
+			var confirmed bool
+			confirmed, err = n.paymentConfirmed(policy, contract, records)
+			if err != nil {
+				log.Warningf("Checking the payment confirmations of order %s: %s", orderID, err.Error())
+			}
+			if !confirmed {
+				continue
+			}
+			fulfillment.CryptocurrencyDelivery, err = n.cryptocurrencyDeliveries(orderID, listing, contract)
+		default:
+			continue
+		}
+		if err != nil {
+			log.Warningf("Leaving %s in order %s for the vendor to fulfill: %s", listing.Slug, orderID, err.Error())
+			continue
+		}
+		if len(fulfillment.DigitalDelivery)+len(fulfillment.CryptocurrencyDelivery) == 0 {
+			continue
+		}
+		if err := n.FulfillOrder(fulfillment, contract, records); err != nil {
+			return err
+		}
+		log.Infof("Automatically fulfilled %s in order %s", listing.Slug, orderID)
+	}
+	return nil
+}
+
 // SignOrderFulfillment - add signature to order fulfillment
 func (n *OpenBazaarNode) SignOrderFulfillment(contract *pb.RicardianContract) (*pb.RicardianContract, error) {
 	serializedOrderFulfil, err := proto.Marshal(contract.VendorOrderFulfillment[0])
@@ -72,6 +72,12 @@ func (n *OpenBazaarNode) SetListingInventory(l repo.Listing) error {
 		}
 	}
 
+	// Variants sold from a license key pool have as many units as keys left
+	_, err = n.syncLicenseKeyInventory(l.GetSlug())
+	if err != nil {
+		return err
+	}
+
 	err = n.PublishInventory()
 	if err != nil {
 		return err
@@ -136,6 +142,15 @@ func (n *OpenBazaarNode) saveListing(l repo.Listing, publish bool) error {
 		}
 	}
 
+	if l.UsesStoreTaxes() {
+		sd, err := n.Datastore.Settings().Get()
+		if err == nil && sd.StoreTaxes != nil {
+			if err := l.SetTaxes(repo.StoreTaxesToProtobuf(*sd.StoreTaxes)); err != nil {
+				return err
+			}
+		}
+	}
+
 	ct := l.GetContractType()
 	if pb.Listing_Metadata_ContractType_value[ct] == int32(pb.Listing_Metadata_CRYPTOCURRENCY) {
 		if err := l.ValidateCryptoListing(); err != nil {
@@ -269,6 +284,13 @@ func (n *OpenBazaarNode) toListingIndexData(l *repo.Listing) (repo.ListingIndexD
 	if err != nil {
 		return repo.ListingIndexData{}, fmt.Errorf("get price: %s", err.Error())
 	}
+	var crowdfund *repo.CrowdfundProgress
+	if l.GetContractType() == pb.Listing_Metadata_CROWD_FUND.String() {
+		crowdfund, err = n.crowdfundProgress(l, n.listingIndexCrowdfund(l.GetSlug()), time.Now())
+		if err != nil {
+			return repo.ListingIndexData{}, fmt.Errorf("get crowdfund progress: %s", err.Error())
+		}
+	}
 
 	return repo.ListingIndexData{
 		Hash:         listingHash,
@@ -291,6 +313,7 @@ func (n *OpenBazaarNode) toListingIndexData(l *repo.Listing) (repo.ListingIndexD
 		ModeratorIDs:       l.GetModerators(),
 		AcceptedCurrencies: l.GetAcceptedCurrencies(),
 		CryptoCurrencyCode: l.GetCryptoCurrencyCode(),
+		Crowdfund:          crowdfund,
 	}, nil
 }
 
@@ -320,10 +343,14 @@ func (n *OpenBazaarNode) updateListingOnDisk(index []repo.ListingIndexData, ld r
 	// Check to see if the listing we are adding already exists in the list. If so delete it.
 	var avgRating float32
 	var ratingCount uint32
+	var ratingAverages *repo.RatingAverages
+	var ratingHistogram []uint32
 	for i, d := range index {
 		if d.Slug == ld.Slug {
 			avgRating = d.AverageRating
 			ratingCount = d.RatingCount
+			ratingAverages = d.RatingAverages
+			ratingHistogram = d.RatingHistogram
 
 			if len(index) == 1 {
 				index = []repo.ListingIndexData{}
@@ -338,6 +365,16 @@ func (n *OpenBazaarNode) updateListingOnDisk(index []repo.ListingIndexData, ld r
 	if !updateRatings {
 		ld.AverageRating = avgRating
 		ld.RatingCount = ratingCount
+		ld.RatingAverages = ratingAverages
+		ld.RatingHistogram = ratingHistogram
+		// Listings rated before the scores were summarized are filled in
+		// when they are next saved
+		if ratingCount > 0 && ratingAverages == nil {
+			if summary, ok, err := n.listingRatingSummary(ld.Slug); err == nil && ok {
+				ld.RatingAverages = &summary.Averages
+				ld.RatingHistogram = summary.Histograms.Overall
+			}
+		}
 	}
 	index = append(index, ld)
 
@@ -380,6 +417,14 @@ func (n *OpenBazaarNode) updateRatingInListingIndex(rating *pb.Rating) error {
 	totalRating += float32(rating.RatingData.Overall)
 	ld.AverageRating = totalRating / float32(ld.RatingCount+1)
 	ld.RatingCount++
+	summary, ok, err := n.listingRatingSummary(ld.Slug)
+	if err != nil {
+		return err
+	}
+	if ok {
+		ld.RatingAverages = &summary.Averages
+		ld.RatingHistogram = summary.Histograms.Overall
+	}
 	return n.updateListingOnDisk(index, ld, true)
 }
 
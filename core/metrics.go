@@ -0,0 +1,117 @@
+package core
+
+import (
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	prometheus "gx/ipfs/QmTQuFQWHAWy4wMH6ZyPfGiawA5u9T8rs79FENoV8yXaoS/client_golang/prometheus"
+)
+
+// offlineMessagesSent counts the offline messages stored for other peers,
+// labelled with the message type
+var offlineMessagesSent = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "openbazaar",
+		Subsystem: "offline_messages",
+		Name:      "sent_total",
+		Help:      "Number of offline messages sent.",
+	},
+	[]string{"type"},
+)
+
+// publishDuration measures how long publishing the root directory to IPNS
+// takes, labelled with the result
+var publishDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: "openbazaar",
+		Subsystem: "publish",
+		Name:      "duration_seconds",
+		Help:      "Time taken to publish the root directory to IPNS.",
+		Buckets:   prometheus.ExponentialBuckets(0.5, 2, 10),
+	},
+	[]string{"result"},
+)
+
+// publishInflight is the number of IPNS publishes in progress
+var publishInflight = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Namespace: "openbazaar",
+		Subsystem: "publish",
+		Name:      "inflight_requests",
+		Help:      "Number of IPNS publishes in progress.",
+	},
+)
+
+func init() {
+	prometheus.MustRegister(offlineMessagesSent, publishDuration, publishInflight)
+}
+
+var (
+	connectedPeersDesc = prometheus.NewDesc(
+		"openbazaar_peers_connected",
+		"Number of connected peers.",
+		nil, nil,
+	)
+	walletHeightDesc = prometheus.NewDesc(
+		"openbazaar_wallet_chain_height",
+		"Height of the chain tip the wallet has synced to.",
+		[]string{"coin"}, nil,
+	)
+	ordersDesc = prometheus.NewDesc(
+		"openbazaar_orders",
+		"Number of orders in each state, for purchases and sales.",
+		[]string{"role", "state"}, nil,
+	)
+)
+
+// nodeCollector reads metrics which describe the current state of the node
+// when they are scraped
+type nodeCollector struct {
+	node *OpenBazaarNode
+}
+
+// NewMetricsCollector returns a collector for the connected peers, the
+// wallet heights and the order counts of the node
+func NewMetricsCollector(n *OpenBazaarNode) prometheus.Collector {
+	return &nodeCollector{n}
+}
+
+func (c *nodeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- connectedPeersDesc
+	ch <- walletHeightDesc
+	ch <- ordersDesc
+}
+
+func (c *nodeCollector) Collect(ch chan<- prometheus.Metric) {
+	if c.node.IpfsNode != nil {
+		peers := ipfs.ConnectedPeers(c.node.IpfsNode)
+		ch <- prometheus.MustNewConstMetric(connectedPeersDesc, prometheus.GaugeValue, float64(len(peers)))
+	}
+	for _, w := range c.node.Multiwallet {
+		height, _ := w.ChainTip()
+		ch <- prometheus.MustNewConstMetric(walletHeightDesc, prometheus.GaugeValue, float64(height), w.CurrencyCode())
+	}
+	if c.node.Datastore == nil {
+		return
+	}
+	if purchases, err := c.node.Datastore.Purchases().CountByState(); err != nil {
+		log.Errorf("counting purchases: %s", err)
+	} else {
+		collectOrderCounts(ch, "purchase", purchases)
+	}
+	if sales, err := c.node.Datastore.Sales().CountByState(); err != nil {
+		log.Errorf("counting sales: %s", err)
+	} else {
+		collectOrderCounts(ch, "sale", sales)
+	}
+}
+
+func collectOrderCounts(ch chan<- prometheus.Metric, role string, counts map[pb.OrderState]int) {
+	// Every state is reported so that series drop to zero rather than
+	// disappearing when the last order leaves a state
+	for state, name := range pb.OrderState_name {
+		ch <- prometheus.MustNewConstMetric(ordersDesc, prometheus.GaugeValue,
+			float64(counts[pb.OrderState(state)]), role, strings.ToLower(name))
+	}
+}
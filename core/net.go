@@ -7,6 +7,7 @@ import (
 	"time"
 
 	libp2p "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+	ma "gx/ipfs/QmTZBfrPJmjWsCvHEtX5FE6KimVJhsJg5sBbqEFYf4UZtL/go-multiaddr"
 	"gx/ipfs/QmTbxNB1NwDesLmKTscr4udL2tVP7MaxvXnD1D9yX7g3PN/go-cid"
 	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
 	"gx/ipfs/QmerPMzPk1mJVowm8KgmoknWa4yCYvvugMPsgWmDNUvDLW/go-multihash"
@@ -14,6 +15,7 @@ import (
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/openbazaar-go/repo"
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/any"
@@ -75,29 +77,44 @@ func (n *OpenBazaarNode) SendOfflineMessage(p peer.ID, k *libp2p.PubKey, m *pb.M
 	if cerr != nil {
 		return cerr
 	}
-	addr, aerr := n.MessageStorage.Store(p, ciphertext)
+	addrs, aerr := n.storeOfflineMessage(p, ciphertext)
 	if aerr != nil {
 		return aerr
 	}
+	offlineMessagesSent.WithLabelValues(m.MessageType.String()).Inc()
 	mh, mherr := multihash.FromB58String(p.Pretty())
 	if mherr != nil {
 		return mherr
 	}
-	/* TODO: We are just using a default prefix length for now. Eventually we will want to customize this,
-	   but we will need some way to get the recipient's desired prefix length. Likely will be in profile. */
-	pointer, err := ipfs.NewPointer(mh, DefaultPointerPrefixLength, addr, ciphertext)
-	if err != nil {
-		return err
-	}
-	if m.MessageType != pb.Message_OFFLINE_ACK {
-		pointer.Purpose = ipfs.MESSAGE
-		pointer.CancelID = &p
-		err = n.Datastore.Pointers().Put(pointer)
+	var pointers []ipfs.Pointer
+	for i, addr := range addrs {
+		// Each location gets its own pointer ID. The first keeps the ID
+		// derived from the ciphertext alone as with a single location.
+		entropy := ciphertext
+		if i > 0 {
+			entropy = append(append([]byte{}, ciphertext...), addr.Bytes()...)
+		}
+		/* TODO: We are just using a default prefix length for now. Eventually we will want to customize this,
+		   but we will need some way to get the recipient's desired prefix length. Likely will be in profile. */
+		pointer, err := ipfs.NewPointer(mh, DefaultPointerPrefixLength, addr, entropy)
 		if err != nil {
 			return err
 		}
+		if m.MessageType != pb.Message_OFFLINE_ACK {
+			pointer.Purpose = ipfs.MESSAGE
+			pointer.CancelID = &p
+			err = n.Datastore.Pointers().Put(pointer)
+			if err != nil {
+				return err
+			}
+		}
+		// Acks are tracked too so their stored copies expire with the rest
+		if err := n.trackOutboxMessage(p, pointer, m, ciphertext); err != nil {
+			log.Errorf("tracking outbox message: %s", err)
+		}
+		log.Debugf("Sending offline message to: %s, Message Type: %s, PointerID: %s, Location: %s", p.Pretty(), m.MessageType.String(), pointer.Cid.String(), pointer.Value.Addrs[0].String())
+		pointers = append(pointers, pointer)
 	}
-	log.Debugf("Sending offline message to: %s, Message Type: %s, PointerID: %s, Location: %s", p.Pretty(), m.MessageType.String(), pointer.Cid.String(), pointer.Value.Addrs[0].String())
 
 	// We publish our pointers to three different locations:
 	// 1. The pushnodes
@@ -106,38 +123,66 @@ func (n *OpenBazaarNode) SendOfflineMessage(p peer.ID, k *libp2p.PubKey, m *pb.M
 	// Each one is done in a separate goroutine so as to not block but we
 	// do increment the OfflineMessageWaitGroup which is used to block
 	// shutdown until all publishing is finished.
-	OfflineMessageWaitGroup.Add(2 + len(n.PushNodes))
-	for _, p := range n.PushNodes {
-		go func(pid peer.ID) {
+	OfflineMessageWaitGroup.Add(len(pointers)*(1+len(n.PushNodes)) + 1)
+	for _, pointer := range pointers {
+		for _, p := range n.PushNodes {
+			go func(pid peer.ID, pointer ipfs.Pointer) {
+				ctx, cancel := context.WithCancel(context.Background())
+				defer cancel()
+				err := ipfs.PutPointerToPeer(n.DHT, ctx, pid, pointer)
+				if err != nil {
+					log.Error(err)
+				}
+				OfflineMessageWaitGroup.Done()
+			}(p, pointer)
+		}
+		go func(pointer ipfs.Pointer) {
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
-			err := ipfs.PutPointerToPeer(n.DHT, ctx, pid, pointer)
+			err := ipfs.PublishPointer(n.DHT, ctx, pointer)
 			if err != nil {
 				log.Error(err)
 			}
+
 			OfflineMessageWaitGroup.Done()
-		}(p)
+		}(pointer)
 	}
+	// The ciphertext itself is published once whatever the number of locations
 	go func() {
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
-		err := ipfs.PublishPointer(n.DHT, ctx, pointer)
+		err := n.Pubsub.Publisher.Publish(ctx, pointers[0].Cid.String(), ciphertext)
 		if err != nil {
 			log.Error(err)
 		}
-
 		OfflineMessageWaitGroup.Done()
 	}()
-	go func() {
-		ctx, cancel := context.WithCancel(context.Background())
-		defer cancel()
-		err := n.Pubsub.Publisher.Publish(ctx, pointer.Cid.String(), ciphertext)
+	return nil
+}
+
+// storeOfflineMessage stores the ciphertext and returns its locations. When
+// the storage has several locations the message is sent as long as one of
+// them succeeded, and the failures are logged.
+func (n *OpenBazaarNode) storeOfflineMessage(p peer.ID, ciphertext []byte) ([]ma.Multiaddr, error) {
+	multi, ok := n.MessageStorage.(sto.MultiLocationStorage)
+	if !ok {
+		addr, err := n.MessageStorage.Store(p, ciphertext)
 		if err != nil {
-			log.Error(err)
+			return nil, err
 		}
-		OfflineMessageWaitGroup.Done()
-	}()
-	return nil
+		return []ma.Multiaddr{addr}, nil
+	}
+	addrs, err := multi.StoreAll(p, ciphertext)
+	if len(addrs) == 0 {
+		if err == nil {
+			err = errors.New("offline message not stored in any location")
+		}
+		return nil, err
+	}
+	if err != nil {
+		log.Warningf("offline message to %s stored in %d locations: %s", p.Pretty(), len(addrs), err)
+	}
+	return addrs, nil
 }
 
 // SendOfflineAck - send ack to offline peer
@@ -341,6 +386,98 @@ func (n *OpenBazaarNode) SendOrder(peerID string, contract *pb.RicardianContract
 	return resp, nil
 }
 
+// SendPriceQuoteRequest asks the vendor to quote the amount of the order and
+// returns the quote
+func (n *OpenBazaarNode) SendPriceQuoteRequest(peerID string, contract *pb.RicardianContract) (*pb.SignedPriceQuote, error) {
+	p, err := peer.IDB58Decode(peerID)
+	if err != nil {
+		return nil, err
+	}
+	pbAny, err := ptypes.MarshalAny(contract)
+	if err != nil {
+		return nil, err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_PRICE_QUOTE_REQUEST,
+		Payload:     pbAny,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.OfflineMessageFailoverTimeout)
+	defer cancel()
+	resp, err := n.Service.SendRequest(ctx, p, &m)
+	if err != nil {
+		return nil, fmt.Errorf("vendor is unreachable: %s", err.Error())
+	}
+	if resp.Payload == nil {
+		return nil, errors.New("vendor responded with nil payload")
+	}
+	if resp.MessageType == pb.Message_ERROR {
+		rejectMsg := new(pb.Error)
+		if err := ptypes.UnmarshalAny(resp.Payload, rejectMsg); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("vendor declined to quote: %s", rejectMsg.ErrorMessage)
+	}
+	if resp.MessageType != pb.Message_PRICE_QUOTE {
+		return nil, fmt.Errorf("unexpected response type %s", resp.MessageType)
+	}
+	quote := new(pb.SignedPriceQuote)
+	if err := ptypes.UnmarshalAny(resp.Payload, quote); err != nil {
+		return nil, err
+	}
+	return quote, nil
+}
+
+// SendSubscription sends a signed subscription agreement to the vendor. An
+// online vendor checks the agreement before replying, otherwise it is sent
+// as an offline message which is processed before the first order.
+func (n *OpenBazaarNode) SendSubscription(peerID string, agreement *pb.SignedSubscriptionAgreement) error {
+	p, err := peer.IDB58Decode(peerID)
+	if err != nil {
+		return err
+	}
+	pbAny, err := ptypes.MarshalAny(agreement)
+	if err != nil {
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_SUBSCRIPTION,
+		Payload:     pbAny,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.OfflineMessageFailoverTimeout)
+	defer cancel()
+	resp, err := n.Service.SendRequest(ctx, p, &m)
+	if err != nil {
+		return n.SendOfflineMessage(p, nil, &m)
+	}
+	if resp.MessageType == pb.Message_ERROR && resp.Payload != nil {
+		rejectMsg := new(pb.Error)
+		if err := ptypes.UnmarshalAny(resp.Payload, rejectMsg); err != nil {
+			return err
+		}
+		return fmt.Errorf("vendor declined the subscription: %s", rejectMsg.ErrorMessage)
+	}
+	return nil
+}
+
+// SendSubscriptionCancel tells the other party of a subscription that it
+// has been cancelled
+func (n *OpenBazaarNode) SendSubscriptionCancel(peerID, subscriptionID string) error {
+	pbAny, err := ptypes.MarshalAny(&pb.SubscriptionCancel{
+		SubscriptionID: subscriptionID,
+		Timestamp:      ptypes.TimestampNow(),
+	})
+	if err != nil {
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_SUBSCRIPTION_CANCEL,
+		Payload:     pbAny,
+	}
+	return n.sendMessage(peerID, nil, m)
+}
+
 // SendError - send error msg to peer
 func (n *OpenBazaarNode) SendError(peerID string, k *libp2p.PubKey, errorMessage pb.Message) error {
 	return n.sendMessage(peerID, k, errorMessage)
@@ -506,6 +643,54 @@ func (n *OpenBazaarNode) SendOrderFulfillment(peerID string, k *libp2p.PubKey, f
 	return n.sendMessage(peerID, k, m)
 }
 
+// SendBackorderRelease - send backorder release msg to peer
+func (n *OpenBazaarNode) SendBackorderRelease(peerID string, k *libp2p.PubKey, releaseMessage *pb.RicardianContract) error {
+	a, err := ptypes.MarshalAny(releaseMessage)
+	if err != nil {
+		log.Errorf("failed to marshal the contract: %v", err)
+		return err
+	}
+	m := pb.Message{
+		MessageType: pb.Message_BACKORDER_RELEASE,
+		Payload:     a,
+	}
+	orderID := releaseMessage.VendorBackorderRelease.OrderID
+	err = n.Datastore.Messages().Put(
+		fmt.Sprintf("%s-%d", orderID, int(pb.Message_BACKORDER_RELEASE)),
+		orderID, pb.Message_BACKORDER_RELEASE, peerID, repo.Message{Msg: m},
+		"", 0, []byte{})
+	if err != nil {
+		log.Errorf("failed putting message (%s-%d): %v", orderID, int(pb.Message_BACKORDER_RELEASE), err)
+	}
+	return n.sendMessage(peerID, k, m)
+}
+
+// SendAppointment - send appointment confirmation or reschedule msg to peer
+func (n *OpenBazaarNode) SendAppointment(peerID string, k *libp2p.PubKey, appointmentMessage *pb.RicardianContract) error {
+	a, err := ptypes.MarshalAny(appointmentMessage)
+	if err != nil {
+		log.Errorf("failed to marshal the contract: %v", err)
+		return err
+	}
+	msgType := pb.Message_APPOINTMENT_CONFIRMATION
+	if appointmentMessage.VendorAppointment.Rescheduled {
+		msgType = pb.Message_APPOINTMENT_RESCHEDULE
+	}
+	m := pb.Message{
+		MessageType: msgType,
+		Payload:     a,
+	}
+	orderID := appointmentMessage.VendorAppointment.OrderID
+	err = n.Datastore.Messages().Put(
+		fmt.Sprintf("%s-%d", orderID, int(msgType)),
+		orderID, msgType, peerID, repo.Message{Msg: m},
+		"", 0, []byte{})
+	if err != nil {
+		log.Errorf("failed putting message (%s-%d): %v", orderID, int(msgType), err)
+	}
+	return n.sendMessage(peerID, k, m)
+}
+
 // SendOrderCompletion - send order completion msg to peer
 func (n *OpenBazaarNode) SendOrderCompletion(peerID string, k *libp2p.PubKey, completionMessage *pb.RicardianContract) error {
 	a, err := ptypes.MarshalAny(completionMessage)
@@ -107,6 +107,7 @@ func (n *OpenBazaarNode) GetOrder(orderID string) (*pb.OrderRespApi, error) {
 		return nil, err
 	}
 	resp.UnreadChatMessages = uint64(unread)
+	resp.ExchangeRate = n.orderExchangeRate(resp.Contract)
 
 	if isSale {
 		err = n.Datastore.Sales().MarkAsRead(orderID)
@@ -174,6 +175,7 @@ func (n *OpenBazaarNode) Purchase(data *repo.PurchaseData) (orderID string, paym
 			Code:         defn.Code.String(),
 			Divisibility: uint32(defn.Divisibility),
 		}
+		payment.PriceQuote = data.PriceQuote.GetProtobuf()
 	} else {
 		payment.Coin = defn.Code.String()
 	}
@@ -251,6 +253,7 @@ func prepareModeratedOrderContract(data *repo.PurchaseData, n *OpenBazaarNode, c
 			Code:         defn.Code.String(),
 			Divisibility: uint32(defn.Divisibility),
 		}
+		payment.PriceQuote = data.PriceQuote.GetProtobuf()
 	} else {
 		payment.Coin = defn.Code.String()
 	}
@@ -595,6 +598,7 @@ func (n *OpenBazaarNode) createContractWithOrder(data *repo.PurchaseData) (*pb.R
 	order.Version = 2
 	order.Shipping = shipping
 	order.AlternateContactInfo = data.AlternateContactInfo
+	order.SubscriptionID = data.SubscriptionID
 
 	if data.RefundAddress != nil {
 		order.RefundAddress = *(data.RefundAddress)
@@ -646,6 +650,9 @@ func (n *OpenBazaarNode) createContractWithOrder(data *repo.PurchaseData) (*pb.R
 		if err != nil || !n.currencyInAcceptedCurrenciesList(data.PaymentCoin, listing.GetAcceptedCurrencies()) {
 			return nil, errors.New("listing does not accept the selected currency")
 		}
+		if listing.GetContractType() == pb.Listing_Metadata_CROWD_FUND.String() && data.Moderator == "" {
+			return nil, ErrCrowdfundPledgeNotModerated
+		}
 
 		ser, err := listing.MarshalProtobuf()
 		if err != nil {
@@ -677,6 +684,7 @@ func (n *OpenBazaarNode) createContractWithOrder(data *repo.PurchaseData) (*pb.R
 		}
 
 		i.Memo = item.Memo
+		i.SlotID = item.SlotID
 
 		if listing.GetContractType() != pb.Listing_Metadata_CRYPTOCURRENCY.String() {
 			// Remove any duplicate coupons
@@ -717,6 +725,10 @@ func (n *OpenBazaarNode) createContractWithOrder(data *repo.PurchaseData) (*pb.R
 		}
 	}
 
+	if err := setItemTaxes(contract); err != nil {
+		return nil, err
+	}
+
 	return contract, nil
 }
 
@@ -957,66 +969,70 @@ func (n *OpenBazaarNode) CheckoutBreakdown(data *repo.PurchaseData) (repo.Checko
 	}
 
 	// Shipping Costs
-	isPhysicalGood := false
 	var physicalGoods = make(map[string]*repo.Listing)
-
-	if nrl.GetContractType() == pb.Listing_Metadata_PHYSICAL_GOOD.String() {
-		isPhysicalGood = true
-		physicalGoods[firstItem.ListingHash] = nrl
-	}
-
-	shippingTotal := new(big.Int).SetInt64(0)
-	if isPhysicalGood {
-		shippingTotal, err = getPretaxShippingCost(v5Order, nrl)
+	for _, item := range v5Order.Items {
+		l, err := GetNormalizedListing(item.ListingHash, contract)
 		if err != nil {
 			return emptyCheckoutBreakdown, err
 		}
+		if l.GetContractType() == pb.Listing_Metadata_PHYSICAL_GOOD.String() {
+			physicalGoods[item.ListingHash] = l
+		}
 	}
-	// Convert to final currency
-	originalPrice, err := nrl.GetPrice()
+	charges, err := n.calculateShippingCharges(contract, physicalGoods, cc)
 	if err != nil {
 		return emptyCheckoutBreakdown, err
 	}
-	originalPrice.Amount = shippingTotal
-
-	finalShippingTotal, _, err := originalPrice.ConvertUsingProtobufDef(v5Order.Payment.AmountCurrency, cc)
-	if err != nil {
-		return emptyCheckoutBreakdown, err
+	finalShippingTotal := new(big.Int)
+	shippingTaxes := new(big.Int)
+	for _, c := range charges {
+		price := c.pretax
+		if c.taxInclusive {
+			price = c.total
+		}
+		finalShippingTotal.Add(finalShippingTotal, price)
+		shippingTaxes.Add(shippingTaxes, new(big.Int).Sub(c.total, c.pretax))
+		checkoutBreakdown.Shipping = append(checkoutBreakdown.Shipping, repo.CheckoutShipping{
+			Option:   c.option,
+			Service:  c.service,
+			Rate:     c.rate,
+			Quantity: c.quantity,
+			Grams:    c.grams,
+			Price:    price.String(),
+		})
 	}
 
-	// Taxes
-	taxesTotal := new(big.Int).SetInt64(0)
-	for _, tax := range nrl.GetProtobuf().Taxes {
-		for _, taxRegion := range tax.TaxRegions {
-			if contract.BuyerOrder.Shipping.Country == taxRegion {
-				factor := toHundredths(tax.Percentage)
-
-				var taxes *big.Int
-				amountToTax := new(big.Int).SetInt64(0)
-
-				totalBasePrice := new(big.Int).Mul(itemOriginAmt.Amount, totalQuantity)
-				amountToTax.Add(totalBasePrice, totalSurcharge)
-				amountToTax.Sub(amountToTax, couponDiscount)
-
-				if tax.TaxShipping {
-					amountToTax.Add(amountToTax, shippingTotal)
-				}
-
-				taxes, _ = new(big.Float).Mul(new(big.Float).SetInt(amountToTax), factor).Int(nil)
-				taxesTotal = new(big.Int).Add(taxesTotal, taxes)
-
-				break
-			}
+	// Taxes, as recorded on each line in the listing currency
+	finalTaxesTotal := new(big.Int)
+	for _, item := range v5Order.Items {
+		l, err := GetNormalizedListing(item.ListingHash, contract)
+		if err != nil {
+			return emptyCheckoutBreakdown, err
+		}
+		taxes, err := GetItemTax(l, item, v5Order.Shipping)
+		if err != nil {
+			return emptyCheckoutBreakdown, err
+		}
+		price, err := l.GetPrice()
+		if err != nil {
+			return emptyCheckoutBreakdown, err
+		}
+		price.Amount = taxes
+		finalTaxes, _, err := price.ConvertUsingProtobufDef(v5Order.Payment.AmountCurrency, cc)
+		if err != nil {
+			return emptyCheckoutBreakdown, err
+		}
+		finalTaxesTotal.Add(finalTaxesTotal, finalTaxes.Amount)
+		if l.IsTaxInclusive() {
+			checkoutBreakdown.TaxInclusive = true
 		}
 	}
-	taxesCurrencyValue := repo.NewCurrencyValueFromBigInt(taxesTotal, listingCurDef)
-	finalTaxesTotal, _, err := taxesCurrencyValue.ConvertUsingProtobufDef(v5Order.Payment.AmountCurrency, cc)
-	if err != nil {
-		return emptyCheckoutBreakdown, err
-	}
 
-	checkoutBreakdown.Tax = finalTaxesTotal.Amount.String()
-	checkoutBreakdown.ShippingPrice = finalShippingTotal.Amount.String()
+	// Shipping taxes are already in the final currency
+	finalTaxesTotal.Add(finalTaxesTotal, shippingTaxes)
+
+	checkoutBreakdown.Tax = finalTaxesTotal.String()
+	checkoutBreakdown.ShippingPrice = finalShippingTotal.String()
 	checkoutBreakdown.Coupon = finalCouponDiscount.Amount.String()
 	checkoutBreakdown.OptionSurcharge = finalOptionSurcharge.Amount.String()
 	checkoutBreakdown.BasePrice = finalBasePrice.Amount.String()
@@ -1026,60 +1042,6 @@ func (n *OpenBazaarNode) CheckoutBreakdown(data *repo.PurchaseData) (repo.Checko
 	return checkoutBreakdown, nil
 }
 
-func getPretaxShippingCost(v5Order *pb.Order, nrl *repo.Listing) (*big.Int, error) {
-	pretaxShippingCost := new(big.Int).SetInt64(0)
-
-	for _, item := range v5Order.Items {
-		//shippingOption := item.ShippingOption
-
-		itemQuantity, ok := new(big.Int).SetString(item.BigQuantity, 10)
-		if !ok {
-			return new(big.Int).SetInt64(0), errors.New("bad bigQuantity")
-		}
-		listingShippingOptions, err := nrl.GetShippingOptions()
-		if err != nil {
-			return new(big.Int).SetInt64(0), err
-		}
-
-		for _, listingShippingOption := range listingShippingOptions {
-			if inShippingRegions(v5Order.Shipping.Country, listingShippingOption.Regions) {
-				for _, listingService := range listingShippingOption.Services {
-					if item.ShippingOption.Service == listingService.Name {
-						servicePrice, _ := new(big.Int).SetString(listingService.BigPrice, 10)
-						if err != nil {
-							return new(big.Int).SetInt64(0), err
-						}
-						additionalItemPrice, _ := new(big.Int).SetString(listingService.BigAdditionalItemPrice, 10)
-						if err != nil {
-							return new(big.Int).SetInt64(0), err
-						}
-
-						pretaxShippingCost.Add(pretaxShippingCost, servicePrice)
-
-						if itemQuantity.Cmp(new(big.Int).SetInt64(1)) == 1 {
-							// Add additional item price for each quantity over 1
-							additionalCost := new(big.Int).Mul(additionalItemPrice, itemQuantity)
-							pretaxShippingCost.Add(pretaxShippingCost, additionalCost)
-						}
-
-					}
-				}
-			}
-		}
-	}
-
-	return pretaxShippingCost, nil
-}
-
-func inShippingRegions(needle pb.CountryCode, regions []pb.CountryCode) bool {
-	for _, region := range regions {
-		if needle == region || region == pb.CountryCode_ALL {
-			return true
-		}
-	}
-	return false
-}
-
 // CancelOfflineOrder - cancel order
 func (n *OpenBazaarNode) CancelOfflineOrder(contract *pb.RicardianContract, records []*wallet.TransactionRecord) error {
 	v5Order, err := repo.ToV5Order(contract.BuyerOrder, nil)
@@ -1169,7 +1131,25 @@ func (n *OpenBazaarNode) CalcOrderID(order *pb.Order) (string, error) {
 	return id.B58String(), nil
 }
 
+// CalculateOrderTotal returns the amount to pay for the order in the payment
+// currency. Orders with a price quote are priced at the quoted rates and must
+// total the quoted amount.
 func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (*big.Int, error) {
+	cc, err := n.orderCurrencyConverter(contract)
+	if err != nil {
+		return big.NewInt(0), err
+	}
+	total, err := n.calculateOrderTotal(contract, cc)
+	if err != nil {
+		return big.NewInt(0), err
+	}
+	if quote := contract.BuyerOrder.GetPayment().GetPriceQuote(); quote != nil && total.String() != quote.Quote.BigAmount {
+		return big.NewInt(0), ErrPriceQuoteMismatch
+	}
+	return total, nil
+}
+
+func (n *OpenBazaarNode) calculateOrderTotal(contract *pb.RicardianContract, cc *repo.CurrencyConverter) (*big.Int, error) {
 	var (
 		total         = big.NewInt(0)
 		physicalGoods = make(map[string]*repo.Listing)
@@ -1201,52 +1181,19 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (*b
 			physicalGoods[item.ListingHash] = nrl
 		}
 
-		// calculate base amount
-		itemOriginAmt, err = GetOriginalAmount(nrl, item)
-		if err != nil {
-			return big.NewInt(0), err
-		}
-
-		// apply surcharges
-		itemSurcharge, err := GetItemSurchargeAmount(nrl, item.Options)
+		// calculate base amount with surcharges and coupon discounts
+		itemOriginAmt, err = getItemUnitAmount(nrl, item)
 		if err != nil {
 			return big.NewInt(0), err
 		}
-		itemOriginAmt = itemOriginAmt.AddBigInt(itemSurcharge)
-
-		// apply coupon discounts
-		totalDiscount, err := GetTotalCouponCodeDiscount(nrl, item.CouponCodes, itemOriginAmt)
-		if err != nil {
-			return big.NewInt(0), err
-		}
-		itemOriginAmt = itemOriginAmt.AddBigInt(totalDiscount)
 
 		// apply taxes
-		for _, tax := range nrl.GetProtobuf().Taxes {
-			for _, taxRegion := range tax.TaxRegions {
-				if contract.BuyerOrder.Shipping.Country == taxRegion {
-					itemOriginAmt = itemOriginAmt.AddBigFloatProduct(toHundredths(tax.Percentage))
-					break
-				}
-			}
-		}
+		itemOriginAmt, _ = applyListingTaxes(nrl, itemOriginAmt, v5Order.Shipping)
 
 		// apply requested quantity
-		if !(nrl.GetContractType() == pb.Listing_Metadata_CRYPTOCURRENCY.String() &&
-			nrl.GetFormat() == pb.Listing_Metadata_MARKET_PRICE.String()) {
-			if itemQuantity := GetOrderQuantity(nrl.GetProtobuf(), item); itemQuantity.Cmp(big.NewInt(0)) > 0 {
-				itemOriginAmt = itemOriginAmt.MulBigInt(itemQuantity)
-			} else {
-				log.Debugf("missing quantity for order, assuming quantity 1")
-			}
-		}
+		itemOriginAmt = itemOriginAmt.MulBigInt(getItemLineQuantity(nrl, item))
 
 		// convert subtotal to final currency
-		cc, err := n.ReserveCurrencyConverter()
-		if err != nil {
-			return big.NewInt(0), fmt.Errorf("preparing reserve currency converter: %s", err.Error())
-		}
-
 		finalItemAmount, _, err := itemOriginAmt.ConvertUsingProtobufDef(v5Order.Payment.AmountCurrency, cc)
 		if err != nil {
 			return big.NewInt(0), err
@@ -1256,7 +1203,7 @@ func (n *OpenBazaarNode) CalculateOrderTotal(contract *pb.RicardianContract) (*b
 		total.Add(total, finalItemAmount.AmountBigInt())
 	}
 
-	shippingTotal, err := n.calculateShippingTotalForListings(contract, physicalGoods)
+	shippingTotal, err := n.calculateShippingTotalForListings(contract, physicalGoods, cc)
 	if err != nil {
 		return big.NewInt(0), err
 	}
@@ -1357,173 +1304,6 @@ func GetOriginalAmount(nrl *repo.Listing, item *pb.Order_Item) (*repo.CurrencyVa
 	return itemOriginAmt, nil
 }
 
-func (n *OpenBazaarNode) calculateShippingTotalForListings(contract *pb.RicardianContract, listings map[string]*repo.Listing) (*big.Int, error) {
-	type itemShipping struct {
-		primary               *big.Int
-		secondary             *big.Int
-		quantity              uint64
-		shippingTaxPercentage float32
-		version               uint32
-	}
-	var (
-		v5Order, err  = repo.ToV5Order(contract.BuyerOrder, n.LookupCurrency)
-		is            []itemShipping
-		shippingTotal *big.Int
-	)
-	if err != nil {
-		return big.NewInt(0), fmt.Errorf("normalizing buyer order: %s", err.Error())
-	}
-
-	// First loop through to validate and filter out non-physical items
-	for _, item := range v5Order.Items {
-		rl, ok := listings[item.ListingHash]
-		if !ok {
-			continue
-		}
-
-		// Check if physical good
-		if rl.GetContractType() != pb.Listing_Metadata_PHYSICAL_GOOD.String() {
-			continue
-		}
-
-		// Check selected option exists
-		option, err := getShippingOption(rl, item.ShippingOption.Name)
-		if err != nil {
-			return big.NewInt(0), err
-		}
-
-		if option.Type == pb.Listing_ShippingOption_LOCAL_PICKUP {
-			continue
-		}
-
-		// Check that this option ships to us
-		regions := make(map[pb.CountryCode]bool)
-		for _, country := range option.Regions {
-			regions[country] = true
-		}
-		_, shipsToMe := regions[v5Order.Shipping.Country]
-		_, shipsToAll := regions[pb.CountryCode_ALL]
-		if !shipsToMe && !shipsToAll {
-			return big.NewInt(0), errors.New("listing does ship to selected country")
-		}
-
-		cc, err := n.ReserveCurrencyConverter()
-		if err != nil {
-			return big.NewInt(0), fmt.Errorf("preparing reserve currency converter: %s", err.Error())
-		}
-
-		// Check service exists
-		services := make(map[string]*pb.Listing_ShippingOption_Service)
-		for _, shippingService := range option.Services {
-			services[strings.ToLower(shippingService.Name)] = shippingService
-		}
-		service, ok := services[strings.ToLower(item.ShippingOption.Service)]
-		if !ok {
-			return big.NewInt(0), errors.New("shipping service not found in listing")
-		}
-		servicePrice, err := repo.NewCurrencyValueFromProtobuf(service.BigPrice, rl.GetProtobuf().Item.PriceCurrency)
-		if err != nil {
-			return big.NewInt(0), fmt.Errorf("parsing service price (%v): %s", service.Name, err.Error())
-		}
-		convertedShippingPrice, _, err := servicePrice.ConvertUsingProtobufDef(v5Order.Payment.AmountCurrency, cc)
-		if err != nil {
-			return big.NewInt(0), fmt.Errorf("converting service price (%s): %s", service.Name, err.Error())
-		}
-
-		auxServicePrice, err := repo.NewCurrencyValueFromProtobuf(service.BigAdditionalItemPrice, rl.GetProtobuf().Item.PriceCurrency)
-		if err != nil {
-			return big.NewInt(0), fmt.Errorf("parsing aux service price (%v): %s", service.Name, err.Error())
-		}
-		var convertedAuxPrice = repo.NewCurrencyValueFromBigInt(big.NewInt(0), convertedShippingPrice.Currency)
-		if auxServicePrice.IsPositive() {
-			finalAux, _, err := auxServicePrice.ConvertUsingProtobufDef(v5Order.Payment.AmountCurrency, cc)
-			if err != nil {
-				return big.NewInt(0), fmt.Errorf("converting aux service price (%s): %s", service.Name, err.Error())
-			}
-			convertedAuxPrice = finalAux
-		}
-
-		// Calculate tax percentage
-		var shippingTaxPercentage float32
-		for _, tax := range rl.GetProtobuf().Taxes {
-			regions := make(map[pb.CountryCode]bool)
-			for _, taxRegion := range tax.TaxRegions {
-				regions[taxRegion] = true
-			}
-			_, ok := regions[v5Order.Shipping.Country]
-			if ok && tax.TaxShipping {
-				shippingTaxPercentage = tax.Percentage / 100
-			}
-		}
-
-		var qty uint64
-		if q := quantityForItem(rl.GetVersion(), item); q.IsUint64() {
-			qty = q.Uint64()
-		} else {
-			orderID, _ := n.CalcOrderID(contract.BuyerOrder)
-			log.Warningf("unable to detect quantity in contract (%s)", orderID)
-		}
-		is = append(is, itemShipping{
-			primary:               convertedShippingPrice.AmountBigInt(),
-			secondary:             convertedAuxPrice.AmountBigInt(),
-			quantity:              qty,
-			shippingTaxPercentage: shippingTaxPercentage,
-			version:               rl.GetVersion(),
-		})
-	}
-
-	if len(is) == 0 {
-		return big.NewInt(0), nil
-	}
-
-	if len(is) == 1 {
-		s := int64(((1 + is[0].shippingTaxPercentage) * 100) + .5)
-		shippingTotalPrimary := new(big.Int).Mul(is[0].primary, big.NewInt(s))
-		stp, _ := new(big.Float).Mul(big.NewFloat(0.01), new(big.Float).SetInt(shippingTotalPrimary)).Int(nil)
-		shippingTotal = stp
-		if is[0].quantity > 1 {
-			if is[0].version == 1 {
-				t1 := new(big.Int).Mul(stp, big.NewInt(int64(is[0].quantity-1)))
-				shippingTotal = new(big.Int).Add(stp, t1)
-			} else if is[0].version >= 2 {
-				shippingTotalSecondary := new(big.Int).Mul(is[0].secondary, big.NewInt(s))
-				sts, _ := new(big.Float).Mul(big.NewFloat(0.01), new(big.Float).SetInt(shippingTotalSecondary)).Int(nil)
-
-				t1 := new(big.Int).Mul(sts, big.NewInt(int64(is[0].quantity-1)))
-				shippingTotal = new(big.Int).Add(stp, t1)
-
-			} else {
-				return big.NewInt(0), errors.New("unknown listing version")
-			}
-		}
-		return shippingTotal, nil
-	}
-
-	var highest *big.Int
-	var i int
-	for x, s := range is {
-		if s.primary.Cmp(highest) > 0 {
-			highest = new(big.Int).Set(s.primary)
-			i = x
-		}
-		s0 := int64(((1 + s.shippingTaxPercentage) * 100) + .5)
-		shippingTotalSec := new(big.Int).Mul(s.secondary, big.NewInt(s0))
-		sts0, _ := new(big.Float).Mul(big.NewFloat(0.01), new(big.Float).SetInt(shippingTotalSec)).Int(nil)
-		shippingTotal0 := new(big.Int).Mul(sts0, big.NewInt(int64(s.quantity)))
-		shippingTotal = new(big.Int).Add(shippingTotal, shippingTotal0)
-	}
-	sp := int64(((1 + is[i].shippingTaxPercentage) * 100) + .5)
-	shippingTotalPrimary0 := new(big.Int).Mul(is[i].primary, big.NewInt(sp))
-	stp0, _ := new(big.Float).Mul(big.NewFloat(0.01), new(big.Float).SetInt(shippingTotalPrimary0)).Int(nil)
-	shippingTotal = new(big.Int).Sub(shippingTotal, stp0)
-
-	shippingTotalSecondary0 := new(big.Int).Mul(is[i].secondary, big.NewInt(sp))
-	sts0, _ := new(big.Float).Mul(big.NewFloat(0.01), new(big.Float).SetInt(shippingTotalSecondary0)).Int(nil)
-	shippingTotal = new(big.Int).Add(shippingTotal, sts0)
-
-	return shippingTotal, nil
-}
-
 func getShippingOption(rl *repo.Listing, optionName string) (*pb.Listing_ShippingOption, error) {
 	shippingOptions := make(map[string]*pb.Listing_ShippingOption)
 	for _, so := range rl.GetProtobuf().ShippingOptions {
@@ -1605,6 +1385,12 @@ func (n *OpenBazaarNode) ValidateOrder(contract *pb.RicardianContract, checkInve
 	if contract.BuyerOrder.Timestamp == nil {
 		return errors.New("order is missing a timestamp")
 	}
+	if err := validateCrowdfundPledge(contract, time.Now()); err != nil {
+		return err
+	}
+	if err := validateAppointmentBooking(contract, time.Now()); err != nil {
+		return err
+	}
 	if contract.BuyerOrder.Payment.Method == pb.Order_Payment_MODERATED {
 		_, err := mh.FromB58String(contract.BuyerOrder.Payment.Moderator)
 		if err != nil {
@@ -1672,9 +1458,10 @@ collectListings:
 
 	// Validate the selected variants
 	type inventory struct {
-		Slug    string
-		Variant int
-		Count   *big.Int
+		Slug      string
+		Variant   int
+		Count     *big.Int
+		Backorder *pb.Listing_Backorder
 	}
 	var inventoryList []inventory
 	for _, item := range contract.BuyerOrder.Items {
@@ -1684,7 +1471,7 @@ collectListings:
 			listingOptions = append(listingOptions, opt.Name)
 		}
 		userOptions = append(userOptions, item.Options...)
-		inv := inventory{Slug: listingMap[item.ListingHash].Slug}
+		inv := inventory{Slug: listingMap[item.ListingHash].Slug, Backorder: listingMap[item.ListingHash].Backorder}
 		selectedVariant, err := GetSelectedSku(listingMap[item.ListingHash], item.Options)
 		if err != nil {
 			return err
@@ -1773,6 +1560,11 @@ collectListings:
 		}
 	}
 
+	// Validate the taxes recorded on the order
+	if err := validateItemTaxes(contract); err != nil {
+		return err
+	}
+
 	// Check we have enough inventory
 	if checkInventory {
 		for _, inv := range inventoryList {
@@ -1780,10 +1572,24 @@ collectListings:
 			if err != nil {
 				return errors.New("vendor has no inventory for the selected variant")
 			}
+			if inv.Backorder != nil {
+				// the stock of backordered listings may go down to -limit
+				available := new(big.Int).Add(amt, new(big.Int).SetUint64(inv.Backorder.Limit))
+				if available.Cmp(inv.Count) < 0 {
+					if available.Sign() < 0 {
+						available = big.NewInt(0)
+					}
+					return NewErrOutOfInventory(available)
+				}
+				continue
+			}
 			if amt.Cmp(big.NewInt(0)) >= 0 && amt.Cmp(inv.Count) < 0 {
 				return NewErrOutOfInventory(amt)
 			}
 		}
+		if err := n.checkSlotCapacity(contract); err != nil {
+			return err
+		}
 	}
 
 	// Validate shipping
@@ -2037,6 +1843,28 @@ func quantityForItem(version uint32, item *pb.Order_Item) *big.Int {
 	return new(big.Int).SetUint64(uint64(item.Quantity))
 }
 
+// orderExchangeRate returns the rate of the payment coin in the local
+// currency when the order was placed, or nil if the rate was not recorded
+func (n *OpenBazaarNode) orderExchangeRate(contract *pb.RicardianContract) *pb.ExchangeRate {
+	order := contract.GetBuyerOrder()
+	if order.GetPayment().GetAmountCurrency() == nil || order.GetTimestamp() == nil {
+		return nil
+	}
+	currencyCode := defaultAccountingCurrency
+	if settings, err := n.Datastore.Settings().Get(); err == nil && settings.LocalCurrency != nil && *settings.LocalCurrency != "" {
+		currencyCode = *settings.LocalCurrency
+	}
+	placed, err := ptypes.Timestamp(order.Timestamp)
+	if err != nil {
+		return nil
+	}
+	rate, err := n.historicalCoinRate(order.Payment.AmountCurrency.Code, currencyCode, placed)
+	if err != nil {
+		return nil
+	}
+	return &pb.ExchangeRate{CurrencyCode: currencyCode, Rate: rate}
+}
+
 // ReserveCurrencyConverter will attempt to build a CurrencyConverter based on
 // the reserve currency, or will panic if unsuccessful
 func (n *OpenBazaarNode) ReserveCurrencyConverter() (*repo.CurrencyConverter, error) {
@@ -2045,6 +1873,17 @@ func (n *OpenBazaarNode) ReserveCurrencyConverter() (*repo.CurrencyConverter, er
 	// provide reliable reserve currency rates, they can be
 	// reflected upon instead of using an explicit whitelist
 	var preferredReserveWalletCodes = []string{"BTC"}
+	if n.ExchangeRates != nil {
+		reserveCode := preferredReserveWalletCodes[0]
+		if n.RegressionTestEnable || n.TestnetEnable {
+			reserveCode = "T" + reserveCode
+		}
+		// stale rates fail here rather than falling back to the wallet
+		if _, err := n.ExchangeRates.GetAllRates(true); err != nil {
+			return nil, fmt.Errorf("reserve exchange rates unavailable: %s", err.Error())
+		}
+		return repo.NewCurrencyConverter(reserveCode, n.ExchangeRates)
+	}
 	for _, code := range preferredReserveWalletCodes {
 		var reserveCode = code
 		if n.RegressionTestEnable || n.TestnetEnable {
@@ -0,0 +1,140 @@
+package core
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"time"
+
+	ma "gx/ipfs/QmTZBfrPJmjWsCvHEtX5FE6KimVJhsJg5sBbqEFYf4UZtL/go-multiaddr"
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
+)
+
+// maxOutboxDeleteAttempts is the number of sweeps which try to delete the
+// stored copy of a message before it is marked failed
+const maxOutboxDeleteAttempts = 5
+
+// trackOutboxMessage records an offline message stored for its recipient so
+// the stored copy can be deleted once it is acked or expires
+func (n *OpenBazaarNode) trackOutboxMessage(recipient peer.ID, pointer ipfs.Pointer, m *pb.Message, ciphertext []byte) error {
+	digest := sha256.Sum256(ciphertext)
+	return n.Datastore.Outbox().Put(repo.OutboxMessage{
+		PointerID:   pointer.Value.ID.Pretty(),
+		Recipient:   recipient.Pretty(),
+		MessageType: m.MessageType.String(),
+		Location:    pointer.Value.Addrs[0].String(),
+		Digest:      hex.EncodeToString(digest[:]),
+		Status:      repo.OutboxPending,
+		Timestamp:   repo.NewAPITime(time.Now()),
+	})
+}
+
+// AckOutboxMessage marks the message acked and deletes the stored copy.
+// Copies of the same message in other locations are no longer needed either,
+// so they are acked and their pointers deleted too. Messages sent before the
+// outbox was tracked are ignored.
+func (n *OpenBazaarNode) AckOutboxMessage(pointerID string) error {
+	msg, err := n.Datastore.Outbox().Get(pointerID)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if err := n.closeOutboxMessage(*msg, repo.OutboxAcked); err != nil {
+		return err
+	}
+
+	pending, err := n.Datastore.Outbox().GetAll(repo.OutboxPending)
+	if err != nil {
+		return err
+	}
+	for _, other := range pending {
+		if other.Digest != msg.Digest {
+			continue
+		}
+		if id, err := peer.IDB58Decode(other.PointerID); err == nil {
+			if err := n.Datastore.Pointers().Delete(id); err != nil {
+				log.Errorf("deleting pointer %s: %s", other.PointerID, err)
+			}
+		}
+		if err := n.closeOutboxMessage(other, repo.OutboxAcked); err != nil {
+			log.Errorf("deleting acked outbox message %s: %s", other.PointerID, err)
+		}
+	}
+	return nil
+}
+
+// ExpireOutboxMessages marks the pending messages stored before the cutoff
+// expired and deletes the stored copies
+func (n *OpenBazaarNode) ExpireOutboxMessages(cutoff time.Time) error {
+	msgs, err := n.Datastore.Outbox().GetAll(repo.OutboxPending)
+	if err != nil {
+		return err
+	}
+	for _, msg := range msgs {
+		if !msg.Timestamp.Before(cutoff) {
+			continue
+		}
+		if err := n.closeOutboxMessage(msg, repo.OutboxExpired); err != nil {
+			log.Errorf("expiring outbox message %s: %s", msg.PointerID, err)
+		}
+	}
+	return nil
+}
+
+// closeOutboxMessage deletes the stored copy of a pending message and sets its
+// status. Failed deletes are retried by later sweeps until the message has
+// failed maxOutboxDeleteAttempts times, when it is marked failed instead.
+func (n *OpenBazaarNode) closeOutboxMessage(msg repo.OutboxMessage, status repo.OutboxStatus) error {
+	if msg.Status != repo.OutboxPending {
+		return nil
+	}
+	if err := n.deleteStoredMessage(msg); err != nil {
+		attempts := msg.DeleteAttempts + 1
+		if ferr := n.Datastore.Outbox().SetDeleteFailure(msg.PointerID, attempts, err.Error()); ferr != nil {
+			return ferr
+		}
+		if attempts >= maxOutboxDeleteAttempts {
+			if ferr := n.Datastore.Outbox().SetStatus(msg.PointerID, repo.OutboxFailed, time.Now()); ferr != nil {
+				return ferr
+			}
+		}
+		return err
+	}
+	return n.Datastore.Outbox().SetStatus(msg.PointerID, status, time.Now())
+}
+
+func (n *OpenBazaarNode) deleteStoredMessage(msg repo.OutboxMessage) error {
+	deleter, ok := n.MessageStorage.(sto.OfflineMessageDeleter)
+	if !ok {
+		return nil
+	}
+	addr, err := ma.NewMultiaddr(msg.Location)
+	if err != nil {
+		return err
+	}
+	if err := deleter.Delete(addr, msg.Digest); err != nil && err != sto.ErrNotStored {
+		return err
+	}
+	return nil
+}
+
+// GetOutboxMessages returns the offline messages sent by the node with the
+// given status, or all of them if the status is empty
+func (n *OpenBazaarNode) GetOutboxMessages(status repo.OutboxStatus) ([]repo.OutboxMessage, error) {
+	return n.Datastore.Outbox().GetAll(status)
+}
+
+// GetOutboxMessage returns the offline message with the pointer ID
+func (n *OpenBazaarNode) GetOutboxMessage(pointerID string) (*repo.OutboxMessage, error) {
+	msg, err := n.Datastore.Outbox().Get(pointerID)
+	if err == sql.ErrNoRows {
+		return nil, ErrOutboxMessageNotFound
+	}
+	return msg, err
+}
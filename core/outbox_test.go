@@ -0,0 +1,142 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	ma "gx/ipfs/QmTZBfrPJmjWsCvHEtX5FE6KimVJhsJg5sBbqEFYf4UZtL/go-multiaddr"
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/test"
+)
+
+// failingStorage is a message storage which can't delete stored messages
+type failingStorage struct{}
+
+func (failingStorage) Store(peer.ID, []byte) (ma.Multiaddr, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (failingStorage) Delete(ma.Multiaddr, string) error {
+	return errors.New("unpin failed")
+}
+
+func TestOutboxMessageStatus(t *testing.T) {
+	node, err := test.NewNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sentAt := time.Now().Add(-time.Hour * 24 * 31)
+	for _, id := range []string{"QmOutboxAcked", "QmOutboxAckedCopy", "QmOutboxExpired"} {
+		digest := id
+		if id == "QmOutboxAckedCopy" {
+			digest = "QmOutboxAcked"
+		}
+		err := node.Datastore.Outbox().Put(repo.OutboxMessage{
+			PointerID: id,
+			Digest:    digest,
+			Recipient: "QmRecipient",
+			Location:  "/ipfs/QmNLei78zWmzUdbeRB3CiUfAizWUrbeeZh5K1rhAQKCh51",
+			Status:    repo.OutboxPending,
+			Timestamp: repo.NewAPITime(sentAt),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := node.Datastore.Outbox().Put(repo.OutboxMessage{
+		PointerID: "QmOutboxRecent",
+		Status:    repo.OutboxPending,
+		Timestamp: repo.NewAPITime(time.Now()),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := node.AckOutboxMessage("QmOutboxAcked"); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.AckOutboxMessage("QmOutboxUntracked"); err != nil {
+		t.Errorf("expected acks of untracked messages to be ignored, got %s", err)
+	}
+	if err := node.ExpireOutboxMessages(time.Now().Add(-time.Hour * 24 * 30)); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]repo.OutboxStatus{
+		"QmOutboxAcked":     repo.OutboxAcked,
+		"QmOutboxAckedCopy": repo.OutboxAcked,
+		"QmOutboxExpired":   repo.OutboxExpired,
+		"QmOutboxRecent":    repo.OutboxPending,
+	}
+	for id, status := range expected {
+		msg, err := node.GetOutboxMessage(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if msg.Status != status {
+			t.Errorf("expected %s to be %s, got %s", id, status, msg.Status)
+		}
+	}
+	if _, err := node.GetOutboxMessage("QmOutboxUntracked"); err != core.ErrOutboxMessageNotFound {
+		t.Errorf("expected ErrOutboxMessageNotFound, got %v", err)
+	}
+}
+
+func TestOutboxDeleteFailures(t *testing.T) {
+	node, err := test.NewNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.MessageStorage = failingStorage{}
+
+	if err := node.Datastore.Outbox().Put(repo.OutboxMessage{
+		PointerID: "QmOutboxUndeletable",
+		Digest:    "QmOutboxUndeletable",
+		Location:  "/ipfs/QmNLei78zWmzUdbeRB3CiUfAizWUrbeeZh5K1rhAQKCh51",
+		Status:    repo.OutboxPending,
+		Timestamp: repo.NewAPITime(time.Now().Add(-time.Hour * 24 * 31)),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	cutoff := time.Now().Add(-time.Hour * 24 * 30)
+	for i := 1; i <= 5; i++ {
+		msg, err := node.GetOutboxMessage("QmOutboxUndeletable")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if msg.Status != repo.OutboxPending || msg.DeleteAttempts != i-1 {
+			t.Fatalf("expected the message to be retried, got %+v", msg)
+		}
+		if err := node.ExpireOutboxMessages(cutoff); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	msg, err := node.GetOutboxMessage("QmOutboxUndeletable")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Status != repo.OutboxFailed || msg.DeleteAttempts != 5 || msg.LastError != "unpin failed" {
+		t.Errorf("expected the message to be marked failed, got %+v", msg)
+	}
+	failed, err := node.GetOutboxMessages(repo.OutboxFailed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(failed) != 1 || failed[0].PointerID != "QmOutboxUndeletable" {
+		t.Errorf("expected the failed message to be listed, got %+v", failed)
+	}
+
+	// Failed messages are not retried
+	if err := node.ExpireOutboxMessages(cutoff); err != nil {
+		t.Fatal(err)
+	}
+	if msg, _ := node.GetOutboxMessage("QmOutboxUndeletable"); msg.DeleteAttempts != 5 {
+		t.Errorf("expected the failed message to be left alone, got %+v", msg)
+	}
+}
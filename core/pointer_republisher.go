@@ -6,6 +6,6 @@ import (
 
 // StartPointerRepublisher - setup republisher for IPNS
 func (n *OpenBazaarNode) StartPointerRepublisher() {
-	n.PointerRepublisher = net.NewPointerRepublisher(n.DHT, n.Datastore, n.PushNodes, n.IsModerator)
+	n.PointerRepublisher = net.NewPointerRepublisher(n.DHT, n.Datastore, n.PushNodes, n.IsModerator, n.ExpireOutboxMessages)
 	go n.PointerRepublisher.Run()
 }
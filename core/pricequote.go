@@ -0,0 +1,178 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// DefaultPriceQuoteMinutes is how long price quotes last when the vendor has
+// not set it
+const DefaultPriceQuoteMinutes = 15
+
+// RequestPriceQuote asks the vendor for a quote fixing the amount of the
+// order in the payment coin. The quote is passed back in the purchase data to
+// buy at the quoted amount.
+func (n *OpenBazaarNode) RequestPriceQuote(data *repo.PurchaseData) (*pb.SignedPriceQuote, error) {
+	contract, err := n.createContractWithOrder(data)
+	if err != nil {
+		return nil, err
+	}
+	if contract.VendorListings[0].Metadata.Version < repo.ListingVersion {
+		return nil, errors.New("listing version does not support price quotes")
+	}
+	defn, err := n.LookupCurrency(data.PaymentCoin)
+	if err != nil {
+		return nil, errors.New("invalid payment coin")
+	}
+	contract.BuyerOrder.Payment = &pb.Order_Payment{
+		AmountCurrency: &pb.CurrencyDefinition{
+			Code:         defn.Code.String(),
+			Divisibility: uint32(defn.Divisibility),
+		},
+	}
+
+	quote, err := n.SendPriceQuoteRequest(contract.VendorListings[0].VendorID.PeerID, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	// check the vendor quoted this order
+	contract.BuyerOrder.Payment.PriceQuote = quote
+	if _, err := n.CalculateOrderTotal(contract); err != nil {
+		return nil, fmt.Errorf("invalid price quote: %s", err.Error())
+	}
+	return quote, nil
+}
+
+// NewPriceQuote prices an order for the vendor's listings at the current
+// rates and signs a quote fixing the amount until it expires
+func (n *OpenBazaarNode) NewPriceQuote(contract *pb.RicardianContract) (*pb.SignedPriceQuote, error) {
+	if contract.BuyerOrder == nil || len(contract.BuyerOrder.Items) == 0 {
+		return nil, errors.New("order hasn't selected any items")
+	}
+	if contract.BuyerOrder.Payment.GetAmountCurrency() == nil {
+		return nil, errors.New("order doesn't contain a payment currency")
+	}
+	if len(contract.VendorListings) == 0 {
+		return nil, errors.New("order doesn't contain any listings")
+	}
+	if !n.hasKnownListings(contract) {
+		return nil, ErrPurchaseUnknownListing
+	}
+	if !n.currencyInAcceptedCurrenciesList(contract.BuyerOrder.Payment.AmountCurrency.Code,
+		contract.VendorListings[0].Metadata.AcceptedCurrencies) {
+		return nil, errors.New("payment coin not accepted")
+	}
+	contract.BuyerOrder.Payment.PriceQuote = nil
+
+	cc, err := n.ReserveCurrencyConverter()
+	if err != nil {
+		return nil, fmt.Errorf("preparing reserve currency converter: %s", err.Error())
+	}
+	recorder, used := cc.Recording()
+	total, err := n.calculateOrderTotal(contract, recorder)
+	if err != nil {
+		return nil, err
+	}
+
+	minutes := uint32(DefaultPriceQuoteMinutes)
+	if settings, err := n.Datastore.Settings().Get(); err == nil && settings.PriceQuoteMinutes != nil && *settings.PriceQuoteMinutes > 0 {
+		minutes = *settings.PriceQuoteMinutes
+	}
+	now := time.Now()
+	timestamp, err := ptypes.TimestampProto(now)
+	if err != nil {
+		return nil, err
+	}
+	expires, err := ptypes.TimestampProto(now.Add(time.Duration(minutes) * time.Minute))
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &pb.PriceQuote{
+		VendorID:        n.IpfsNode.Identity.Pretty(),
+		AmountCurrency:  contract.BuyerOrder.Payment.AmountCurrency,
+		BigAmount:       total.String(),
+		ReserveCurrency: cc.ReserveCode(),
+		Timestamp:       timestamp,
+		Expires:         expires,
+	}
+	for code, rate := range used {
+		quote.Rates = append(quote.Rates, &pb.PriceQuote_Rate{CurrencyCode: code, Rate: rate})
+	}
+	sort.Slice(quote.Rates, func(i, j int) bool {
+		return quote.Rates[i].CurrencyCode < quote.Rates[j].CurrencyCode
+	})
+
+	ser, err := proto.Marshal(quote)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SignedPriceQuote{Quote: quote, Signature: signature}, nil
+}
+
+// orderCurrencyConverter returns the converter pricing the order: the quoted
+// rates when it has a price quote, otherwise the current rates
+func (n *OpenBazaarNode) orderCurrencyConverter(contract *pb.RicardianContract) (*repo.CurrencyConverter, error) {
+	quote := contract.GetBuyerOrder().GetPayment().GetPriceQuote()
+	if quote == nil {
+		cc, err := n.ReserveCurrencyConverter()
+		if err != nil {
+			return nil, fmt.Errorf("preparing reserve currency converter: %s", err.Error())
+		}
+		return cc, nil
+	}
+	if err := verifyPriceQuote(contract, quote, time.Now()); err != nil {
+		return nil, err
+	}
+	rates := map[string]float64{repo.MainnetCurrencyCode(quote.Quote.ReserveCurrency): 1}
+	for _, r := range quote.Quote.Rates {
+		rates[r.CurrencyCode] = r.Rate
+	}
+	return repo.NewFixedRateConverter(quote.Quote.ReserveCurrency, rates)
+}
+
+// verifyPriceQuote checks the quote was signed by the vendor of the order
+// for its payment currency and has not expired
+func verifyPriceQuote(contract *pb.RicardianContract, signed *pb.SignedPriceQuote, now time.Time) error {
+	quote := signed.Quote
+	if quote == nil {
+		return errors.New("price quote is empty")
+	}
+	if len(contract.VendorListings) == 0 || contract.VendorListings[0].VendorID == nil {
+		return errors.New("order doesn't contain any listings")
+	}
+	vendorID := contract.VendorListings[0].VendorID
+	if quote.VendorID != vendorID.PeerID {
+		return errors.New("price quote is not from the vendor")
+	}
+	if err := verifySignature(quote, vendorID.Pubkeys.GetIdentity(), signed.Signature, quote.VendorID); err != nil {
+		return fmt.Errorf("price quote signature: %s", err.Error())
+	}
+	payment := contract.BuyerOrder.GetPayment().GetAmountCurrency()
+	if payment == nil || quote.AmountCurrency == nil ||
+		!strings.EqualFold(payment.Code, quote.AmountCurrency.Code) ||
+		payment.Divisibility != quote.AmountCurrency.Divisibility {
+		return errors.New("price quote is for another payment currency")
+	}
+	expires, err := ptypes.Timestamp(quote.Expires)
+	if err != nil {
+		return fmt.Errorf("price quote expiry: %s", err.Error())
+	}
+	if now.After(expires) {
+		return ErrPriceQuoteExpired
+	}
+	return nil
+}
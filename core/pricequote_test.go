@@ -0,0 +1,159 @@
+package core_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/test"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+)
+
+type staticRates map[string]float64
+
+func (r staticRates) GetExchangeRate(code string) (float64, error) {
+	rate, ok := r[code]
+	if !ok {
+		return 0, fmt.Errorf("no rate for %s", code)
+	}
+	return rate, nil
+}
+func (r staticRates) GetLatestRate(code string) (float64, error)   { return r.GetExchangeRate(code) }
+func (r staticRates) GetAllRates(bool) (map[string]float64, error) { return r, nil }
+func (r staticRates) UnitsPerCoin() int64                          { return 100000000 }
+
+func TestOpenBazaarNode_CalculateOrderTotalWithPriceQuote(t *testing.T) {
+	node, err := test.NewNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.ExchangeRates = staticRates{"BTC": 1, "USD": 20000}
+	vendorID, err := node.GetNodeID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the test node's identity is not derived from its key
+	pid, err := peer.IDFromPublicKey(node.IpfsNode.PrivateKey.GetPublic())
+	if err != nil {
+		t.Fatal(err)
+	}
+	vendorID.PeerID = pid.Pretty()
+
+	contract := &pb.RicardianContract{
+		VendorListings: []*pb.Listing{
+			{
+				VendorID: vendorID,
+				Metadata: &pb.Listing_Metadata{
+					ContractType:       pb.Listing_Metadata_PHYSICAL_GOOD,
+					Format:             pb.Listing_Metadata_FIXED_PRICE,
+					AcceptedCurrencies: []string{"TBTC"},
+					EscrowTimeoutHours: 1080,
+					Version:            5,
+				},
+				Item: &pb.Listing_Item{
+					BigPrice:      "1000",
+					PriceCurrency: &pb.CurrencyDefinition{Code: "USD", Divisibility: 2},
+				},
+				ShippingOptions: []*pb.Listing_ShippingOption{
+					{
+						Name:    "UPS",
+						Regions: []pb.CountryCode{pb.CountryCode_UNITED_STATES},
+						Type:    pb.Listing_ShippingOption_FIXED_PRICE,
+						Services: []*pb.Listing_ShippingOption_Service{
+							{Name: "Standard shipping", BigPrice: "250"},
+						},
+					},
+				},
+			},
+		},
+	}
+	ser, err := proto.Marshal(contract.VendorListings[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	listingID, err := ipfs.EncodeCID(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contract.BuyerOrder = &pb.Order{
+		Items: []*pb.Order_Item{
+			{
+				ListingHash:    listingID.String(),
+				BigQuantity:    "1",
+				ShippingOption: &pb.Order_Item_ShippingOption{Name: "UPS", Service: "Standard shipping"},
+			},
+		},
+		Shipping: &pb.Order_Shipping{Country: pb.CountryCode_UNITED_STATES},
+		Payment: &pb.Order_Payment{
+			AmountCurrency: &pb.CurrencyDefinition{Code: "TBTC", Divisibility: 8},
+		},
+	}
+
+	// $12.50 at the current rate of 20000
+	total, err := node.CalculateOrderTotal(contract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total.Int64() > 63000 {
+		t.Errorf("expected about 62500 at the current rate, got %s", total)
+	}
+
+	signQuote := func(amount string, expires time.Time) *pb.SignedPriceQuote {
+		expiresTS, _ := ptypes.TimestampProto(expires)
+		quote := &pb.PriceQuote{
+			VendorID:        vendorID.PeerID,
+			AmountCurrency:  contract.BuyerOrder.Payment.AmountCurrency,
+			BigAmount:       amount,
+			ReserveCurrency: "TBTC",
+			Rates:           []*pb.PriceQuote_Rate{{CurrencyCode: "USD", Rate: 10000}},
+			Timestamp:       ptypes.TimestampNow(),
+			Expires:         expiresTS,
+		}
+		ser, err := proto.Marshal(quote)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig, err := node.IpfsNode.PrivateKey.Sign(ser)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &pb.SignedPriceQuote{Quote: quote, Signature: sig}
+	}
+
+	// the quoted rate of 10000 is honoured, the item and shipping are each
+	// rounded up
+	contract.BuyerOrder.Payment.PriceQuote = signQuote("125002", time.Now().Add(time.Minute))
+	total, err = node.CalculateOrderTotal(contract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total.Int64() != 125002 {
+		t.Errorf("expected the quoted 125002, got %s", total)
+	}
+
+	// a quote for another order
+	contract.BuyerOrder.Payment.PriceQuote = signQuote("120000", time.Now().Add(time.Minute))
+	if _, err := node.CalculateOrderTotal(contract); err != core.ErrPriceQuoteMismatch {
+		t.Errorf("expected a mismatched quote, got %v", err)
+	}
+
+	// an expired quote
+	contract.BuyerOrder.Payment.PriceQuote = signQuote("125002", time.Now().Add(-time.Minute))
+	if _, err := node.CalculateOrderTotal(contract); err != core.ErrPriceQuoteExpired {
+		t.Errorf("expected an expired quote, got %v", err)
+	}
+
+	// a quote altered after signing
+	quote := signQuote("125002", time.Now().Add(time.Minute))
+	quote.Quote.Rates[0].Rate = 5000
+	quote.Quote.BigAmount = "250004"
+	contract.BuyerOrder.Payment.PriceQuote = quote
+	if _, err := node.CalculateOrderTotal(contract); err == nil {
+		t.Error("expected an altered quote to be rejected")
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"reflect"
 	"strings"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 
 	"github.com/OpenBazaar/jsonpb"
 	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/imdario/mergo"
 )
@@ -242,6 +244,27 @@ func (n *OpenBazaarNode) appendCountsToProfile(profile *pb.Profile) (*pb.Profile
 		profile.Stats.AverageRating = averageRating
 		changed = true
 	}
+	if stats, err := n.GetRatingStats(); err == nil {
+		var (
+			averages  *pb.Profile_RatingAverages
+			histogram []uint32
+		)
+		if stats.Store.Count > 0 {
+			averages = &pb.Profile_RatingAverages{
+				Overall:         stats.Store.Averages.Overall,
+				Quality:         stats.Store.Averages.Quality,
+				Description:     stats.Store.Averages.Description,
+				DeliverySpeed:   stats.Store.Averages.DeliverySpeed,
+				CustomerService: stats.Store.Averages.CustomerService,
+			}
+			histogram = stats.Store.Histograms.Overall
+		}
+		if !proto.Equal(averages, profile.Stats.RatingAverages) || !reflect.DeepEqual(histogram, profile.Stats.RatingHistogram) {
+			profile.Stats.RatingAverages = averages
+			profile.Stats.RatingHistogram = histogram
+			changed = true
+		}
+	}
 	return profile, changed
 }
 
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+func newStatsRating(slug string, overall uint32) *pb.Rating {
+	return &pb.Rating{RatingData: &pb.Rating_RatingData{
+		VendorSig: &pb.RatingSignature{
+			Metadata: &pb.RatingSignature_TransactionMetadata{ListingSlug: slug},
+		},
+		Overall: overall,
+	}}
+}
+
+func writeStatsRating(t *testing.T, ratingsPath, name string, rating *pb.Rating) {
+	out, err := new(jsonpb.Marshaler).MarshalToString(rating)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path.Join(ratingsPath, name), []byte(out), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetRatingStatsCachesTallies(t *testing.T) {
+	repoPath, err := ioutil.TempDir("", "ratingstats")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(repoPath)
+	ratingsPath := path.Join(repoPath, "root", "ratings")
+	if err := os.MkdirAll(ratingsPath, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	node := &OpenBazaarNode{RepoPath: repoPath}
+
+	writeStatsRating(t, ratingsPath, "a.json", newStatsRating("shoes", 5))
+	stats, err := node.GetRatingStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Store.Count != 1 {
+		t.Fatalf("expected the saved rating to be counted, got %d", stats.Store.Count)
+	}
+
+	// Saved ratings are added to the tallies without reading the files again
+	node.addRatingToStats(newStatsRating("shoes", 3))
+	writeStatsRating(t, ratingsPath, "b.json", newStatsRating("hats", 1))
+	stats, err = node.GetRatingStats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Store.Count != 2 || stats.Listings["shoes"].Averages.Overall != 4 {
+		t.Errorf("expected the added rating to be counted, got %+v", stats.Store)
+	}
+	if _, ok := stats.Listings["hats"]; ok {
+		t.Error("expected the ratings to be read once")
+	}
+}
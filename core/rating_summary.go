@@ -0,0 +1,199 @@
+package core
+
+import (
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// RatingHistograms holds the number of ratings with each score from
+// RatingMin to RatingMax for each of the scores of a rating
+type RatingHistograms struct {
+	Overall         []uint32 `json:"overall"`
+	Quality         []uint32 `json:"quality"`
+	Description     []uint32 `json:"description"`
+	DeliverySpeed   []uint32 `json:"deliverySpeed"`
+	CustomerService []uint32 `json:"customerService"`
+}
+
+// RatingSummary aggregates the scores of a set of ratings
+type RatingSummary struct {
+	Count      uint32              `json:"count"`
+	Averages   repo.RatingAverages `json:"averages"`
+	Histograms RatingHistograms    `json:"histograms"`
+}
+
+// RatingStats summarizes the ratings of the whole store and of each listing
+// by slug
+type RatingStats struct {
+	Store    RatingSummary            `json:"store"`
+	Listings map[string]RatingSummary `json:"listings"`
+}
+
+const ratingScores = 5
+
+// ratingTally accumulates the scores of ratings in the order overall,
+// quality, description, delivery speed and customer service
+type ratingTally struct {
+	count      uint32
+	totals     [ratingScores]uint64
+	histograms [ratingScores][RatingMax - RatingMin + 1]uint32
+}
+
+func (t *ratingTally) add(rd *pb.Rating_RatingData) {
+	t.count++
+	for i, score := range [ratingScores]uint32{rd.Overall, rd.Quality, rd.Description, rd.DeliverySpeed, rd.CustomerService} {
+		t.totals[i] += uint64(score)
+		if score >= RatingMin && score <= RatingMax {
+			t.histograms[i][score-RatingMin]++
+		}
+	}
+}
+
+func (t *ratingTally) averages() repo.RatingAverages {
+	var avg [ratingScores]float32
+	if t.count > 0 {
+		for i, total := range t.totals {
+			avg[i] = float32(total) / float32(t.count)
+		}
+	}
+	return repo.RatingAverages{
+		Overall:         avg[0],
+		Quality:         avg[1],
+		Description:     avg[2],
+		DeliverySpeed:   avg[3],
+		CustomerService: avg[4],
+	}
+}
+
+func (t *ratingTally) histogram(score int) []uint32 {
+	return append([]uint32(nil), t.histograms[score][:]...)
+}
+
+func (t *ratingTally) summary() RatingSummary {
+	return RatingSummary{
+		Count:    t.count,
+		Averages: t.averages(),
+		Histograms: RatingHistograms{
+			Overall:         t.histogram(0),
+			Quality:         t.histogram(1),
+			Description:     t.histogram(2),
+			DeliverySpeed:   t.histogram(3),
+			CustomerService: t.histogram(4),
+		},
+	}
+}
+
+// ratingTallies accumulates the scores of the store and of each listing
+type ratingTallies struct {
+	store    ratingTally
+	listings map[string]*ratingTally
+}
+
+func newRatingTallies() *ratingTallies {
+	return &ratingTallies{listings: make(map[string]*ratingTally)}
+}
+
+func (t *ratingTallies) add(rating *pb.Rating) {
+	rd := rating.RatingData
+	if rd == nil || rd.VendorSig == nil || rd.VendorSig.Metadata == nil {
+		return
+	}
+	t.store.add(rd)
+	slug := rd.VendorSig.Metadata.ListingSlug
+	if t.listings[slug] == nil {
+		t.listings[slug] = new(ratingTally)
+	}
+	t.listings[slug].add(rd)
+}
+
+func (t *ratingTallies) stats() RatingStats {
+	stats := RatingStats{
+		Store:    t.store.summary(),
+		Listings: make(map[string]RatingSummary),
+	}
+	for slug, listing := range t.listings {
+		stats.Listings[slug] = listing.summary()
+	}
+	return stats
+}
+
+// SummarizeRatings aggregates the ratings for the store and for each listing
+func SummarizeRatings(ratings []*pb.Rating) RatingStats {
+	tallies := newRatingTallies()
+	for _, rating := range ratings {
+		tallies.add(rating)
+	}
+	return tallies.stats()
+}
+
+// GetRatingStats returns the rating analytics of the node's store. The saved
+// ratings are read once and the tallies are kept up to date as ratings are
+// saved.
+func (n *OpenBazaarNode) GetRatingStats() (RatingStats, error) {
+	n.ratingTalliesLock.Lock()
+	defer n.ratingTalliesLock.Unlock()
+
+	if n.ratingTallies == nil {
+		ratings, err := n.getSavedRatings()
+		if err != nil {
+			return RatingStats{}, err
+		}
+		n.ratingTallies = newRatingTallies()
+		for _, rating := range ratings {
+			n.ratingTallies.add(rating)
+		}
+	}
+	return n.ratingTallies.stats(), nil
+}
+
+// addRatingToStats adds a newly saved rating to the tallies if they have
+// been read
+func (n *OpenBazaarNode) addRatingToStats(rating *pb.Rating) {
+	n.ratingTalliesLock.Lock()
+	defer n.ratingTalliesLock.Unlock()
+
+	if n.ratingTallies != nil {
+		n.ratingTallies.add(rating)
+	}
+}
+
+// getSavedRatings reads the ratings the node has saved in its root
+func (n *OpenBazaarNode) getSavedRatings() ([]*pb.Rating, error) {
+	ratingsPath := path.Join(n.RepoPath, "root", "ratings")
+	files, err := ioutil.ReadDir(ratingsPath)
+	if err != nil {
+		return nil, err
+	}
+	var ratings []*pb.Rating
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+		file, err := ioutil.ReadFile(path.Join(ratingsPath, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		rating := new(pb.Rating)
+		if err := jsonpb.UnmarshalString(string(file), rating); err != nil {
+			log.Warningf("skipping unreadable rating %s: %s", f.Name(), err)
+			continue
+		}
+		ratings = append(ratings, rating)
+	}
+	return ratings, nil
+}
+
+// listingRatingSummary returns the summary of the ratings of a listing
+func (n *OpenBazaarNode) listingRatingSummary(slug string) (RatingSummary, bool, error) {
+	stats, err := n.GetRatingStats()
+	if err != nil {
+		return RatingSummary{}, false, err
+	}
+	summary, ok := stats.Listings[slug]
+	return summary, ok, nil
+}
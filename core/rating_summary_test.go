@@ -0,0 +1,64 @@
+package core_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/ptypes"
+)
+
+func newSummaryRating(t *testing.T, slug string, ts time.Time, overall, quality, description, deliverySpeed, customerService uint32) *pb.Rating {
+	timestamp, err := ptypes.TimestampProto(ts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &pb.Rating{RatingData: &pb.Rating_RatingData{
+		VendorSig: &pb.RatingSignature{
+			Metadata: &pb.RatingSignature_TransactionMetadata{ListingSlug: slug},
+		},
+		Timestamp:       timestamp,
+		Overall:         overall,
+		Quality:         quality,
+		Description:     description,
+		DeliverySpeed:   deliverySpeed,
+		CustomerService: customerService,
+	}}
+}
+
+func TestSummarizeRatings(t *testing.T) {
+	now := time.Now()
+	ratings := []*pb.Rating{
+		newSummaryRating(t, "shoes", now.Add(-24*time.Hour), 5, 5, 4, 3, 5),
+		newSummaryRating(t, "shoes", now.Add(-60*24*time.Hour), 3, 4, 4, 1, 2),
+		newSummaryRating(t, "hats", now.Add(-400*24*time.Hour), 1, 1, 2, 2, 1),
+		{RatingData: &pb.Rating_RatingData{Overall: 5}},
+	}
+	stats := core.SummarizeRatings(ratings)
+
+	if stats.Store.Count != 3 {
+		t.Errorf("expected ratings without listing metadata to be skipped, got a count of %d", stats.Store.Count)
+	}
+	expectedStore := repo.RatingAverages{Overall: 3, Quality: 10.0 / 3, Description: 10.0 / 3, DeliverySpeed: 2, CustomerService: 8.0 / 3}
+	if stats.Store.Averages != expectedStore {
+		t.Errorf("expected store averages %+v, got %+v", expectedStore, stats.Store.Averages)
+	}
+	if expected := []uint32{1, 0, 1, 0, 1}; !reflect.DeepEqual(stats.Store.Histograms.Overall, expected) {
+		t.Errorf("expected overall histogram %v, got %v", expected, stats.Store.Histograms.Overall)
+	}
+	if expected := []uint32{1, 1, 1, 0, 0}; !reflect.DeepEqual(stats.Store.Histograms.DeliverySpeed, expected) {
+		t.Errorf("expected delivery speed histogram %v, got %v", expected, stats.Store.Histograms.DeliverySpeed)
+	}
+
+	shoes, ok := stats.Listings["shoes"]
+	if !ok || len(stats.Listings) != 2 {
+		t.Fatalf("expected a summary for each listing, got %v", stats.Listings)
+	}
+	if shoes.Count != 2 || shoes.Averages.Overall != 4 || shoes.Averages.DeliverySpeed != 2 {
+		t.Errorf("unexpected summary of the shoes listing: %+v", shoes)
+	}
+
+}
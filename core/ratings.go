@@ -5,6 +5,9 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"sync"
+	"time"
 
 	crypto "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
 	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
@@ -13,11 +16,17 @@ import (
 	"os"
 	"path"
 
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 )
 
+// RatingResponseMaxCharacters - max size for a vendor's response to a rating
+const RatingResponseMaxCharacters = 3000
+
 // ValidateRating - validates rating for API GET and Post/Update
 func ValidateRating(rating *pb.Rating) (bool, error) {
 	if rating.RatingData == nil || rating.RatingData.VendorID == nil || rating.RatingData.VendorID.Pubkeys == nil || rating.RatingData.VendorSig == nil || rating.RatingData.VendorSig.Metadata == nil {
@@ -146,3 +155,242 @@ func (n *OpenBazaarNode) GetRatingCounts() (uint32, float32, error) {
 	averageRating := totalRating / float32(ratingCount)
 	return ratingCount, averageRating, nil
 }
+
+// ValidateRatingResponse - validates that the response to the rating with
+// the given hash was signed by the rated vendor
+func ValidateRatingResponse(response *pb.RatingResponse, ratingHash string, rating *pb.Rating) (bool, error) {
+	if response.ResponseData == nil || response.ResponseData.VendorID == nil || response.ResponseData.VendorID.Pubkeys == nil {
+		return false, errors.New("missing response data")
+	}
+	if rating.RatingData == nil || rating.RatingData.VendorID == nil {
+		return false, errors.New("missing rating data")
+	}
+
+	// Validate the response belongs to this rating
+	if response.ResponseData.RatingHash != ratingHash || !bytes.Equal(response.ResponseData.RatingKey, rating.RatingData.RatingKey) {
+		return false, errors.New("response is for a different rating")
+	}
+	if response.ResponseData.VendorID.PeerID != rating.RatingData.VendorID.PeerID {
+		return false, errors.New("response is not from the rated vendor")
+	}
+
+	// Validate the vendor's signature on the response
+	vendorKey, err := crypto.UnmarshalPublicKey(response.ResponseData.VendorID.Pubkeys.Identity)
+	if err != nil {
+		return false, err
+	}
+	ser, err := proto.Marshal(response.ResponseData)
+	if err != nil {
+		return false, err
+	}
+	valid, err := vendorKey.Verify(ser, response.Signature)
+	if !valid || err != nil {
+		return false, errors.New("invalid vendor signature")
+	}
+
+	// Validate vendor peerID matches pubkey
+	id, err := peer.IDB58Decode(response.ResponseData.VendorID.PeerID)
+	if err != nil {
+		return false, err
+	}
+	if !id.MatchesPublicKey(vendorKey) {
+		return false, errors.New("vendor ID does not match public key")
+	}
+	return true, nil
+}
+
+// RespondToRating - signs the vendor's public response to one of the
+// ratings in the index and saves it in the root next to the ratings
+func (n *OpenBazaarNode) RespondToRating(ratingHash, text string) (*pb.RatingResponse, error) {
+	if text == "" {
+		return nil, errors.New("response is empty")
+	}
+	if len(text) > RatingResponseMaxCharacters {
+		return nil, fmt.Errorf("response is longer than the max of %d characters", RatingResponseMaxCharacters)
+	}
+	indexed, err := n.ratingIndexed(ratingHash)
+	if err != nil {
+		return nil, err
+	}
+	if !indexed {
+		return nil, ErrRatingNotFound
+	}
+	rating, err := n.getRating(ratingHash)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := n.GetNodeID()
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.RatingResponse{
+		ResponseData: &pb.RatingResponse_ResponseData{
+			RatingHash: ratingHash,
+			RatingKey:  rating.RatingData.RatingKey,
+			VendorID:   id,
+			Timestamp:  ptypes.TimestampNow(),
+			Response:   text,
+		},
+	}
+	ser, err := proto.Marshal(response.ResponseData)
+	if err != nil {
+		return nil, err
+	}
+	response.Signature, err = n.Sign(ser)
+	if err != nil {
+		return nil, err
+	}
+
+	m := jsonpb.Marshaler{
+		EnumsAsInts:  false,
+		EmitDefaults: false,
+		Indent:       "    ",
+		OrigName:     false,
+	}
+	out, err := m.MarshalToString(response)
+	if err != nil {
+		return nil, err
+	}
+	responsePath := n.ratingResponsePath(ratingHash)
+	if err := os.MkdirAll(path.Dir(responsePath), os.ModePerm); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(responsePath, []byte(out), os.ModePerm); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// DeleteRatingResponse - removes the vendor's response to a rating
+func (n *OpenBazaarNode) DeleteRatingResponse(ratingHash string) error {
+	err := os.Remove(n.ratingResponsePath(ratingHash))
+	if os.IsNotExist(err) {
+		return ErrRatingResponseNotFound
+	}
+	return err
+}
+
+// RatingResponses - fetches the vendor responses to a set of ratings. The
+// root of each vendor is resolved once and the responses are read under it,
+// so a RatingResponses is used for the ratings of a single request.
+type RatingResponses struct {
+	node     *OpenBazaarNode
+	useCache bool
+
+	lock  sync.Mutex
+	roots map[string]*vendorRoot
+}
+
+type vendorRoot struct {
+	once sync.Once
+	hash string
+	err  error
+}
+
+// NewRatingResponses - returns a RatingResponses which resolves the vendor
+// roots from the IPNS cache if useCache is set
+func (n *OpenBazaarNode) NewRatingResponses(useCache bool) *RatingResponses {
+	return &RatingResponses{
+		node:     n,
+		useCache: useCache,
+		roots:    make(map[string]*vendorRoot),
+	}
+}
+
+// Get - returns the validated response of the rated vendor, fetched from the
+// vendor's root unless this node is the vendor
+func (r *RatingResponses) Get(ratingHash string, rating *pb.Rating) (*pb.RatingResponse, error) {
+	if rating.RatingData == nil || rating.RatingData.VendorID == nil {
+		return nil, errors.New("missing rating data")
+	}
+	vendorID := rating.RatingData.VendorID.PeerID
+
+	var (
+		responseBytes []byte
+		err           error
+	)
+	if vendorID == r.node.IPFSIdentityString() {
+		responseBytes, err = ioutil.ReadFile(r.node.ratingResponsePath(ratingHash))
+	} else {
+		var root string
+		root, err = r.vendorRoot(vendorID)
+		if err == nil {
+			responseBytes, err = ipfs.Cat(r.node.IpfsNode, path.Join(root, "ratings", "responses", ratingHash+".json"), time.Minute)
+		}
+	}
+	if err != nil {
+		return nil, ErrRatingResponseNotFound
+	}
+
+	response := new(pb.RatingResponse)
+	if err := jsonpb.UnmarshalString(string(responseBytes), response); err != nil {
+		return nil, err
+	}
+	if valid, err := ValidateRatingResponse(response, ratingHash, rating); !valid || err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// vendorRoot resolves the root of the vendor on the first call for the vendor
+// and returns the same result to concurrent and later calls
+func (r *RatingResponses) vendorRoot(vendorID string) (string, error) {
+	r.lock.Lock()
+	root, ok := r.roots[vendorID]
+	if !ok {
+		root = new(vendorRoot)
+		r.roots[vendorID] = root
+	}
+	r.lock.Unlock()
+
+	root.once.Do(func() {
+		pid, err := peer.IDB58Decode(vendorID)
+		if err != nil {
+			root.err = err
+			return
+		}
+		root.hash, root.err = ipfs.Resolve(r.node.IpfsNode, pid, time.Minute, r.node.IPNSQuorumSize, r.useCache)
+	})
+	return root.hash, root.err
+}
+
+func (n *OpenBazaarNode) ratingResponsePath(ratingHash string) string {
+	return path.Join(n.RepoPath, "root", "ratings", "responses", ratingHash+".json")
+}
+
+func (n *OpenBazaarNode) ratingIndexed(ratingHash string) (bool, error) {
+	indexBytes, err := ioutil.ReadFile(path.Join(n.RepoPath, "root", "ratings.json"))
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	var index []SavedRating
+	if err := json.Unmarshal(indexBytes, &index); err != nil {
+		return false, err
+	}
+	for _, r := range index {
+		for _, h := range r.Ratings {
+			if h == ratingHash {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func (n *OpenBazaarNode) getRating(ratingHash string) (*pb.Rating, error) {
+	ratingBytes, err := ipfs.Cat(n.IpfsNode, ratingHash, time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	rating := new(pb.Rating)
+	if err := jsonpb.UnmarshalString(string(ratingBytes), rating); err != nil {
+		return nil, err
+	}
+	if valid, err := ValidateRating(rating); !valid || err != nil {
+		return nil, err
+	}
+	return rating, nil
+}
@@ -0,0 +1,87 @@
+package core_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	crypto "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+func signedRatingResponse(t *testing.T, key crypto.PrivKey, data *pb.RatingResponse_ResponseData) *pb.RatingResponse {
+	ser, err := proto.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := key.Sign(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &pb.RatingResponse{ResponseData: data, Signature: sig}
+}
+
+func newRatingResponseID(t *testing.T) (crypto.PrivKey, *pb.ID) {
+	key, pub, err := crypto.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubBytes, err := pub.Bytes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := peer.IDFromPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, &pb.ID{PeerID: id.Pretty(), Pubkeys: &pb.ID_Pubkeys{Identity: pubBytes}}
+}
+
+func TestValidateRatingResponse(t *testing.T) {
+	vendorKey, vendorID := newRatingResponseID(t)
+	otherKey, otherID := newRatingResponseID(t)
+	rating := &pb.Rating{RatingData: &pb.Rating_RatingData{
+		RatingKey: []byte("rating key"),
+		VendorID:  vendorID,
+	}}
+	responseData := func(ratingHash string, ratingKey []byte, id *pb.ID) *pb.RatingResponse_ResponseData {
+		return &pb.RatingResponse_ResponseData{
+			RatingHash: ratingHash,
+			RatingKey:  ratingKey,
+			VendorID:   id,
+			Response:   "Thanks for the review",
+		}
+	}
+
+	response := signedRatingResponse(t, vendorKey, responseData("QmRating", rating.RatingData.RatingKey, vendorID))
+	if valid, err := core.ValidateRatingResponse(response, "QmRating", rating); !valid || err != nil {
+		t.Fatalf("expected the vendor's response to be valid, got %v", err)
+	}
+
+	tampered := signedRatingResponse(t, vendorKey, responseData("QmRating", rating.RatingData.RatingKey, vendorID))
+	tampered.ResponseData.Response = "Changed"
+
+	// Signed by someone else who claims to be the vendor
+	forgedID := &pb.ID{PeerID: vendorID.PeerID, Pubkeys: otherID.Pubkeys}
+	forged := signedRatingResponse(t, otherKey, responseData("QmRating", rating.RatingData.RatingKey, forgedID))
+
+	for name, invalid := range map[string]*pb.RatingResponse{
+		"other rating":   response,
+		"other key":      signedRatingResponse(t, vendorKey, responseData("QmRating", []byte("other key"), vendorID)),
+		"other vendor":   signedRatingResponse(t, otherKey, responseData("QmRating", rating.RatingData.RatingKey, otherID)),
+		"forged peer ID": forged,
+		"tampered":       tampered,
+		"missing data":   {},
+	} {
+		ratingHash := "QmRating"
+		if name == "other rating" {
+			ratingHash = "QmOtherRating"
+		}
+		if valid, err := core.ValidateRatingResponse(invalid, ratingHash, rating); valid || err == nil {
+			t.Errorf("expected the %s response to be invalid", name)
+		}
+	}
+}
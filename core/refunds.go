@@ -121,6 +121,9 @@ func (n *OpenBazaarNode) RefundOrder(contract *pb.RicardianContract, records []*
 	if err != nil {
 		log.Error(err)
 	}
+	if err := n.CancelAppointment(orderID, true); err != nil {
+		log.Errorf("failed releasing the slot of order (%s): %s", orderID, err.Error())
+	}
 	return nil
 }
 
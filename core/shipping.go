@@ -0,0 +1,388 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// Shipping rate types, as reported in the checkout breakdown
+const (
+	ShippingRateFlat     = "flat"
+	ShippingRateWeight   = "weight"
+	ShippingRateQuantity = "quantity"
+)
+
+// shippingRate is a weight or quantity bracket converted to the payment
+// currency
+type shippingRate struct {
+	max   uint64
+	price *big.Int
+}
+
+// itemShipping holds what is needed to price the shipping of an order item,
+// with prices converted to the payment currency
+type itemShipping struct {
+	option                string
+	service               string
+	primary               *big.Int
+	secondary             *big.Int
+	weightRates           []shippingRate
+	quantityRates         []shippingRate
+	quantity              uint64
+	grams                 uint64
+	shippingTaxPercentage float32
+	taxInclusive          bool
+	version               uint32
+}
+
+func (s itemShipping) rateType() string {
+	switch {
+	case len(s.weightRates) > 0:
+		return ShippingRateWeight
+	case len(s.quantityRates) > 0:
+		return ShippingRateQuantity
+	default:
+		return ShippingRateFlat
+	}
+}
+
+// shippingCharge is the shipping charged for the items of an order sent with
+// one service
+type shippingCharge struct {
+	rate     string
+	option   string
+	service  string
+	quantity uint64
+	grams    uint64
+	pretax   *big.Int
+	total    *big.Int
+	// Whether the listed prices include the tax
+	taxInclusive bool
+}
+
+func (n *OpenBazaarNode) calculateShippingTotalForListings(contract *pb.RicardianContract, listings map[string]*repo.Listing, cc *repo.CurrencyConverter) (*big.Int, error) {
+	charges, err := n.calculateShippingCharges(contract, listings, cc)
+	if err != nil {
+		return big.NewInt(0), err
+	}
+	total := big.NewInt(0)
+	for _, c := range charges {
+		total.Add(total, c.total)
+	}
+	return total, nil
+}
+
+// calculateShippingCharges validates the shipping selected for the physical
+// items of the order and returns the charges in the payment currency
+func (n *OpenBazaarNode) calculateShippingCharges(contract *pb.RicardianContract, listings map[string]*repo.Listing, cc *repo.CurrencyConverter) ([]shippingCharge, error) {
+	var is []itemShipping
+	v5Order, err := repo.ToV5Order(contract.BuyerOrder, n.LookupCurrency)
+	if err != nil {
+		return nil, fmt.Errorf("normalizing buyer order: %s", err.Error())
+	}
+
+	// First loop through to validate and filter out non-physical items
+	for _, item := range v5Order.Items {
+		rl, ok := listings[item.ListingHash]
+		if !ok {
+			continue
+		}
+
+		// Check if physical good
+		if rl.GetContractType() != pb.Listing_Metadata_PHYSICAL_GOOD.String() {
+			continue
+		}
+
+		// Check selected option exists
+		option, err := getShippingOption(rl, item.ShippingOption.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if option.Type == pb.Listing_ShippingOption_LOCAL_PICKUP {
+			continue
+		}
+
+		// Check that this option ships to us
+		regions := make(map[pb.CountryCode]bool)
+		for _, country := range option.Regions {
+			regions[country] = true
+		}
+		_, shipsToMe := regions[v5Order.Shipping.Country]
+		_, shipsToAll := regions[pb.CountryCode_ALL]
+		if !shipsToMe && !shipsToAll {
+			return nil, errors.New("listing does ship to selected country")
+		}
+
+		// Check service exists
+		services := make(map[string]*pb.Listing_ShippingOption_Service)
+		for _, shippingService := range option.Services {
+			services[strings.ToLower(shippingService.Name)] = shippingService
+		}
+		service, ok := services[strings.ToLower(item.ShippingOption.Service)]
+		if !ok {
+			return nil, errors.New("shipping service not found in listing")
+		}
+		convert := func(amount, name string) (*big.Int, error) {
+			price, err := repo.NewCurrencyValueFromProtobuf(amount, rl.GetProtobuf().Item.PriceCurrency)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %s (%v): %s", name, service.Name, err.Error())
+			}
+			if !price.IsPositive() {
+				return big.NewInt(0), nil
+			}
+			converted, _, err := price.ConvertUsingProtobufDef(v5Order.Payment.AmountCurrency, cc)
+			if err != nil {
+				return nil, fmt.Errorf("converting %s (%s): %s", name, service.Name, err.Error())
+			}
+			return converted.AmountBigInt(), nil
+		}
+
+		convertedShippingPrice, err := convert(service.BigPrice, "service price")
+		if err != nil {
+			return nil, err
+		}
+		convertedAuxPrice, err := convert(service.BigAdditionalItemPrice, "aux service price")
+		if err != nil {
+			return nil, err
+		}
+		var weightRates, quantityRates []shippingRate
+		for _, r := range service.WeightRates {
+			price, err := convert(r.BigPrice, "weight rate price")
+			if err != nil {
+				return nil, err
+			}
+			weightRates = append(weightRates, shippingRate{max: r.MaxGrams, price: price})
+		}
+		for _, r := range service.QuantityRates {
+			price, err := convert(r.BigPrice, "quantity rate price")
+			if err != nil {
+				return nil, err
+			}
+			quantityRates = append(quantityRates, shippingRate{max: r.MaxQuantity, price: price})
+		}
+
+		var qty uint64
+		if q := quantityForItem(rl.GetVersion(), item); q.IsUint64() {
+			qty = q.Uint64()
+		} else {
+			orderID, _ := n.CalcOrderID(contract.BuyerOrder)
+			log.Warningf("unable to detect quantity in contract (%s)", orderID)
+		}
+		is = append(is, itemShipping{
+			option:                option.Name,
+			service:               service.Name,
+			primary:               convertedShippingPrice,
+			secondary:             convertedAuxPrice,
+			weightRates:           weightRates,
+			quantityRates:         quantityRates,
+			quantity:              qty,
+			grams:                 uint64(math.Ceil(float64(rl.GetProtobuf().Item.Grams) * float64(qty))),
+			shippingTaxPercentage: shippingTaxPercentage(rl, v5Order.Shipping),
+			taxInclusive:          rl.IsTaxInclusive(),
+			version:               rl.GetVersion(),
+		})
+	}
+	return combineShippingCharges(is)
+}
+
+// combineShippingCharges prices the shipping of the items of an order.
+//
+// Items sent with a weight or quantity priced service are grouped by
+// service, and each group pays the bracket matching its combined weight or
+// quantity. Where listings disagree on the price of the bracket the highest
+// is charged.
+//
+// Items sent with flat priced services are combined across listings: the
+// item with the highest price pays it once and every other unit pays the
+// additional item price of its listing.
+func combineShippingCharges(is []itemShipping) ([]shippingCharge, error) {
+	var (
+		charges []shippingCharge
+		flat    []itemShipping
+		groups  = make(map[string][]itemShipping)
+		keys    []string
+	)
+	for _, s := range is {
+		rate := s.rateType()
+		if rate == ShippingRateFlat {
+			flat = append(flat, s)
+			continue
+		}
+		key := rate + "/" + strings.ToLower(s.option) + "/" + strings.ToLower(s.service)
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], s)
+	}
+
+	for _, key := range keys {
+		c, err := tieredShippingCharge(groups[key])
+		if err != nil {
+			return nil, err
+		}
+		charges = append(charges, c)
+	}
+
+	flatCharges, err := flatShippingCharges(flat)
+	if err != nil {
+		return nil, err
+	}
+	return mergeShippingCharges(append(charges, flatCharges...)), nil
+}
+
+// tieredShippingCharge prices a group of items sent with the same weight or
+// quantity priced service
+func tieredShippingCharge(group []itemShipping) (shippingCharge, error) {
+	c := shippingCharge{
+		rate:    group[0].rateType(),
+		option:  group[0].option,
+		service: group[0].service,
+	}
+	for _, s := range group {
+		c.quantity += s.quantity
+		c.grams += s.grams
+	}
+
+	var (
+		highest   *big.Int
+		taxPct    float32
+		inclusive bool
+	)
+	for _, s := range group {
+		rates, total := s.quantityRates, c.quantity
+		if c.rate == ShippingRateWeight {
+			rates, total = s.weightRates, c.grams
+		}
+		price, err := shippingRateFor(rates, total)
+		if err != nil {
+			return shippingCharge{}, fmt.Errorf("shipping service %s: %s", s.service, err.Error())
+		}
+		if highest == nil || price.Cmp(highest) > 0 {
+			highest, taxPct, inclusive = price, s.shippingTaxPercentage, s.taxInclusive
+		}
+	}
+	c.pretax, c.total = shippingPrices(highest, taxPct, inclusive)
+	c.taxInclusive = inclusive
+	return c, nil
+}
+
+// shippingRateFor returns the price of the smallest bracket which covers the
+// total
+func shippingRateFor(rates []shippingRate, total uint64) (*big.Int, error) {
+	sorted := make([]shippingRate, len(rates))
+	copy(sorted, rates)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].max < sorted[j].max })
+	for _, r := range sorted {
+		if total <= r.max {
+			return r.price, nil
+		}
+	}
+	return nil, fmt.Errorf("order exceeds the largest shipping rate (%d)", sorted[len(sorted)-1].max)
+}
+
+// flatShippingCharges prices the items sent with flat priced services
+func flatShippingCharges(is []itemShipping) ([]shippingCharge, error) {
+	if len(is) == 0 {
+		return nil, nil
+	}
+
+	charge := func(s itemShipping) shippingCharge {
+		return shippingCharge{
+			rate:     ShippingRateFlat,
+			option:   s.option,
+			service:  s.service,
+			quantity: s.quantity,
+			pretax:   big.NewInt(0),
+			total:    big.NewInt(0),
+
+			taxInclusive: s.taxInclusive,
+		}
+	}
+	add := func(c shippingCharge, price *big.Int, taxPct float32, units uint64) {
+		n := new(big.Int).SetUint64(units)
+		pretax, total := shippingPrices(price, taxPct, c.taxInclusive)
+		c.pretax.Add(c.pretax, pretax.Mul(pretax, n))
+		c.total.Add(c.total, total.Mul(total, n))
+	}
+
+	if len(is) == 1 {
+		s := is[0]
+		c := charge(s)
+		add(c, s.primary, s.shippingTaxPercentage, 1)
+		if s.quantity > 1 {
+			switch {
+			case s.version == 1:
+				add(c, s.primary, s.shippingTaxPercentage, s.quantity-1)
+			case s.version >= 2:
+				add(c, s.secondary, s.shippingTaxPercentage, s.quantity-1)
+			default:
+				return nil, errors.New("unknown listing version")
+			}
+		}
+		return []shippingCharge{c}, nil
+	}
+
+	var i int
+	for x, s := range is {
+		if s.primary.Cmp(is[i].primary) > 0 {
+			i = x
+		}
+	}
+	var charges []shippingCharge
+	for x, s := range is {
+		c := charge(s)
+		if x == i {
+			add(c, s.primary, s.shippingTaxPercentage, 1)
+			if s.quantity > 1 {
+				add(c, s.secondary, s.shippingTaxPercentage, s.quantity-1)
+			}
+		} else {
+			add(c, s.secondary, s.shippingTaxPercentage, s.quantity)
+		}
+		charges = append(charges, c)
+	}
+	return charges, nil
+}
+
+// mergeShippingCharges adds up the charges of the same service
+func mergeShippingCharges(charges []shippingCharge) []shippingCharge {
+	var (
+		merged []shippingCharge
+		index  = make(map[string]int)
+	)
+	for _, c := range charges {
+		key := c.rate + "/" + strings.ToLower(c.option) + "/" + strings.ToLower(c.service)
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(merged)
+			merged = append(merged, c)
+			continue
+		}
+		merged[i].quantity += c.quantity
+		merged[i].grams += c.grams
+		merged[i].pretax = new(big.Int).Add(merged[i].pretax, c.pretax)
+		merged[i].total = new(big.Int).Add(merged[i].total, c.total)
+	}
+	return merged
+}
+
+// shippingPrices returns a shipping price before and after tax. The tax is
+// added to the price, rounded as the contracts expect, unless the price
+// includes it.
+func shippingPrices(price *big.Int, taxPct float32, inclusive bool) (*big.Int, *big.Int) {
+	s := int64(((1 + taxPct) * 100) + .5)
+	if inclusive {
+		pretax := new(big.Int).Div(new(big.Int).Mul(price, big.NewInt(100)), big.NewInt(s))
+		return pretax, new(big.Int).Set(price)
+	}
+	taxed, _ := new(big.Float).Mul(big.NewFloat(0.01), new(big.Float).SetInt(new(big.Int).Mul(price, big.NewInt(s)))).Int(nil)
+	return new(big.Int).Set(price), taxed
+}
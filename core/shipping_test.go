@@ -0,0 +1,132 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCombineShippingCharges(t *testing.T) {
+	weightRates := []shippingRate{
+		{max: 5000, price: big.NewInt(1500)},
+		{max: 1000, price: big.NewInt(500)},
+	}
+	tests := []struct {
+		name    string
+		items   []itemShipping
+		total   int64
+		pretax  int64
+		charges int
+		wantErr bool
+	}{
+		{
+			name: "single flat item",
+			items: []itemShipping{
+				{option: "a", service: "s", primary: big.NewInt(1000), secondary: big.NewInt(100), quantity: 3, version: 5},
+			},
+			total:   1200,
+			pretax:  1200,
+			charges: 1,
+		},
+		{
+			name: "single flat v1 item pays the full price per unit",
+			items: []itemShipping{
+				{option: "a", service: "s", primary: big.NewInt(1000), secondary: big.NewInt(100), quantity: 3, version: 1},
+			},
+			total:   3000,
+			pretax:  3000,
+			charges: 1,
+		},
+		{
+			name: "flat items combine across listings",
+			items: []itemShipping{
+				{option: "a", service: "s", primary: big.NewInt(500), secondary: big.NewInt(50), quantity: 2, version: 5},
+				{option: "a", service: "s", primary: big.NewInt(1000), secondary: big.NewInt(100), quantity: 2, version: 5},
+			},
+			total:   1200,
+			pretax:  1200,
+			charges: 1,
+		},
+		{
+			name: "flat shipping tax",
+			items: []itemShipping{
+				{option: "a", service: "s", primary: big.NewInt(1000), secondary: big.NewInt(100), quantity: 2, version: 5, shippingTaxPercentage: 0.1},
+			},
+			total:   1210,
+			pretax:  1100,
+			charges: 1,
+		},
+		{
+			name: "tax inclusive shipping",
+			items: []itemShipping{
+				{option: "a", service: "s", primary: big.NewInt(1100), secondary: big.NewInt(550), quantity: 2, version: 5, shippingTaxPercentage: 0.1, taxInclusive: true},
+			},
+			total:   1650,
+			pretax:  1500,
+			charges: 1,
+		},
+		{
+			name: "weight brackets use the combined weight",
+			items: []itemShipping{
+				{option: "a", service: "s", weightRates: weightRates, quantity: 1, grams: 800},
+				{option: "A", service: "S", weightRates: weightRates, quantity: 1, grams: 800},
+			},
+			total:   1500,
+			pretax:  1500,
+			charges: 1,
+		},
+		{
+			name: "weight over the largest bracket",
+			items: []itemShipping{
+				{option: "a", service: "s", weightRates: weightRates, quantity: 1, grams: 6000},
+			},
+			wantErr: true,
+		},
+		{
+			name: "quantity tiers charge the highest listing price",
+			items: []itemShipping{
+				{option: "a", service: "s", quantityRates: []shippingRate{{max: 5, price: big.NewInt(300)}}, quantity: 2},
+				{option: "a", service: "s", quantityRates: []shippingRate{{max: 10, price: big.NewInt(400)}}, quantity: 2},
+			},
+			total:   400,
+			pretax:  400,
+			charges: 1,
+		},
+		{
+			name: "tiered and flat services are charged separately",
+			items: []itemShipping{
+				{option: "a", service: "s", quantityRates: []shippingRate{{max: 5, price: big.NewInt(300)}}, quantity: 2},
+				{option: "a", service: "t", primary: big.NewInt(1000), secondary: big.NewInt(100), quantity: 1, version: 5},
+			},
+			total:   1300,
+			pretax:  1300,
+			charges: 2,
+		},
+	}
+	for _, test := range tests {
+		charges, err := combineShippingCharges(test.items)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+		if len(charges) != test.charges {
+			t.Errorf("%s: expected %d charges, got %d", test.name, test.charges, len(charges))
+		}
+		total, pretax := big.NewInt(0), big.NewInt(0)
+		for _, c := range charges {
+			total.Add(total, c.total)
+			pretax.Add(pretax, c.pretax)
+		}
+		if total.Int64() != test.total {
+			t.Errorf("%s: expected total %d, got %s", test.name, test.total, total)
+		}
+		if pretax.Int64() != test.pretax {
+			t.Errorf("%s: expected pretax %d, got %s", test.name, test.pretax, pretax)
+		}
+	}
+}
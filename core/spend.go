@@ -19,6 +19,8 @@ const DefaultCurrencyDivisibility uint = 8
 
 type SpendRequest struct {
 	decodedAddress btcutil.Address
+	approved       bool
+	payout         bool
 
 	Amount                 string                   `json:"amount"`
 	Currency               *repo.CurrencyDefinition `json:"currency"`
@@ -29,6 +31,7 @@ type SpendRequest struct {
 	OrderID                string                   `json:"orderId"`
 	RequireAssociatedOrder bool                     `json:"requireOrder"`
 	SpendAll               bool                     `json:"spendAll"`
+	TOTP                   string                   `json:"totp,omitempty"`
 }
 
 type SpendResponse struct {
@@ -90,6 +93,16 @@ func (n *OpenBazaarNode) Spend(args *SpendRequest) (*SpendResponse, error) {
 		return nil, ErrOrderNotFound
 	}
 
+	// The daily limit counts the wallet's transactions, so the spend is sent
+	// before the next spend from the wallet is checked
+	spendLock := n.walletSpendLock(wal)
+	spendLock.Lock()
+	defer spendLock.Unlock()
+
+	if err := n.applySpendingPolicy(wal, args, amt); err != nil {
+		return nil, err
+	}
+
 	switch strings.ToUpper(args.FeeLevel) {
 	case "PRIORITY":
 		feeLevel = wallet.PRIORITY
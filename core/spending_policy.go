@@ -0,0 +1,304 @@
+package core
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/btcsuite/btcutil/base58"
+)
+
+const (
+	// totpStep is the number of seconds each authenticator code is valid for
+	totpStep = 30
+	// totpSkew is the number of steps either side of now which are accepted
+	// to allow for clock drift
+	totpSkew = 1
+)
+
+// spendingPolicy returns the policy configured for the wallet or nil. Testnet
+// wallets use the policy of the corresponding mainnet coin.
+func (n *OpenBazaarNode) spendingPolicy(currencyCode string) *schema.SpendingPolicy {
+	code := strings.ToUpper(currencyCode)
+	if policy, ok := n.SpendingPolicies[code]; ok {
+		return policy
+	}
+	if n.TestNetworkEnabled() || n.RegressionNetworkEnabled() {
+		return n.SpendingPolicies[repo.MainnetCurrencyCode(code)]
+	}
+	return nil
+}
+
+// walletSpendLock returns the lock held from the spending policy check of a
+// spend from the wallet until it is sent
+func (n *OpenBazaarNode) walletSpendLock(wal wallet.Wallet) *sync.Mutex {
+	n.spendLocksLock.Lock()
+	defer n.spendLocksLock.Unlock()
+	code := strings.ToUpper(wal.CurrencyCode())
+	if n.spendLocks == nil {
+		n.spendLocks = make(map[string]*sync.Mutex)
+	}
+	if _, ok := n.spendLocks[code]; !ok {
+		n.spendLocks[code] = new(sync.Mutex)
+	}
+	return n.spendLocks[code]
+}
+
+// applySpendingPolicy returns an error if the spending policy of the wallet
+// does not allow the spend to go ahead. Spends at or above the approval
+// threshold are saved as pending and ErrSpendPendingApproval is returned,
+// unless the request carries a valid authenticator code or was approved.
+// The automatic payouts of orders go to the buyers and are not limited to
+// the allowed addresses.
+func (n *OpenBazaarNode) applySpendingPolicy(wal wallet.Wallet, args *SpendRequest, amount *big.Int) error {
+	policy := n.spendingPolicy(wal.CurrencyCode())
+	if policy == nil {
+		return nil
+	}
+
+	if len(policy.AllowedAddresses) > 0 && !args.payout && !spendAddressAllowed(wal, policy.AllowedAddresses, args) {
+		return ErrSpendAddressNotAllowed
+	}
+
+	if args.SpendAll {
+		confirmed, unconfirmed := wal.Balance()
+		amount = new(big.Int).Add(&confirmed.Value, &unconfirmed.Value)
+	}
+
+	if policy.DailyLimit != "" {
+		limit, _ := new(big.Int).SetString(policy.DailyLimit, 10)
+		txns, err := wal.Transactions()
+		if err != nil {
+			return fmt.Errorf("checking daily spend limit: %s", err)
+		}
+		spent := spentSince(txns, time.Now().Add(-24*time.Hour))
+		if spent.Add(spent, amount).Cmp(limit) > 0 {
+			return ErrSpendDailyLimitExceeded
+		}
+	}
+
+	if policy.ApprovalThreshold == "" || args.approved {
+		return nil
+	}
+	threshold, _ := new(big.Int).SetString(policy.ApprovalThreshold, 10)
+	if amount.Cmp(threshold) < 0 {
+		return nil
+	}
+	if args.TOTP != "" {
+		if policy.TOTPSecret == "" || !n.useTOTP(policy.TOTPSecret, args.TOTP, time.Now()) {
+			return ErrInvalidTOTP
+		}
+		return nil
+	}
+	return n.holdSpend(wal, args, amount)
+}
+
+// holdSpend saves the spend until it is approved or cancelled
+func (n *OpenBazaarNode) holdSpend(wal wallet.Wallet, args *SpendRequest, amount *big.Int) error {
+	idBytes := make([]byte, 16)
+	if _, err := rand.Read(idBytes); err != nil {
+		return err
+	}
+	coin := strings.ToUpper(wal.CurrencyCode())
+	req := *args
+	req.CurrencyCode = coin
+	req.TOTP = ""
+	ser, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	spend := repo.PendingSpend{
+		ID:        base58.Encode(idBytes),
+		Coin:      coin,
+		Amount:    amount.String(),
+		Address:   args.Address,
+		Memo:      args.Memo,
+		OrderID:   args.OrderID,
+		Timestamp: repo.NewAPITime(time.Now()),
+		Request:   ser,
+	}
+	if err := n.Datastore.PendingSpends().Put(spend); err != nil {
+		return fmt.Errorf("failed persisting pending spend: %s", err)
+	}
+	log.Noticef("Spend of %s %s to %s is pending approval (%s)", spend.Amount, spend.Coin, spend.Address, spend.ID)
+	return ErrSpendPendingApproval{Spend: spend}
+}
+
+// GetPendingSpends returns the spends awaiting approval, oldest first
+func (n *OpenBazaarNode) GetPendingSpends() ([]repo.PendingSpend, error) {
+	return n.Datastore.PendingSpends().GetAll()
+}
+
+// ApprovePendingSpend sends a spend held by the spending policy. When the
+// policy has a TOTP secret a valid authenticator code is required. The
+// spend is removed from the pending spends before it is sent, so that it is
+// sent once however many approvals arrive. It is put back if it fails so
+// that it can be retried or cancelled. Order payments are sent to the
+// vendor as they are by /ob/orderspend.
+func (n *OpenBazaarNode) ApprovePendingSpend(id, totp string) (*SpendResponse, error) {
+	spend, err := n.claimPendingSpend(id, totp)
+	if err != nil {
+		return nil, err
+	}
+
+	var args SpendRequest
+	if err := json.Unmarshal(spend.Request, &args); err != nil {
+		n.restorePendingSpend(spend)
+		return nil, fmt.Errorf("failed reading pending spend: %s", err)
+	}
+	args.approved = true
+	result, err := n.Spend(&args)
+	if err != nil {
+		n.restorePendingSpend(spend)
+		return nil, err
+	}
+	if args.RequireAssociatedOrder {
+		if err := n.SendOrderPayment(result); err != nil {
+			log.Errorf("error sending order with id %s payment: %v", result.OrderID, err)
+		}
+	}
+	return result, nil
+}
+
+// claimPendingSpend checks the authenticator code and removes the pending
+// spend, returning it to be sent
+func (n *OpenBazaarNode) claimPendingSpend(id, totp string) (*repo.PendingSpend, error) {
+	n.pendingSpendLock.Lock()
+	defer n.pendingSpendLock.Unlock()
+
+	spend, err := n.Datastore.PendingSpends().Get(id)
+	if err != nil {
+		return nil, ErrPendingSpendNotFound
+	}
+	policy := n.spendingPolicy(spend.Coin)
+	if policy != nil && policy.TOTPSecret != "" && !n.useTOTP(policy.TOTPSecret, totp, time.Now()) {
+		return nil, ErrInvalidTOTP
+	}
+	if err := n.Datastore.PendingSpends().Delete(id); err != nil {
+		return nil, fmt.Errorf("failed claiming pending spend: %s", err)
+	}
+	return spend, nil
+}
+
+// restorePendingSpend puts back a claimed spend which was not sent
+func (n *OpenBazaarNode) restorePendingSpend(spend *repo.PendingSpend) {
+	n.pendingSpendLock.Lock()
+	defer n.pendingSpendLock.Unlock()
+
+	if err := n.Datastore.PendingSpends().Put(*spend); err != nil {
+		log.Errorf("failed restoring pending spend %s: %s", spend.ID, err)
+	}
+}
+
+// CancelPendingSpend discards a spend held by the spending policy
+func (n *OpenBazaarNode) CancelPendingSpend(id string) error {
+	n.pendingSpendLock.Lock()
+	defer n.pendingSpendLock.Unlock()
+
+	if _, err := n.Datastore.PendingSpends().Get(id); err != nil {
+		return ErrPendingSpendNotFound
+	}
+	return n.Datastore.PendingSpends().Delete(id)
+}
+
+func spendAddressAllowed(wal wallet.Wallet, allowed []string, args *SpendRequest) bool {
+	for _, a := range allowed {
+		if strings.EqualFold(a, args.Address) {
+			return true
+		}
+		addr, err := wal.DecodeAddress(a)
+		if err == nil && args.decodedAddress != nil && addr.String() == args.decodedAddress.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// spentSince returns the total of the outgoing transactions seen after since
+func spentSince(txns []wallet.Txn, since time.Time) *big.Int {
+	spent := new(big.Int)
+	for _, txn := range txns {
+		if txn.WatchOnly || txn.Timestamp.Before(since) {
+			continue
+		}
+		value, ok := new(big.Int).SetString(txn.Value, 10)
+		if !ok || value.Sign() >= 0 {
+			continue
+		}
+		spent.Sub(spent, value)
+	}
+	return spent
+}
+
+// useTOTP checks the authenticator code and records it as used. Codes which
+// are not newer than the last code accepted for the secret are rejected. The
+// counter is saved in the database so that codes can't be replayed after a
+// restart.
+func (n *OpenBazaarNode) useTOTP(secret, code string, now time.Time) bool {
+	counter, ok := matchTOTP(secret, code, now)
+	if !ok {
+		return false
+	}
+	n.totpLock.Lock()
+	defer n.totpLock.Unlock()
+
+	// The secret itself is kept out of the database
+	sum := sha256.Sum256([]byte(secret))
+	secretID := hex.EncodeToString(sum[:])
+	last, err := n.Datastore.Config().GetTOTPCounter(secretID)
+	if err != nil {
+		log.Errorf("reading the last authenticator code: %s", err)
+		return false
+	}
+	if counter <= last {
+		return false
+	}
+	if err := n.Datastore.Config().PutTOTPCounter(secretID, counter); err != nil {
+		log.Errorf("recording the authenticator code: %s", err)
+		return false
+	}
+	return true
+}
+
+// matchTOTP checks the code against the RFC 6238 authenticator codes of the
+// base32 encoded secret around the given time and returns the counter of
+// the matching code
+func matchTOTP(secret, code string, now time.Time) (int64, bool) {
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil || len(code) != 6 {
+		return 0, false
+	}
+	counter := now.Unix() / totpStep
+	for i := int64(-totpSkew); i <= totpSkew; i++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, uint64(counter+i))), []byte(code)) == 1 {
+			return counter + i, true
+		}
+	}
+	return 0, false
+}
+
+// totpCode returns the six digit HOTP code for the counter (RFC 4226)
+func totpCode(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%06d", value%1000000)
+}
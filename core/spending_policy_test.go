@@ -0,0 +1,204 @@
+package core_test
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/openbazaar-go/test"
+)
+
+const testSpendAddress = "1HYhu8e2wv19LZ2umXoo1pMiwzy2rL32UQ"
+
+// newTestTOTPSecret returns a random base32 encoded secret. The last code
+// accepted for a secret is saved in the test database, which is shared by
+// test runs.
+func newTestTOTPSecret(t *testing.T) string {
+	key := make([]byte, 20)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatal(err)
+	}
+	return base32.StdEncoding.EncodeToString(key)
+}
+
+// testTOTP returns the current authenticator code of the secret as
+// described by RFC 6238
+func testTOTP(t *testing.T, secret string) string {
+	key, err := base32.StdEncoding.DecodeString(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(time.Now().Unix()/30))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	return fmt.Sprintf("%06d", (binary.BigEndian.Uint32(sum[offset:offset+4])&0x7fffffff)%1000000)
+}
+
+func newSpendingPolicyNode(t *testing.T, policy *schema.SpendingPolicy) *core.OpenBazaarNode {
+	node, err := test.NewNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node.SpendingPolicies = map[string]*schema.SpendingPolicy{"BTC": policy}
+
+	// The test database is shared with earlier tests
+	spends, err := node.GetPendingSpends()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, spend := range spends {
+		if err := node.CancelPendingSpend(spend.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return node
+}
+
+func newPolicySpendRequest(amount string) *core.SpendRequest {
+	return &core.SpendRequest{
+		CurrencyCode: "TBTC",
+		Address:      testSpendAddress,
+		Amount:       amount,
+		FeeLevel:     "NORMAL",
+		Memo:         "rent",
+	}
+}
+
+func TestSpendingPolicyAllowedAddresses(t *testing.T) {
+	node := newSpendingPolicyNode(t, &schema.SpendingPolicy{
+		AllowedAddresses: []string{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"},
+	})
+
+	if _, err := node.Spend(newPolicySpendRequest("1234")); err != core.ErrSpendAddressNotAllowed {
+		t.Errorf("expected %s, got %v", core.ErrSpendAddressNotAllowed, err)
+	}
+
+	node.SpendingPolicies["BTC"].AllowedAddresses = append(node.SpendingPolicies["BTC"].AllowedAddresses, testSpendAddress)
+	if _, err := node.Spend(newPolicySpendRequest("1234")); err != core.ErrInsufficientFunds {
+		t.Errorf("expected the allowed address to reach the wallet, got %v", err)
+	}
+}
+
+func TestSpendingPolicyDailyLimit(t *testing.T) {
+	node := newSpendingPolicyNode(t, &schema.SpendingPolicy{DailyLimit: "1000"})
+
+	if _, err := node.Spend(newPolicySpendRequest("1001")); err != core.ErrSpendDailyLimitExceeded {
+		t.Errorf("expected %s, got %v", core.ErrSpendDailyLimitExceeded, err)
+	}
+	if _, err := node.Spend(newPolicySpendRequest("1000")); err != core.ErrInsufficientFunds {
+		t.Errorf("expected a spend within the limit to reach the wallet, got %v", err)
+	}
+}
+
+func TestSpendingPolicyPendingApproval(t *testing.T) {
+	node := newSpendingPolicyNode(t, &schema.SpendingPolicy{ApprovalThreshold: "1000"})
+
+	if _, err := node.Spend(newPolicySpendRequest("999")); err != core.ErrInsufficientFunds {
+		t.Errorf("expected a spend below the threshold to reach the wallet, got %v", err)
+	}
+
+	_, err := node.Spend(newPolicySpendRequest("1000"))
+	pending, ok := err.(core.ErrSpendPendingApproval)
+	if !ok {
+		t.Fatalf("expected the spend to be pending approval, got %v", err)
+	}
+	if pending.Spend.ID == "" || pending.Spend.Coin != "TBTC" || pending.Spend.Amount != "1000" ||
+		pending.Spend.Address != testSpendAddress || pending.Spend.Memo != "rent" {
+		t.Errorf("unexpected pending spend %+v", pending.Spend)
+	}
+
+	spends, err := node.GetPendingSpends()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spends) != 1 || spends[0].ID != pending.Spend.ID {
+		t.Fatalf("expected the spend to be listed, got %+v", spends)
+	}
+
+	// Approval sends the spend, which fails here as the wallet is empty.
+	// Failed spends stay pending.
+	if _, err := node.ApprovePendingSpend(pending.Spend.ID, ""); err != core.ErrInsufficientFunds {
+		t.Errorf("expected the approved spend to reach the wallet, got %v", err)
+	}
+	if spends, _ := node.GetPendingSpends(); len(spends) != 1 {
+		t.Errorf("expected the failed spend to remain pending, got %+v", spends)
+	}
+
+	if err := node.CancelPendingSpend(pending.Spend.ID); err != nil {
+		t.Fatal(err)
+	}
+	if spends, _ := node.GetPendingSpends(); len(spends) != 0 {
+		t.Errorf("expected no pending spends after cancelling, got %+v", spends)
+	}
+	if err := node.CancelPendingSpend(pending.Spend.ID); err != core.ErrPendingSpendNotFound {
+		t.Errorf("expected %s, got %v", core.ErrPendingSpendNotFound, err)
+	}
+	if _, err := node.ApprovePendingSpend(pending.Spend.ID, ""); err != core.ErrPendingSpendNotFound {
+		t.Errorf("expected %s, got %v", core.ErrPendingSpendNotFound, err)
+	}
+}
+
+func TestSpendingPolicyTOTP(t *testing.T) {
+	testTOTPSecret := newTestTOTPSecret(t)
+	node := newSpendingPolicyNode(t, &schema.SpendingPolicy{
+		ApprovalThreshold: "1000",
+		TOTPSecret:        testTOTPSecret,
+	})
+
+	req := newPolicySpendRequest("5000")
+	req.TOTP = "000000"
+	if testTOTP(t, testTOTPSecret) == req.TOTP {
+		req.TOTP = "111111"
+	}
+	if _, err := node.Spend(req); err != core.ErrInvalidTOTP {
+		t.Errorf("expected %s, got %v", core.ErrInvalidTOTP, err)
+	}
+
+	_, err := node.Spend(newPolicySpendRequest("5000"))
+	pending, ok := err.(core.ErrSpendPendingApproval)
+	if !ok {
+		t.Fatalf("expected the spend to be pending approval, got %v", err)
+	}
+	if _, err := node.ApprovePendingSpend(pending.Spend.ID, ""); err != core.ErrInvalidTOTP {
+		t.Errorf("expected approval without a code to fail with %s, got %v", core.ErrInvalidTOTP, err)
+	}
+	code := testTOTP(t, testTOTPSecret)
+	if _, err := node.ApprovePendingSpend(pending.Spend.ID, code); err != core.ErrInsufficientFunds {
+		t.Errorf("expected approval with a valid code to reach the wallet, got %v", err)
+	}
+
+	// Each code is accepted once
+	if _, err := node.ApprovePendingSpend(pending.Spend.ID, code); err != core.ErrInvalidTOTP {
+		t.Errorf("expected a reused code to fail with %s, got %v", core.ErrInvalidTOTP, err)
+	}
+	req.TOTP = code
+	if _, err := node.Spend(req); err != core.ErrInvalidTOTP {
+		t.Errorf("expected a reused code to fail with %s, got %v", core.ErrInvalidTOTP, err)
+	}
+	if spends, _ := node.GetPendingSpends(); len(spends) != 1 {
+		t.Errorf("expected the failed spend to remain pending, got %+v", spends)
+	}
+
+	// Used codes are remembered across restarts
+	restarted, err := test.NewNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	restarted.SpendingPolicies = node.SpendingPolicies
+	if _, err := restarted.ApprovePendingSpend(pending.Spend.ID, code); err != core.ErrInvalidTOTP {
+		t.Errorf("expected a code reused after a restart to fail with %s, got %v", core.ErrInvalidTOTP, err)
+	}
+	if err := node.CancelPendingSpend(pending.Spend.ID); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,493 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+)
+
+// SubscriptionOrder is the order placed for a period of a subscription
+type SubscriptionOrder struct {
+	OrderID        string              `json:"orderId"`
+	PaymentAddress string              `json:"paymentAddress"`
+	Amount         *repo.CurrencyValue `json:"amount"`
+	VendorOnline   bool                `json:"vendorOnline"`
+}
+
+// Subscribe signs an agreement to order the item of a subscription listing
+// every period, sends it to the vendor and places the first order. The
+// first order is paid like any other purchase; the following orders are
+// placed by the subscription scheduler.
+func (n *OpenBazaarNode) Subscribe(data *repo.SubscriptionData) (*repo.Subscription, *SubscriptionOrder, error) {
+	if len(data.Items) != 1 {
+		return nil, nil, errors.New("subscriptions must order a single item")
+	}
+	if data.Periods == 0 {
+		return nil, nil, errors.New("subscriptions must have at least one period")
+	}
+	if data.AutoPayCap != "" {
+		if limit, ok := new(big.Int).SetString(data.AutoPayCap, 10); !ok || limit.Sign() <= 0 {
+			return nil, nil, errors.New("invalid auto pay cap")
+		}
+	}
+
+	contract, err := n.createContractWithOrder(&data.PurchaseData)
+	if err != nil {
+		return nil, nil, err
+	}
+	listing := contract.VendorListings[0]
+	terms := listing.Subscription
+	if terms == nil {
+		return nil, nil, ErrListingNotSubscription
+	}
+	if terms.MaxPeriods > 0 && data.Periods > terms.MaxPeriods {
+		return nil, nil, fmt.Errorf("listing allows at most %d periods", terms.MaxPeriods)
+	}
+	price, err := subscriptionListingPrice(listing)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	timestamp, err := ptypes.TimestampProto(now)
+	if err != nil {
+		return nil, nil, err
+	}
+	agreement := &pb.SubscriptionAgreement{
+		VendorID:     listing.VendorID.PeerID,
+		BuyerID:      contract.BuyerOrder.BuyerID,
+		ListingSlug:  listing.Slug,
+		IntervalDays: terms.IntervalDays,
+		Periods:      data.Periods,
+		BigPrice:     price.Amount.String(),
+		PriceCurrency: &pb.CurrencyDefinition{
+			Code:         price.Currency.Code.String(),
+			Divisibility: uint32(price.Currency.Divisibility),
+		},
+		Timestamp: timestamp,
+	}
+	ser, err := proto.Marshal(agreement)
+	if err != nil {
+		return nil, nil, err
+	}
+	id, err := ipfs.EncodeMultihash(ser)
+	if err != nil {
+		return nil, nil, err
+	}
+	agreement.SubscriptionID = id.B58String()
+	ser, err = proto.Marshal(agreement)
+	if err != nil {
+		return nil, nil, err
+	}
+	signature, err := n.IpfsNode.PrivateKey.Sign(ser)
+	if err != nil {
+		return nil, nil, err
+	}
+	signed := &pb.SignedSubscriptionAgreement{Agreement: agreement, Signature: signature}
+	if err := n.SendSubscription(agreement.VendorID, signed); err != nil {
+		return nil, nil, err
+	}
+
+	// the quote only prices the first order
+	purchase := data.PurchaseData
+	purchase.PriceQuote = nil
+	sub := &repo.Subscription{
+		ID:           agreement.SubscriptionID,
+		Role:         repo.SubscriptionBuyer,
+		PeerID:       agreement.VendorID,
+		Status:       repo.SubscriptionActive,
+		AutoPayCap:   data.AutoPayCap,
+		NextOrder:    repo.NewAPITime(now),
+		Timestamp:    repo.NewAPITime(now),
+		Agreement:    signed,
+		PurchaseData: &purchase,
+	}
+	if err := n.Datastore.Subscriptions().Put(*sub); err != nil {
+		return nil, nil, err
+	}
+
+	order, err := n.placeSubscriptionOrder(sub, &data.PurchaseData, now)
+	if err != nil {
+		if _, cErr := n.CancelSubscription(sub.ID); cErr != nil {
+			log.Errorf("cancelling subscription %s: %s", sub.ID, cErr)
+		}
+		return nil, nil, err
+	}
+	return sub, order, nil
+}
+
+// GetSubscriptions returns the subscriptions of the node as buyer and
+// vendor, newest first
+func (n *OpenBazaarNode) GetSubscriptions() ([]repo.Subscription, error) {
+	return n.Datastore.Subscriptions().GetAll()
+}
+
+// GetSubscription returns a subscription by its ID
+func (n *OpenBazaarNode) GetSubscription(id string) (*repo.Subscription, error) {
+	sub, err := n.Datastore.Subscriptions().Get(id)
+	if err != nil {
+		return nil, ErrSubscriptionNotFound
+	}
+	return sub, nil
+}
+
+// CancelSubscription stops an active subscription and tells the other party.
+// Orders already placed are not affected.
+func (n *OpenBazaarNode) CancelSubscription(id string) (*repo.Subscription, error) {
+	n.subscriptionLock.Lock()
+	sub, err := n.Datastore.Subscriptions().Get(id)
+	if err != nil {
+		n.subscriptionLock.Unlock()
+		return nil, ErrSubscriptionNotFound
+	}
+	if sub.Status != repo.SubscriptionActive {
+		n.subscriptionLock.Unlock()
+		return nil, ErrSubscriptionInactive
+	}
+	sub.Status = repo.SubscriptionCancelled
+	sub.NextOrder = nil
+	err = n.Datastore.Subscriptions().Put(*sub)
+	n.subscriptionLock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := n.SendSubscriptionCancel(sub.PeerID, id); err != nil {
+		log.Errorf("sending subscription %s cancellation: %s", id, err)
+	}
+	return sub, nil
+}
+
+// AcceptSubscription stores a buyer's subscription to one of the node's
+// listings after checking the agreement matches the listing
+func (n *OpenBazaarNode) AcceptSubscription(signed *pb.SignedSubscriptionAgreement, buyerID string) error {
+	agreement := signed.GetAgreement()
+	if agreement == nil || agreement.BuyerID == nil || agreement.BuyerID.Pubkeys == nil {
+		return errors.New("subscription agreement is incomplete")
+	}
+	if agreement.BuyerID.PeerID != buyerID {
+		return errors.New("subscription agreement is not from the buyer")
+	}
+	if err := verifySignature(agreement, agreement.BuyerID.Pubkeys.Identity, signed.Signature, buyerID); err != nil {
+		return fmt.Errorf("subscription agreement signature: %s", err.Error())
+	}
+	if agreement.VendorID != n.IpfsNode.Identity.Pretty() {
+		return errors.New("subscription agreement is for another vendor")
+	}
+
+	sl, err := n.GetListingFromSlug(agreement.ListingSlug)
+	if err != nil {
+		return ErrPurchaseUnknownListing
+	}
+	terms := sl.Listing.Subscription
+	if terms == nil {
+		return ErrListingNotSubscription
+	}
+	if agreement.IntervalDays != terms.IntervalDays {
+		return errors.New("subscription interval does not match the listing")
+	}
+	if agreement.Periods == 0 || (terms.MaxPeriods > 0 && agreement.Periods > terms.MaxPeriods) {
+		return errors.New("invalid number of subscription periods")
+	}
+	price, err := subscriptionListingPrice(sl.Listing)
+	if err != nil {
+		return err
+	}
+	if !subscriptionPriceCovers(agreement, price) {
+		return ErrSubscriptionPriceChanged
+	}
+
+	n.subscriptionLock.Lock()
+	defer n.subscriptionLock.Unlock()
+	if _, err := n.Datastore.Subscriptions().Get(agreement.SubscriptionID); err == nil {
+		return nil
+	}
+	timestamp, err := ptypes.Timestamp(agreement.Timestamp)
+	if err != nil {
+		timestamp = time.Now()
+	}
+	sub := repo.Subscription{
+		ID:        agreement.SubscriptionID,
+		Role:      repo.SubscriptionVendor,
+		PeerID:    buyerID,
+		Status:    repo.SubscriptionActive,
+		Timestamp: repo.NewAPITime(timestamp),
+		Agreement: signed,
+	}
+	if err := n.Datastore.Subscriptions().Put(sub); err != nil {
+		return err
+	}
+	n.notifySubscription(repo.SubscriptionNotification{
+		ID:             repo.NewNotificationID(),
+		Type:           repo.NotifierTypeSubscriptionNotification,
+		SubscriptionID: sub.ID,
+		PeerID:         buyerID,
+		Status:         sub.Status,
+	})
+	return nil
+}
+
+// SubscriptionCancelled records the cancellation of a subscription by the
+// other party
+func (n *OpenBazaarNode) SubscriptionCancelled(id, peerID string) error {
+	n.subscriptionLock.Lock()
+	defer n.subscriptionLock.Unlock()
+	sub, err := n.Datastore.Subscriptions().Get(id)
+	if err != nil || sub.PeerID != peerID {
+		return ErrSubscriptionNotFound
+	}
+	if sub.Status != repo.SubscriptionActive {
+		return ErrSubscriptionInactive
+	}
+	sub.Status = repo.SubscriptionCancelled
+	sub.NextOrder = nil
+	if err := n.Datastore.Subscriptions().Put(*sub); err != nil {
+		return err
+	}
+	n.notifySubscription(repo.SubscriptionNotification{
+		ID:             repo.NewNotificationID(),
+		Type:           repo.NotifierTypeSubscriptionNotification,
+		SubscriptionID: id,
+		PeerID:         peerID,
+		Status:         sub.Status,
+	})
+	return nil
+}
+
+// ValidateSubscriptionOrder checks an incoming order of a subscription
+// belongs to an active subscription of the buyer to the listing. Orders
+// without a subscription are not checked.
+func (n *OpenBazaarNode) ValidateSubscriptionOrder(contract *pb.RicardianContract, orderID string) error {
+	n.subscriptionLock.Lock()
+	defer n.subscriptionLock.Unlock()
+	_, err := n.subscriptionOfOrder(contract, orderID)
+	return err
+}
+
+// CountSubscriptionOrder counts the period of a subscription order once the
+// order has been accepted
+func (n *OpenBazaarNode) CountSubscriptionOrder(contract *pb.RicardianContract, orderID string) error {
+	n.subscriptionLock.Lock()
+	defer n.subscriptionLock.Unlock()
+	sub, err := n.subscriptionOfOrder(contract, orderID)
+	if err != nil || sub == nil || sub.LastOrderID == orderID {
+		return err
+	}
+	agreement := sub.Agreement.GetAgreement()
+	sub.PeriodsCompleted++
+	sub.LastOrderID = orderID
+	if sub.PeriodsCompleted >= agreement.GetPeriods() {
+		sub.Status = repo.SubscriptionCompleted
+	}
+	return n.Datastore.Subscriptions().Put(*sub)
+}
+
+// subscriptionOfOrder returns the subscription the order belongs to, or nil
+// if the order has no subscription. The subscription lock must be held.
+func (n *OpenBazaarNode) subscriptionOfOrder(contract *pb.RicardianContract, orderID string) (*repo.Subscription, error) {
+	id := contract.GetBuyerOrder().GetSubscriptionID()
+	if id == "" {
+		return nil, nil
+	}
+	sub, err := n.Datastore.Subscriptions().Get(id)
+	if err != nil || sub.Role != repo.SubscriptionVendor || sub.PeerID != contract.BuyerOrder.GetBuyerID().GetPeerID() {
+		return nil, ErrSubscriptionNotFound
+	}
+	if sub.LastOrderID == orderID {
+		return sub, nil
+	}
+	if sub.Status != repo.SubscriptionActive {
+		return nil, ErrSubscriptionInactive
+	}
+	agreement := sub.Agreement.GetAgreement()
+	if len(contract.BuyerOrder.Items) != 1 || len(contract.VendorListings) != 1 ||
+		contract.VendorListings[0].Slug != agreement.GetListingSlug() {
+		return nil, errors.New("order does not match the subscription")
+	}
+	return sub, nil
+}
+
+// StartSubscriptionScheduler orders the due periods of the node's
+// subscriptions now and then at every notifier interval
+func (n *OpenBazaarNode) StartSubscriptionScheduler() {
+	go func() {
+		t := time.NewTicker(n.intervalDelay())
+		for ; true; <-t.C {
+			n.ProcessSubscriptions(time.Now())
+		}
+	}()
+}
+
+// ProcessSubscriptions orders the periods of the node's subscriptions which
+// are due. Each order is paid from the wallet when its amount is within the
+// subscription's auto pay cap, otherwise the buyer is notified to pay it.
+// Runs are serialized so that a period is not ordered by two runs.
+func (n *OpenBazaarNode) ProcessSubscriptions(now time.Time) {
+	n.processSubscriptionsLock.Lock()
+	defer n.processSubscriptionsLock.Unlock()
+	subs, err := n.Datastore.Subscriptions().GetDue(now)
+	if err != nil {
+		log.Errorf("loading due subscriptions: %s", err)
+		return
+	}
+	for i := range subs {
+		if err := n.orderSubscriptionPeriod(&subs[i], now); err != nil {
+			log.Errorf("ordering subscription %s: %s", subs[i].ID, err)
+		}
+	}
+}
+
+func (n *OpenBazaarNode) orderSubscriptionPeriod(sub *repo.Subscription, now time.Time) error {
+	if sub.PurchaseData == nil || sub.Agreement.GetAgreement() == nil {
+		return errors.New("subscription is missing its order")
+	}
+	data := *sub.PurchaseData
+
+	// the vendor may not raise the price of a subscription
+	contract, err := n.createContractWithOrder(&data)
+	if err != nil {
+		return err
+	}
+	price, err := subscriptionListingPrice(contract.VendorListings[0])
+	if err != nil {
+		return err
+	}
+	if !subscriptionPriceCovers(sub.Agreement.Agreement, price) {
+		if _, err := n.CancelSubscription(sub.ID); err != nil {
+			log.Errorf("cancelling subscription %s: %s", sub.ID, err)
+		}
+		return ErrSubscriptionPriceChanged
+	}
+
+	order, err := n.placeSubscriptionOrder(sub, &data, now)
+	if err != nil {
+		return err
+	}
+	autoPaid, err := n.autoPaySubscriptionOrder(sub, data.PaymentCoin, order)
+	if err != nil {
+		log.Errorf("paying order %s of subscription %s: %s", order.OrderID, sub.ID, err)
+	}
+	n.notifySubscription(repo.SubscriptionPayment{
+		ID:             repo.NewNotificationID(),
+		Type:           repo.NotifierTypeSubscriptionPayment,
+		SubscriptionID: sub.ID,
+		OrderID:        order.OrderID,
+		PaymentAddress: order.PaymentAddress,
+		Amount:         order.Amount,
+		AutoPaid:       autoPaid,
+	})
+	return nil
+}
+
+// placeSubscriptionOrder purchases a period of the subscription and
+// schedules the next one. Missed periods are not ordered twice, the next
+// order is an interval after a late order.
+func (n *OpenBazaarNode) placeSubscriptionOrder(sub *repo.Subscription, data *repo.PurchaseData, now time.Time) (*SubscriptionOrder, error) {
+	data.SubscriptionID = sub.ID
+	orderID, paymentAddress, amount, vendorOnline, err := n.Purchase(data)
+	if err != nil {
+		return nil, err
+	}
+
+	n.subscriptionLock.Lock()
+	defer n.subscriptionLock.Unlock()
+	if current, err := n.Datastore.Subscriptions().Get(sub.ID); err == nil {
+		*sub = *current
+	}
+	agreement := sub.Agreement.GetAgreement()
+	interval := time.Duration(agreement.GetIntervalDays()) * 24 * time.Hour
+	next := now
+	if sub.NextOrder != nil {
+		next = sub.NextOrder.Time
+	}
+	if next = next.Add(interval); !next.After(now) {
+		next = now.Add(interval)
+	}
+
+	sub.PeriodsCompleted++
+	sub.LastOrderID = orderID
+	sub.NextOrder = repo.NewAPITime(next)
+	if sub.PeriodsCompleted >= agreement.GetPeriods() {
+		sub.Status = repo.SubscriptionCompleted
+	}
+	if sub.Status != repo.SubscriptionActive {
+		sub.NextOrder = nil
+	}
+	if err := n.Datastore.Subscriptions().Put(*sub); err != nil {
+		log.Errorf("updating subscription %s: %s", sub.ID, err)
+	}
+	return &SubscriptionOrder{
+		OrderID:        orderID,
+		PaymentAddress: paymentAddress,
+		Amount:         amount,
+		VendorOnline:   vendorOnline,
+	}, nil
+}
+
+// autoPaySubscriptionOrder pays the order from the wallet when its amount is
+// within the auto pay cap of the subscription. The spend is subject to the
+// wallet's spending policy.
+func (n *OpenBazaarNode) autoPaySubscriptionOrder(sub *repo.Subscription, coin string, order *SubscriptionOrder) (bool, error) {
+	if sub.AutoPayCap == "" || order.Amount == nil || order.Amount.Amount == nil {
+		return false, nil
+	}
+	limit, ok := new(big.Int).SetString(sub.AutoPayCap, 10)
+	if !ok {
+		return false, errors.New("invalid auto pay cap")
+	}
+	if order.Amount.Amount.Cmp(limit) > 0 {
+		return false, nil
+	}
+	result, err := n.Spend(&SpendRequest{
+		Amount:                 order.Amount.Amount.String(),
+		CurrencyCode:           coin,
+		Address:                order.PaymentAddress,
+		FeeLevel:               "NORMAL",
+		OrderID:                order.OrderID,
+		RequireAssociatedOrder: true,
+	})
+	if err != nil {
+		return false, err
+	}
+	if err := n.SendOrderPayment(result); err != nil {
+		log.Errorf("error sending order with id %s payment: %v", result.OrderID, err)
+	}
+	return true, nil
+}
+
+func (n *OpenBazaarNode) notifySubscription(notifier repo.Notifier) {
+	n.Broadcast <- notifier
+	if err := n.Datastore.Notifications().PutRecord(repo.NewNotification(notifier, time.Now(), false)); err != nil {
+		log.Error(err)
+	}
+}
+
+// subscriptionListingPrice returns the item price of the listing
+func subscriptionListingPrice(listing *pb.Listing) (*repo.CurrencyValue, error) {
+	rl, err := repo.NewListingFromProtobuf(listing)
+	if err != nil {
+		return nil, err
+	}
+	return rl.GetPrice()
+}
+
+// subscriptionPriceCovers indicates whether the listing price is no more
+// than the price agreed in the subscription
+func subscriptionPriceCovers(agreement *pb.SubscriptionAgreement, price *repo.CurrencyValue) bool {
+	agreed, ok := new(big.Int).SetString(agreement.GetBigPrice(), 10)
+	if !ok || agreement.PriceCurrency == nil || price.Amount == nil {
+		return false
+	}
+	if agreement.PriceCurrency.Code != price.Currency.Code.String() ||
+		agreement.PriceCurrency.Divisibility != uint32(price.Currency.Divisibility) {
+		return false
+	}
+	return price.Amount.Cmp(agreed) <= 0
+}
@@ -0,0 +1,113 @@
+package core_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	wi "github.com/OpenBazaar/wallet-interface"
+)
+
+func TestOpenBazaarNode_ValidateSubscriptionOrder(t *testing.T) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	node := &core.OpenBazaarNode{Datastore: db.NewSQLiteDatastore(database, new(sync.Mutex), wi.Bitcoin)}
+
+	sub := repo.Subscription{
+		ID:        "sub1",
+		Role:      repo.SubscriptionVendor,
+		PeerID:    "QmBuyer",
+		Status:    repo.SubscriptionActive,
+		Timestamp: repo.NewAPITime(time.Now()),
+		Agreement: &pb.SignedSubscriptionAgreement{
+			Agreement: &pb.SubscriptionAgreement{SubscriptionID: "sub1", ListingSlug: "box", IntervalDays: 30, Periods: 2},
+		},
+	}
+	if err := node.Datastore.Subscriptions().Put(sub); err != nil {
+		t.Fatal(err)
+	}
+
+	order := func(slug, buyer string) *pb.RicardianContract {
+		return &pb.RicardianContract{
+			VendorListings: []*pb.Listing{{Slug: slug}},
+			BuyerOrder: &pb.Order{
+				SubscriptionID: "sub1",
+				BuyerID:        &pb.ID{PeerID: buyer},
+				Items:          []*pb.Order_Item{{ListingHash: "QmListing", BigQuantity: "1"}},
+			},
+		}
+	}
+
+	// orders without a subscription are not checked
+	if err := node.ValidateSubscriptionOrder(&pb.RicardianContract{BuyerOrder: &pb.Order{}}, "order0"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := node.ValidateSubscriptionOrder(order("box", "QmOther"), "order1"); err != core.ErrSubscriptionNotFound {
+		t.Errorf("expected another buyer's order to be rejected, got %v", err)
+	}
+	if err := node.ValidateSubscriptionOrder(order("other", "QmBuyer"), "order1"); err == nil {
+		t.Error("expected an order of another listing to be rejected")
+	}
+
+	// validating an order doesn't count it, as it may still be rejected
+	if err := node.ValidateSubscriptionOrder(order("box", "QmBuyer"), "order1"); err != nil {
+		t.Fatal(err)
+	}
+	ret, err := node.GetSubscription("sub1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.PeriodsCompleted != 0 || ret.LastOrderID != "" {
+		t.Errorf("expected the validated order not to be counted, got %d %s", ret.PeriodsCompleted, ret.LastOrderID)
+	}
+
+	if err := node.CountSubscriptionOrder(order("box", "QmBuyer"), "order1"); err != nil {
+		t.Fatal(err)
+	}
+	// processing the same order twice counts it once
+	if err := node.ValidateSubscriptionOrder(order("box", "QmBuyer"), "order1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := node.CountSubscriptionOrder(order("box", "QmBuyer"), "order1"); err != nil {
+		t.Fatal(err)
+	}
+	ret, err = node.GetSubscription("sub1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.PeriodsCompleted != 1 || ret.Status != repo.SubscriptionActive {
+		t.Errorf("expected 1 active period, got %d %s", ret.PeriodsCompleted, ret.Status)
+	}
+
+	if err := node.CountSubscriptionOrder(order("box", "QmBuyer"), "order2"); err != nil {
+		t.Fatal(err)
+	}
+	ret, err = node.GetSubscription("sub1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.PeriodsCompleted != 2 || ret.Status != repo.SubscriptionCompleted {
+		t.Errorf("expected the subscription to be completed, got %d %s", ret.PeriodsCompleted, ret.Status)
+	}
+	if err := node.ValidateSubscriptionOrder(order("box", "QmBuyer"), "order3"); err != core.ErrSubscriptionInactive {
+		t.Errorf("expected an order past the last period to be rejected, got %v", err)
+	}
+}
@@ -0,0 +1,203 @@
+package core
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+// applyListingTaxes returns what the buyer pays for an amount of the listing
+// shipped to the address, and the tax included in it. The taxes are added
+// to the amount unless the listing is tax inclusive.
+func applyListingTaxes(nrl *repo.Listing, amount *repo.CurrencyValue, shipping *pb.Order_Shipping) (*repo.CurrencyValue, *big.Int) {
+	var percentages []float32
+	for _, tax := range nrl.GetProtobuf().Taxes {
+		if pct, ok := repo.TaxPercentage(tax, shipping); ok && pct > 0 {
+			percentages = append(percentages, pct)
+		}
+	}
+
+	if !nrl.IsTaxInclusive() {
+		total := amount
+		for _, pct := range percentages {
+			total = total.AddBigFloatProduct(toHundredths(pct))
+		}
+		return total, new(big.Int).Sub(total.Amount, amount.Amount)
+	}
+
+	factor := big.NewFloat(1)
+	for _, pct := range percentages {
+		factor.Mul(factor, new(big.Float).Add(big.NewFloat(1), toHundredths(pct)))
+	}
+	net, _ := new(big.Float).Quo(new(big.Float).SetInt(amount.Amount), factor).Int(nil)
+	return amount, new(big.Int).Sub(amount.Amount, net)
+}
+
+// shippingTaxPercentage returns the fraction of the shipping price charged as
+// tax when shipping to the address
+func shippingTaxPercentage(nrl *repo.Listing, shipping *pb.Order_Shipping) float32 {
+	var shippingTaxPercentage float32
+	for _, tax := range nrl.GetProtobuf().Taxes {
+		if pct, ok := repo.TaxPercentage(tax, shipping); ok && tax.TaxShipping {
+			shippingTaxPercentage = pct / 100
+		}
+	}
+	return shippingTaxPercentage
+}
+
+// getItemUnitAmount returns the price of one unit of an order item in the
+// listing currency, with the surcharge and coupons applied
+func getItemUnitAmount(nrl *repo.Listing, item *pb.Order_Item) (*repo.CurrencyValue, error) {
+	itemOriginAmt, err := GetOriginalAmount(nrl, item)
+	if err != nil {
+		return nil, err
+	}
+
+	// apply surcharges
+	itemSurcharge, err := GetItemSurchargeAmount(nrl, item.Options)
+	if err != nil {
+		return nil, err
+	}
+	itemOriginAmt = itemOriginAmt.AddBigInt(itemSurcharge)
+
+	// apply coupon discounts
+	totalDiscount, err := GetTotalCouponCodeDiscount(nrl, item.CouponCodes, itemOriginAmt)
+	if err != nil {
+		return nil, err
+	}
+	return itemOriginAmt.AddBigInt(totalDiscount), nil
+}
+
+// getItemLineQuantity returns the number of units the line of an order item
+// is priced for
+func getItemLineQuantity(nrl *repo.Listing, item *pb.Order_Item) *big.Int {
+	if nrl.GetContractType() == pb.Listing_Metadata_CRYPTOCURRENCY.String() &&
+		nrl.GetFormat() == pb.Listing_Metadata_MARKET_PRICE.String() {
+		return big.NewInt(1)
+	}
+	if itemQuantity := GetOrderQuantity(nrl.GetProtobuf(), item); itemQuantity.Cmp(big.NewInt(0)) > 0 {
+		return itemQuantity
+	}
+	log.Debugf("missing quantity for order, assuming quantity 1")
+	return big.NewInt(1)
+}
+
+// GetItemTax returns the tax on the line of an order item in the listing
+// currency
+func GetItemTax(nrl *repo.Listing, item *pb.Order_Item, shipping *pb.Order_Shipping) (*big.Int, error) {
+	unitAmount, err := getItemUnitAmount(nrl, item)
+	if err != nil {
+		return nil, err
+	}
+	_, tax := applyListingTaxes(nrl, unitAmount, shipping)
+	return tax.Mul(tax, getItemLineQuantity(nrl, item)), nil
+}
+
+// setItemTaxes records the tax on each line of the order
+func setItemTaxes(contract *pb.RicardianContract) error {
+	for _, item := range contract.BuyerOrder.Items {
+		nrl, err := GetNormalizedListing(item.ListingHash, contract)
+		if err != nil {
+			return err
+		}
+		tax, err := GetItemTax(nrl, item, contract.BuyerOrder.Shipping)
+		if err != nil {
+			return err
+		}
+		item.BigTax = tax.String()
+	}
+	return nil
+}
+
+// validateItemTaxes checks the taxes recorded on the lines of the order
+func validateItemTaxes(contract *pb.RicardianContract) error {
+	for _, item := range contract.BuyerOrder.Items {
+		if item.BigTax == "" {
+			continue
+		}
+		nrl, err := GetNormalizedListing(item.ListingHash, contract)
+		if err != nil {
+			return err
+		}
+		tax, err := GetItemTax(nrl, item, contract.BuyerOrder.Shipping)
+		if err != nil {
+			return err
+		}
+		if tax.String() != item.BigTax {
+			return fmt.Errorf("tax on item %s does not match the listing", item.ListingHash)
+		}
+	}
+	return nil
+}
+
+// SetStoreTaxesOnListings copies the vendor's tax table to the listings
+// using the store taxes
+func (n *OpenBazaarNode) SetStoreTaxesOnListings(taxes []*pb.Listing_Tax) error {
+	if err := repo.ValidateTaxes(taxes); err != nil {
+		return err
+	}
+	absPath, err := filepath.Abs(path.Join(n.RepoPath, "root", "listings"))
+	if err != nil {
+		return err
+	}
+	hashes := make(map[string]string)
+	walkpath := func(p string, f os.FileInfo, err error) error {
+		if err != nil || f.IsDir() {
+			return err
+		}
+		listingJSONBytes, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		oldSL, err := repo.UnmarshalJSONSignedListing(listingJSONBytes)
+		if err != nil {
+			return err
+		}
+		l := oldSL.GetListing()
+		if !l.UsesStoreTaxes() {
+			return nil
+		}
+		if err := l.SetTaxes(taxes); err != nil {
+			return fmt.Errorf("setting taxes on (%s): %s", f.Name(), err.Error())
+		}
+
+		sl, err := l.Sign(n)
+		if err != nil {
+			return fmt.Errorf("signing listing (%s): %s", l.GetSlug(), err.Error())
+		}
+		slBytes, err := sl.MarshalJSON()
+		if err != nil {
+			return fmt.Errorf("marshal signed listing (%s): %s", l.GetSlug(), err.Error())
+		}
+		if err := ioutil.WriteFile(p, slBytes, os.ModePerm); err != nil {
+			return err
+		}
+		hash, err := ipfs.GetHashOfFile(n.IpfsNode, p)
+		if err != nil {
+			return err
+		}
+		hashes[sl.GetSlug()] = hash
+		return nil
+	}
+	if err := filepath.Walk(absPath, walkpath); err != nil {
+		return err
+	}
+	if len(hashes) == 0 {
+		return nil
+	}
+
+	updater := func(listing *repo.ListingIndexData) error {
+		if hash, ok := hashes[listing.Slug]; ok {
+			listing.Hash = hash
+		}
+		return nil
+	}
+	return n.UpdateEachListingOnIndex(updater)
+}
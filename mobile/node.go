@@ -40,6 +40,7 @@ import (
 	apiSchema "github.com/OpenBazaar/openbazaar-go/schema"
 	"github.com/OpenBazaar/openbazaar-go/storage/selfhosted"
 	"github.com/OpenBazaar/openbazaar-go/wallet"
+	"github.com/OpenBazaar/openbazaar-go/wallet/exchangerates"
 	lis "github.com/OpenBazaar/openbazaar-go/wallet/listeners"
 	"github.com/OpenBazaar/openbazaar-go/wallet/resync"
 	wi "github.com/OpenBazaar/wallet-interface"
@@ -66,6 +67,13 @@ type Node struct {
 	gateway        *api.Gateway
 	started        bool
 	startMtx       sync.Mutex
+
+	// Whether the publish lock taken when the node was built is still held
+	publishLocked bool
+	publishMtx    sync.Mutex
+
+	// syncFunc does the work of Sync. syncOnce is used when nil.
+	syncFunc func(ctx context.Context) (bool, error)
 }
 
 var (
@@ -161,6 +169,11 @@ func NewNodeWithConfig(config *NodeConfig, password string, mnemonic string) (*N
 		return nil, err
 	}
 
+	exchangeRatesConfig, err := apiSchema.GetExchangeRatesConfig(configFile)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create user-agent file
 	userAgentBytes := []byte(core.USERAGENT + config.UserAgent)
 	err = ioutil.WriteFile(path.Join(config.RepoPath, "root", "user_agent"), userAgentBytes, os.ModePerm)
@@ -251,6 +264,24 @@ func NewNodeWithConfig(config *NodeConfig, password string, mnemonic string) (*N
 		return nil, err
 	}
 
+	// Exchange rates setup. The rates are refreshed and recorded as they
+	// are requested rather than in the background.
+	reserveCode := "BTC"
+	if config.Testnet {
+		reserveCode = "TBTC"
+	}
+	var builtinRates wi.ExchangeRates
+	if reserveWallet, err := mw.WalletForCurrencyCode(reserveCode); err == nil {
+		builtinRates = reserveWallet.ExchangeRates()
+	}
+	var exchangeRates wi.ExchangeRates
+	aggregator, err := exchangerates.NewAggregatorFromConfig(exchangeRatesConfig, reserveCode, builtinRates, config.RepoPath, &http.Client{Timeout: time.Second * 30}, sqliteDB.ExchangeRates())
+	if err != nil && err != exchangerates.ErrNoProviders {
+		return nil, err
+	} else if err == nil {
+		exchangeRates = aggregator
+	}
+
 	// Set up the ban manager
 	settings, err := sqliteDB.Settings().Get()
 	if err != nil && err != db.SettingsNotSetError {
@@ -289,6 +320,7 @@ func NewNodeWithConfig(config *NodeConfig, password string, mnemonic string) (*N
 		RepoPath:                      config.RepoPath,
 		UserAgent:                     core.USERAGENT,
 		IPNSQuorumSize:                uint(ipnsExtraConfig.DHTQuorumSize),
+		ExchangeRates:                 exchangeRates,
 	}
 
 	if len(cfg.Addresses.Gateway) <= 0 {
@@ -308,7 +340,7 @@ func NewNodeWithConfig(config *NodeConfig, password string, mnemonic string) (*N
 		return nil, fmt.Errorf("verifying reserve currency converter: %s", err.Error())
 	}
 
-	return &Node{OpenBazaarNode: node, config: *config, ipfsConfig: ncfg, apiConfig: apiConfig, startMtx: sync.Mutex{}}, nil
+	return &Node{OpenBazaarNode: node, config: *config, ipfsConfig: ncfg, apiConfig: apiConfig, startMtx: sync.Mutex{}, publishLocked: true}, nil
 }
 
 func constructMobileRouting(ctx context.Context, host p2phost.Host, dstore ds.Batching, validator record.Validator) (routing.IpfsRouting, error) {
@@ -367,19 +399,18 @@ func (n *Node) mountProfileHandlerAndListen() {
 	}
 }
 
-func (n *Node) start() error {
-	if n.config.Profile {
-		go n.mountProfileHandlerAndListen()
-	}
+// startNetwork starts the IPFS node and sets up the parts of the
+// OpenBazaar node which need it
+func (n *Node) startNetwork() (commands.Context, error) {
 	nd, ctx, err := n.startIPFSNode(n.config.RepoPath, n.ipfsConfig)
 	if err != nil {
-		return err
+		return ctx, err
 	}
 
 	// Extract the DHT from the tiered routing so it will be more accessible later
 	tiered, ok := nd.Routing.(routinghelpers.Tiered)
 	if !ok {
-		return errors.New("IPFS routing is not a type routinghelpers.Tiered")
+		return ctx, errors.New("IPFS routing is not a type routinghelpers.Tiered")
 	}
 	var dhtRouting *dht.IpfsDHT
 	for _, router := range tiered.Routers {
@@ -388,7 +419,7 @@ func (n *Node) start() error {
 		}
 	}
 	if dhtRouting == nil {
-		return errors.New("IPFS DHT routing is not configured")
+		return ctx, errors.New("IPFS DHT routing is not configured")
 	}
 
 	n.OpenBazaarNode.IpfsNode = nd
@@ -405,15 +436,6 @@ func (n *Node) start() error {
 		n.OpenBazaarNode.RootHash = string(rec.Value)
 	}
 
-	configFile, err := ioutil.ReadFile(path.Join(n.OpenBazaarNode.RepoPath, "config"))
-	if err != nil {
-		return err
-	}
-	republishInterval, err := apiSchema.GetRepublishInterval(configFile)
-	if err != nil {
-		return err
-	}
-
 	// Offline messaging storage
 	n.OpenBazaarNode.MessageStorage = selfhosted.NewSelfHostedStorage(n.OpenBazaarNode.RepoPath, n.OpenBazaarNode.IpfsNode, n.OpenBazaarNode.PushNodes, n.OpenBazaarNode.SendStore)
 
@@ -423,6 +445,27 @@ func (n *Node) start() error {
 	ps := ipfs.Pubsub{Publisher: publisher, Subscriber: subscriber}
 	n.OpenBazaarNode.Pubsub = ps
 
+	return ctx, nil
+}
+
+func (n *Node) start() error {
+	if n.config.Profile {
+		go n.mountProfileHandlerAndListen()
+	}
+	ctx, err := n.startNetwork()
+	if err != nil {
+		return err
+	}
+
+	configFile, err := ioutil.ReadFile(path.Join(n.OpenBazaarNode.RepoPath, "config"))
+	if err != nil {
+		return err
+	}
+	republishInterval, err := apiSchema.GetRepublishInterval(configFile)
+	if err != nil {
+		return err
+	}
+
 	// Start gateway
 	// Create authentication cookie
 	var authCookie http.Cookie
@@ -449,12 +492,7 @@ func (n *Node) start() error {
 			if resyncManager == nil {
 				n.OpenBazaarNode.WaitForMessageRetrieverCompletion()
 			}
-			TL := lis.NewTransactionListener(n.OpenBazaarNode.Multiwallet, n.OpenBazaarNode.Datastore, n.OpenBazaarNode.Broadcast)
-			for ct, wal := range n.OpenBazaarNode.Multiwallet {
-				WL := lis.NewWalletListener(n.OpenBazaarNode.Datastore, n.OpenBazaarNode.Broadcast, ct)
-				wal.AddTransactionListener(WL.OnTransactionReceived)
-				wal.AddTransactionListener(TL.OnTransactionReceived)
-			}
+			n.addWalletListeners()
 			su := wallet.NewStatusUpdater(n.OpenBazaarNode.Multiwallet, n.OpenBazaarNode.Broadcast, n.OpenBazaarNode.IpfsNode.Context())
 			go su.Start()
 			go n.OpenBazaarNode.Multiwallet.Start()
@@ -468,26 +506,15 @@ func (n *Node) start() error {
 		}
 		n.OpenBazaarNode.Service = service.New(n.OpenBazaarNode, n.OpenBazaarNode.Datastore)
 		n.OpenBazaarNode.Service.WaitForReady()
-		MR := ret.NewMessageRetriever(ret.MRConfig{
-			Db:        n.OpenBazaarNode.Datastore,
-			IPFSNode:  n.OpenBazaarNode.IpfsNode,
-			DHT:       n.OpenBazaarNode.DHT,
-			BanManger: n.OpenBazaarNode.BanManager,
-			Service:   n.OpenBazaarNode.Service,
-			PrefixLen: 14,
-			PushNodes: n.OpenBazaarNode.PushNodes,
-			Dialer:    nil,
-			SendAck:   n.OpenBazaarNode.SendOfflineAck,
-			SendError: n.OpenBazaarNode.SendError,
-		})
+		MR := n.newMessageRetriever()
 		go MR.Run()
 		n.OpenBazaarNode.MessageRetriever = MR
-		PR := rep.NewPointerRepublisher(n.OpenBazaarNode.DHT, n.OpenBazaarNode.Datastore, n.OpenBazaarNode.PushNodes, n.OpenBazaarNode.IsModerator)
+		PR := rep.NewPointerRepublisher(n.OpenBazaarNode.DHT, n.OpenBazaarNode.Datastore, n.OpenBazaarNode.PushNodes, n.OpenBazaarNode.IsModerator, n.OpenBazaarNode.ExpireOutboxMessages)
 		go PR.Run()
 		n.OpenBazaarNode.PointerRepublisher = PR
 		MR.Wait()
 
-		n.OpenBazaarNode.PublishLock.Unlock()
+		n.unlockPublish()
 		publishUnlocked = true
 		err = n.OpenBazaarNode.UpdateFollow()
 		if err != nil {
@@ -500,11 +527,40 @@ func (n *Node) start() error {
 			}
 		}
 		n.OpenBazaarNode.SetUpRepublisher(republishInterval)
+		n.OpenBazaarNode.StartSubscriptionScheduler()
+		n.OpenBazaarNode.StartCrowdfundScheduler()
+		n.OpenBazaarNode.StartAutoFulfillScheduler()
 	}()
 	n.started = true
 	return nil
 }
 
+func (n *Node) newMessageRetriever() *ret.MessageRetriever {
+	return ret.NewMessageRetriever(ret.MRConfig{
+		Db:        n.OpenBazaarNode.Datastore,
+		IPFSNode:  n.OpenBazaarNode.IpfsNode,
+		DHT:       n.OpenBazaarNode.DHT,
+		BanManger: n.OpenBazaarNode.BanManager,
+		Service:   n.OpenBazaarNode.Service,
+		PrefixLen: 14,
+		PushNodes: n.OpenBazaarNode.PushNodes,
+		Dialer:    nil,
+		SendAck:   n.OpenBazaarNode.SendOfflineAck,
+		SendError: n.OpenBazaarNode.SendError,
+	})
+}
+
+// addWalletListeners records the wallet transactions in the database and
+// sends the notifications for them
+func (n *Node) addWalletListeners() {
+	TL := lis.NewTransactionListener(n.OpenBazaarNode.Multiwallet, n.OpenBazaarNode.Datastore, n.OpenBazaarNode.Broadcast)
+	for ct, wal := range n.OpenBazaarNode.Multiwallet {
+		WL := lis.NewWalletListener(n.OpenBazaarNode.Datastore, n.OpenBazaarNode.Broadcast, ct)
+		wal.AddTransactionListener(WL.OnTransactionReceived)
+		wal.AddTransactionListener(TL.OnTransactionReceived)
+	}
+}
+
 // Stop stop openbazaard
 func (n *Node) Stop() error {
 	n.startMtx.Lock()
@@ -550,6 +606,15 @@ func (n *Node) Restart() error {
 
 	// This node has been stopped by the stop command so we need to create
 	// a new one before starting it again.
+	if err := n.rebuild(); err != nil {
+		return err
+	}
+	return n.start()
+}
+
+// rebuild replaces the stopped OpenBazaar node with a new one built from the
+// same configuration
+func (n *Node) rebuild() error {
 	newNode, err := NewNodeWithConfig(&n.config, "", "")
 	if err != nil {
 		return err
@@ -559,7 +624,21 @@ func (n *Node) Restart() error {
 	n.ipfsConfig = newNode.ipfsConfig
 	n.apiConfig = newNode.apiConfig
 
-	return n.start()
+	n.publishMtx.Lock()
+	n.publishLocked = newNode.publishLocked
+	n.publishMtx.Unlock()
+	return nil
+}
+
+// unlockPublish releases the publish lock taken when the node was built, if
+// it is still held
+func (n *Node) unlockPublish() {
+	n.publishMtx.Lock()
+	defer n.publishMtx.Unlock()
+	if n.publishLocked {
+		n.OpenBazaarNode.PublishLock.Unlock()
+		n.publishLocked = false
+	}
 }
 
 // PublishUnlocked return true if publish is unlocked
@@ -0,0 +1,282 @@
+package mobile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/core"
+	"github.com/OpenBazaar/openbazaar-go/net/service"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/wallet/resync"
+	"github.com/ipfs/go-ipfs/repo/fsrepo"
+)
+
+const (
+	// maxSyncNotifications is the most notifications returned by Sync
+	maxSyncNotifications = 100
+
+	// maxSyncShutdownTime is the most of the time budget kept to shut the
+	// node down once the sync is done or has run out of time
+	maxSyncShutdownTime = 5 * time.Second
+)
+
+// SyncSummary is returned by Sync, encoded as JSON
+type SyncSummary struct {
+	// The notifications created during the sync, newest first
+	Notifications []*repo.Notification `json:"notifications"`
+	// The number of unread notifications, including older ones
+	UnreadCount int `json:"unreadCount"`
+	// Whether pending changes to the store were published
+	Published bool `json:"published"`
+	// Whether the sync finished within the time budget
+	Completed bool `json:"completed"`
+}
+
+type syncResult struct {
+	published bool
+	err       error
+}
+
+// Sync runs the node without the API just long enough to retrieve and
+// process the offline messages, catch up with the wallets and publish
+// pending changes, then shuts it down. It returns within timeoutSeconds
+// whether or not the work is done, with a JSON encoded SyncSummary of the
+// new notifications so a background task can show them. Once out of time
+// the step being run is stopped as soon as it allows and the node is shut
+// down in the background, changes it publishes meanwhile are not reported.
+//
+// The node must not be running. Sync leaves the node stopped and rebuilt so
+// that it can be started or synced again, Start and Sync wait for a shut
+// down still running in the background.
+func (n *Node) Sync(timeoutSeconds int) (string, error) {
+	n.startMtx.Lock()
+	// The lock is released by the shut down in the background if the sync
+	// runs out of time
+	unlock := true
+	defer func() {
+		if unlock {
+			n.startMtx.Unlock()
+		}
+	}()
+
+	if n.started {
+		return "", errors.New("the node is already running")
+	}
+	if timeoutSeconds <= 0 {
+		return "", errors.New("the timeout must be positive")
+	}
+	budget := time.Duration(timeoutSeconds) * time.Second
+	shutdownTime := budget / 4
+	if shutdownTime > maxSyncShutdownTime {
+		shutdownTime = maxSyncShutdownTime
+	}
+	deadline := time.Now().Add(budget)
+
+	newestID, err := n.newestNotificationID()
+	if err != nil {
+		return "", err
+	}
+
+	// Nothing serves the notifications while syncing, they are read from
+	// the database once the sync is done
+	var (
+		node      = n.OpenBazaarNode
+		original  = node.Broadcast
+		broadcast = make(chan repo.Notifier)
+		done      = make(chan struct{})
+	)
+	go func() {
+		for {
+			select {
+			case <-broadcast:
+			case <-done:
+				return
+			}
+		}
+	}()
+	node.Broadcast = broadcast
+	restoreBroadcast := func() {
+		node.Broadcast = original
+		close(done)
+	}
+
+	if _, err := n.startNetwork(); err != nil {
+		restoreBroadcast()
+		return "", err
+	}
+
+	work := n.syncFunc
+	if work == nil {
+		work = n.syncOnce
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var (
+		summary  SyncSummary
+		finished bool
+		result   = make(chan syncResult, 1)
+	)
+	go func() {
+		published, err := work(ctx)
+		result <- syncResult{published, err}
+	}()
+	select {
+	case r := <-result:
+		finished = true
+		logSyncError(r.err)
+		summary.Completed = r.err == nil
+		summary.Published = r.published
+	case <-time.After(time.Until(deadline.Add(-shutdownTime))):
+		log.Warning("sync ran out of time")
+		cancel()
+	}
+
+	summary.Notifications, err = n.notificationsSince(newestID)
+	if err != nil {
+		log.Errorf("sync: reading notifications: %s", err)
+	}
+	summary.UnreadCount, err = n.OpenBazaarNode.Datastore.Notifications().GetUnreadCount()
+	if err != nil {
+		log.Errorf("sync: counting unread notifications: %s", err)
+	}
+	ret, err := json.Marshal(summary)
+	if err != nil {
+		return "", err
+	}
+
+	if !finished {
+		// The running step can't be interrupted, the node is shut down
+		// once it returns
+		unlock = false
+		go func() {
+			defer n.startMtx.Unlock()
+			defer restoreBroadcast()
+			logSyncError((<-result).err)
+			n.stopSync(time.Now().Add(shutdownTime))
+			if err := n.rebuild(); err != nil {
+				log.Errorf("sync: rebuilding the node: %s", err)
+			}
+		}()
+		return string(ret), nil
+	}
+
+	defer restoreBroadcast()
+	n.stopSync(deadline)
+	if err := n.rebuild(); err != nil {
+		return "", err
+	}
+	return string(ret), nil
+}
+
+// logSyncError logs the error returned by the sync work unless it was
+// stopped for running out of time
+func logSyncError(err error) {
+	if err != nil && err != context.Canceled {
+		log.Errorf("sync: %s", err)
+	}
+}
+
+// syncOnce does the work of Sync and returns whether the store was
+// published. It returns early once the context is cancelled.
+func (n *Node) syncOnce(ctx context.Context) (bool, error) {
+	n.OpenBazaarNode.Service = service.New(n.OpenBazaarNode, n.OpenBazaarNode.Datastore)
+	if !n.config.DisableWallet {
+		n.addWalletListeners()
+		go n.OpenBazaarNode.Multiwallet.Start()
+	}
+	n.OpenBazaarNode.Service.WaitForReady()
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	MR := n.newMessageRetriever()
+	n.OpenBazaarNode.MessageRetriever = MR
+	MR.RunOnce()
+	// Abandon the downloads still running once out of time
+	retrieved := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			close(MR.DoneChan)
+		case <-retrieved:
+		}
+	}()
+	MR.Wait()
+	close(retrieved)
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	if !n.config.DisableWallet {
+		// Watch the payment addresses of unfunded orders so that payments
+		// are picked up while the wallets catch up
+		resync.NewResyncManager(n.OpenBazaarNode.Datastore.Sales(), n.OpenBazaarNode.Datastore.Purchases(), n.OpenBazaarNode.Multiwallet).CheckUnfunded()
+	}
+	n.OpenBazaarNode.ProcessSubscriptions(time.Now())
+	n.OpenBazaarNode.ProcessCrowdfunds(time.Now())
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	n.unlockPublish()
+	return n.OpenBazaarNode.PublishChanges()
+}
+
+// stopSync shuts down the node started by Sync. The offline messages still
+// being sent are given until the deadline.
+func (n *Node) stopSync(deadline time.Time) {
+	sent := make(chan struct{})
+	go func() {
+		core.OfflineMessageWaitGroup.Wait()
+		close(sent)
+	}()
+	select {
+	case <-sent:
+	case <-time.After(time.Until(deadline)):
+		log.Warning("sync: stopping before all offline messages were sent")
+	}
+
+	// The wallets were not started if they are disabled and can't be closed
+	if !n.config.DisableWallet {
+		n.OpenBazaarNode.Multiwallet.Close()
+	}
+	if err := n.OpenBazaarNode.IpfsNode.Close(); err != nil {
+		log.Error(err)
+	}
+	n.OpenBazaarNode.Datastore.Close()
+	repoLockFile := filepath.Join(n.OpenBazaarNode.RepoPath, fsrepo.LockFile)
+	if err := os.Remove(repoLockFile); err != nil {
+		log.Error(err)
+	}
+}
+
+// newestNotificationID returns the ID of the newest notification in the
+// database, if there is one
+func (n *Node) newestNotificationID() (string, error) {
+	notifications, _, err := n.OpenBazaarNode.Datastore.Notifications().GetAll("", 1, nil)
+	if err != nil || len(notifications) == 0 {
+		return "", err
+	}
+	return notifications[0].GetID(), nil
+}
+
+// notificationsSince returns the notifications newer than the one with the
+// given ID, newest first
+func (n *Node) notificationsSince(id string) ([]*repo.Notification, error) {
+	notifications, _, err := n.OpenBazaarNode.Datastore.Notifications().GetAll("", maxSyncNotifications, nil)
+	if err != nil {
+		return nil, err
+	}
+	ret := []*repo.Notification{}
+	for _, notification := range notifications {
+		if id != "" && notification.GetID() == id {
+			break
+		}
+		ret = append(ret, notification)
+	}
+	return ret, nil
+}
@@ -0,0 +1,110 @@
+package mobile
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func newSyncNode(t *testing.T) (*Node, func()) {
+	s, err := schema.NewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	config := &NodeConfig{
+		RepoPath:             s.DataPath(),
+		DisableWallet:        true,
+		DisableExchangerates: true,
+		Testnet:              true,
+	}
+	node, err := NewNodeWithConfig(config, "", "")
+	if err != nil {
+		s.DestroySchemaDirectories()
+		t.Fatal(err)
+	}
+	return node, s.DestroySchemaDirectories
+}
+
+func syncSummary(t *testing.T, node *Node, timeoutSeconds int) SyncSummary {
+	ret, err := node.Sync(timeoutSeconds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var summary SyncSummary
+	if err := json.Unmarshal([]byte(ret), &summary); err != nil {
+		t.Fatal(err)
+	}
+	return summary
+}
+
+func TestSyncTimeout(t *testing.T) {
+	node, teardown := newSyncNode(t)
+	defer teardown()
+
+	var (
+		release = make(chan struct{})
+		stopped = make(chan struct{})
+	)
+	node.syncFunc = func(ctx context.Context) (bool, error) {
+		defer close(stopped)
+		<-ctx.Done()
+		// A step which ignores the context keeps running after Sync returns
+		<-release
+		// The node is only shut down once the step has returned
+		if _, err := node.OpenBazaarNode.Datastore.Notifications().GetUnreadCount(); err != nil {
+			t.Errorf("expected the datastore to be open until the step returns, got %s", err)
+		}
+		return false, ctx.Err()
+	}
+	start := time.Now()
+	if summary := syncSummary(t, node, 1); summary.Completed {
+		t.Error("expected the sync to run out of time")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected the sync to return within its timeout, took %s", elapsed)
+	}
+	select {
+	case <-stopped:
+		t.Fatal("expected the sync to return before the step")
+	default:
+	}
+	close(release)
+
+	// Wait for the shut down in the background
+	node.startMtx.Lock()
+	defer node.startMtx.Unlock()
+	if node.OpenBazaarNode.Broadcast != nil {
+		t.Error("expected the broadcast channel to be restored")
+	}
+	if _, err := node.OpenBazaarNode.Datastore.Notifications().GetUnreadCount(); err != nil {
+		t.Errorf("expected the node to be rebuilt, got %s", err)
+	}
+}
+
+func TestSyncTwice(t *testing.T) {
+	node, teardown := newSyncNode(t)
+	defer teardown()
+
+	node.syncFunc = func(ctx context.Context) (bool, error) {
+		node.unlockPublish()
+		node.unlockPublish()
+		return false, nil
+	}
+	for i := 0; i < 2; i++ {
+		if summary := syncSummary(t, node, 10); !summary.Completed {
+			t.Errorf("expected sync %d to complete", i+1)
+		}
+		if _, err := node.OpenBazaarNode.Datastore.Notifications().GetUnreadCount(); err != nil {
+			t.Errorf("expected the node to be usable after sync %d, got %s", i+1, err)
+		}
+	}
+}
@@ -3,32 +3,54 @@ package net
 import (
 	"gx/ipfs/QmSY3nkMNLzh9GdbFKK5tT7YMfLpf52iUZ8ZRkr29MJaa5/go-libp2p-kad-dht"
 	"gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+	"sync"
 	"time"
 
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/op/go-logging"
 	"golang.org/x/net/context"
+	prometheus "gx/ipfs/QmTQuFQWHAWy4wMH6ZyPfGiawA5u9T8rs79FENoV8yXaoS/client_golang/prometheus"
 )
 
 var log = logging.MustGetLogger("service")
 
+// republishDuration measures each run of the republisher
+var republishDuration = prometheus.NewHistogram(
+	prometheus.HistogramOpts{
+		Namespace: "openbazaar",
+		Subsystem: "pointer_republisher",
+		Name:      "run_duration_seconds",
+		Help:      "Time taken to republish the pointers, until every put has returned.",
+		Buckets:   prometheus.ExponentialBuckets(0.01, 4, 10),
+	},
+)
+
+func init() {
+	prometheus.MustRegister(republishDuration)
+}
+
 const kRepointFrequency = time.Hour * 12
 const kPointerExpiration = time.Hour * 24 * 30
 
 type PointerRepublisher struct {
-	routing     *dht.IpfsDHT
-	db          repo.Datastore
-	pushNodes   []peer.ID
-	isModerator func() bool
+	routing      *dht.IpfsDHT
+	db           repo.Datastore
+	pushNodes    []peer.ID
+	isModerator  func() bool
+	expireOutbox func(cutoff time.Time) error
 }
 
-func NewPointerRepublisher(dht *dht.IpfsDHT, database repo.Datastore, pushNodes []peer.ID, isModerator func() bool) *PointerRepublisher {
+// NewPointerRepublisher returns a republisher. expireOutbox is called with the
+// pointer expiry cutoff after each run so the messages behind expired
+// pointers can be deleted.
+func NewPointerRepublisher(dht *dht.IpfsDHT, database repo.Datastore, pushNodes []peer.ID, isModerator func() bool, expireOutbox func(cutoff time.Time) error) *PointerRepublisher {
 	return &PointerRepublisher{
-		routing:     dht,
-		db:          database,
-		pushNodes:   pushNodes,
-		isModerator: isModerator,
+		routing:      dht,
+		db:           database,
+		pushNodes:    pushNodes,
+		isModerator:  isModerator,
+		expireOutbox: expireOutbox,
 	}
 }
 
@@ -42,6 +64,10 @@ func (r *PointerRepublisher) Run() {
 }
 
 func (r *PointerRepublisher) Republish() {
+	start := time.Now()
+	defer func() {
+		republishDuration.Observe(time.Since(start).Seconds())
+	}()
 	republishModerator := r.isModerator()
 	pointers, err := r.db.Pointers().GetAll()
 	if err != nil {
@@ -49,6 +75,7 @@ func (r *PointerRepublisher) Republish() {
 		return
 	}
 	ctx := context.Background()
+	var wg sync.WaitGroup
 
 	for _, p := range pointers {
 		switch p.Purpose {
@@ -59,14 +86,18 @@ func (r *PointerRepublisher) Republish() {
 					log.Error(err)
 				}
 			} else {
+				wg.Add(1)
 				go func(d *dht.IpfsDHT, ctx context.Context, pointer ipfs.Pointer) {
+					defer wg.Done()
 					err := ipfs.PublishPointer(d, ctx, pointer)
 					if err != nil {
 						log.Error(err)
 					}
 				}(r.routing, ctx, p)
 				for _, peer0 := range r.pushNodes {
+					wg.Add(1)
 					go func(d *dht.IpfsDHT, ctx context.Context, peerID peer.ID, pointer ipfs.Pointer) {
+						defer wg.Done()
 						err := ipfs.PutPointerToPeer(d, ctx, peerID, pointer)
 						if err != nil {
 							log.Error(err)
@@ -76,7 +107,9 @@ func (r *PointerRepublisher) Republish() {
 			}
 		case ipfs.MODERATOR:
 			if republishModerator {
+				wg.Add(1)
 				go func(d *dht.IpfsDHT, ctx context.Context, pointer ipfs.Pointer) {
+					defer wg.Done()
 					err := ipfs.PublishPointer(d, ctx, pointer)
 					if err != nil {
 						log.Error(err)
@@ -92,4 +125,11 @@ func (r *PointerRepublisher) Republish() {
 			continue
 		}
 	}
+
+	if r.expireOutbox != nil {
+		if err := r.expireOutbox(time.Now().Add(-kPointerExpiration)); err != nil {
+			log.Error(err)
+		}
+	}
+	wg.Wait()
 }
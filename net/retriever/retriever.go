@@ -2,7 +2,10 @@ package net
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
 
 	routing "gx/ipfs/QmSY3nkMNLzh9GdbFKK5tT7YMfLpf52iUZ8ZRkr29MJaa5/go-libp2p-kad-dht"
 	libp2p "gx/ipfs/QmTW4SdgBWq9GjsBsHeUx8WuGxzhgzAf88UMH2w62PC8yK/go-libp2p-crypto"
@@ -21,16 +24,47 @@ import (
 	"github.com/OpenBazaar/openbazaar-go/net"
 	"github.com/OpenBazaar/openbazaar-go/pb"
 	"github.com/OpenBazaar/openbazaar-go/repo"
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
 	"github.com/golang/protobuf/proto"
 	"github.com/ipfs/go-ipfs/core"
 	"github.com/op/go-logging"
 	"golang.org/x/net/proxy"
+	prometheus "gx/ipfs/QmTQuFQWHAWy4wMH6ZyPfGiawA5u9T8rs79FENoV8yXaoS/client_golang/prometheus"
 )
 
 const DefaultPointerPrefixLength = 14
 
 var log = logging.MustGetLogger("retriever")
 
+// offlineMessagesRetrieved counts the offline messages downloaded and
+// verified, labelled with the message type
+var offlineMessagesRetrieved = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "openbazaar",
+		Subsystem: "offline_messages",
+		Name:      "retrieved_total",
+		Help:      "Number of offline messages retrieved.",
+	},
+	[]string{"type"},
+)
+
+// retrieverRunDuration measures each run of the retriever, labelled with
+// where the pointers were looked up
+var retrieverRunDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Namespace: "openbazaar",
+		Subsystem: "message_retriever",
+		Name:      "run_duration_seconds",
+		Help:      "Time taken to look up pointers and download the offline messages.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+	},
+	[]string{"source"},
+)
+
+func init() {
+	prometheus.MustRegister(offlineMessagesRetrieved, retrieverRunDuration)
+}
+
 type MRConfig struct {
 	Db        repo.Datastore
 	IPFSNode  *core.IpfsNode
@@ -56,8 +90,10 @@ type MessageRetriever struct {
 	httpClient *http.Client
 	dataPeers  []peer.ID
 	queueLock  *sync.Mutex
+	dedupLock  *sync.Mutex
 	DoneChan   chan struct{}
 	inFlight   chan struct{}
+	firstRun   sync.Once
 	*sync.WaitGroup
 }
 
@@ -86,6 +122,7 @@ func NewMessageRetriever(cfg MRConfig) *MessageRetriever {
 		httpClient: client,
 		dataPeers:  cfg.PushNodes,
 		queueLock:  new(sync.Mutex),
+		dedupLock:  new(sync.Mutex),
 		DoneChan:   make(chan struct{}),
 		inFlight:   make(chan struct{}, 5),
 		WaitGroup:  new(sync.WaitGroup),
@@ -100,8 +137,7 @@ func (m *MessageRetriever) Run() {
 	peers := time.NewTicker(time.Minute)
 	defer dht.Stop()
 	defer peers.Stop()
-	go m.fetchPointersFromDHT()
-	go m.fetchPointersFromPushNodes()
+	m.fetchPointers()
 	for {
 		select {
 		case <-dht.C:
@@ -116,13 +152,24 @@ func (m *MessageRetriever) Run() {
 
 // RunOnce - used to fetch messages only once
 func (m *MessageRetriever) RunOnce() {
-	m.Add(1)
+	m.fetchPointers()
+}
+
+// fetchPointers looks up the pointers in the DHT and at the push nodes in
+// the background. The first lookup is counted by NewMessageRetriever so that
+// Wait blocks until it is done even if it has not started yet.
+func (m *MessageRetriever) fetchPointers() {
+	first := false
+	m.firstRun.Do(func() { first = true })
+	if !first {
+		m.Add(2)
+	}
 	go m.fetchPointersFromDHT()
-	m.Add(1)
 	go m.fetchPointersFromPushNodes()
 }
 
 func (m *MessageRetriever) fetchPointersFromDHT() {
+	defer observeRunDuration("dht", time.Now())
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	mh, _ := multihash.FromB58String(m.node.Identity.Pretty())
@@ -140,6 +187,7 @@ func (m *MessageRetriever) fetchPointersFromDHT() {
 }
 
 func (m *MessageRetriever) fetchPointersFromPushNodes() {
+	defer observeRunDuration("pushnodes", time.Now())
 	peerOut := make(chan ps.PeerInfo)
 	go func(c chan ps.PeerInfo) {
 		out := m.getPointersDataPeers()
@@ -162,30 +210,19 @@ func (m *MessageRetriever) downloadMessages(peerOut chan ps.PeerInfo) {
 		if len(p.Addrs) > 0 && !m.db.OfflineMessages().Has(p.Addrs[0].String()) && !inFlight[p.Addrs[0].String()] {
 			inFlight[p.Addrs[0].String()] = true
 			log.Debugf("Found pointer with location %s", p.Addrs[0].String())
-			// IPFS
-			if len(p.Addrs[0].Protocols()) == 1 && p.Addrs[0].Protocols()[0].Code == ma.P_IPFS {
+			// HTTPS
+			if url, ok := sto.URLFromMultiaddr(p.Addrs[0]); ok {
 				wg.Add(1)
 				downloaded++
-				go m.fetchIPFS(p.ID, m.node, p.Addrs[0], wg)
+				go m.fetchHTTPS(p.ID, url, p.Addrs[0], wg)
+				continue
 			}
 
-			// HTTPS
-			if len(p.Addrs[0].Protocols()) == 2 && p.Addrs[0].Protocols()[0].Code == ma.P_IPFS && p.Addrs[0].Protocols()[1].Code == ma.P_HTTPS {
-				enc, err := p.Addrs[0].ValueForProtocol(ma.P_IPFS)
-				if err != nil {
-					continue
-				}
-				mh, err := multihash.FromB58String(enc)
-				if err != nil {
-					continue
-				}
-				d, err := multihash.Decode(mh)
-				if err != nil {
-					continue
-				}
+			// IPFS
+			if len(p.Addrs[0].Protocols()) == 1 && p.Addrs[0].Protocols()[0].Code == ma.P_IPFS {
 				wg.Add(1)
 				downloaded++
-				go m.fetchHTTPS(p.ID, string(d.Digest), p.Addrs[0], wg)
+				go m.fetchIPFS(p.ID, m.node, p.Addrs[0], wg)
 			}
 		}
 	}
@@ -198,6 +235,10 @@ func (m *MessageRetriever) downloadMessages(peerOut chan ps.PeerInfo) {
 	m.Done()
 }
 
+func observeRunDuration(source string, start time.Time) {
+	retrieverRunDuration.WithLabelValues(source).Observe(time.Since(start).Seconds())
+}
+
 // Connect directly to our data peers and ask them if they have the pointer we're interested in
 func (m *MessageRetriever) getPointersDataPeers() <-chan ps.PeerInfo {
 	peerOut := make(chan ps.PeerInfo, 100000)
@@ -259,6 +300,10 @@ func (m *MessageRetriever) fetchIPFS(pid peer.ID, n *core.IpfsNode, addr ma.Mult
 		if err != nil {
 			log.Error(err)
 		}
+		if m.seenCiphertext(ciphertext) {
+			log.Debugf("Discarding offline message from %s already downloaded from another location", addr.String())
+			return
+		}
 		m.attemptDecrypt(ciphertext, pid, addr)
 	case <-m.DoneChan:
 		return
@@ -274,19 +319,13 @@ func (m *MessageRetriever) fetchHTTPS(pid peer.ID, url string, addr ma.Multiaddr
 		<-m.inFlight
 	}()
 
-	c := make(chan struct{})
+	c := make(chan struct{}, 1)
 	var ciphertext []byte
 	var err error
 
 	go func() {
-		var resp *http.Response
-		resp, err = m.httpClient.Get(url)
-		if err != nil {
-			log.Errorf("Error retrieving offline message from %s, %s", addr.String(), err.Error())
-			c <- struct{}{}
-			return
-		}
-		ciphertext, err = ioutil.ReadAll(resp.Body)
+		ciphertext, err = downloadHTTPS(m.httpClient, url)
+		c <- struct{}{}
 	}()
 
 	select {
@@ -300,12 +339,47 @@ func (m *MessageRetriever) fetchHTTPS(pid peer.ID, url string, addr ma.Multiaddr
 		if err != nil {
 			log.Error(err)
 		}
+		if m.seenCiphertext(ciphertext) {
+			log.Debugf("Discarding offline message from %s already downloaded from another location", addr.String())
+			return
+		}
 		m.attemptDecrypt(ciphertext, pid, addr)
 	case <-m.DoneChan:
 		return
 	}
 }
 
+// seenCiphertext reports whether the same ciphertext was already downloaded,
+// as happens when a message is stored in several locations, and records it
+// otherwise
+func (m *MessageRetriever) seenCiphertext(ciphertext []byte) bool {
+	digest := sha256.Sum256(ciphertext)
+	key := "sha256:" + hex.EncodeToString(digest[:])
+	m.dedupLock.Lock()
+	defer m.dedupLock.Unlock()
+	if m.db.OfflineMessages().Has(key) {
+		return true
+	}
+	if err := m.db.OfflineMessages().Put(key); err != nil {
+		log.Error(err)
+	}
+	return false
+}
+
+// downloadHTTPS returns the body of the URL. Anything but a 200 response is
+// an error so that error pages are not mistaken for messages.
+func downloadHTTPS(client *http.Client, url string) ([]byte, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response %s", resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
 // attemptDecrypt will try to decrypt the message using our identity private key. If it decrypts it will be passed to
 // a handler for processing. Not all messages will decrypt. Given the natural of the prefix addressing, we may download
 // some messages intended for others. If we can't decrypt it, we can just discard it.
@@ -350,6 +424,7 @@ func (m *MessageRetriever) attemptDecrypt(ciphertext []byte, pid peer.ID, addr m
 	}
 
 	log.Debugf("Received offline message %s from: %s\n", addr.String(), id.Pretty())
+	offlineMessagesRetrieved.WithLabelValues(env.Message.MessageType.String()).Inc()
 
 	if m.bm.IsBanned(id) {
 		log.Warningf("Received and dropped offline message from banned user: %s\n", id.Pretty())
@@ -434,11 +509,15 @@ func (m *MessageRetriever) handleMessage(env pb.Envelope, addr string, id *peer.
 }
 
 var MessageProcessingOrder = []pb.Message_MessageType{
+	pb.Message_SUBSCRIPTION,
 	pb.Message_ORDER,
 	pb.Message_ORDER_CANCEL,
 	pb.Message_ORDER_REJECT,
 	pb.Message_ORDER_CONFIRMATION,
 	pb.Message_ORDER_PAYMENT,
+	pb.Message_BACKORDER_RELEASE,
+	pb.Message_APPOINTMENT_CONFIRMATION,
+	pb.Message_APPOINTMENT_RESCHEDULE,
 	pb.Message_ORDER_FULFILLMENT,
 	pb.Message_ORDER_COMPLETION,
 	pb.Message_DISPUTE_OPEN,
@@ -446,6 +525,7 @@ var MessageProcessingOrder = []pb.Message_MessageType{
 	pb.Message_VENDOR_FINALIZED_PAYMENT,
 	pb.Message_DISPUTE_CLOSE,
 	pb.Message_REFUND,
+	pb.Message_SUBSCRIPTION_CANCEL,
 	pb.Message_CHAT,
 	pb.Message_FOLLOW,
 	pb.Message_UNFOLLOW,
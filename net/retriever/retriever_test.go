@@ -1,9 +1,15 @@
 package net
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/wallet-interface"
 )
 
 // TestEnsureNoOmissionsInMessageProcessingOrder ensures that
@@ -23,6 +29,8 @@ func TestEnsureNoOmissionsInMessageProcessingOrder(t *testing.T) {
 		pb.Message_BLOCK:                    {},
 		pb.Message_ORDER_PROCESSING_FAILURE: {},
 		pb.Message_ERROR:                    {},
+		pb.Message_PRICE_QUOTE_REQUEST:      {},
+		pb.Message_PRICE_QUOTE:              {},
 	}
 
 	// Inclusion check
@@ -38,3 +46,54 @@ func TestEnsureNoOmissionsInMessageProcessingOrder(t *testing.T) {
 		t.Errorf("found %d unexpected message types which are not considered in MessageProcessingOrder: %v", l, messages)
 	}
 }
+
+func TestDownloadHTTPS(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/message" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("ciphertext"))
+	}))
+	defer server.Close()
+
+	b, err := downloadHTTPS(server.Client(), server.URL+"/message")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "ciphertext" {
+		t.Errorf("expected the message body, got %q", b)
+	}
+	if _, err := downloadHTTPS(server.Client(), server.URL+"/missing"); err == nil {
+		t.Error("expected an error for a missing message")
+	}
+}
+
+func TestSeenCiphertext(t *testing.T) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewMessageRetriever(MRConfig{Db: db.NewSQLiteDatastore(database, new(sync.Mutex), wallet.Bitcoin)})
+	if m.seenCiphertext([]byte("ciphertext")) {
+		t.Error("expected the first copy of a message to be processed")
+	}
+	if !m.seenCiphertext([]byte("ciphertext")) {
+		t.Error("expected a second copy of the message to be discarded")
+	}
+	if m.seenCiphertext([]byte("other ciphertext")) {
+		t.Error("expected a different message to be processed")
+	}
+}
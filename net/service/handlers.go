@@ -78,6 +78,16 @@ func (service *OpenBazaarService) HandlerForMsgType(t pb.Message_MessageType) fu
 		return service.handleStore
 	case pb.Message_ORDER_PAYMENT:
 		return service.handleOrderPayment
+	case pb.Message_PRICE_QUOTE_REQUEST:
+		return service.handlePriceQuoteRequest
+	case pb.Message_SUBSCRIPTION:
+		return service.handleSubscription
+	case pb.Message_SUBSCRIPTION_CANCEL:
+		return service.handleSubscriptionCancel
+	case pb.Message_BACKORDER_RELEASE:
+		return service.handleBackorderRelease
+	case pb.Message_APPOINTMENT_CONFIRMATION, pb.Message_APPOINTMENT_RESCHEDULE:
+		return service.handleAppointment
 	case pb.Message_ERROR:
 		return service.handleError
 	case pb.Message_ORDER_PROCESSING_FAILURE:
@@ -209,6 +219,9 @@ func (service *OpenBazaarService) handleOfflineAck(p peer.ID, pmes *pb.Message,
 	if err != nil {
 		return nil, err
 	}
+	if err := service.node.AckOutboxMessage(pid.Pretty()); err != nil {
+		log.Errorf("deleting acked outbox message %s: %s", pid.Pretty(), err)
+	}
 	log.Debugf("received OFFLINE_ACK: %s", p.Pretty())
 	return nil, nil
 }
@@ -360,6 +373,16 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 		}
 	}
 
+	if err := service.node.ValidateSubscriptionOrder(contract, orderId); err != nil {
+		return errorResponse(err.Error()), err
+	}
+	// The period of a subscription is counted once the order is accepted
+	countSubscriptionOrder := func() {
+		if err := service.node.CountSubscriptionOrder(contract, orderId); err != nil {
+			log.Errorf("failed counting subscription order (%s): %s", orderId, err)
+		}
+	}
+
 	order, err := repo.ToV5Order(contract.BuyerOrder, service.node.LookupCurrency)
 	if err != nil {
 		return nil, err
@@ -397,6 +420,7 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 			log.Errorf("failed to put sale (%s): %s", contract.VendorOrderConfirmation.OrderID, err)
 			return errorResponse("Error persisting order"), err
 		}
+		countSubscriptionOrder()
 		m := pb.Message{
 			MessageType: pb.Message_ORDER_CONFIRMATION,
 			Payload:     a,
@@ -424,6 +448,7 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 		if err != nil {
 			log.Error(err)
 		}
+		countSubscriptionOrder()
 		log.Debugf("successfully processed direct ORDER message from %s", peer.Pretty())
 		return nil, nil
 	} else if order.Payment.Method == pb.Order_Payment_MODERATED && !offline {
@@ -468,6 +493,7 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 		if err != nil {
 			log.Error(err)
 		}
+		countSubscriptionOrder()
 		log.Debugf("storing sales order %s in database", orderId)
 		m := pb.Message{
 			MessageType: pb.Message_ORDER_CONFIRMATION,
@@ -501,6 +527,7 @@ func (service *OpenBazaarService) handleOrder(peer peer.ID, pmes *pb.Message, op
 		if err != nil {
 			log.Error(err)
 		}
+		countSubscriptionOrder()
 		log.Debugf("successfully processed offline moderated ORDER message from %s", peer.Pretty())
 		return nil, nil
 	}
@@ -995,6 +1022,9 @@ func (service *OpenBazaarService) handleRefund(p peer.ID, pmes *pb.Message, opti
 	if err != nil {
 		log.Error(err)
 	}
+	if err := service.node.CancelAppointment(contract.Refund.OrderID, false); err != nil {
+		log.Error(err)
+	}
 
 	var thumbnailTiny string
 	var thumbnailSmall string
@@ -1134,6 +1164,146 @@ func (service *OpenBazaarService) handleOrderFulfillment(p peer.ID, pmes *pb.Mes
 	return nil, nil
 }
 
+func (service *OpenBazaarService) handleBackorderRelease(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	rc := new(pb.RicardianContract)
+	err := ptypes.UnmarshalAny(pmes.Payload, rc)
+	if err != nil {
+		return nil, err
+	}
+	if rc.VendorBackorderRelease == nil {
+		return nil, errors.New("received BACKORDER_RELEASE message with nil VendorBackorderRelease object")
+	}
+	orderID := rc.VendorBackorderRelease.OrderID
+	log.Debugf("received backorder release message for order %s from %s", orderID, p.Pretty())
+
+	contract, state, _, _, _, _, err := service.datastore.Purchases().GetByOrderId(orderID)
+	if err != nil {
+		return nil, net.OutOfOrderMessage
+	}
+	if contract.VendorBackorderRelease != nil {
+		return nil, net.DuplicateMessage
+	}
+
+	contract.VendorBackorderRelease = rc.VendorBackorderRelease
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_BACKORDER_RELEASE {
+			contract.Signatures = append(contract.Signatures, sig)
+		}
+	}
+	if err := service.node.ValidateBackorderRelease(contract); err != nil {
+		return nil, err
+	}
+	if err := service.datastore.Purchases().Put(orderID, *contract, state, false); err != nil {
+		return nil, err
+	}
+
+	var thumbnailTiny, thumbnailSmall, vendorHandle, vendorID string
+	if len(contract.VendorListings) > 0 && contract.VendorListings[0].Item != nil && len(contract.VendorListings[0].Item.Images) > 0 {
+		thumbnailTiny = contract.VendorListings[0].Item.Images[0].Tiny
+		thumbnailSmall = contract.VendorListings[0].Item.Images[0].Small
+	}
+	if len(contract.VendorListings) > 0 && contract.VendorListings[0].VendorID != nil {
+		vendorID = contract.VendorListings[0].VendorID.PeerID
+		vendorHandle = contract.VendorListings[0].VendorID.Handle
+	}
+	n := repo.BackorderReleaseNotification{
+		ID:           repo.NewNotificationID(),
+		Type:         repo.NotifierTypeBackorderReleaseNotification,
+		OrderId:      orderID,
+		Thumbnail:    repo.Thumbnail{Tiny: thumbnailTiny, Small: thumbnailSmall},
+		VendorHandle: vendorHandle,
+		VendorID:     vendorID,
+	}
+	service.broadcast <- n
+	if err := service.datastore.Notifications().PutRecord(repo.NewNotification(n, time.Now(), false)); err != nil {
+		log.Error(err)
+	}
+	log.Debugf("successfully processed BACKORDER_RELEASE message from %s", p.Pretty())
+	return nil, nil
+}
+
+func (service *OpenBazaarService) handleAppointment(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	rc := new(pb.RicardianContract)
+	err := ptypes.UnmarshalAny(pmes.Payload, rc)
+	if err != nil {
+		return nil, err
+	}
+	if rc.VendorAppointment == nil || rc.VendorAppointment.Timestamp == nil {
+		return nil, fmt.Errorf("received %s message with nil VendorAppointment object", pmes.MessageType)
+	}
+	if rc.VendorAppointment.Rescheduled != (pmes.MessageType == pb.Message_APPOINTMENT_RESCHEDULE) {
+		return nil, fmt.Errorf("received %s message with a mismatched appointment", pmes.MessageType)
+	}
+	orderID := rc.VendorAppointment.OrderID
+	log.Debugf("received %s message for order %s from %s", pmes.MessageType, orderID, p.Pretty())
+
+	contract, state, _, _, _, _, err := service.datastore.Purchases().GetByOrderId(orderID)
+	if err != nil {
+		return nil, net.OutOfOrderMessage
+	}
+	// Appointments replace each other, so only the latest one is kept
+	if previous := contract.VendorAppointment; previous != nil && previous.Timestamp != nil &&
+		rc.VendorAppointment.Timestamp.Seconds <= previous.Timestamp.Seconds {
+		return nil, net.DuplicateMessage
+	}
+
+	contract.VendorAppointment = rc.VendorAppointment
+	var sigs []*pb.Signature
+	for _, sig := range contract.Signatures {
+		if sig.Section != pb.Signature_APPOINTMENT {
+			sigs = append(sigs, sig)
+		}
+	}
+	for _, sig := range rc.Signatures {
+		if sig.Section == pb.Signature_APPOINTMENT {
+			sigs = append(sigs, sig)
+		}
+	}
+	contract.Signatures = sigs
+	if err := service.node.ValidateAppointment(contract); err != nil {
+		return nil, err
+	}
+	if err := service.datastore.Purchases().Put(orderID, *contract, state, false); err != nil {
+		return nil, err
+	}
+	if err := service.node.RecordAppointment(contract); err != nil {
+		log.Error(err)
+	}
+
+	var thumbnailTiny, thumbnailSmall, vendorHandle, vendorID string
+	if len(contract.VendorListings) > 0 && contract.VendorListings[0].Item != nil && len(contract.VendorListings[0].Item.Images) > 0 {
+		thumbnailTiny = contract.VendorListings[0].Item.Images[0].Tiny
+		thumbnailSmall = contract.VendorListings[0].Item.Images[0].Small
+	}
+	if len(contract.VendorListings) > 0 && contract.VendorListings[0].VendorID != nil {
+		vendorID = contract.VendorListings[0].VendorID.PeerID
+		vendorHandle = contract.VendorListings[0].VendorID.Handle
+	}
+	n := repo.AppointmentNotification{
+		ID:           repo.NewNotificationID(),
+		Type:         repo.NotifierTypeAppointmentNotification,
+		OrderId:      orderID,
+		Thumbnail:    repo.Thumbnail{Tiny: thumbnailTiny, Small: thumbnailSmall},
+		VendorHandle: vendorHandle,
+		VendorID:     vendorID,
+		Start:        time.Unix(rc.VendorAppointment.Start.GetSeconds(), 0),
+		Rescheduled:  rc.VendorAppointment.Rescheduled,
+		Note:         rc.VendorAppointment.Note,
+	}
+	service.broadcast <- n
+	if err := service.datastore.Notifications().PutRecord(repo.NewNotification(n, time.Now(), false)); err != nil {
+		log.Error(err)
+	}
+	log.Debugf("successfully processed %s message from %s", pmes.MessageType, p.Pretty())
+	return nil, nil
+}
+
 func (service *OpenBazaarService) handleOrderCompletion(p peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
 
 	if pmes.Payload == nil {
@@ -1892,6 +2062,102 @@ func (service *OpenBazaarService) handleVendorFinalizedPayment(pid peer.ID, pmes
 	return nil, nil
 }
 
+func (service *OpenBazaarService) handlePriceQuoteRequest(pid peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	errorResponse := func(errMsg string) *pb.Message {
+		a, err := ptypes.MarshalAny(&pb.Error{ErrorMessage: errMsg})
+		if err != nil {
+			log.Errorf("failed marshaling errorResponse (%s) for price quote: %s", errMsg, err)
+		}
+		return &pb.Message{
+			MessageType: pb.Message_ERROR,
+			Payload:     a,
+		}
+	}
+
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	contract := new(pb.RicardianContract)
+	if err := ptypes.UnmarshalAny(pmes.Payload, contract); err != nil {
+		return nil, err
+	}
+	log.Debugf("Received PRICE_QUOTE_REQUEST message from %s", pid.Pretty())
+
+	pro, err := service.node.GetProfile()
+	if err != nil {
+		return errorResponse("unable to read vendor profile"), err
+	}
+	if !pro.Vendor {
+		return errorResponse("the vendor is not accepting orders at this time"), errors.New("store is turned off")
+	}
+
+	quote, err := service.node.NewPriceQuote(contract)
+	if err != nil {
+		return errorResponse(err.Error()), err
+	}
+	a, err := ptypes.MarshalAny(quote)
+	if err != nil {
+		return errorResponse("Error marshalling price quote"), err
+	}
+	return &pb.Message{
+		MessageType: pb.Message_PRICE_QUOTE,
+		Payload:     a,
+	}, nil
+}
+
+func (service *OpenBazaarService) handleSubscription(pid peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	errorResponse := func(errMsg string) *pb.Message {
+		a, err := ptypes.MarshalAny(&pb.Error{ErrorMessage: errMsg})
+		if err != nil {
+			log.Errorf("failed marshaling errorResponse (%s) for subscription: %s", errMsg, err)
+		}
+		return &pb.Message{
+			MessageType: pb.Message_ERROR,
+			Payload:     a,
+		}
+	}
+
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	agreement := new(pb.SignedSubscriptionAgreement)
+	if err := ptypes.UnmarshalAny(pmes.Payload, agreement); err != nil {
+		return nil, err
+	}
+	log.Debugf("Received SUBSCRIPTION message from %s", pid.Pretty())
+
+	pro, err := service.node.GetProfile()
+	if err != nil {
+		return errorResponse("unable to read vendor profile"), err
+	}
+	if !pro.Vendor {
+		return errorResponse("the vendor is not accepting orders at this time"), errors.New("store is turned off")
+	}
+	if err := service.node.AcceptSubscription(agreement, pid.Pretty()); err != nil {
+		return errorResponse(err.Error()), err
+	}
+	return pmes, nil
+}
+
+func (service *OpenBazaarService) handleSubscriptionCancel(pid peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
+	if pmes.Payload == nil {
+		return nil, ErrEmptyPayload
+	}
+	cancel := new(pb.SubscriptionCancel)
+	if err := ptypes.UnmarshalAny(pmes.Payload, cancel); err != nil {
+		return nil, err
+	}
+	log.Debugf("Received SUBSCRIPTION_CANCEL message from %s", pid.Pretty())
+
+	if err := service.node.SubscriptionCancelled(cancel.SubscriptionID, pid.Pretty()); err != nil {
+		if err == core.ErrSubscriptionInactive {
+			return nil, net.DuplicateMessage
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
 func (service *OpenBazaarService) handleStore(pid peer.ID, pmes *pb.Message, options interface{}) (*pb.Message, error) {
 	// If we aren't accepting store requests then ban this peer
 	if !service.node.AcceptStoreRequests {
@@ -21,10 +21,39 @@ import (
 	"github.com/OpenBazaar/openbazaar-go/repo"
 	ctxio "github.com/jbenet/go-context/io"
 	"github.com/op/go-logging"
+	prometheus "gx/ipfs/QmTQuFQWHAWy4wMH6ZyPfGiawA5u9T8rs79FENoV8yXaoS/client_golang/prometheus"
 )
 
 var log = logging.MustGetLogger("service")
 
+// messagesSent and messagesReceived count the direct messages exchanged
+// with other peers, labelled with the message type. Responses to requests
+// are counted with the message type of the response.
+var (
+	messagesSent = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "openbazaar",
+			Subsystem: "messages",
+			Name:      "sent_total",
+			Help:      "Number of messages sent directly to other peers.",
+		},
+		[]string{"type"},
+	)
+	messagesReceived = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "openbazaar",
+			Subsystem: "messages",
+			Name:      "received_total",
+			Help:      "Number of messages received directly from other peers.",
+		},
+		[]string{"type"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(messagesSent, messagesReceived)
+}
+
 type OpenBazaarService struct {
 	host      host.Host
 	self      peer.ID
@@ -116,6 +145,7 @@ func (service *OpenBazaarService) handleNewMessage(s inet.Stream) {
 			}
 			return
 		}
+		messagesReceived.WithLabelValues(pmes.MessageType.String()).Inc()
 
 		if pmes.IsResponse {
 			log.Debugf("received response message from %s: %d", mPeer.Pretty(), pmes.RequestId)
@@ -180,6 +210,7 @@ func (service *OpenBazaarService) handleNewMessage(s inet.Stream) {
 			log.Debugf("send response error: %s", err)
 			return
 		}
+		messagesSent.WithLabelValues(rpmes.MessageType.String()).Inc()
 	}
 }
 
@@ -195,6 +226,7 @@ func (service *OpenBazaarService) SendRequest(ctx context.Context, p peer.ID, pm
 		log.Debugf("No response from %s", p.Pretty())
 		return nil, err
 	}
+	messagesSent.WithLabelValues(pmes.MessageType.String()).Inc()
 
 	if rpmes == nil {
 		log.Debugf("No response from %s", p.Pretty())
@@ -217,5 +249,6 @@ func (service *OpenBazaarService) SendMessage(ctx context.Context, p peer.ID, pm
 	if err := ms.SendMessage(ctx, pmes); err != nil {
 		return err
 	}
+	messagesSent.WithLabelValues(pmes.MessageType.String()).Inc()
 	return nil
 }
@@ -98,6 +98,13 @@ func main() {
 	if err != nil {
 		log.Error(err)
 	}
+	_, err = parser.AddCommand("changepassword",
+		"change the database password",
+		"This command changes the password of the encrypted database, including the wallet keys stored in it, without decrypting it to disk.",
+		&cmd.ChangePassword{})
+	if err != nil {
+		log.Error(err)
+	}
 	_, err = parser.AddCommand("restore",
 		"restore user data",
 		"This command will attempt to restore user data (profile, listings, ratings, etc) by downloading them from the network. This will only work if the IPNS mapping is still available in the DHT. Optionally it will take a mnemonic seed to restore from.",
@@ -105,6 +112,36 @@ func main() {
 	if err != nil {
 		log.Error(err)
 	}
+	migrate := new(cmd.Migrate)
+	migrateCmd, err := parser.AddCommand("migrate",
+		"migrate the database schema",
+		"This command shows the schema version of the repo and runs or reverts migrations. The database, config and repover files are backed up before anything is changed and restored if a migration fails.",
+		migrate)
+	if err != nil {
+		log.Error(err)
+	} else {
+		_, err = migrateCmd.AddCommand("status",
+			"show the schema version",
+			"Prints the schema version of the repo and the migrations which have not run yet",
+			&cmd.MigrateStatus{Migrate: migrate})
+		if err != nil {
+			log.Error(err)
+		}
+		_, err = migrateCmd.AddCommand("up",
+			"run migrations",
+			"Runs the pending migrations up to the latest schema version or the version given with --to",
+			&cmd.MigrateUp{Migrate: migrate})
+		if err != nil {
+			log.Error(err)
+		}
+		_, err = migrateCmd.AddCommand("down",
+			"revert migrations",
+			"Reverts the last migration or the migrations down to the version given with --to",
+			&cmd.MigrateDown{Migrate: migrate})
+		if err != nil {
+			log.Error(err)
+		}
+	}
 	if len(os.Args) > 1 && (os.Args[1] == "--version" || os.Args[1] == "-v") {
 		fmt.Println(core.VERSION)
 		return
@@ -76,6 +76,7 @@ type OrderRespApi struct {
 	UnreadChatMessages         uint64               `protobuf:"varint,5,opt,name=unreadChatMessages,proto3" json:"unreadChatMessages,omitempty"`
 	PaymentAddressTransactions []*TransactionRecord `protobuf:"bytes,6,rep,name=paymentAddressTransactions,proto3" json:"paymentAddressTransactions,omitempty"`
 	RefundAddressTransaction   *TransactionRecord   `protobuf:"bytes,7,opt,name=refundAddressTransaction,proto3" json:"refundAddressTransaction,omitempty"`
+	ExchangeRate               *ExchangeRate        `protobuf:"bytes,8,opt,name=exchangeRate,proto3" json:"exchangeRate,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}             `json:"-"`
 	XXX_unrecognized           []byte               `json:"-"`
 	XXX_sizecache              int32                `json:"-"`
@@ -155,6 +156,62 @@ func (m *OrderRespApi) GetRefundAddressTransaction() *TransactionRecord {
 	return nil
 }
 
+func (m *OrderRespApi) GetExchangeRate() *ExchangeRate {
+	if m != nil {
+		return m.ExchangeRate
+	}
+	return nil
+}
+
+// ExchangeRate is the price of one payment coin in a currency at the time
+// the order was placed
+type ExchangeRate struct {
+	CurrencyCode         string   `protobuf:"bytes,1,opt,name=currencyCode,proto3" json:"currencyCode,omitempty"`
+	Rate                 float64  `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExchangeRate) Reset()         { *m = ExchangeRate{} }
+func (m *ExchangeRate) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRate) ProtoMessage()    {}
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+}
+
+func (m *ExchangeRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeRate.Unmarshal(m, b)
+}
+func (m *ExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeRate.Marshal(b, m, deterministic)
+}
+func (m *ExchangeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRate.Merge(m, src)
+}
+func (m *ExchangeRate) XXX_Size() int {
+	return xxx_messageInfo_ExchangeRate.Size(m)
+}
+func (m *ExchangeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRate proto.InternalMessageInfo
+
+func (m *ExchangeRate) GetCurrencyCode() string {
+	if m != nil {
+		return m.CurrencyCode
+	}
+	return ""
+}
+
+func (m *ExchangeRate) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
 type CaseRespApi struct {
 	Timestamp                      *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	BuyerContract                  *RicardianContract   `protobuf:"bytes,2,opt,name=buyerContract,proto3" json:"buyerContract,omitempty"`
@@ -176,7 +233,7 @@ func (m *CaseRespApi) Reset()         { *m = CaseRespApi{} }
 func (m *CaseRespApi) String() string { return proto.CompactTextString(m) }
 func (*CaseRespApi) ProtoMessage()    {}
 func (*CaseRespApi) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{2}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
 }
 
 func (m *CaseRespApi) XXX_Unmarshal(b []byte) error {
@@ -291,7 +348,7 @@ func (m *TransactionRecord) Reset()         { *m = TransactionRecord{} }
 func (m *TransactionRecord) String() string { return proto.CompactTextString(m) }
 func (*TransactionRecord) ProtoMessage()    {}
 func (*TransactionRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{3}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
 }
 
 func (m *TransactionRecord) XXX_Unmarshal(b []byte) error {
@@ -374,7 +431,7 @@ func (m *PeerAndProfile) Reset()         { *m = PeerAndProfile{} }
 func (m *PeerAndProfile) String() string { return proto.CompactTextString(m) }
 func (*PeerAndProfile) ProtoMessage()    {}
 func (*PeerAndProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{4}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
 }
 
 func (m *PeerAndProfile) XXX_Unmarshal(b []byte) error {
@@ -422,7 +479,7 @@ func (m *PeerAndProfileWithID) Reset()         { *m = PeerAndProfileWithID{} }
 func (m *PeerAndProfileWithID) String() string { return proto.CompactTextString(m) }
 func (*PeerAndProfileWithID) ProtoMessage()    {}
 func (*PeerAndProfileWithID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{5}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
 }
 
 func (m *PeerAndProfileWithID) XXX_Unmarshal(b []byte) error {
@@ -477,7 +534,7 @@ func (m *RatingWithID) Reset()         { *m = RatingWithID{} }
 func (m *RatingWithID) String() string { return proto.CompactTextString(m) }
 func (*RatingWithID) ProtoMessage()    {}
 func (*RatingWithID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{6}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{7}
 }
 
 func (m *RatingWithID) XXX_Unmarshal(b []byte) error {
@@ -522,6 +579,7 @@ func (m *RatingWithID) GetRating() *Rating {
 func init() {
 	proto.RegisterType((*Coupon)(nil), "Coupon")
 	proto.RegisterType((*OrderRespApi)(nil), "OrderRespApi")
+	proto.RegisterType((*ExchangeRate)(nil), "ExchangeRate")
 	proto.RegisterType((*CaseRespApi)(nil), "CaseRespApi")
 	proto.RegisterType((*TransactionRecord)(nil), "TransactionRecord")
 	proto.RegisterType((*PeerAndProfile)(nil), "PeerAndProfile")
@@ -534,47 +592,50 @@ func init() {
 }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 670 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6a, 0x1b, 0x3b,
-	0x10, 0xc6, 0xeb, 0xbf, 0xf5, 0x38, 0xf6, 0xe1, 0xe8, 0x84, 0xc3, 0x62, 0x38, 0x27, 0xee, 0xd2,
-	0x0b, 0x5f, 0x6d, 0x8a, 0x7b, 0x13, 0x7a, 0x97, 0xd8, 0x2d, 0x04, 0xda, 0x26, 0xa8, 0x21, 0x85,
-	0xf6, 0x4a, 0x5e, 0xc9, 0xb6, 0xc0, 0x96, 0x16, 0x49, 0x1b, 0x9a, 0x17, 0xe8, 0x63, 0xf4, 0xdd,
-	0xfa, 0x26, 0x45, 0x5a, 0xed, 0xc6, 0xae, 0xb3, 0x09, 0xbd, 0xd3, 0xcc, 0x7c, 0xf3, 0x49, 0x33,
-	0xf3, 0x8d, 0xa0, 0x47, 0x32, 0x9e, 0x64, 0x4a, 0x1a, 0x39, 0xfa, 0x2b, 0x95, 0xc2, 0x28, 0x92,
-	0x1a, 0xed, 0x1d, 0x47, 0x52, 0x51, 0xa6, 0x4a, 0x6b, 0x90, 0x29, 0xb9, 0xe4, 0x1b, 0xe6, 0xcd,
-	0x93, 0x95, 0x94, 0xab, 0x0d, 0x3b, 0x75, 0xd6, 0x22, 0x5f, 0x9e, 0x1a, 0xbe, 0x65, 0xda, 0x90,
-	0x6d, 0x56, 0x00, 0xe2, 0x57, 0xd0, 0x99, 0xc9, 0x3c, 0x93, 0x02, 0x21, 0x68, 0xad, 0x89, 0x5e,
-	0x47, 0x8d, 0x71, 0x63, 0xd2, 0xc3, 0xee, 0x6c, 0x7d, 0xa9, 0xa4, 0x2c, 0x0a, 0x0a, 0x9f, 0x3d,
-	0xc7, 0x3f, 0x03, 0x38, 0xba, 0xb2, 0x57, 0x62, 0xa6, 0xb3, 0xf3, 0x8c, 0xa3, 0x04, 0xc2, 0xf2,
-	0x4d, 0x2e, 0xb9, 0x3f, 0x45, 0x09, 0xe6, 0x29, 0x51, 0x94, 0x13, 0x31, 0xf3, 0x11, 0x5c, 0x61,
-	0xd0, 0x0b, 0x68, 0x6b, 0x43, 0x4c, 0xc1, 0x3a, 0x9c, 0xf6, 0x13, 0xc7, 0xf6, 0xc9, 0xba, 0x70,
-	0x11, 0xb1, 0xf7, 0x2a, 0x46, 0x68, 0xd4, 0x1c, 0x37, 0x26, 0x21, 0x76, 0x67, 0xf4, 0x2f, 0x74,
-	0x96, 0xb9, 0xa0, 0x8c, 0x46, 0x2d, 0xe7, 0xf5, 0x16, 0x4a, 0x00, 0xe5, 0xc2, 0x22, 0x66, 0x6b,
-	0x62, 0x3e, 0x30, 0xad, 0xc9, 0x8a, 0xe9, 0xa8, 0x3d, 0x6e, 0x4c, 0x5a, 0xf8, 0x91, 0x08, 0xc2,
-	0x30, 0xca, 0xc8, 0xfd, 0x96, 0x09, 0x73, 0x4e, 0xa9, 0x62, 0x5a, 0xdf, 0x28, 0x22, 0x34, 0x49,
-	0x0d, 0x97, 0x42, 0x47, 0x9d, 0x71, 0xd3, 0x15, 0xb0, 0xe3, 0xc4, 0x2c, 0x95, 0x8a, 0xe2, 0x27,
-	0xb2, 0xd0, 0x47, 0x88, 0x14, 0xb3, 0xef, 0x39, 0x0c, 0x46, 0x5d, 0xdf, 0x92, 0x43, 0xc6, 0xda,
-	0x9c, 0xf8, 0x47, 0x0b, 0xfa, 0x33, 0xa2, 0x59, 0xd9, 0xe2, 0x33, 0xe8, 0x55, 0x83, 0xf3, 0x3d,
-	0x1e, 0x25, 0xc5, 0x68, 0x93, 0x72, 0xb4, 0xc9, 0x4d, 0x89, 0xc0, 0x0f, 0x60, 0x74, 0x06, 0x83,
-	0x45, 0x7e, 0xcf, 0x54, 0x39, 0x07, 0xd7, 0xf4, 0xc7, 0x27, 0xb4, 0x0f, 0x44, 0x6f, 0x60, 0x78,
-	0xc7, 0x04, 0x95, 0x0f, 0xa9, 0xcd, 0xda, 0xd4, 0xdf, 0x90, 0x68, 0x0e, 0xff, 0xed, 0x91, 0xdd,
-	0x92, 0x0d, 0xa7, 0xc4, 0x96, 0xf6, 0x56, 0x29, 0xa9, 0x74, 0xd4, 0x1a, 0x37, 0x27, 0x3d, 0xfc,
-	0x34, 0x08, 0xbd, 0x83, 0xff, 0xf7, 0x79, 0x0f, 0x68, 0xda, 0x8e, 0xe6, 0x19, 0xd4, 0x83, 0xe0,
-	0x3a, 0xcf, 0x0a, 0xae, 0xbb, 0x23, 0xb8, 0x31, 0xf4, 0xdd, 0xfb, 0xae, 0x32, 0x26, 0x18, 0x8d,
-	0x42, 0x17, 0xda, 0x75, 0xa1, 0x63, 0x68, 0xa7, 0x1b, 0xc2, 0xb7, 0x51, 0xcf, 0xed, 0x47, 0x61,
-	0xd4, 0x08, 0x12, 0x6a, 0x05, 0x39, 0x05, 0x50, 0x4c, 0xcb, 0x4d, 0xee, 0xe4, 0xd2, 0xf7, 0x4d,
-	0x9e, 0x73, 0x9d, 0xe5, 0xc6, 0x2a, 0xc0, 0x47, 0xf0, 0x0e, 0x2a, 0xfe, 0x1e, 0xc0, 0xdf, 0x07,
-	0x82, 0xb2, 0x55, 0x98, 0x6f, 0x9c, 0x96, 0x2b, 0x6c, 0xcf, 0x28, 0x82, 0xf6, 0x1d, 0xd9, 0xe4,
-	0xc5, 0xb6, 0x35, 0x2f, 0x82, 0xa8, 0x81, 0x0b, 0x07, 0x7a, 0x09, 0x83, 0x54, 0x8a, 0x25, 0x57,
-	0x5b, 0x52, 0x68, 0xdf, 0xce, 0x77, 0x80, 0xf7, 0x9d, 0x76, 0xed, 0xd6, 0x8c, 0xaf, 0xd6, 0xc6,
-	0xad, 0xdd, 0x00, 0x7b, 0x6b, 0x5f, 0x92, 0xed, 0x3f, 0x91, 0xe4, 0x29, 0x84, 0x69, 0xae, 0x14,
-	0x13, 0xe9, 0xbd, 0x9b, 0x48, 0x7f, 0xfa, 0x4f, 0x32, 0xf3, 0x8e, 0x39, 0x5b, 0x72, 0xc1, 0x5d,
-	0x49, 0x15, 0x08, 0x8d, 0x20, 0x5c, 0xf0, 0xd5, 0xad, 0xab, 0xa2, 0xeb, 0x4a, 0xab, 0xec, 0xf8,
-	0x3d, 0x0c, 0xaf, 0x19, 0x53, 0xe7, 0x82, 0x5e, 0x17, 0x1f, 0x9f, 0x7d, 0x70, 0xc6, 0x98, 0xba,
-	0x2c, 0xdb, 0xe0, 0x2d, 0x14, 0x43, 0xd7, 0xff, 0x8d, 0x7e, 0x07, 0xc2, 0xc4, 0xa7, 0xe0, 0x32,
-	0x10, 0x2f, 0xe0, 0x78, 0x9f, 0xed, 0x33, 0x37, 0xeb, 0xcb, 0x39, 0x1a, 0x42, 0x50, 0xb5, 0x35,
-	0xe0, 0x74, 0xe7, 0x8e, 0xa0, 0xee, 0x8e, 0x66, 0xdd, 0x1d, 0x5f, 0xe1, 0x08, 0x13, 0xc3, 0xc5,
-	0xaa, 0x86, 0x7b, 0x04, 0xa1, 0x72, 0xf1, 0x8a, 0xbd, 0xb2, 0xd1, 0x09, 0x74, 0x8a, 0xb3, 0xa7,
-	0xef, 0x26, 0x05, 0x15, 0xf6, 0xee, 0x8b, 0xd6, 0x97, 0x20, 0x5b, 0x2c, 0x3a, 0x6e, 0x00, 0xaf,
-	0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x26, 0xa7, 0xc9, 0x5a, 0x37, 0x06, 0x00, 0x00,
+	// 711 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x5d, 0x6f, 0xd3, 0x30,
+	0x14, 0x55, 0xd3, 0xaf, 0xf4, 0xf6, 0x03, 0x61, 0x26, 0x14, 0x55, 0x82, 0x95, 0x88, 0x87, 0x3e,
+	0x65, 0x50, 0x5e, 0x26, 0xde, 0xb6, 0x76, 0x93, 0x26, 0x01, 0x9b, 0xcc, 0x34, 0x24, 0x78, 0x72,
+	0x93, 0xdb, 0xd6, 0x52, 0x6b, 0x47, 0x76, 0x32, 0x6d, 0x7f, 0x80, 0x5f, 0xc0, 0x33, 0xbf, 0x15,
+	0xc5, 0x71, 0xb2, 0x86, 0xd2, 0x4d, 0xbc, 0xf9, 0x9e, 0x7b, 0x7c, 0x6c, 0xdf, 0x7b, 0xae, 0xa1,
+	0xc3, 0x62, 0x1e, 0xc4, 0x4a, 0x26, 0x72, 0xf8, 0x2c, 0x94, 0x22, 0x51, 0x2c, 0x4c, 0xb4, 0x05,
+	0x7a, 0x52, 0x45, 0xa8, 0x8a, 0xa8, 0x1f, 0x2b, 0xb9, 0xe0, 0x6b, 0xb4, 0xe1, 0xe1, 0x52, 0xca,
+	0xe5, 0x1a, 0x8f, 0x4c, 0x34, 0x4f, 0x17, 0x47, 0x09, 0xdf, 0xa0, 0x4e, 0xd8, 0x26, 0xce, 0x09,
+	0xfe, 0x3b, 0x68, 0x4d, 0x65, 0x1a, 0x4b, 0x41, 0x08, 0x34, 0x56, 0x4c, 0xaf, 0xbc, 0xda, 0xa8,
+	0x36, 0xee, 0x50, 0xb3, 0xce, 0xb0, 0x50, 0x46, 0xe8, 0x39, 0x39, 0x96, 0xad, 0xfd, 0x5f, 0x75,
+	0xe8, 0x5d, 0x66, 0x47, 0x52, 0xd4, 0xf1, 0x49, 0xcc, 0x49, 0x00, 0x6e, 0x71, 0x27, 0xb3, 0xb9,
+	0x3b, 0x21, 0x01, 0xe5, 0x21, 0x53, 0x11, 0x67, 0x62, 0x6a, 0x33, 0xb4, 0xe4, 0x90, 0x37, 0xd0,
+	0xd4, 0x09, 0x4b, 0x72, 0xd5, 0xc1, 0xa4, 0x1b, 0x18, 0xb5, 0xaf, 0x19, 0x44, 0xf3, 0x4c, 0x76,
+	0xae, 0x42, 0x16, 0x79, 0xf5, 0x51, 0x6d, 0xec, 0x52, 0xb3, 0x26, 0x2f, 0xa1, 0xb5, 0x48, 0x45,
+	0x84, 0x91, 0xd7, 0x30, 0xa8, 0x8d, 0x48, 0x00, 0x24, 0x15, 0x19, 0x63, 0xba, 0x62, 0xc9, 0x67,
+	0xd4, 0x9a, 0x2d, 0x51, 0x7b, 0xcd, 0x51, 0x6d, 0xdc, 0xa0, 0xff, 0xc8, 0x10, 0x0a, 0xc3, 0x98,
+	0xdd, 0x6f, 0x50, 0x24, 0x27, 0x51, 0xa4, 0x50, 0xeb, 0x6b, 0xc5, 0x84, 0x66, 0x61, 0xc2, 0xa5,
+	0xd0, 0x5e, 0x6b, 0x54, 0x37, 0x0f, 0xd8, 0x02, 0x29, 0x86, 0x52, 0x45, 0xf4, 0x91, 0x5d, 0xe4,
+	0x0b, 0x78, 0x0a, 0xb3, 0xfb, 0xec, 0x26, 0xbd, 0xb6, 0x2d, 0xc9, 0xae, 0xe2, 0xde, 0x3d, 0xe4,
+	0x3d, 0xf4, 0xf0, 0x2e, 0x5c, 0x31, 0xb1, 0x44, 0x9a, 0x55, 0xca, 0x35, 0x1a, 0xfd, 0xe0, 0x6c,
+	0x0b, 0xa4, 0x15, 0x8a, 0x7f, 0x0e, 0xbd, 0xed, 0x2c, 0xf1, 0xa1, 0x17, 0xa6, 0x4a, 0xa1, 0x08,
+	0xef, 0xa7, 0x59, 0x0b, 0xf3, 0xb6, 0x56, 0x30, 0x53, 0xe6, 0xa2, 0x11, 0x35, 0x6a, 0xd6, 0xfe,
+	0xef, 0x06, 0x74, 0xa7, 0x4c, 0x63, 0xd1, 0xdd, 0x63, 0xe8, 0x94, 0x9e, 0xb1, 0xed, 0x1d, 0x06,
+	0xb9, 0xab, 0x82, 0xc2, 0x55, 0xc1, 0x75, 0xc1, 0xa0, 0x0f, 0x64, 0x72, 0x0c, 0xfd, 0x79, 0x7a,
+	0x8f, 0xaa, 0xb0, 0x80, 0xe7, 0xd8, 0x4a, 0xec, 0x9a, 0xa3, 0x4a, 0x24, 0x1f, 0x61, 0x70, 0x8b,
+	0x22, 0x92, 0x0f, 0x5b, 0xeb, 0x7b, 0xb7, 0xfe, 0xc5, 0x24, 0x33, 0x78, 0x55, 0x11, 0xbb, 0x61,
+	0x6b, 0x1e, 0xb1, 0xac, 0xaa, 0x67, 0x4a, 0x49, 0xa5, 0xbd, 0xc6, 0xa8, 0x3e, 0xee, 0xd0, 0xc7,
+	0x49, 0xe4, 0x1c, 0x5e, 0x57, 0x75, 0x77, 0x64, 0x9a, 0x46, 0xe6, 0x09, 0xd6, 0x83, 0xd7, 0x5b,
+	0x4f, 0x7a, 0xbd, 0xbd, 0xe5, 0xf5, 0x11, 0x74, 0xcd, 0xfd, 0x2e, 0x63, 0x14, 0x18, 0x99, 0xf6,
+	0xbb, 0x74, 0x1b, 0x22, 0x07, 0xd0, 0x0c, 0xd7, 0x8c, 0x6f, 0xbc, 0x8e, 0xe9, 0x6b, 0x1e, 0xec,
+	0x99, 0x05, 0xd8, 0x3b, 0x0b, 0x13, 0x00, 0x85, 0x5a, 0xae, 0x53, 0xe3, 0xd4, 0xae, 0x2d, 0xf2,
+	0x8c, 0xeb, 0x38, 0x4d, 0x90, 0x96, 0x19, 0xba, 0xc5, 0xf2, 0x7f, 0x3a, 0xf0, 0x7c, 0xc7, 0xcb,
+	0xd9, 0x2b, 0x92, 0x3b, 0x1e, 0x15, 0xbf, 0x47, 0xb6, 0x26, 0x1e, 0x34, 0x6f, 0xd9, 0x3a, 0xcd,
+	0xfd, 0x55, 0x3f, 0x75, 0xbc, 0x1a, 0xcd, 0x01, 0xf2, 0x16, 0xfa, 0xa1, 0x14, 0x0b, 0xae, 0x36,
+	0x2c, 0x1f, 0xbb, 0xac, 0xbf, 0x7d, 0x5a, 0x05, 0xb3, 0x89, 0x5f, 0x21, 0x5f, 0xae, 0x12, 0x33,
+	0xf1, 0x7d, 0x6a, 0xa3, 0xaa, 0x25, 0x9b, 0xff, 0x63, 0xc9, 0x23, 0x70, 0x8b, 0x01, 0x30, 0x1d,
+	0xe9, 0x4e, 0x5e, 0x04, 0x53, 0x0b, 0xcc, 0x70, 0xc1, 0x05, 0x37, 0x4f, 0x2a, 0x49, 0x64, 0x08,
+	0xee, 0x9c, 0x2f, 0x6f, 0xcc, 0x2b, 0xda, 0xe6, 0x69, 0x65, 0xec, 0x7f, 0x82, 0xc1, 0x15, 0xa2,
+	0x3a, 0x11, 0xd1, 0x55, 0xfe, 0xe7, 0x66, 0x17, 0x8e, 0x11, 0xd5, 0x45, 0x51, 0x06, 0x1b, 0x11,
+	0x1f, 0xda, 0xf6, 0x5b, 0xb6, 0x33, 0xe0, 0x06, 0x76, 0x0b, 0x2d, 0x12, 0xfe, 0x1c, 0x0e, 0xaa,
+	0x6a, 0xdf, 0x78, 0xb2, 0xba, 0x98, 0x91, 0x01, 0x38, 0x65, 0x59, 0x1d, 0x1e, 0x6d, 0x9d, 0xe1,
+	0xec, 0x3b, 0xa3, 0xbe, 0xef, 0x8c, 0x1f, 0xd0, 0xa3, 0x2c, 0xe1, 0x62, 0xb9, 0x47, 0x7b, 0x08,
+	0xae, 0x32, 0xf9, 0x52, 0xbd, 0x8c, 0xc9, 0x21, 0xb4, 0xf2, 0xb5, 0x95, 0x6f, 0x07, 0xb9, 0x14,
+	0xb5, 0xf0, 0x69, 0xe3, 0xbb, 0x13, 0xcf, 0xe7, 0x2d, 0xd3, 0x80, 0x0f, 0x7f, 0x06, 0x00, 0x9a,
+	0x1f, 0x6d, 0xf2, 0xb2, 0x06, 0x00, 0x00,
 }
@@ -144,6 +144,8 @@ const (
 	Signature_DISPUTE            Signature_Section = 5
 	Signature_DISPUTE_RESOLUTION Signature_Section = 6
 	Signature_REFUND             Signature_Section = 7
+	Signature_BACKORDER_RELEASE  Signature_Section = 8
+	Signature_APPOINTMENT        Signature_Section = 9
 )
 
 var Signature_Section_name = map[int32]string{
@@ -155,6 +157,8 @@ var Signature_Section_name = map[int32]string{
 	5: "DISPUTE",
 	6: "DISPUTE_RESOLUTION",
 	7: "REFUND",
+	8: "BACKORDER_RELEASE",
+	9: "APPOINTMENT",
 }
 
 var Signature_Section_value = map[string]int32{
@@ -166,6 +170,8 @@ var Signature_Section_value = map[string]int32{
 	"DISPUTE":            5,
 	"DISPUTE_RESOLUTION": 6,
 	"REFUND":             7,
+	"BACKORDER_RELEASE":  8,
+	"APPOINTMENT":        9,
 }
 
 func (x Signature_Section) String() string {
@@ -173,7 +179,7 @@ func (x Signature_Section) String() string {
 }
 
 func (Signature_Section) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{19, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{27, 0}
 }
 
 type RicardianContract struct {
@@ -188,6 +194,8 @@ type RicardianContract struct {
 	Refund                  *Refund             `protobuf:"bytes,9,opt,name=refund,proto3" json:"refund,omitempty"`
 	Signatures              []*Signature        `protobuf:"bytes,10,rep,name=signatures,proto3" json:"signatures,omitempty"`
 	Errors                  []string            `protobuf:"bytes,11,rep,name=errors,proto3" json:"errors,omitempty"`
+	VendorBackorderRelease  *BackorderRelease   `protobuf:"bytes,12,opt,name=vendorBackorderRelease,proto3" json:"vendorBackorderRelease,omitempty"`
+	VendorAppointment       *Appointment        `protobuf:"bytes,13,opt,name=vendorAppointment,proto3" json:"vendorAppointment,omitempty"`
 	XXX_NoUnkeyedLiteral    struct{}            `json:"-"`
 	XXX_unrecognized        []byte              `json:"-"`
 	XXX_sizecache           int32               `json:"-"`
@@ -295,6 +303,20 @@ func (m *RicardianContract) GetErrors() []string {
 	return nil
 }
 
+func (m *RicardianContract) GetVendorBackorderRelease() *BackorderRelease {
+	if m != nil {
+		return m.VendorBackorderRelease
+	}
+	return nil
+}
+
+func (m *RicardianContract) GetVendorAppointment() *Appointment {
+	if m != nil {
+		return m.VendorAppointment
+	}
+	return nil
+}
+
 type CurrencyDefinition struct {
 	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
 	Divisibility         uint32   `protobuf:"varint,2,opt,name=divisibility,proto3" json:"divisibility,omitempty"`
@@ -353,6 +375,12 @@ type Listing struct {
 	Moderators           []string                  `protobuf:"bytes,8,rep,name=moderators,proto3" json:"moderators,omitempty"`
 	TermsAndConditions   string                    `protobuf:"bytes,9,opt,name=termsAndConditions,proto3" json:"termsAndConditions,omitempty"`
 	RefundPolicy         string                    `protobuf:"bytes,10,opt,name=refundPolicy,proto3" json:"refundPolicy,omitempty"`
+	TaxInclusive         bool                      `protobuf:"varint,11,opt,name=taxInclusive,proto3" json:"taxInclusive,omitempty"`
+	StoreTaxes           bool                      `protobuf:"varint,12,opt,name=storeTaxes,proto3" json:"storeTaxes,omitempty"`
+	Subscription         *Listing_Subscription     `protobuf:"bytes,13,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	Backorder            *Listing_Backorder        `protobuf:"bytes,14,opt,name=backorder,proto3" json:"backorder,omitempty"`
+	Crowdfund            *Listing_Crowdfund        `protobuf:"bytes,15,opt,name=crowdfund,proto3" json:"crowdfund,omitempty"`
+	Availability         *Listing_Availability     `protobuf:"bytes,16,opt,name=availability,proto3" json:"availability,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
 	XXX_sizecache        int32                     `json:"-"`
@@ -453,6 +481,48 @@ func (m *Listing) GetRefundPolicy() string {
 	return ""
 }
 
+func (m *Listing) GetTaxInclusive() bool {
+	if m != nil {
+		return m.TaxInclusive
+	}
+	return false
+}
+
+func (m *Listing) GetStoreTaxes() bool {
+	if m != nil {
+		return m.StoreTaxes
+	}
+	return false
+}
+
+func (m *Listing) GetSubscription() *Listing_Subscription {
+	if m != nil {
+		return m.Subscription
+	}
+	return nil
+}
+
+func (m *Listing) GetBackorder() *Listing_Backorder {
+	if m != nil {
+		return m.Backorder
+	}
+	return nil
+}
+
+func (m *Listing) GetCrowdfund() *Listing_Crowdfund {
+	if m != nil {
+		return m.Crowdfund
+	}
+	return nil
+}
+
+func (m *Listing) GetAvailability() *Listing_Availability {
+	if m != nil {
+		return m.Availability
+	}
+	return nil
+}
+
 type Listing_Metadata struct {
 	Version                 uint32                        `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	ContractType            Listing_Metadata_ContractType `protobuf:"varint,2,opt,name=contractType,proto3,enum=Listing_Metadata_ContractType" json:"contractType,omitempty"`
@@ -1068,15 +1138,20 @@ func (m *Listing_ShippingOption) GetServices() []*Listing_ShippingOption_Service
 }
 
 type Listing_ShippingOption_Service struct {
-	Name                   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Price                  uint64   `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"` // Deprecated: Do not use.
-	EstimatedDelivery      string   `protobuf:"bytes,3,opt,name=estimatedDelivery,proto3" json:"estimatedDelivery,omitempty"`
-	AdditionalItemPrice    uint64   `protobuf:"varint,4,opt,name=additionalItemPrice,proto3" json:"additionalItemPrice,omitempty"` // Deprecated: Do not use.
-	BigPrice               string   `protobuf:"bytes,5,opt,name=bigPrice,proto3" json:"bigPrice,omitempty"`
-	BigAdditionalItemPrice string   `protobuf:"bytes,6,opt,name=bigAdditionalItemPrice,proto3" json:"bigAdditionalItemPrice,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+	Name                   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Price                  uint64 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"` // Deprecated: Do not use.
+	EstimatedDelivery      string `protobuf:"bytes,3,opt,name=estimatedDelivery,proto3" json:"estimatedDelivery,omitempty"`
+	AdditionalItemPrice    uint64 `protobuf:"varint,4,opt,name=additionalItemPrice,proto3" json:"additionalItemPrice,omitempty"` // Deprecated: Do not use.
+	BigPrice               string `protobuf:"bytes,5,opt,name=bigPrice,proto3" json:"bigPrice,omitempty"`
+	BigAdditionalItemPrice string `protobuf:"bytes,6,opt,name=bigAdditionalItemPrice,proto3" json:"bigAdditionalItemPrice,omitempty"`
+	// A service is priced by weight, by quantity or with the flat
+	// prices above. The rates are shared by the items of an order
+	// sent with the service, see WeightRate and QuantityRate.
+	WeightRates          []*Listing_ShippingOption_Service_WeightRate   `protobuf:"bytes,7,rep,name=weightRates,proto3" json:"weightRates,omitempty"`
+	QuantityRates        []*Listing_ShippingOption_Service_QuantityRate `protobuf:"bytes,8,rep,name=quantityRates,proto3" json:"quantityRates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
 }
 
 func (m *Listing_ShippingOption_Service) Reset()         { *m = Listing_ShippingOption_Service{} }
@@ -1148,14 +1223,135 @@ func (m *Listing_ShippingOption_Service) GetBigAdditionalItemPrice() string {
 	return ""
 }
 
+func (m *Listing_ShippingOption_Service) GetWeightRates() []*Listing_ShippingOption_Service_WeightRate {
+	if m != nil {
+		return m.WeightRates
+	}
+	return nil
+}
+
+func (m *Listing_ShippingOption_Service) GetQuantityRates() []*Listing_ShippingOption_Service_QuantityRate {
+	if m != nil {
+		return m.QuantityRates
+	}
+	return nil
+}
+
+// The price of shipping the items of an order sent with the
+// service when they weigh at most maxGrams in total
+type Listing_ShippingOption_Service_WeightRate struct {
+	MaxGrams             uint64   `protobuf:"varint,1,opt,name=maxGrams,proto3" json:"maxGrams,omitempty"`
+	BigPrice             string   `protobuf:"bytes,2,opt,name=bigPrice,proto3" json:"bigPrice,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Listing_ShippingOption_Service_WeightRate) Reset() {
+	*m = Listing_ShippingOption_Service_WeightRate{}
+}
+func (m *Listing_ShippingOption_Service_WeightRate) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Listing_ShippingOption_Service_WeightRate) ProtoMessage() {}
+func (*Listing_ShippingOption_Service_WeightRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 2, 0, 0}
+}
+
+func (m *Listing_ShippingOption_Service_WeightRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_ShippingOption_Service_WeightRate.Unmarshal(m, b)
+}
+func (m *Listing_ShippingOption_Service_WeightRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_ShippingOption_Service_WeightRate.Marshal(b, m, deterministic)
+}
+func (m *Listing_ShippingOption_Service_WeightRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_ShippingOption_Service_WeightRate.Merge(m, src)
+}
+func (m *Listing_ShippingOption_Service_WeightRate) XXX_Size() int {
+	return xxx_messageInfo_Listing_ShippingOption_Service_WeightRate.Size(m)
+}
+func (m *Listing_ShippingOption_Service_WeightRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_ShippingOption_Service_WeightRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Listing_ShippingOption_Service_WeightRate proto.InternalMessageInfo
+
+func (m *Listing_ShippingOption_Service_WeightRate) GetMaxGrams() uint64 {
+	if m != nil {
+		return m.MaxGrams
+	}
+	return 0
+}
+
+func (m *Listing_ShippingOption_Service_WeightRate) GetBigPrice() string {
+	if m != nil {
+		return m.BigPrice
+	}
+	return ""
+}
+
+// The price of shipping the items of an order sent with the
+// service when there are at most maxQuantity of them
+type Listing_ShippingOption_Service_QuantityRate struct {
+	MaxQuantity          uint64   `protobuf:"varint,1,opt,name=maxQuantity,proto3" json:"maxQuantity,omitempty"`
+	BigPrice             string   `protobuf:"bytes,2,opt,name=bigPrice,proto3" json:"bigPrice,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Listing_ShippingOption_Service_QuantityRate) Reset() {
+	*m = Listing_ShippingOption_Service_QuantityRate{}
+}
+func (m *Listing_ShippingOption_Service_QuantityRate) String() string {
+	return proto.CompactTextString(m)
+}
+func (*Listing_ShippingOption_Service_QuantityRate) ProtoMessage() {}
+func (*Listing_ShippingOption_Service_QuantityRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 2, 0, 1}
+}
+
+func (m *Listing_ShippingOption_Service_QuantityRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_ShippingOption_Service_QuantityRate.Unmarshal(m, b)
+}
+func (m *Listing_ShippingOption_Service_QuantityRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_ShippingOption_Service_QuantityRate.Marshal(b, m, deterministic)
+}
+func (m *Listing_ShippingOption_Service_QuantityRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_ShippingOption_Service_QuantityRate.Merge(m, src)
+}
+func (m *Listing_ShippingOption_Service_QuantityRate) XXX_Size() int {
+	return xxx_messageInfo_Listing_ShippingOption_Service_QuantityRate.Size(m)
+}
+func (m *Listing_ShippingOption_Service_QuantityRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_ShippingOption_Service_QuantityRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Listing_ShippingOption_Service_QuantityRate proto.InternalMessageInfo
+
+func (m *Listing_ShippingOption_Service_QuantityRate) GetMaxQuantity() uint64 {
+	if m != nil {
+		return m.MaxQuantity
+	}
+	return 0
+}
+
+func (m *Listing_ShippingOption_Service_QuantityRate) GetBigPrice() string {
+	if m != nil {
+		return m.BigPrice
+	}
+	return ""
+}
+
 type Listing_Tax struct {
-	TaxType              string        `protobuf:"bytes,1,opt,name=taxType,proto3" json:"taxType,omitempty"`
-	TaxRegions           []CountryCode `protobuf:"varint,2,rep,packed,name=taxRegions,proto3,enum=CountryCode" json:"taxRegions,omitempty"`
-	TaxShipping          bool          `protobuf:"varint,3,opt,name=taxShipping,proto3" json:"taxShipping,omitempty"`
-	Percentage           float32       `protobuf:"fixed32,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	TaxType              string                 `protobuf:"bytes,1,opt,name=taxType,proto3" json:"taxType,omitempty"`
+	TaxRegions           []CountryCode          `protobuf:"varint,2,rep,packed,name=taxRegions,proto3,enum=CountryCode" json:"taxRegions,omitempty"`
+	TaxShipping          bool                   `protobuf:"varint,3,opt,name=taxShipping,proto3" json:"taxShipping,omitempty"`
+	Percentage           float32                `protobuf:"fixed32,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	Rules                []*Listing_Tax_TaxRule `protobuf:"bytes,5,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
 func (m *Listing_Tax) Reset()         { *m = Listing_Tax{} }
@@ -1211,154 +1407,498 @@ func (m *Listing_Tax) GetPercentage() float32 {
 	return 0
 }
 
-type Listing_Coupon struct {
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	// Types that are valid to be assigned to Code:
-	//	*Listing_Coupon_Hash
-	//	*Listing_Coupon_DiscountCode
-	Code isListing_Coupon_Code `protobuf_oneof:"code"`
-	// Types that are valid to be assigned to Discount:
-	//	*Listing_Coupon_PercentDiscount
-	//	*Listing_Coupon_PriceDiscount
-	//	*Listing_Coupon_BigPriceDiscount
-	Discount             isListing_Coupon_Discount `protobuf_oneof:"discount"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+func (m *Listing_Tax) GetRules() []*Listing_Tax_TaxRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
 }
 
-func (m *Listing_Coupon) Reset()         { *m = Listing_Coupon{} }
-func (m *Listing_Coupon) String() string { return proto.CompactTextString(m) }
-func (*Listing_Coupon) ProtoMessage()    {}
-func (*Listing_Coupon) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{2, 4}
+// TaxRule sets the percentage charged in part of a country. The most
+// specific rule matching the shipping address applies, otherwise
+// the tax percentage applies in the tax regions.
+type Listing_Tax_TaxRule struct {
+	Country              CountryCode `protobuf:"varint,1,opt,name=country,proto3,enum=CountryCode" json:"country,omitempty"`
+	State                string      `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode           string      `protobuf:"bytes,3,opt,name=postalCode,proto3" json:"postalCode,omitempty"`
+	Percentage           float32     `protobuf:"fixed32,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *Listing_Coupon) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Listing_Coupon.Unmarshal(m, b)
+func (m *Listing_Tax_TaxRule) Reset()         { *m = Listing_Tax_TaxRule{} }
+func (m *Listing_Tax_TaxRule) String() string { return proto.CompactTextString(m) }
+func (*Listing_Tax_TaxRule) ProtoMessage()    {}
+func (*Listing_Tax_TaxRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 3, 0}
 }
-func (m *Listing_Coupon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Listing_Coupon.Marshal(b, m, deterministic)
+
+func (m *Listing_Tax_TaxRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_Tax_TaxRule.Unmarshal(m, b)
 }
-func (m *Listing_Coupon) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Listing_Coupon.Merge(m, src)
+func (m *Listing_Tax_TaxRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_Tax_TaxRule.Marshal(b, m, deterministic)
 }
-func (m *Listing_Coupon) XXX_Size() int {
-	return xxx_messageInfo_Listing_Coupon.Size(m)
+func (m *Listing_Tax_TaxRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_Tax_TaxRule.Merge(m, src)
 }
-func (m *Listing_Coupon) XXX_DiscardUnknown() {
-	xxx_messageInfo_Listing_Coupon.DiscardUnknown(m)
+func (m *Listing_Tax_TaxRule) XXX_Size() int {
+	return xxx_messageInfo_Listing_Tax_TaxRule.Size(m)
+}
+func (m *Listing_Tax_TaxRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_Tax_TaxRule.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Listing_Coupon proto.InternalMessageInfo
+var xxx_messageInfo_Listing_Tax_TaxRule proto.InternalMessageInfo
 
-func (m *Listing_Coupon) GetTitle() string {
+func (m *Listing_Tax_TaxRule) GetCountry() CountryCode {
 	if m != nil {
-		return m.Title
+		return m.Country
+	}
+	return CountryCode_NA
+}
+
+func (m *Listing_Tax_TaxRule) GetState() string {
+	if m != nil {
+		return m.State
 	}
 	return ""
 }
 
-type isListing_Coupon_Code interface {
-	isListing_Coupon_Code()
+func (m *Listing_Tax_TaxRule) GetPostalCode() string {
+	if m != nil {
+		return m.PostalCode
+	}
+	return ""
 }
 
-type Listing_Coupon_Hash struct {
-	Hash string `protobuf:"bytes,2,opt,name=hash,proto3,oneof"`
+func (m *Listing_Tax_TaxRule) GetPercentage() float32 {
+	if m != nil {
+		return m.Percentage
+	}
+	return 0
 }
 
-type Listing_Coupon_DiscountCode struct {
-	DiscountCode string `protobuf:"bytes,3,opt,name=discountCode,proto3,oneof"`
+// Subscription sets the terms of recurring orders. The buyer agrees to a
+// number of periods, up to maxPeriods unless it is zero, and is sent a
+// new order every intervalDays.
+type Listing_Subscription struct {
+	IntervalDays         uint32   `protobuf:"varint,1,opt,name=intervalDays,proto3" json:"intervalDays,omitempty"`
+	MaxPeriods           uint32   `protobuf:"varint,2,opt,name=maxPeriods,proto3" json:"maxPeriods,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (*Listing_Coupon_Hash) isListing_Coupon_Code() {}
+func (m *Listing_Subscription) Reset()         { *m = Listing_Subscription{} }
+func (m *Listing_Subscription) String() string { return proto.CompactTextString(m) }
+func (*Listing_Subscription) ProtoMessage()    {}
+func (*Listing_Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 4}
+}
 
-func (*Listing_Coupon_DiscountCode) isListing_Coupon_Code() {}
+func (m *Listing_Subscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_Subscription.Unmarshal(m, b)
+}
+func (m *Listing_Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_Subscription.Marshal(b, m, deterministic)
+}
+func (m *Listing_Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_Subscription.Merge(m, src)
+}
+func (m *Listing_Subscription) XXX_Size() int {
+	return xxx_messageInfo_Listing_Subscription.Size(m)
+}
+func (m *Listing_Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_Subscription.DiscardUnknown(m)
+}
 
-func (m *Listing_Coupon) GetCode() isListing_Coupon_Code {
+var xxx_messageInfo_Listing_Subscription proto.InternalMessageInfo
+
+func (m *Listing_Subscription) GetIntervalDays() uint32 {
 	if m != nil {
-		return m.Code
+		return m.IntervalDays
 	}
-	return nil
+	return 0
 }
 
-func (m *Listing_Coupon) GetHash() string {
-	if x, ok := m.GetCode().(*Listing_Coupon_Hash); ok {
-		return x.Hash
+func (m *Listing_Subscription) GetMaxPeriods() uint32 {
+	if m != nil {
+		return m.MaxPeriods
 	}
-	return ""
+	return 0
 }
 
-func (m *Listing_Coupon) GetDiscountCode() string {
-	if x, ok := m.GetCode().(*Listing_Coupon_DiscountCode); ok {
-		return x.DiscountCode
-	}
-	return ""
+// Backorder lets the stock of each variant go down to -limit. Orders
+// beyond the stock are backorders. Every order of a preorder listing is
+// a preorder, which ships from the expected ship date.
+type Listing_Backorder struct {
+	Preorder             bool                 `protobuf:"varint,1,opt,name=preorder,proto3" json:"preorder,omitempty"`
+	ExpectedShipDate     *timestamp.Timestamp `protobuf:"bytes,2,opt,name=expectedShipDate,proto3" json:"expectedShipDate,omitempty"`
+	Limit                uint64               `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-type isListing_Coupon_Discount interface {
-	isListing_Coupon_Discount()
+func (m *Listing_Backorder) Reset()         { *m = Listing_Backorder{} }
+func (m *Listing_Backorder) String() string { return proto.CompactTextString(m) }
+func (*Listing_Backorder) ProtoMessage()    {}
+func (*Listing_Backorder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 5}
 }
 
-type Listing_Coupon_PercentDiscount struct {
-	PercentDiscount float32 `protobuf:"fixed32,5,opt,name=percentDiscount,proto3,oneof"`
+func (m *Listing_Backorder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_Backorder.Unmarshal(m, b)
 }
-
-type Listing_Coupon_PriceDiscount struct {
-	PriceDiscount uint64 `protobuf:"varint,6,opt,name=priceDiscount,proto3,oneof"`
+func (m *Listing_Backorder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_Backorder.Marshal(b, m, deterministic)
 }
-
-type Listing_Coupon_BigPriceDiscount struct {
-	BigPriceDiscount string `protobuf:"bytes,7,opt,name=bigPriceDiscount,proto3,oneof"`
+func (m *Listing_Backorder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_Backorder.Merge(m, src)
+}
+func (m *Listing_Backorder) XXX_Size() int {
+	return xxx_messageInfo_Listing_Backorder.Size(m)
+}
+func (m *Listing_Backorder) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_Backorder.DiscardUnknown(m)
 }
 
-func (*Listing_Coupon_PercentDiscount) isListing_Coupon_Discount() {}
-
-func (*Listing_Coupon_PriceDiscount) isListing_Coupon_Discount() {}
+var xxx_messageInfo_Listing_Backorder proto.InternalMessageInfo
 
-func (*Listing_Coupon_BigPriceDiscount) isListing_Coupon_Discount() {}
+func (m *Listing_Backorder) GetPreorder() bool {
+	if m != nil {
+		return m.Preorder
+	}
+	return false
+}
 
-func (m *Listing_Coupon) GetDiscount() isListing_Coupon_Discount {
+func (m *Listing_Backorder) GetExpectedShipDate() *timestamp.Timestamp {
 	if m != nil {
-		return m.Discount
+		return m.ExpectedShipDate
 	}
 	return nil
 }
 
-func (m *Listing_Coupon) GetPercentDiscount() float32 {
-	if x, ok := m.GetDiscount().(*Listing_Coupon_PercentDiscount); ok {
-		return x.PercentDiscount
+func (m *Listing_Backorder) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
 	}
 	return 0
 }
 
-// Deprecated: Do not use.
-func (m *Listing_Coupon) GetPriceDiscount() uint64 {
-	if x, ok := m.GetDiscount().(*Listing_Coupon_PriceDiscount); ok {
-		return x.PriceDiscount
-	}
-	return 0
+// Crowdfund sets the funding goal of a CROWD_FUND listing. Orders are
+// pledges held in moderated escrow. The vendor is paid once the pledges
+// reach the goal, otherwise they are refunded after the deadline.
+// Pledge tiers are the listing's skus.
+type Listing_Crowdfund struct {
+	BigGoal              string               `protobuf:"bytes,1,opt,name=bigGoal,proto3" json:"bigGoal,omitempty"`
+	Deadline             *timestamp.Timestamp `protobuf:"bytes,2,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *Listing_Coupon) GetBigPriceDiscount() string {
-	if x, ok := m.GetDiscount().(*Listing_Coupon_BigPriceDiscount); ok {
-		return x.BigPriceDiscount
-	}
-	return ""
+func (m *Listing_Crowdfund) Reset()         { *m = Listing_Crowdfund{} }
+func (m *Listing_Crowdfund) String() string { return proto.CompactTextString(m) }
+func (*Listing_Crowdfund) ProtoMessage()    {}
+func (*Listing_Crowdfund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 6}
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*Listing_Coupon) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*Listing_Coupon_Hash)(nil),
-		(*Listing_Coupon_DiscountCode)(nil),
-		(*Listing_Coupon_PercentDiscount)(nil),
-		(*Listing_Coupon_PriceDiscount)(nil),
-		(*Listing_Coupon_BigPriceDiscount)(nil),
-	}
+func (m *Listing_Crowdfund) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_Crowdfund.Unmarshal(m, b)
+}
+func (m *Listing_Crowdfund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_Crowdfund.Marshal(b, m, deterministic)
+}
+func (m *Listing_Crowdfund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_Crowdfund.Merge(m, src)
+}
+func (m *Listing_Crowdfund) XXX_Size() int {
+	return xxx_messageInfo_Listing_Crowdfund.Size(m)
+}
+func (m *Listing_Crowdfund) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_Crowdfund.DiscardUnknown(m)
 }
 
-type Order struct {
+var xxx_messageInfo_Listing_Crowdfund proto.InternalMessageInfo
+
+func (m *Listing_Crowdfund) GetBigGoal() string {
+	if m != nil {
+		return m.BigGoal
+	}
+	return ""
+}
+
+func (m *Listing_Crowdfund) GetDeadline() *timestamp.Timestamp {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
+// Availability is the calendar of a SERVICE listing. Each order books
+// one of the slots, up to its capacity.
+type Listing_Availability struct {
+	Location             string                       `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Slots                []*Listing_Availability_Slot `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *Listing_Availability) Reset()         { *m = Listing_Availability{} }
+func (m *Listing_Availability) String() string { return proto.CompactTextString(m) }
+func (*Listing_Availability) ProtoMessage()    {}
+func (*Listing_Availability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 7}
+}
+
+func (m *Listing_Availability) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_Availability.Unmarshal(m, b)
+}
+func (m *Listing_Availability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_Availability.Marshal(b, m, deterministic)
+}
+func (m *Listing_Availability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_Availability.Merge(m, src)
+}
+func (m *Listing_Availability) XXX_Size() int {
+	return xxx_messageInfo_Listing_Availability.Size(m)
+}
+func (m *Listing_Availability) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_Availability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Listing_Availability proto.InternalMessageInfo
+
+func (m *Listing_Availability) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
+func (m *Listing_Availability) GetSlots() []*Listing_Availability_Slot {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
+}
+
+type Listing_Availability_Slot struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Start                *timestamp.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	DurationMinutes      uint32               `protobuf:"varint,3,opt,name=durationMinutes,proto3" json:"durationMinutes,omitempty"`
+	Capacity             uint32               `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Listing_Availability_Slot) Reset()         { *m = Listing_Availability_Slot{} }
+func (m *Listing_Availability_Slot) String() string { return proto.CompactTextString(m) }
+func (*Listing_Availability_Slot) ProtoMessage()    {}
+func (*Listing_Availability_Slot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 7, 0}
+}
+
+func (m *Listing_Availability_Slot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_Availability_Slot.Unmarshal(m, b)
+}
+func (m *Listing_Availability_Slot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_Availability_Slot.Marshal(b, m, deterministic)
+}
+func (m *Listing_Availability_Slot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_Availability_Slot.Merge(m, src)
+}
+func (m *Listing_Availability_Slot) XXX_Size() int {
+	return xxx_messageInfo_Listing_Availability_Slot.Size(m)
+}
+func (m *Listing_Availability_Slot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_Availability_Slot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Listing_Availability_Slot proto.InternalMessageInfo
+
+func (m *Listing_Availability_Slot) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Listing_Availability_Slot) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *Listing_Availability_Slot) GetDurationMinutes() uint32 {
+	if m != nil {
+		return m.DurationMinutes
+	}
+	return 0
+}
+
+func (m *Listing_Availability_Slot) GetCapacity() uint32 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+type Listing_Coupon struct {
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// Types that are valid to be assigned to Code:
+	//	*Listing_Coupon_Hash
+	//	*Listing_Coupon_DiscountCode
+	Code isListing_Coupon_Code `protobuf_oneof:"code"`
+	// Types that are valid to be assigned to Discount:
+	//	*Listing_Coupon_PercentDiscount
+	//	*Listing_Coupon_PriceDiscount
+	//	*Listing_Coupon_BigPriceDiscount
+	Discount             isListing_Coupon_Discount `protobuf_oneof:"discount"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *Listing_Coupon) Reset()         { *m = Listing_Coupon{} }
+func (m *Listing_Coupon) String() string { return proto.CompactTextString(m) }
+func (*Listing_Coupon) ProtoMessage()    {}
+func (*Listing_Coupon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{2, 8}
+}
+
+func (m *Listing_Coupon) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Listing_Coupon.Unmarshal(m, b)
+}
+func (m *Listing_Coupon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Listing_Coupon.Marshal(b, m, deterministic)
+}
+func (m *Listing_Coupon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing_Coupon.Merge(m, src)
+}
+func (m *Listing_Coupon) XXX_Size() int {
+	return xxx_messageInfo_Listing_Coupon.Size(m)
+}
+func (m *Listing_Coupon) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing_Coupon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Listing_Coupon proto.InternalMessageInfo
+
+func (m *Listing_Coupon) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+type isListing_Coupon_Code interface {
+	isListing_Coupon_Code()
+}
+
+type Listing_Coupon_Hash struct {
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3,oneof"`
+}
+
+type Listing_Coupon_DiscountCode struct {
+	DiscountCode string `protobuf:"bytes,3,opt,name=discountCode,proto3,oneof"`
+}
+
+func (*Listing_Coupon_Hash) isListing_Coupon_Code() {}
+
+func (*Listing_Coupon_DiscountCode) isListing_Coupon_Code() {}
+
+func (m *Listing_Coupon) GetCode() isListing_Coupon_Code {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+func (m *Listing_Coupon) GetHash() string {
+	if x, ok := m.GetCode().(*Listing_Coupon_Hash); ok {
+		return x.Hash
+	}
+	return ""
+}
+
+func (m *Listing_Coupon) GetDiscountCode() string {
+	if x, ok := m.GetCode().(*Listing_Coupon_DiscountCode); ok {
+		return x.DiscountCode
+	}
+	return ""
+}
+
+type isListing_Coupon_Discount interface {
+	isListing_Coupon_Discount()
+}
+
+type Listing_Coupon_PercentDiscount struct {
+	PercentDiscount float32 `protobuf:"fixed32,5,opt,name=percentDiscount,proto3,oneof"`
+}
+
+type Listing_Coupon_PriceDiscount struct {
+	PriceDiscount uint64 `protobuf:"varint,6,opt,name=priceDiscount,proto3,oneof"`
+}
+
+type Listing_Coupon_BigPriceDiscount struct {
+	BigPriceDiscount string `protobuf:"bytes,7,opt,name=bigPriceDiscount,proto3,oneof"`
+}
+
+func (*Listing_Coupon_PercentDiscount) isListing_Coupon_Discount() {}
+
+func (*Listing_Coupon_PriceDiscount) isListing_Coupon_Discount() {}
+
+func (*Listing_Coupon_BigPriceDiscount) isListing_Coupon_Discount() {}
+
+func (m *Listing_Coupon) GetDiscount() isListing_Coupon_Discount {
+	if m != nil {
+		return m.Discount
+	}
+	return nil
+}
+
+func (m *Listing_Coupon) GetPercentDiscount() float32 {
+	if x, ok := m.GetDiscount().(*Listing_Coupon_PercentDiscount); ok {
+		return x.PercentDiscount
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (m *Listing_Coupon) GetPriceDiscount() uint64 {
+	if x, ok := m.GetDiscount().(*Listing_Coupon_PriceDiscount); ok {
+		return x.PriceDiscount
+	}
+	return 0
+}
+
+func (m *Listing_Coupon) GetBigPriceDiscount() string {
+	if x, ok := m.GetDiscount().(*Listing_Coupon_BigPriceDiscount); ok {
+		return x.BigPriceDiscount
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Listing_Coupon) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Listing_Coupon_Hash)(nil),
+		(*Listing_Coupon_DiscountCode)(nil),
+		(*Listing_Coupon_PercentDiscount)(nil),
+		(*Listing_Coupon_PriceDiscount)(nil),
+		(*Listing_Coupon_BigPriceDiscount)(nil),
+	}
+}
+
+type Order struct {
 	RefundAddress        string               `protobuf:"bytes,1,opt,name=refundAddress,proto3" json:"refundAddress,omitempty"`
 	RefundFee            uint64               `protobuf:"varint,2,opt,name=refundFee,proto3" json:"refundFee,omitempty"` // Deprecated: Do not use.
 	Shipping             *Order_Shipping      `protobuf:"bytes,3,opt,name=shipping,proto3" json:"shipping,omitempty"`
@@ -1370,6 +1910,7 @@ type Order struct {
 	AlternateContactInfo string               `protobuf:"bytes,9,opt,name=alternateContactInfo,proto3" json:"alternateContactInfo,omitempty"`
 	Version              uint32               `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
 	BigRefundFee         string               `protobuf:"bytes,11,opt,name=bigRefundFee,proto3" json:"bigRefundFee,omitempty"`
+	SubscriptionID       string               `protobuf:"bytes,12,opt,name=subscriptionID,proto3" json:"subscriptionID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1478,6 +2019,13 @@ func (m *Order) GetBigRefundFee() string {
 	return ""
 }
 
+func (m *Order) GetSubscriptionID() string {
+	if m != nil {
+		return m.SubscriptionID
+	}
+	return ""
+}
+
 type Order_Shipping struct {
 	ShipTo               string      `protobuf:"bytes,1,opt,name=shipTo,proto3" json:"shipTo,omitempty"`
 	Address              string      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -1575,6 +2123,8 @@ type Order_Item struct {
 	PaymentAddress       string                     `protobuf:"bytes,7,opt,name=paymentAddress,proto3" json:"paymentAddress,omitempty"`
 	Quantity64           uint64                     `protobuf:"varint,8,opt,name=quantity64,proto3" json:"quantity64,omitempty"` // Deprecated: Do not use.
 	BigQuantity          string                     `protobuf:"bytes,9,opt,name=bigQuantity,proto3" json:"bigQuantity,omitempty"`
+	BigTax               string                     `protobuf:"bytes,10,opt,name=bigTax,proto3" json:"bigTax,omitempty"`
+	SlotID               string                     `protobuf:"bytes,11,opt,name=slotID,proto3" json:"slotID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -1670,6 +2220,20 @@ func (m *Order_Item) GetBigQuantity() string {
 	return ""
 }
 
+func (m *Order_Item) GetBigTax() string {
+	if m != nil {
+		return m.BigTax
+	}
+	return ""
+}
+
+func (m *Order_Item) GetSlotID() string {
+	if m != nil {
+		return m.SlotID
+	}
+	return ""
+}
+
 type Order_Item_Option struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -1775,6 +2339,7 @@ type Order_Payment struct {
 	Coin                 string               `protobuf:"bytes,8,opt,name=coin,proto3" json:"coin,omitempty"` // Deprecated: Do not use.
 	BigAmount            string               `protobuf:"bytes,9,opt,name=bigAmount,proto3" json:"bigAmount,omitempty"`
 	AmountCurrency       *CurrencyDefinition  `protobuf:"bytes,10,opt,name=amountCurrency,proto3" json:"amountCurrency,omitempty"`
+	PriceQuote           *SignedPriceQuote    `protobuf:"bytes,11,opt,name=priceQuote,proto3" json:"priceQuote,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1877,18 +2442,27 @@ func (m *Order_Payment) GetAmountCurrency() *CurrencyDefinition {
 	return nil
 }
 
+func (m *Order_Payment) GetPriceQuote() *SignedPriceQuote {
+	if m != nil {
+		return m.PriceQuote
+	}
+	return nil
+}
+
 type OrderConfirmation struct {
 	OrderID   string               `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
 	Timestamp *timestamp.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	// Direct payments only
-	PaymentAddress          string              `protobuf:"bytes,3,opt,name=paymentAddress,proto3" json:"paymentAddress,omitempty"`
-	RequestedAmount         uint64              `protobuf:"varint,4,opt,name=requestedAmount,proto3" json:"requestedAmount,omitempty"` // Deprecated: Do not use.
-	RatingSignatures        []*RatingSignature  `protobuf:"bytes,5,rep,name=ratingSignatures,proto3" json:"ratingSignatures,omitempty"`
-	BigRequestedAmount      string              `protobuf:"bytes,6,opt,name=bigRequestedAmount,proto3" json:"bigRequestedAmount,omitempty"`
-	RequestedAmountCurrency *CurrencyDefinition `protobuf:"bytes,7,opt,name=requestedAmountCurrency,proto3" json:"requestedAmountCurrency,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}            `json:"-"`
-	XXX_unrecognized        []byte              `json:"-"`
-	XXX_sizecache           int32               `json:"-"`
+	PaymentAddress          string               `protobuf:"bytes,3,opt,name=paymentAddress,proto3" json:"paymentAddress,omitempty"`
+	RequestedAmount         uint64               `protobuf:"varint,4,opt,name=requestedAmount,proto3" json:"requestedAmount,omitempty"` // Deprecated: Do not use.
+	RatingSignatures        []*RatingSignature   `protobuf:"bytes,5,rep,name=ratingSignatures,proto3" json:"ratingSignatures,omitempty"`
+	BigRequestedAmount      string               `protobuf:"bytes,6,opt,name=bigRequestedAmount,proto3" json:"bigRequestedAmount,omitempty"`
+	RequestedAmountCurrency *CurrencyDefinition  `protobuf:"bytes,7,opt,name=requestedAmountCurrency,proto3" json:"requestedAmountCurrency,omitempty"`
+	Backordered             bool                 `protobuf:"varint,8,opt,name=backordered,proto3" json:"backordered,omitempty"`
+	ExpectedShipDate        *timestamp.Timestamp `protobuf:"bytes,9,opt,name=expectedShipDate,proto3" json:"expectedShipDate,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}             `json:"-"`
+	XXX_unrecognized        []byte               `json:"-"`
+	XXX_sizecache           int32                `json:"-"`
 }
 
 func (m *OrderConfirmation) Reset()         { *m = OrderConfirmation{} }
@@ -1966,6 +2540,20 @@ func (m *OrderConfirmation) GetRequestedAmountCurrency() *CurrencyDefinition {
 	return nil
 }
 
+func (m *OrderConfirmation) GetBackordered() bool {
+	if m != nil {
+		return m.Backordered
+	}
+	return false
+}
+
+func (m *OrderConfirmation) GetExpectedShipDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpectedShipDate
+	}
+	return nil
+}
+
 type OrderReject struct {
 	OrderID              string               `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
 	Timestamp            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -2261,16 +2849,162 @@ func (m *BitcoinSignature) GetSignature() []byte {
 	return nil
 }
 
-type OrderFulfillment struct {
-	OrderId   string               `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
-	Slug      string               `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
-	Timestamp *timestamp.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// Physical goods only
-	PhysicalDelivery []*OrderFulfillment_PhysicalDelivery `protobuf:"bytes,4,rep,name=physicalDelivery,proto3" json:"physicalDelivery,omitempty"`
-	//Digital goods only
-	DigitalDelivery []*OrderFulfillment_DigitalDelivery `protobuf:"bytes,5,rep,name=digitalDelivery,proto3" json:"digitalDelivery,omitempty"`
-	// Moderated payments only
-	Payout          *OrderFulfillment_Payout `protobuf:"bytes,6,opt,name=payout,proto3" json:"payout,omitempty"`
+// BackorderRelease tells the buyer a backordered order is in stock and
+// moving to fulfillment
+type BackorderRelease struct {
+	OrderID              string               `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BackorderRelease) Reset()         { *m = BackorderRelease{} }
+func (m *BackorderRelease) String() string { return proto.CompactTextString(m) }
+func (*BackorderRelease) ProtoMessage()    {}
+func (*BackorderRelease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{8}
+}
+
+func (m *BackorderRelease) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BackorderRelease.Unmarshal(m, b)
+}
+func (m *BackorderRelease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BackorderRelease.Marshal(b, m, deterministic)
+}
+func (m *BackorderRelease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackorderRelease.Merge(m, src)
+}
+func (m *BackorderRelease) XXX_Size() int {
+	return xxx_messageInfo_BackorderRelease.Size(m)
+}
+func (m *BackorderRelease) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackorderRelease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackorderRelease proto.InternalMessageInfo
+
+func (m *BackorderRelease) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *BackorderRelease) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+// Appointment confirms the time of the slot booked by an order, or moves
+// the booking to another slot of the listing when rescheduled
+type Appointment struct {
+	OrderID              string               `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	SlotID               string               `protobuf:"bytes,2,opt,name=slotID,proto3" json:"slotID,omitempty"`
+	Start                *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	DurationMinutes      uint32               `protobuf:"varint,4,opt,name=durationMinutes,proto3" json:"durationMinutes,omitempty"`
+	Location             string               `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Rescheduled          bool                 `protobuf:"varint,6,opt,name=rescheduled,proto3" json:"rescheduled,omitempty"`
+	Note                 string               `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Appointment) Reset()         { *m = Appointment{} }
+func (m *Appointment) String() string { return proto.CompactTextString(m) }
+func (*Appointment) ProtoMessage()    {}
+func (*Appointment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{9}
+}
+
+func (m *Appointment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Appointment.Unmarshal(m, b)
+}
+func (m *Appointment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Appointment.Marshal(b, m, deterministic)
+}
+func (m *Appointment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Appointment.Merge(m, src)
+}
+func (m *Appointment) XXX_Size() int {
+	return xxx_messageInfo_Appointment.Size(m)
+}
+func (m *Appointment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Appointment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Appointment proto.InternalMessageInfo
+
+func (m *Appointment) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *Appointment) GetSlotID() string {
+	if m != nil {
+		return m.SlotID
+	}
+	return ""
+}
+
+func (m *Appointment) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *Appointment) GetDurationMinutes() uint32 {
+	if m != nil {
+		return m.DurationMinutes
+	}
+	return 0
+}
+
+func (m *Appointment) GetLocation() string {
+	if m != nil {
+		return m.Location
+	}
+	return ""
+}
+
+func (m *Appointment) GetRescheduled() bool {
+	if m != nil {
+		return m.Rescheduled
+	}
+	return false
+}
+
+func (m *Appointment) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *Appointment) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type OrderFulfillment struct {
+	OrderId   string               `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Slug      string               `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Physical goods only
+	PhysicalDelivery []*OrderFulfillment_PhysicalDelivery `protobuf:"bytes,4,rep,name=physicalDelivery,proto3" json:"physicalDelivery,omitempty"`
+	//Digital goods only
+	DigitalDelivery []*OrderFulfillment_DigitalDelivery `protobuf:"bytes,5,rep,name=digitalDelivery,proto3" json:"digitalDelivery,omitempty"`
+	// Moderated payments only
+	Payout          *OrderFulfillment_Payout `protobuf:"bytes,6,opt,name=payout,proto3" json:"payout,omitempty"`
 	RatingSignature *RatingSignature         `protobuf:"bytes,7,opt,name=ratingSignature,proto3" json:"ratingSignature,omitempty"`
 	Note            string                   `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
 	// Cryptocurrencies only
@@ -2284,7 +3018,7 @@ func (m *OrderFulfillment) Reset()         { *m = OrderFulfillment{} }
 func (m *OrderFulfillment) String() string { return proto.CompactTextString(m) }
 func (*OrderFulfillment) ProtoMessage()    {}
 func (*OrderFulfillment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{8}
+	return fileDescriptor_b6d125f880f9ca35, []int{10}
 }
 
 func (m *OrderFulfillment) XXX_Unmarshal(b []byte) error {
@@ -2380,7 +3114,7 @@ func (m *OrderFulfillment_PhysicalDelivery) Reset()         { *m = OrderFulfillm
 func (m *OrderFulfillment_PhysicalDelivery) String() string { return proto.CompactTextString(m) }
 func (*OrderFulfillment_PhysicalDelivery) ProtoMessage()    {}
 func (*OrderFulfillment_PhysicalDelivery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{8, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{10, 0}
 }
 
 func (m *OrderFulfillment_PhysicalDelivery) XXX_Unmarshal(b []byte) error {
@@ -2416,8 +3150,12 @@ func (m *OrderFulfillment_PhysicalDelivery) GetTrackingNumber() string {
 }
 
 type OrderFulfillment_DigitalDelivery struct {
-	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Url      string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Set when the vendor node fulfills the order automatically
+	LicenseKey           string   `protobuf:"bytes,3,opt,name=licenseKey,proto3" json:"licenseKey,omitempty"`
+	Cid                  string   `protobuf:"bytes,4,opt,name=cid,proto3" json:"cid,omitempty"`
+	Filename             string   `protobuf:"bytes,5,opt,name=filename,proto3" json:"filename,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2427,7 +3165,7 @@ func (m *OrderFulfillment_DigitalDelivery) Reset()         { *m = OrderFulfillme
 func (m *OrderFulfillment_DigitalDelivery) String() string { return proto.CompactTextString(m) }
 func (*OrderFulfillment_DigitalDelivery) ProtoMessage()    {}
 func (*OrderFulfillment_DigitalDelivery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{8, 1}
+	return fileDescriptor_b6d125f880f9ca35, []int{10, 1}
 }
 
 func (m *OrderFulfillment_DigitalDelivery) XXX_Unmarshal(b []byte) error {
@@ -2462,6 +3200,27 @@ func (m *OrderFulfillment_DigitalDelivery) GetPassword() string {
 	return ""
 }
 
+func (m *OrderFulfillment_DigitalDelivery) GetLicenseKey() string {
+	if m != nil {
+		return m.LicenseKey
+	}
+	return ""
+}
+
+func (m *OrderFulfillment_DigitalDelivery) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *OrderFulfillment_DigitalDelivery) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
 type OrderFulfillment_CryptocurrencyDelivery struct {
 	TransactionID        string   `protobuf:"bytes,1,opt,name=transactionID,proto3" json:"transactionID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2475,7 +3234,7 @@ func (m *OrderFulfillment_CryptocurrencyDelivery) Reset() {
 func (m *OrderFulfillment_CryptocurrencyDelivery) String() string { return proto.CompactTextString(m) }
 func (*OrderFulfillment_CryptocurrencyDelivery) ProtoMessage()    {}
 func (*OrderFulfillment_CryptocurrencyDelivery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{8, 2}
+	return fileDescriptor_b6d125f880f9ca35, []int{10, 2}
 }
 
 func (m *OrderFulfillment_CryptocurrencyDelivery) XXX_Unmarshal(b []byte) error {
@@ -2518,7 +3277,7 @@ func (m *OrderFulfillment_Payout) Reset()         { *m = OrderFulfillment_Payout
 func (m *OrderFulfillment_Payout) String() string { return proto.CompactTextString(m) }
 func (*OrderFulfillment_Payout) ProtoMessage()    {}
 func (*OrderFulfillment_Payout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{8, 3}
+	return fileDescriptor_b6d125f880f9ca35, []int{10, 3}
 }
 
 func (m *OrderFulfillment_Payout) XXX_Unmarshal(b []byte) error {
@@ -2589,7 +3348,7 @@ func (m *OrderCompletion) Reset()         { *m = OrderCompletion{} }
 func (m *OrderCompletion) String() string { return proto.CompactTextString(m) }
 func (*OrderCompletion) ProtoMessage()    {}
 func (*OrderCompletion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{9}
+	return fileDescriptor_b6d125f880f9ca35, []int{11}
 }
 
 func (m *OrderCompletion) XXX_Unmarshal(b []byte) error {
@@ -2651,7 +3410,7 @@ func (m *OrderProcessingFailure) Reset()         { *m = OrderProcessingFailure{}
 func (m *OrderProcessingFailure) String() string { return proto.CompactTextString(m) }
 func (*OrderProcessingFailure) ProtoMessage()    {}
 func (*OrderProcessingFailure) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{10}
+	return fileDescriptor_b6d125f880f9ca35, []int{12}
 }
 
 func (m *OrderProcessingFailure) XXX_Unmarshal(b []byte) error {
@@ -2696,6 +3455,7 @@ func (m *OrderProcessingFailure) GetContract() *RicardianContract {
 type Rating struct {
 	RatingData           *Rating_RatingData `protobuf:"bytes,1,opt,name=ratingData,proto3" json:"ratingData,omitempty"`
 	Signature            []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	VendorResponse       *RatingResponse    `protobuf:"bytes,3,opt,name=vendorResponse,proto3" json:"vendorResponse,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -2705,7 +3465,7 @@ func (m *Rating) Reset()         { *m = Rating{} }
 func (m *Rating) String() string { return proto.CompactTextString(m) }
 func (*Rating) ProtoMessage()    {}
 func (*Rating) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{11}
+	return fileDescriptor_b6d125f880f9ca35, []int{13}
 }
 
 func (m *Rating) XXX_Unmarshal(b []byte) error {
@@ -2740,6 +3500,13 @@ func (m *Rating) GetSignature() []byte {
 	return nil
 }
 
+func (m *Rating) GetVendorResponse() *RatingResponse {
+	if m != nil {
+		return m.VendorResponse
+	}
+	return nil
+}
+
 type Rating_RatingData struct {
 	RatingKey            []byte               `protobuf:"bytes,1,opt,name=ratingKey,proto3" json:"ratingKey,omitempty"`
 	VendorID             *ID                  `protobuf:"bytes,2,opt,name=vendorID,proto3" json:"vendorID,omitempty"`
@@ -2764,7 +3531,7 @@ func (m *Rating_RatingData) Reset()         { *m = Rating_RatingData{} }
 func (m *Rating_RatingData) String() string { return proto.CompactTextString(m) }
 func (*Rating_RatingData) ProtoMessage()    {}
 func (*Rating_RatingData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{11, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{13, 0}
 }
 
 func (m *Rating_RatingData) XXX_Unmarshal(b []byte) error {
@@ -2883,6 +3650,124 @@ func (m *Rating_RatingData) GetReview() string {
 	return ""
 }
 
+type RatingResponse struct {
+	ResponseData         *RatingResponse_ResponseData `protobuf:"bytes,1,opt,name=responseData,proto3" json:"responseData,omitempty"`
+	Signature            []byte                       `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *RatingResponse) Reset()         { *m = RatingResponse{} }
+func (m *RatingResponse) String() string { return proto.CompactTextString(m) }
+func (*RatingResponse) ProtoMessage()    {}
+func (*RatingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{14}
+}
+
+func (m *RatingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RatingResponse.Unmarshal(m, b)
+}
+func (m *RatingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RatingResponse.Marshal(b, m, deterministic)
+}
+func (m *RatingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatingResponse.Merge(m, src)
+}
+func (m *RatingResponse) XXX_Size() int {
+	return xxx_messageInfo_RatingResponse.Size(m)
+}
+func (m *RatingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RatingResponse proto.InternalMessageInfo
+
+func (m *RatingResponse) GetResponseData() *RatingResponse_ResponseData {
+	if m != nil {
+		return m.ResponseData
+	}
+	return nil
+}
+
+func (m *RatingResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type RatingResponse_ResponseData struct {
+	RatingHash           string               `protobuf:"bytes,1,opt,name=ratingHash,proto3" json:"ratingHash,omitempty"`
+	RatingKey            []byte               `protobuf:"bytes,2,opt,name=ratingKey,proto3" json:"ratingKey,omitempty"`
+	VendorID             *ID                  `protobuf:"bytes,3,opt,name=vendorID,proto3" json:"vendorID,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Response             string               `protobuf:"bytes,5,opt,name=response,proto3" json:"response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RatingResponse_ResponseData) Reset()         { *m = RatingResponse_ResponseData{} }
+func (m *RatingResponse_ResponseData) String() string { return proto.CompactTextString(m) }
+func (*RatingResponse_ResponseData) ProtoMessage()    {}
+func (*RatingResponse_ResponseData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{14, 0}
+}
+
+func (m *RatingResponse_ResponseData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RatingResponse_ResponseData.Unmarshal(m, b)
+}
+func (m *RatingResponse_ResponseData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RatingResponse_ResponseData.Marshal(b, m, deterministic)
+}
+func (m *RatingResponse_ResponseData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatingResponse_ResponseData.Merge(m, src)
+}
+func (m *RatingResponse_ResponseData) XXX_Size() int {
+	return xxx_messageInfo_RatingResponse_ResponseData.Size(m)
+}
+func (m *RatingResponse_ResponseData) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatingResponse_ResponseData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RatingResponse_ResponseData proto.InternalMessageInfo
+
+func (m *RatingResponse_ResponseData) GetRatingHash() string {
+	if m != nil {
+		return m.RatingHash
+	}
+	return ""
+}
+
+func (m *RatingResponse_ResponseData) GetRatingKey() []byte {
+	if m != nil {
+		return m.RatingKey
+	}
+	return nil
+}
+
+func (m *RatingResponse_ResponseData) GetVendorID() *ID {
+	if m != nil {
+		return m.VendorID
+	}
+	return nil
+}
+
+func (m *RatingResponse_ResponseData) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *RatingResponse_ResponseData) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
 type Dispute struct {
 	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	Claim                string               `protobuf:"bytes,2,opt,name=claim,proto3" json:"claim,omitempty"`
@@ -2898,7 +3783,7 @@ func (m *Dispute) Reset()         { *m = Dispute{} }
 func (m *Dispute) String() string { return proto.CompactTextString(m) }
 func (*Dispute) ProtoMessage()    {}
 func (*Dispute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{12}
+	return fileDescriptor_b6d125f880f9ca35, []int{15}
 }
 
 func (m *Dispute) XXX_Unmarshal(b []byte) error {
@@ -2970,7 +3855,7 @@ func (m *DisputeResolution) Reset()         { *m = DisputeResolution{} }
 func (m *DisputeResolution) String() string { return proto.CompactTextString(m) }
 func (*DisputeResolution) ProtoMessage()    {}
 func (*DisputeResolution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{13}
+	return fileDescriptor_b6d125f880f9ca35, []int{16}
 }
 
 func (m *DisputeResolution) XXX_Unmarshal(b []byte) error {
@@ -3049,7 +3934,7 @@ func (m *DisputeResolution_Payout) Reset()         { *m = DisputeResolution_Payo
 func (m *DisputeResolution_Payout) String() string { return proto.CompactTextString(m) }
 func (*DisputeResolution_Payout) ProtoMessage()    {}
 func (*DisputeResolution_Payout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{13, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{16, 0}
 }
 
 func (m *DisputeResolution_Payout) XXX_Unmarshal(b []byte) error {
@@ -3128,7 +4013,7 @@ func (m *DisputeResolution_Payout_Output) Reset()         { *m = DisputeResoluti
 func (m *DisputeResolution_Payout_Output) String() string { return proto.CompactTextString(m) }
 func (*DisputeResolution_Payout_Output) ProtoMessage()    {}
 func (*DisputeResolution_Payout_Output) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{13, 0, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{16, 0, 0}
 }
 
 func (m *DisputeResolution_Payout_Output) XXX_Unmarshal(b []byte) error {
@@ -3221,7 +4106,7 @@ func (m *DisputeAcceptance) Reset()         { *m = DisputeAcceptance{} }
 func (m *DisputeAcceptance) String() string { return proto.CompactTextString(m) }
 func (*DisputeAcceptance) ProtoMessage()    {}
 func (*DisputeAcceptance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{14}
+	return fileDescriptor_b6d125f880f9ca35, []int{17}
 }
 
 func (m *DisputeAcceptance) XXX_Unmarshal(b []byte) error {
@@ -3270,7 +4155,7 @@ func (m *Outpoint) Reset()         { *m = Outpoint{} }
 func (m *Outpoint) String() string { return proto.CompactTextString(m) }
 func (*Outpoint) ProtoMessage()    {}
 func (*Outpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{15}
+	return fileDescriptor_b6d125f880f9ca35, []int{18}
 }
 
 func (m *Outpoint) XXX_Unmarshal(b []byte) error {
@@ -3335,7 +4220,7 @@ func (m *Refund) Reset()         { *m = Refund{} }
 func (m *Refund) String() string { return proto.CompactTextString(m) }
 func (*Refund) ProtoMessage()    {}
 func (*Refund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{16}
+	return fileDescriptor_b6d125f880f9ca35, []int{19}
 }
 
 func (m *Refund) XXX_Unmarshal(b []byte) error {
@@ -3405,7 +4290,7 @@ func (m *Refund_TransactionInfo) Reset()         { *m = Refund_TransactionInfo{}
 func (m *Refund_TransactionInfo) String() string { return proto.CompactTextString(m) }
 func (*Refund_TransactionInfo) ProtoMessage()    {}
 func (*Refund_TransactionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{16, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{19, 0}
 }
 
 func (m *Refund_TransactionInfo) XXX_Unmarshal(b []byte) error {
@@ -3466,7 +4351,7 @@ func (m *VendorFinalizedPayment) Reset()         { *m = VendorFinalizedPayment{}
 func (m *VendorFinalizedPayment) String() string { return proto.CompactTextString(m) }
 func (*VendorFinalizedPayment) ProtoMessage()    {}
 func (*VendorFinalizedPayment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{17}
+	return fileDescriptor_b6d125f880f9ca35, []int{20}
 }
 
 func (m *VendorFinalizedPayment) XXX_Unmarshal(b []byte) error {
@@ -3494,6 +4379,391 @@ func (m *VendorFinalizedPayment) GetOrderID() string {
 	return ""
 }
 
+// PriceQuote fixes the amount of an order in the payment currency until it
+// expires. The rates are the price of one reserve coin in each currency
+// used to price the order, sorted by currency code.
+type PriceQuote struct {
+	VendorID             string               `protobuf:"bytes,1,opt,name=vendorID,proto3" json:"vendorID,omitempty"`
+	AmountCurrency       *CurrencyDefinition  `protobuf:"bytes,2,opt,name=amountCurrency,proto3" json:"amountCurrency,omitempty"`
+	BigAmount            string               `protobuf:"bytes,3,opt,name=bigAmount,proto3" json:"bigAmount,omitempty"`
+	ReserveCurrency      string               `protobuf:"bytes,4,opt,name=reserveCurrency,proto3" json:"reserveCurrency,omitempty"`
+	Rates                []*PriceQuote_Rate   `protobuf:"bytes,5,rep,name=rates,proto3" json:"rates,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Expires              *timestamp.Timestamp `protobuf:"bytes,7,opt,name=expires,proto3" json:"expires,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *PriceQuote) Reset()         { *m = PriceQuote{} }
+func (m *PriceQuote) String() string { return proto.CompactTextString(m) }
+func (*PriceQuote) ProtoMessage()    {}
+func (*PriceQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{21}
+}
+
+func (m *PriceQuote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceQuote.Unmarshal(m, b)
+}
+func (m *PriceQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceQuote.Marshal(b, m, deterministic)
+}
+func (m *PriceQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceQuote.Merge(m, src)
+}
+func (m *PriceQuote) XXX_Size() int {
+	return xxx_messageInfo_PriceQuote.Size(m)
+}
+func (m *PriceQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceQuote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceQuote proto.InternalMessageInfo
+
+func (m *PriceQuote) GetVendorID() string {
+	if m != nil {
+		return m.VendorID
+	}
+	return ""
+}
+
+func (m *PriceQuote) GetAmountCurrency() *CurrencyDefinition {
+	if m != nil {
+		return m.AmountCurrency
+	}
+	return nil
+}
+
+func (m *PriceQuote) GetBigAmount() string {
+	if m != nil {
+		return m.BigAmount
+	}
+	return ""
+}
+
+func (m *PriceQuote) GetReserveCurrency() string {
+	if m != nil {
+		return m.ReserveCurrency
+	}
+	return ""
+}
+
+func (m *PriceQuote) GetRates() []*PriceQuote_Rate {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+func (m *PriceQuote) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *PriceQuote) GetExpires() *timestamp.Timestamp {
+	if m != nil {
+		return m.Expires
+	}
+	return nil
+}
+
+type PriceQuote_Rate struct {
+	CurrencyCode         string   `protobuf:"bytes,1,opt,name=currencyCode,proto3" json:"currencyCode,omitempty"`
+	Rate                 float64  `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PriceQuote_Rate) Reset()         { *m = PriceQuote_Rate{} }
+func (m *PriceQuote_Rate) String() string { return proto.CompactTextString(m) }
+func (*PriceQuote_Rate) ProtoMessage()    {}
+func (*PriceQuote_Rate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{21, 0}
+}
+
+func (m *PriceQuote_Rate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceQuote_Rate.Unmarshal(m, b)
+}
+func (m *PriceQuote_Rate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceQuote_Rate.Marshal(b, m, deterministic)
+}
+func (m *PriceQuote_Rate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceQuote_Rate.Merge(m, src)
+}
+func (m *PriceQuote_Rate) XXX_Size() int {
+	return xxx_messageInfo_PriceQuote_Rate.Size(m)
+}
+func (m *PriceQuote_Rate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceQuote_Rate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceQuote_Rate proto.InternalMessageInfo
+
+func (m *PriceQuote_Rate) GetCurrencyCode() string {
+	if m != nil {
+		return m.CurrencyCode
+	}
+	return ""
+}
+
+func (m *PriceQuote_Rate) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+type SignedPriceQuote struct {
+	Quote                *PriceQuote `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	Signature            []byte      `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SignedPriceQuote) Reset()         { *m = SignedPriceQuote{} }
+func (m *SignedPriceQuote) String() string { return proto.CompactTextString(m) }
+func (*SignedPriceQuote) ProtoMessage()    {}
+func (*SignedPriceQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{22}
+}
+
+func (m *SignedPriceQuote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignedPriceQuote.Unmarshal(m, b)
+}
+func (m *SignedPriceQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignedPriceQuote.Marshal(b, m, deterministic)
+}
+func (m *SignedPriceQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedPriceQuote.Merge(m, src)
+}
+func (m *SignedPriceQuote) XXX_Size() int {
+	return xxx_messageInfo_SignedPriceQuote.Size(m)
+}
+func (m *SignedPriceQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedPriceQuote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedPriceQuote proto.InternalMessageInfo
+
+func (m *SignedPriceQuote) GetQuote() *PriceQuote {
+	if m != nil {
+		return m.Quote
+	}
+	return nil
+}
+
+func (m *SignedPriceQuote) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// SubscriptionAgreement is the buyer's agreement to buy the item of a
+// listing every interval for a number of periods. Each period is a separate
+// order with the same items, shipping and payment coin. The price is the
+// item price the buyer agreed to in the listing currency.
+type SubscriptionAgreement struct {
+	SubscriptionID       string               `protobuf:"bytes,1,opt,name=subscriptionID,proto3" json:"subscriptionID,omitempty"`
+	VendorID             string               `protobuf:"bytes,2,opt,name=vendorID,proto3" json:"vendorID,omitempty"`
+	BuyerID              *ID                  `protobuf:"bytes,3,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	ListingSlug          string               `protobuf:"bytes,4,opt,name=listingSlug,proto3" json:"listingSlug,omitempty"`
+	IntervalDays         uint32               `protobuf:"varint,5,opt,name=intervalDays,proto3" json:"intervalDays,omitempty"`
+	Periods              uint32               `protobuf:"varint,6,opt,name=periods,proto3" json:"periods,omitempty"`
+	BigPrice             string               `protobuf:"bytes,7,opt,name=bigPrice,proto3" json:"bigPrice,omitempty"`
+	PriceCurrency        *CurrencyDefinition  `protobuf:"bytes,8,opt,name=priceCurrency,proto3" json:"priceCurrency,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SubscriptionAgreement) Reset()         { *m = SubscriptionAgreement{} }
+func (m *SubscriptionAgreement) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionAgreement) ProtoMessage()    {}
+func (*SubscriptionAgreement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{23}
+}
+
+func (m *SubscriptionAgreement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionAgreement.Unmarshal(m, b)
+}
+func (m *SubscriptionAgreement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionAgreement.Marshal(b, m, deterministic)
+}
+func (m *SubscriptionAgreement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionAgreement.Merge(m, src)
+}
+func (m *SubscriptionAgreement) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionAgreement.Size(m)
+}
+func (m *SubscriptionAgreement) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionAgreement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionAgreement proto.InternalMessageInfo
+
+func (m *SubscriptionAgreement) GetSubscriptionID() string {
+	if m != nil {
+		return m.SubscriptionID
+	}
+	return ""
+}
+
+func (m *SubscriptionAgreement) GetVendorID() string {
+	if m != nil {
+		return m.VendorID
+	}
+	return ""
+}
+
+func (m *SubscriptionAgreement) GetBuyerID() *ID {
+	if m != nil {
+		return m.BuyerID
+	}
+	return nil
+}
+
+func (m *SubscriptionAgreement) GetListingSlug() string {
+	if m != nil {
+		return m.ListingSlug
+	}
+	return ""
+}
+
+func (m *SubscriptionAgreement) GetIntervalDays() uint32 {
+	if m != nil {
+		return m.IntervalDays
+	}
+	return 0
+}
+
+func (m *SubscriptionAgreement) GetPeriods() uint32 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+func (m *SubscriptionAgreement) GetBigPrice() string {
+	if m != nil {
+		return m.BigPrice
+	}
+	return ""
+}
+
+func (m *SubscriptionAgreement) GetPriceCurrency() *CurrencyDefinition {
+	if m != nil {
+		return m.PriceCurrency
+	}
+	return nil
+}
+
+func (m *SubscriptionAgreement) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type SignedSubscriptionAgreement struct {
+	Agreement            *SubscriptionAgreement `protobuf:"bytes,1,opt,name=agreement,proto3" json:"agreement,omitempty"`
+	Signature            []byte                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SignedSubscriptionAgreement) Reset()         { *m = SignedSubscriptionAgreement{} }
+func (m *SignedSubscriptionAgreement) String() string { return proto.CompactTextString(m) }
+func (*SignedSubscriptionAgreement) ProtoMessage()    {}
+func (*SignedSubscriptionAgreement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{24}
+}
+
+func (m *SignedSubscriptionAgreement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SignedSubscriptionAgreement.Unmarshal(m, b)
+}
+func (m *SignedSubscriptionAgreement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SignedSubscriptionAgreement.Marshal(b, m, deterministic)
+}
+func (m *SignedSubscriptionAgreement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignedSubscriptionAgreement.Merge(m, src)
+}
+func (m *SignedSubscriptionAgreement) XXX_Size() int {
+	return xxx_messageInfo_SignedSubscriptionAgreement.Size(m)
+}
+func (m *SignedSubscriptionAgreement) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignedSubscriptionAgreement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignedSubscriptionAgreement proto.InternalMessageInfo
+
+func (m *SignedSubscriptionAgreement) GetAgreement() *SubscriptionAgreement {
+	if m != nil {
+		return m.Agreement
+	}
+	return nil
+}
+
+func (m *SignedSubscriptionAgreement) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type SubscriptionCancel struct {
+	SubscriptionID       string               `protobuf:"bytes,1,opt,name=subscriptionID,proto3" json:"subscriptionID,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SubscriptionCancel) Reset()         { *m = SubscriptionCancel{} }
+func (m *SubscriptionCancel) String() string { return proto.CompactTextString(m) }
+func (*SubscriptionCancel) ProtoMessage()    {}
+func (*SubscriptionCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6d125f880f9ca35, []int{25}
+}
+
+func (m *SubscriptionCancel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscriptionCancel.Unmarshal(m, b)
+}
+func (m *SubscriptionCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscriptionCancel.Marshal(b, m, deterministic)
+}
+func (m *SubscriptionCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscriptionCancel.Merge(m, src)
+}
+func (m *SubscriptionCancel) XXX_Size() int {
+	return xxx_messageInfo_SubscriptionCancel.Size(m)
+}
+func (m *SubscriptionCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscriptionCancel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscriptionCancel proto.InternalMessageInfo
+
+func (m *SubscriptionCancel) GetSubscriptionID() string {
+	if m != nil {
+		return m.SubscriptionID
+	}
+	return ""
+}
+
+func (m *SubscriptionCancel) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
 type ID struct {
 	PeerID               string      `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
 	Handle               string      `protobuf:"bytes,2,opt,name=handle,proto3" json:"handle,omitempty"`
@@ -3508,7 +4778,7 @@ func (m *ID) Reset()         { *m = ID{} }
 func (m *ID) String() string { return proto.CompactTextString(m) }
 func (*ID) ProtoMessage()    {}
 func (*ID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{18}
+	return fileDescriptor_b6d125f880f9ca35, []int{26}
 }
 
 func (m *ID) XXX_Unmarshal(b []byte) error {
@@ -3569,7 +4839,7 @@ func (m *ID_Pubkeys) Reset()         { *m = ID_Pubkeys{} }
 func (m *ID_Pubkeys) String() string { return proto.CompactTextString(m) }
 func (*ID_Pubkeys) ProtoMessage()    {}
 func (*ID_Pubkeys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{18, 0}
+	return fileDescriptor_b6d125f880f9ca35, []int{26, 0}
 }
 
 func (m *ID_Pubkeys) XXX_Unmarshal(b []byte) error {
@@ -3616,7 +4886,7 @@ func (m *Signature) Reset()         { *m = Signature{} }
 func (m *Signature) String() string { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()    {}
 func (*Signature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{19}
+	return fileDescriptor_b6d125f880f9ca35, []int{27}
 }
 
 func (m *Signature) XXX_Unmarshal(b []byte) error {
@@ -3664,7 +4934,7 @@ func (m *SignedListing) Reset()         { *m = SignedListing{} }
 func (m *SignedListing) String() string { return proto.CompactTextString(m) }
 func (*SignedListing) ProtoMessage()    {}
 func (*SignedListing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6d125f880f9ca35, []int{20}
+	return fileDescriptor_b6d125f880f9ca35, []int{28}
 }
 
 func (m *SignedListing) XXX_Unmarshal(b []byte) error {
@@ -3723,7 +4993,15 @@ func init() {
 	proto.RegisterType((*Listing_Item_Image)(nil), "Listing.Item.Image")
 	proto.RegisterType((*Listing_ShippingOption)(nil), "Listing.ShippingOption")
 	proto.RegisterType((*Listing_ShippingOption_Service)(nil), "Listing.ShippingOption.Service")
+	proto.RegisterType((*Listing_ShippingOption_Service_WeightRate)(nil), "Listing.ShippingOption.Service.WeightRate")
+	proto.RegisterType((*Listing_ShippingOption_Service_QuantityRate)(nil), "Listing.ShippingOption.Service.QuantityRate")
 	proto.RegisterType((*Listing_Tax)(nil), "Listing.Tax")
+	proto.RegisterType((*Listing_Tax_TaxRule)(nil), "Listing.Tax.TaxRule")
+	proto.RegisterType((*Listing_Subscription)(nil), "Listing.Subscription")
+	proto.RegisterType((*Listing_Backorder)(nil), "Listing.Backorder")
+	proto.RegisterType((*Listing_Crowdfund)(nil), "Listing.Crowdfund")
+	proto.RegisterType((*Listing_Availability)(nil), "Listing.Availability")
+	proto.RegisterType((*Listing_Availability_Slot)(nil), "Listing.Availability.Slot")
 	proto.RegisterType((*Listing_Coupon)(nil), "Listing.Coupon")
 	proto.RegisterType((*Order)(nil), "Order")
 	proto.RegisterType((*Order_Shipping)(nil), "Order.Shipping")
@@ -3737,6 +5015,8 @@ func init() {
 	proto.RegisterType((*RatingSignature_TransactionMetadata)(nil), "RatingSignature.TransactionMetadata")
 	proto.RegisterType((*RatingSignature_TransactionMetadata_Image)(nil), "RatingSignature.TransactionMetadata.Image")
 	proto.RegisterType((*BitcoinSignature)(nil), "BitcoinSignature")
+	proto.RegisterType((*BackorderRelease)(nil), "BackorderRelease")
+	proto.RegisterType((*Appointment)(nil), "Appointment")
 	proto.RegisterType((*OrderFulfillment)(nil), "OrderFulfillment")
 	proto.RegisterType((*OrderFulfillment_PhysicalDelivery)(nil), "OrderFulfillment.PhysicalDelivery")
 	proto.RegisterType((*OrderFulfillment_DigitalDelivery)(nil), "OrderFulfillment.DigitalDelivery")
@@ -3746,6 +5026,8 @@ func init() {
 	proto.RegisterType((*OrderProcessingFailure)(nil), "OrderProcessingFailure")
 	proto.RegisterType((*Rating)(nil), "Rating")
 	proto.RegisterType((*Rating_RatingData)(nil), "Rating.RatingData")
+	proto.RegisterType((*RatingResponse)(nil), "RatingResponse")
+	proto.RegisterType((*RatingResponse_ResponseData)(nil), "RatingResponse.ResponseData")
 	proto.RegisterType((*Dispute)(nil), "Dispute")
 	proto.RegisterType((*DisputeResolution)(nil), "DisputeResolution")
 	proto.RegisterType((*DisputeResolution_Payout)(nil), "DisputeResolution.Payout")
@@ -3755,6 +5037,12 @@ func init() {
 	proto.RegisterType((*Refund)(nil), "Refund")
 	proto.RegisterType((*Refund_TransactionInfo)(nil), "Refund.TransactionInfo")
 	proto.RegisterType((*VendorFinalizedPayment)(nil), "VendorFinalizedPayment")
+	proto.RegisterType((*PriceQuote)(nil), "PriceQuote")
+	proto.RegisterType((*PriceQuote_Rate)(nil), "PriceQuote.Rate")
+	proto.RegisterType((*SignedPriceQuote)(nil), "SignedPriceQuote")
+	proto.RegisterType((*SubscriptionAgreement)(nil), "SubscriptionAgreement")
+	proto.RegisterType((*SignedSubscriptionAgreement)(nil), "SignedSubscriptionAgreement")
+	proto.RegisterType((*SubscriptionCancel)(nil), "SubscriptionCancel")
 	proto.RegisterType((*ID)(nil), "ID")
 	proto.RegisterType((*ID_Pubkeys)(nil), "ID.Pubkeys")
 	proto.RegisterType((*Signature)(nil), "Signature")
@@ -3766,236 +5054,297 @@ func init() {
 }
 
 var fileDescriptor_b6d125f880f9ca35 = []byte{
-	// 3695 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3a, 0x4b, 0x93, 0x23, 0xc9,
-	0x59, 0x53, 0x7a, 0xeb, 0x6b, 0x75, 0x4b, 0x9d, 0xd3, 0xee, 0x11, 0x8a, 0x65, 0xb7, 0xa7, 0x62,
-	0xbc, 0xb4, 0x77, 0xc7, 0xe5, 0xd9, 0xc6, 0xb1, 0xb1, 0xc6, 0x84, 0xed, 0x6e, 0x49, 0xbd, 0x2d,
-	0xa6, 0x1f, 0x72, 0x4a, 0xb3, 0xb0, 0x5c, 0x86, 0x6a, 0x55, 0xb6, 0x3a, 0x3d, 0x52, 0x95, 0xb6,
-	0x1e, 0x3d, 0x2d, 0xb8, 0x10, 0x9c, 0x20, 0x38, 0x70, 0xc3, 0x47, 0xfe, 0x00, 0xc1, 0x1f, 0x80,
-	0x13, 0x17, 0x4e, 0x84, 0x23, 0x7c, 0x32, 0x77, 0x0e, 0xdc, 0x20, 0x02, 0x22, 0x88, 0x30, 0x17,
-	0x22, 0xbf, 0xcc, 0xac, 0x97, 0xa4, 0xd9, 0x19, 0x13, 0x84, 0x4f, 0xd2, 0xf7, 0xc8, 0xac, 0xcc,
-	0xef, 0xfd, 0x7d, 0x55, 0xd0, 0x9c, 0x78, 0x6e, 0xe8, 0xdb, 0x93, 0x30, 0xb0, 0x16, 0xbe, 0x17,
-	0x7a, 0x1d, 0x32, 0xf1, 0x22, 0x37, 0xf4, 0x97, 0x13, 0xcf, 0x61, 0x1a, 0xb7, 0x3d, 0x67, 0x41,
-	0x60, 0x4f, 0x99, 0x02, 0x3f, 0x98, 0x7a, 0xde, 0x74, 0xc6, 0xbe, 0x83, 0xd0, 0x75, 0x74, 0xf3,
-	0x9d, 0x90, 0xcf, 0x59, 0x10, 0xda, 0xf3, 0x85, 0x64, 0x30, 0xff, 0xa5, 0x04, 0xbb, 0x94, 0x4f,
-	0x6c, 0xdf, 0xe1, 0xb6, 0xdb, 0x55, 0x0f, 0x20, 0xcf, 0x60, 0xe7, 0x8e, 0xb9, 0x8e, 0xe7, 0x9f,
-	0xf3, 0x20, 0xe4, 0xee, 0x34, 0x68, 0x1b, 0x07, 0xc5, 0xc3, 0xad, 0xa3, 0x9a, 0xa5, 0x10, 0x34,
-	0x47, 0x27, 0x1f, 0x02, 0x5c, 0x47, 0x4b, 0xe6, 0x5f, 0xf9, 0x0e, 0xf3, 0xdb, 0x85, 0x03, 0xe3,
-	0x70, 0xeb, 0xa8, 0x62, 0x21, 0x44, 0x53, 0x14, 0x72, 0x0e, 0x8f, 0xe4, 0x4a, 0x04, 0xbb, 0x9e,
-	0x7b, 0xc3, 0xfd, 0xb9, 0x1d, 0x72, 0xcf, 0x6d, 0x17, 0x71, 0x11, 0xb1, 0x56, 0x28, 0x74, 0xd3,
-	0x12, 0x32, 0x80, 0xfd, 0x14, 0xe9, 0x34, 0x9a, 0xdd, 0xf0, 0xd9, 0x6c, 0xce, 0xdc, 0xb0, 0x5d,
-	0xc2, 0xf3, 0xee, 0x5a, 0x79, 0x02, 0xdd, 0xb0, 0x80, 0xf4, 0x60, 0x2f, 0x39, 0x66, 0xd7, 0x9b,
-	0x2f, 0x66, 0x0c, 0x4f, 0x55, 0xc6, 0x53, 0xb5, 0xac, 0x1c, 0x9e, 0xae, 0xe5, 0x26, 0x26, 0x54,
-	0x1d, 0x1e, 0x2c, 0xa2, 0x90, 0xb5, 0x2b, 0xb8, 0xb0, 0x66, 0xf5, 0x24, 0x4c, 0x35, 0x81, 0xfc,
-	0x08, 0x76, 0xd5, 0x5f, 0xca, 0x02, 0x6f, 0x16, 0xe1, 0x63, 0xaa, 0xea, 0xf2, 0xbd, 0x3c, 0x85,
-	0xae, 0x32, 0xa7, 0x76, 0x38, 0x9e, 0x4c, 0xd8, 0x22, 0xb4, 0xdd, 0x09, 0x6b, 0xd7, 0xb2, 0x3b,
-	0x24, 0x14, 0xba, 0xca, 0x4c, 0x3e, 0x80, 0x8a, 0xcf, 0x6e, 0x22, 0xd7, 0x69, 0xd7, 0x71, 0x59,
-	0xd5, 0xa2, 0x08, 0x52, 0x85, 0x26, 0x1f, 0x01, 0x04, 0x7c, 0xea, 0xda, 0x61, 0xe4, 0xb3, 0xa0,
-	0x0d, 0x28, 0x4d, 0xb0, 0x46, 0x1a, 0x45, 0x53, 0x54, 0xb2, 0x0f, 0x15, 0xe6, 0xfb, 0x9e, 0x1f,
-	0xb4, 0xb7, 0x0e, 0x8a, 0x87, 0x75, 0xaa, 0x20, 0xf3, 0x1c, 0x48, 0x37, 0xf2, 0x7d, 0xe6, 0x4e,
-	0x96, 0x3d, 0x76, 0xc3, 0x5d, 0x8e, 0x87, 0x27, 0x50, 0x12, 0x06, 0xdb, 0x36, 0x0e, 0x8c, 0xc3,
-	0x3a, 0xc5, 0xff, 0xc4, 0x84, 0x86, 0xc3, 0xef, 0x78, 0xc0, 0xaf, 0xf9, 0x8c, 0x87, 0x4b, 0xb4,
-	0x9f, 0x6d, 0x9a, 0xc1, 0x99, 0xff, 0xd4, 0x86, 0xaa, 0x32, 0x37, 0xb1, 0x47, 0x30, 0x8b, 0xa6,
-	0x7a, 0x0f, 0xf1, 0x9f, 0x7c, 0x00, 0x35, 0xa9, 0xda, 0x41, 0x4f, 0xd9, 0x5f, 0xd1, 0x1a, 0xf4,
-	0x68, 0x8c, 0x24, 0xdf, 0x86, 0xda, 0x9c, 0x85, 0xb6, 0x63, 0x87, 0xb6, 0xb2, 0xb5, 0x5d, 0x6d,
-	0xce, 0xd6, 0x85, 0x22, 0xd0, 0x98, 0x85, 0x3c, 0x86, 0x12, 0x0f, 0xd9, 0xbc, 0x5d, 0x42, 0xd6,
-	0xed, 0x98, 0x75, 0x10, 0xb2, 0x39, 0x45, 0x12, 0x39, 0x86, 0x66, 0x70, 0xcb, 0x17, 0x0b, 0xee,
-	0x4e, 0xaf, 0x16, 0xe2, 0x72, 0x41, 0xbb, 0x8c, 0x92, 0x7a, 0x14, 0x73, 0x8f, 0x32, 0x74, 0x9a,
-	0xe7, 0x27, 0x26, 0x94, 0x43, 0xfb, 0x9e, 0x05, 0xed, 0x0a, 0x2e, 0x6c, 0xc4, 0x0b, 0xc7, 0xf6,
-	0x3d, 0x95, 0x24, 0xf2, 0x2d, 0xa8, 0x4e, 0xbc, 0x68, 0x21, 0xb6, 0xaf, 0x22, 0x57, 0x33, 0xe6,
-	0xea, 0x22, 0x9e, 0x6a, 0x3a, 0x79, 0x1f, 0x60, 0xee, 0x39, 0xcc, 0xb7, 0x43, 0xa1, 0x8e, 0x1a,
-	0xaa, 0x23, 0x85, 0x21, 0x16, 0x90, 0x90, 0xf9, 0xf3, 0xe0, 0xd8, 0x75, 0xba, 0x9e, 0xeb, 0x70,
-	0x79, 0xe8, 0x3a, 0x8a, 0x71, 0x0d, 0x45, 0x28, 0x46, 0x1a, 0xc4, 0xd0, 0x9b, 0xf1, 0xc9, 0xb2,
-	0x0d, 0xc8, 0x99, 0xc1, 0x75, 0xfe, 0xa2, 0x02, 0x35, 0x2d, 0x3f, 0xd2, 0x86, 0xea, 0x1d, 0xf3,
-	0x03, 0x61, 0xd2, 0x06, 0x2a, 0x51, 0x83, 0xe4, 0x04, 0x1a, 0x3a, 0x80, 0x8d, 0x97, 0x0b, 0x86,
-	0x3a, 0xda, 0x39, 0x7a, 0x7f, 0x45, 0x05, 0x56, 0x37, 0xc5, 0x45, 0x33, 0x6b, 0xc8, 0x33, 0xa8,
-	0xdc, 0x78, 0xc2, 0xf9, 0x51, 0x81, 0x3b, 0x47, 0xed, 0xd5, 0xd5, 0xa7, 0x48, 0xa7, 0x8a, 0x8f,
-	0x1c, 0x41, 0x85, 0xdd, 0x2f, 0xb8, 0xbf, 0x54, 0x7a, 0xec, 0x58, 0x32, 0x22, 0x5a, 0x3a, 0x22,
-	0x5a, 0x63, 0x1d, 0x11, 0xa9, 0xe2, 0x14, 0x42, 0xb2, 0xd1, 0x55, 0x98, 0xa3, 0xec, 0x97, 0x33,
-	0xa9, 0xd9, 0x3a, 0x5d, 0x43, 0x21, 0x4f, 0xa1, 0xb9, 0xf0, 0xf9, 0x84, 0xbb, 0x53, 0x6d, 0xee,
-	0xe8, 0xfc, 0xf5, 0x93, 0x42, 0xdb, 0xa0, 0x79, 0x12, 0xe9, 0x40, 0x6d, 0x66, 0xbb, 0xd3, 0xc8,
-	0x9e, 0x32, 0xf4, 0xfa, 0x3a, 0x8d, 0x61, 0xf1, 0x64, 0x16, 0x4c, 0x7c, 0xef, 0xb5, 0x38, 0x94,
-	0x17, 0x85, 0x67, 0x5e, 0x84, 0x6a, 0x14, 0x82, 0x5c, 0x43, 0x21, 0x4f, 0x80, 0x4c, 0xfc, 0xe5,
-	0x22, 0xf4, 0xf4, 0xee, 0x5d, 0xe1, 0x59, 0x52, 0x9d, 0xb5, 0x89, 0xc7, 0x5d, 0x94, 0xda, 0x53,
-	0xcd, 0xd5, 0x4b, 0xfb, 0x18, 0xe0, 0xae, 0x2d, 0xc1, 0x95, 0xc6, 0x93, 0x43, 0xd8, 0x16, 0x47,
-	0x66, 0x17, 0x9e, 0xc3, 0x6f, 0x38, 0xf3, 0xdb, 0x5b, 0x07, 0xc6, 0x61, 0x01, 0xef, 0x92, 0x25,
-	0x90, 0x53, 0x78, 0xa4, 0xcd, 0xf9, 0xd4, 0xf7, 0xe6, 0x5d, 0x99, 0x8d, 0xf0, 0x08, 0x0d, 0x54,
-	0x4f, 0xc3, 0x4a, 0xe1, 0xe8, 0x26, 0x66, 0xf2, 0x29, 0xec, 0xa7, 0x49, 0x43, 0x2f, 0x08, 0xed,
-	0x19, 0x6e, 0xb3, 0x8d, 0x37, 0xd9, 0x40, 0x35, 0x1d, 0x68, 0xa4, 0x6d, 0x85, 0xec, 0xc2, 0xf6,
-	0xf0, 0xec, 0xcb, 0xd1, 0xa0, 0x7b, 0x7c, 0xfe, 0xf2, 0xf3, 0xab, 0xab, 0x5e, 0xeb, 0x01, 0x69,
-	0x41, 0xa3, 0x37, 0xf8, 0x7c, 0x30, 0xd6, 0x18, 0x83, 0x6c, 0x41, 0x75, 0xd4, 0xa7, 0x5f, 0x0c,
-	0xba, 0xfd, 0x56, 0x81, 0xec, 0x00, 0x74, 0xe9, 0xd5, 0xef, 0xf7, 0x5e, 0x9e, 0xbe, 0xb8, 0xec,
-	0xb5, 0x8a, 0x84, 0xc0, 0x4e, 0x97, 0x7e, 0x39, 0x1c, 0x5f, 0x75, 0x5f, 0x50, 0xda, 0xbf, 0xec,
-	0x7e, 0xd9, 0x2a, 0x99, 0x1f, 0x43, 0x45, 0xda, 0x14, 0x69, 0xc2, 0xd6, 0xe9, 0xe0, 0x0f, 0xfa,
-	0xbd, 0x97, 0x43, 0x2a, 0x96, 0xe3, 0xee, 0x17, 0xc7, 0xf4, 0x79, 0x7f, 0xac, 0x30, 0x85, 0xce,
-	0xdf, 0xd5, 0xa0, 0x24, 0x02, 0x04, 0xd9, 0x83, 0x72, 0xc8, 0xc3, 0x99, 0x0e, 0x73, 0x12, 0x20,
-	0x07, 0xb0, 0xe5, 0x08, 0x35, 0x72, 0xf4, 0x7e, 0x74, 0x81, 0x3a, 0x4d, 0xa3, 0xc8, 0x87, 0xb0,
-	0xb3, 0xf0, 0xbd, 0x09, 0x0b, 0x02, 0xee, 0x4e, 0x85, 0xae, 0xd1, 0xd2, 0xeb, 0x34, 0x87, 0x25,
-	0x6d, 0x28, 0xa3, 0x32, 0xd0, 0xac, 0x4b, 0xa8, 0x1d, 0x89, 0x10, 0xb1, 0xd1, 0x0d, 0x6e, 0x5e,
-	0x63, 0xe2, 0xaa, 0x51, 0xfc, 0x2f, 0x70, 0xa1, 0x3d, 0x95, 0x41, 0xa6, 0x4e, 0xf1, 0x3f, 0xf9,
-	0x18, 0x2a, 0x7c, 0x6e, 0x4f, 0x99, 0x0e, 0x2a, 0x0f, 0x33, 0x11, 0xce, 0x1a, 0x08, 0x1a, 0x55,
-	0x2c, 0x22, 0xae, 0x4c, 0xec, 0x90, 0x4d, 0x3d, 0x5f, 0xb8, 0x82, 0x8a, 0x2b, 0x09, 0x46, 0x5c,
-	0x77, 0xea, 0xdb, 0x73, 0x19, 0x4a, 0x0a, 0x54, 0x02, 0xe4, 0x3d, 0xa8, 0x4f, 0x74, 0x2c, 0x51,
-	0xa1, 0x23, 0x41, 0x10, 0x0b, 0xaa, 0x9e, 0x8a, 0x9a, 0x5b, 0x78, 0x82, 0xbd, 0xec, 0x09, 0x54,
-	0xc8, 0xd4, 0x4c, 0xe4, 0x9b, 0x50, 0x0a, 0x5e, 0x45, 0x41, 0xbb, 0xa1, 0x52, 0x7b, 0x86, 0x79,
-	0xf4, 0x2a, 0xa2, 0x48, 0x26, 0x4f, 0xf2, 0xf6, 0xbb, 0x8d, 0x47, 0xca, 0xd9, 0x6e, 0x07, 0x6a,
-	0xd7, 0x7c, 0x3a, 0x44, 0x11, 0xee, 0x48, 0x7f, 0xd1, 0x30, 0xf9, 0x9e, 0xda, 0x21, 0xf6, 0xe6,
-	0x26, 0x86, 0x8e, 0x87, 0xd6, 0x6a, 0x36, 0xa3, 0x59, 0xce, 0xce, 0x3f, 0x1a, 0x50, 0x91, 0xe7,
-	0x46, 0x3d, 0xd8, 0xf3, 0x38, 0xcf, 0x89, 0xff, 0x6f, 0xa1, 0xff, 0xcf, 0xa0, 0x76, 0x67, 0xfb,
-	0xdc, 0x76, 0xc3, 0xa0, 0x5d, 0xc4, 0x8b, 0xbe, 0xb7, 0x4e, 0x2a, 0xd6, 0x17, 0x92, 0x89, 0xc6,
-	0xdc, 0x9d, 0x33, 0xa8, 0x2a, 0xe4, 0xda, 0x47, 0x7f, 0x0b, 0xca, 0xa8, 0x4b, 0x95, 0x1b, 0xd7,
-	0x6a, 0x5b, 0x72, 0x74, 0x7e, 0x66, 0x40, 0x71, 0xf4, 0x2a, 0x12, 0xc1, 0x5f, 0xed, 0xde, 0xf5,
-	0xe6, 0xd7, 0x1e, 0xd6, 0x80, 0xdb, 0x34, 0x83, 0x13, 0x2a, 0x5e, 0xf8, 0x9e, 0x13, 0x4d, 0x42,
-	0x95, 0x76, 0xeb, 0x34, 0x41, 0x90, 0x03, 0xa8, 0x07, 0x91, 0x3f, 0xb9, 0xb5, 0xfd, 0xa9, 0x34,
-	0xe4, 0x22, 0x5a, 0x6a, 0x82, 0x24, 0xef, 0x43, 0xed, 0xab, 0xc8, 0x76, 0x43, 0x11, 0x91, 0x4a,
-	0x31, 0x43, 0x8c, 0x13, 0x67, 0xb8, 0xe6, 0xd3, 0x51, 0xbc, 0x49, 0x59, 0x26, 0xa0, 0x34, 0x4e,
-	0x48, 0xf5, 0x9a, 0x4f, 0x7f, 0xac, 0xb7, 0xa9, 0x48, 0xa9, 0xa6, 0x50, 0x9d, 0x9f, 0x1a, 0x50,
-	0xc6, 0x2b, 0x0a, 0xbd, 0xdf, 0xf0, 0x19, 0x4b, 0x89, 0x27, 0x86, 0x05, 0xcd, 0xf3, 0xf9, 0x94,
-	0xbb, 0xf6, 0x4c, 0x5d, 0x25, 0x86, 0x85, 0x81, 0xcf, 0xe2, 0x5b, 0xd4, 0xa9, 0x04, 0x44, 0xe5,
-	0x33, 0x67, 0x0e, 0x8f, 0x64, 0x95, 0x50, 0xa7, 0x0a, 0x12, 0xdc, 0xc1, 0xdc, 0x9e, 0xcd, 0xd4,
-	0x71, 0x25, 0x80, 0x5e, 0xc8, 0x5d, 0x7d, 0x40, 0xfc, 0xdf, 0xf9, 0xb7, 0x22, 0xec, 0x64, 0x6b,
-	0x84, 0xb5, 0xda, 0xfb, 0x0c, 0x4a, 0x61, 0x92, 0x34, 0x9f, 0x6c, 0x28, 0x2f, 0x62, 0x10, 0x53,
-	0x27, 0xae, 0x20, 0x1f, 0x42, 0xd5, 0x67, 0x53, 0xf4, 0x32, 0x61, 0x4f, 0xf9, 0xa0, 0xac, 0x89,
-	0xe4, 0xfb, 0x50, 0x0b, 0x98, 0x7f, 0xc7, 0x27, 0x4c, 0x17, 0x31, 0x1f, 0x6c, 0x7c, 0x8a, 0xe4,
-	0xa3, 0xf1, 0x82, 0xce, 0xbf, 0x1b, 0x50, 0x55, 0xd8, 0xb5, 0xc7, 0x8f, 0xa3, 0x55, 0x21, 0x1f,
-	0xad, 0x9e, 0xc2, 0x2e, 0x0b, 0x42, 0x3e, 0xb7, 0x43, 0xe6, 0xf4, 0xd8, 0x8c, 0xdf, 0x31, 0x7f,
-	0xa9, 0x64, 0xbc, 0x4a, 0x20, 0xdf, 0x85, 0x87, 0xb6, 0x23, 0xc3, 0x87, 0x3d, 0x13, 0x86, 0x3b,
-	0xcc, 0xc5, 0xc0, 0x75, 0xe4, 0x8c, 0xaf, 0x97, 0x73, 0xbe, 0xfe, 0x29, 0xec, 0x5f, 0xf3, 0xe9,
-	0xf1, 0x9a, 0x4d, 0xa5, 0x96, 0x36, 0x50, 0xcd, 0x4f, 0xa0, 0x91, 0x16, 0xb6, 0x48, 0x05, 0xe7,
-	0x57, 0x22, 0xf1, 0x0c, 0x07, 0xdd, 0xe7, 0x2f, 0x86, 0xad, 0x07, 0xf9, 0x6c, 0x61, 0x74, 0xfe,
-	0xca, 0x80, 0xe2, 0xd8, 0xbe, 0x17, 0x25, 0x52, 0x68, 0xdf, 0x63, 0x0d, 0x24, 0x65, 0xa4, 0x41,
-	0xf2, 0x14, 0x20, 0xb4, 0xef, 0xa9, 0x52, 0x57, 0x61, 0x8d, 0xba, 0x52, 0x74, 0x61, 0xf6, 0xa1,
-	0x7d, 0xaf, 0x4f, 0x81, 0x42, 0xab, 0xd1, 0x34, 0x4a, 0x44, 0xed, 0x05, 0xf3, 0x27, 0xcc, 0x0d,
-	0x85, 0xe3, 0x97, 0x30, 0x0e, 0xa6, 0x30, 0x9d, 0xff, 0x32, 0xa0, 0x22, 0x2b, 0xc8, 0x0d, 0xf9,
-	0x6a, 0x0f, 0x4a, 0xb7, 0x76, 0x70, 0x2b, 0xbd, 0xe1, 0xec, 0x01, 0x45, 0x88, 0x3c, 0x11, 0xd5,
-	0x7a, 0x80, 0xcd, 0x27, 0x66, 0xe9, 0xa2, 0xa2, 0x66, 0xb0, 0xe4, 0x23, 0x68, 0xaa, 0x47, 0xf5,
-	0x14, 0x1a, 0x85, 0x5f, 0x38, 0x13, 0x35, 0x51, 0x96, 0x40, 0x3e, 0x52, 0x11, 0x37, 0xe6, 0xac,
-	0x68, 0x8d, 0x9e, 0xe9, 0xaa, 0x23, 0xe6, 0x7d, 0x0a, 0x2d, 0xad, 0xbd, 0x98, 0x1d, 0xeb, 0xa8,
-	0x33, 0x83, 0xae, 0x50, 0x4e, 0x2a, 0xb2, 0xdb, 0x38, 0x01, 0xa8, 0xe9, 0xd3, 0x99, 0x7f, 0xda,
-	0x80, 0xb2, 0xec, 0x46, 0x9f, 0xc0, 0xb6, 0x2c, 0x65, 0x8f, 0x1d, 0xc7, 0x67, 0x41, 0xa0, 0x6e,
-	0x9f, 0x45, 0x8a, 0x28, 0x26, 0x11, 0xa7, 0x2c, 0x6d, 0xc1, 0x09, 0x92, 0x7c, 0x0c, 0xb5, 0x20,
-	0xad, 0x07, 0x51, 0xa2, 0xe3, 0x13, 0x62, 0xd7, 0xa1, 0x31, 0x03, 0xf9, 0x4d, 0xa8, 0x62, 0xef,
-	0x38, 0xe8, 0xa9, 0x9a, 0x14, 0xfb, 0x14, 0x8d, 0x23, 0x9f, 0x41, 0x3d, 0x6e, 0xd2, 0x55, 0xf7,
-	0xf9, 0xa6, 0xa2, 0x35, 0x61, 0x26, 0x8f, 0xa1, 0x2c, 0xda, 0x12, 0xdd, 0x4b, 0x6c, 0xa9, 0x23,
-	0x60, 0xc3, 0x22, 0x29, 0xe4, 0x10, 0xaa, 0x0b, 0x7b, 0x89, 0x1d, 0xb2, 0xec, 0x38, 0x77, 0x14,
-	0xd3, 0x50, 0x62, 0xa9, 0x26, 0x0b, 0xdb, 0xf1, 0x6d, 0xe1, 0xfd, 0xcf, 0xd9, 0x52, 0x66, 0xfc,
-	0x06, 0x4d, 0x61, 0xc8, 0x11, 0xec, 0xd9, 0xb3, 0x90, 0xf9, 0xae, 0x1d, 0x32, 0x51, 0x85, 0xd9,
-	0x93, 0x70, 0xe0, 0xde, 0x78, 0xaa, 0xf8, 0x5c, 0x4b, 0x4b, 0x37, 0x07, 0x90, 0x6d, 0x0e, 0x64,
-	0x98, 0xa7, 0xb1, 0x94, 0xb7, 0xe2, 0x30, 0x1f, 0xe3, 0x3a, 0x3f, 0x37, 0xa0, 0x16, 0x9b, 0xf6,
-	0x3e, 0x54, 0x84, 0x40, 0xc7, 0x9e, 0x52, 0x99, 0x82, 0xc4, 0x23, 0x6c, 0xa5, 0x4b, 0x19, 0xc2,
-	0x35, 0x88, 0x7d, 0xa7, 0x48, 0x0f, 0x45, 0xd5, 0x77, 0x8a, 0xec, 0x22, 0xe2, 0x74, 0x68, 0x87,
-	0x4c, 0x85, 0x6f, 0x09, 0xa0, 0xdb, 0x24, 0x35, 0xa8, 0x8c, 0x18, 0x29, 0x8c, 0x08, 0xa9, 0x6a,
-	0xf2, 0x82, 0x76, 0xba, 0x12, 0x52, 0x15, 0x51, 0x5c, 0x4a, 0x3d, 0xfc, 0xd2, 0x0b, 0xb1, 0xce,
-	0xc2, 0x4b, 0xa5, 0x71, 0x9d, 0x9f, 0x17, 0x55, 0xc1, 0x78, 0x00, 0x5b, 0x33, 0x19, 0x6e, 0xcf,
-	0x84, 0xc7, 0xc9, 0x5b, 0xa5, 0x51, 0x99, 0x54, 0x89, 0x0d, 0x72, 0x2e, 0x55, 0x3e, 0x4d, 0xea,
-	0x29, 0x59, 0x39, 0x90, 0x94, 0x01, 0xac, 0x54, 0x53, 0x27, 0xb0, 0x93, 0xed, 0x45, 0xe3, 0x06,
-	0x29, 0xb5, 0x28, 0xd7, 0xbd, 0xe6, 0x56, 0x08, 0x91, 0xce, 0xd9, 0xdc, 0x53, 0x22, 0xc2, 0xff,
-	0xe2, 0x1e, 0xb2, 0x19, 0x15, 0xb2, 0xd0, 0x15, 0x67, 0x1a, 0x85, 0x25, 0xae, 0x34, 0x32, 0xed,
-	0x75, 0x55, 0x55, 0xe2, 0x66, 0xb0, 0xc4, 0x04, 0xd0, 0x77, 0xfb, 0xf4, 0xbb, 0xd8, 0x04, 0x49,
-	0xbf, 0x4b, 0x61, 0xf3, 0xa9, 0xbf, 0xbe, 0x9a, 0xfa, 0x8f, 0xde, 0x58, 0x90, 0xed, 0x41, 0xf9,
-	0xce, 0x9e, 0x45, 0x4c, 0x19, 0x8b, 0x04, 0x3a, 0x3f, 0x78, 0xab, 0x9c, 0xdc, 0x86, 0xaa, 0x4a,
-	0x80, 0xda, 0xd4, 0x14, 0xd8, 0xf9, 0x9b, 0x22, 0x54, 0x95, 0x43, 0x91, 0x6f, 0x8b, 0x12, 0x21,
-	0xbc, 0xf5, 0x1c, 0x5c, 0xbb, 0x73, 0xf4, 0x8d, 0xac, 0xc3, 0x89, 0xc6, 0xf5, 0xd6, 0x73, 0xa8,
-	0x62, 0x12, 0xf5, 0x54, 0xdc, 0xae, 0xeb, 0x7a, 0x2a, 0x46, 0x90, 0x0e, 0x54, 0xec, 0x39, 0x46,
-	0xbc, 0x62, 0x2c, 0x0e, 0x85, 0xc1, 0x62, 0xfb, 0xd6, 0xe6, 0x2e, 0x0e, 0x57, 0x4a, 0xaa, 0xd8,
-	0xd6, 0x88, 0xb4, 0x5f, 0x94, 0xb3, 0x7e, 0x81, 0x2d, 0xbe, 0xc3, 0xd8, 0x7c, 0x84, 0x45, 0xa8,
-	0xca, 0x7b, 0x19, 0x9c, 0xe0, 0x89, 0x0f, 0xf1, 0x9c, 0x2d, 0x51, 0x61, 0x0d, 0x9a, 0xc1, 0x91,
-	0x7d, 0x11, 0x69, 0xb9, 0x8b, 0x8a, 0x92, 0xad, 0x2f, 0xc2, 0xe2, 0x5c, 0x22, 0x87, 0xca, 0x63,
-	0x4b, 0x05, 0x25, 0x08, 0xf2, 0x7d, 0xd8, 0x91, 0xe7, 0x8f, 0x8b, 0x6d, 0xd8, 0x5c, 0x6c, 0xe7,
-	0x58, 0xcd, 0xcf, 0xa0, 0x22, 0xc5, 0x47, 0x1e, 0x42, 0xf3, 0xb8, 0xd7, 0xa3, 0xfd, 0xd1, 0xe8,
-	0x25, 0xed, 0xff, 0xf8, 0x45, 0x7f, 0x34, 0x6e, 0x3d, 0x20, 0x00, 0x95, 0xde, 0x80, 0xf6, 0xbb,
-	0xe3, 0x96, 0x41, 0xb6, 0xa1, 0x7e, 0x71, 0xd5, 0xeb, 0xd3, 0xe3, 0x71, 0xbf, 0xd7, 0x2a, 0x98,
-	0xbf, 0x2c, 0xc0, 0xee, 0xea, 0x38, 0xb1, 0x0d, 0x55, 0x4f, 0x20, 0x07, 0x3d, 0x9d, 0x99, 0x15,
-	0x98, 0x0d, 0xca, 0x85, 0x77, 0x09, 0xca, 0xab, 0xd6, 0x5e, 0x5c, 0x6b, 0xed, 0x4f, 0xa1, 0xe9,
-	0xb3, 0xaf, 0x22, 0x16, 0x84, 0xcc, 0x51, 0xc2, 0x4a, 0xca, 0x9a, 0x3c, 0x89, 0xfc, 0x2e, 0xb4,
-	0x64, 0x2c, 0x1e, 0x25, 0x43, 0x3a, 0x59, 0xb5, 0xb5, 0x2c, 0x9a, 0x25, 0xd0, 0x15, 0x4e, 0x62,
-	0x01, 0xc1, 0xc8, 0x9a, 0x7d, 0x9c, 0x54, 0xfc, 0x1a, 0x0a, 0xb9, 0x80, 0x47, 0xb9, 0x03, 0xc4,
-	0xda, 0xaa, 0x6e, 0xd6, 0xd6, 0xa6, 0x35, 0xe6, 0x9f, 0x1b, 0xb0, 0x25, 0x27, 0xc3, 0xec, 0x27,
-	0x6c, 0x12, 0xfe, 0xbf, 0x88, 0x5d, 0x34, 0x8b, 0x7c, 0xaa, 0x23, 0xe1, 0xae, 0x75, 0xc2, 0x43,
-	0x61, 0x8d, 0x89, 0x54, 0x90, 0x6c, 0xfe, 0xa2, 0x08, 0xcd, 0x9c, 0xbc, 0xc8, 0x8f, 0x52, 0x73,
-	0x42, 0x03, 0x9f, 0xf9, 0x24, 0x2f, 0x53, 0x6b, 0xec, 0xdb, 0x6e, 0x60, 0x4f, 0xc4, 0x3d, 0xd7,
-	0x8c, 0x0e, 0xdf, 0x83, 0x7a, 0x3c, 0x1e, 0xc5, 0x63, 0x37, 0x68, 0x82, 0xe8, 0xfc, 0x6b, 0x01,
-	0x1e, 0xae, 0x59, 0x9f, 0xca, 0x00, 0xa3, 0x64, 0xb6, 0x99, 0x46, 0x89, 0x7d, 0xe3, 0x0c, 0xac,
-	0xf7, 0x8d, 0x11, 0x2b, 0x4e, 0x5a, 0x5c, 0xe3, 0xa4, 0x26, 0x34, 0xd4, 0x86, 0x63, 0xac, 0xf6,
-	0x64, 0x9c, 0xc8, 0xe0, 0xc8, 0x19, 0xd4, 0xc3, 0xdb, 0x68, 0x7e, 0xed, 0xda, 0x7c, 0xa6, 0x0a,
-	0x90, 0x8f, 0xde, 0x46, 0x00, 0xaa, 0x89, 0x4c, 0x16, 0x77, 0xfe, 0x44, 0x77, 0x5d, 0xba, 0xf3,
-	0x31, 0x92, 0xce, 0x27, 0xe9, 0x91, 0x0a, 0xe9, 0x1e, 0x29, 0xe9, 0xa8, 0x8a, 0xf9, 0x8e, 0x4a,
-	0xf6, 0x5f, 0xa5, 0x74, 0xff, 0x95, 0xee, 0xd8, 0xca, 0xd9, 0x8e, 0xcd, 0x1c, 0x42, 0x2b, 0xaf,
-	0x74, 0x91, 0xd9, 0xb9, 0xbb, 0x88, 0xc2, 0x81, 0xeb, 0xb0, 0x7b, 0x35, 0xa0, 0x4c, 0x61, 0xde,
-	0xac, 0x38, 0xf3, 0x67, 0x55, 0x68, 0xad, 0xbc, 0x37, 0x88, 0x8d, 0xd7, 0xc9, 0x1a, 0xaf, 0x13,
-	0x0f, 0xa9, 0x0b, 0xa9, 0x21, 0x75, 0xc6, 0xa0, 0x8b, 0xef, 0x62, 0xd0, 0x97, 0xd0, 0x5a, 0xdc,
-	0x2e, 0x03, 0x3e, 0xb1, 0x67, 0x71, 0x9f, 0x24, 0x5f, 0x72, 0x98, 0x2b, 0x2f, 0x39, 0xac, 0x61,
-	0x8e, 0x93, 0xae, 0xac, 0x25, 0xcf, 0xa1, 0xe9, 0xf0, 0x29, 0x0f, 0x53, 0xdb, 0xc9, 0x00, 0xf2,
-	0x78, 0x75, 0xbb, 0x5e, 0x96, 0x91, 0xe6, 0x57, 0x92, 0x67, 0x50, 0x59, 0xd8, 0x4b, 0x2f, 0x0a,
-	0xd5, 0x5b, 0x8f, 0xf6, 0x9a, 0x23, 0x21, 0x9d, 0x2a, 0x3e, 0xf2, 0x3b, 0xd0, 0xcc, 0x85, 0x25,
-	0x15, 0x4a, 0x56, 0xe3, 0x57, 0x9e, 0x11, 0x93, 0xb1, 0x17, 0xca, 0x37, 0x1e, 0x22, 0x19, 0x7b,
-	0x21, 0x23, 0x7f, 0x04, 0xfb, 0x72, 0xc6, 0x39, 0x89, 0x03, 0x91, 0xba, 0x55, 0x1d, 0x6f, 0x75,
-	0xb8, 0x7a, 0xa2, 0xee, 0x5a, 0x7e, 0xba, 0x61, 0x9f, 0xce, 0x18, 0x5a, 0x79, 0xb1, 0x62, 0x09,
-	0x20, 0x0a, 0x05, 0xe6, 0x6b, 0xe5, 0x2b, 0x50, 0x84, 0xfd, 0xd0, 0xb7, 0x27, 0xaf, 0xb8, 0x3b,
-	0xbd, 0x8c, 0xe6, 0xd7, 0x4c, 0x27, 0xf3, 0x1c, 0xb6, 0xf3, 0x43, 0x68, 0xe6, 0xa4, 0x4b, 0x5a,
-	0x50, 0x8c, 0xfc, 0x99, 0xda, 0x50, 0xfc, 0x15, 0x66, 0xbe, 0xb0, 0x83, 0xe0, 0xb5, 0xe7, 0x3b,
-	0x7a, 0x30, 0xa1, 0xe1, 0xce, 0x0f, 0x60, 0x7f, 0xfd, 0x45, 0x44, 0x73, 0x13, 0x26, 0x5e, 0x1a,
-	0x07, 0xd7, 0x2c, 0xb2, 0xf3, 0x4b, 0x03, 0x2a, 0x52, 0x37, 0x71, 0xcc, 0x34, 0xde, 0x18, 0x33,
-	0x71, 0xc0, 0x86, 0x0b, 0x8e, 0x33, 0x85, 0x76, 0x16, 0x49, 0x2c, 0x68, 0x49, 0xc4, 0x29, 0x63,
-	0x43, 0xe6, 0x9f, 0x2c, 0x43, 0x96, 0x2a, 0x5a, 0x56, 0x68, 0xe4, 0x19, 0x3c, 0x14, 0xcd, 0x5b,
-	0x7e, 0x89, 0x74, 0xf7, 0x75, 0x24, 0x72, 0x0c, 0xbb, 0xf1, 0x2e, 0x71, 0x3e, 0x2a, 0x6f, 0xce,
-	0x47, 0xab, 0xdc, 0xe6, 0xdf, 0x1b, 0xd0, 0xcc, 0xbf, 0xc2, 0xdb, 0xec, 0xd0, 0xbf, 0x7a, 0x36,
-	0xfa, 0x04, 0x40, 0x3e, 0x7c, 0xf4, 0xc6, 0x9c, 0x94, 0x62, 0x22, 0x8f, 0xa1, 0x2a, 0xed, 0x3e,
-	0x50, 0x6e, 0x5e, 0x55, 0x8e, 0x41, 0x35, 0xde, 0xfc, 0x5b, 0x03, 0xf6, 0xf1, 0xf4, 0xc3, 0x78,
-	0x36, 0x7c, 0x6a, 0xf3, 0x99, 0x70, 0x91, 0xcd, 0x29, 0xf5, 0x0c, 0xf6, 0xec, 0x30, 0x64, 0xf3,
-	0x45, 0xc8, 0x9c, 0x0b, 0xf9, 0xae, 0x38, 0xf5, 0x3a, 0x66, 0xcf, 0x52, 0x38, 0x2b, 0x45, 0xa3,
-	0x6b, 0x57, 0x10, 0x0b, 0x6a, 0xfa, 0xe5, 0x4c, 0xfc, 0xee, 0x76, 0xe5, 0x55, 0x32, 0x8d, 0x79,
-	0xcc, 0x7f, 0x2e, 0x41, 0x45, 0x5e, 0x81, 0x1c, 0xe9, 0xe6, 0xb2, 0x97, 0x24, 0x59, 0xa2, 0xee,
-	0xa7, 0x7e, 0x04, 0x85, 0xa6, 0xb8, 0xbe, 0x26, 0xa9, 0xfe, 0x47, 0x11, 0x80, 0x66, 0x98, 0x93,
-	0x4c, 0x69, 0xe4, 0x33, 0xe5, 0xd7, 0xbe, 0x2a, 0xb4, 0xa0, 0x2e, 0xff, 0x8f, 0xb8, 0x6e, 0xe8,
-	0x57, 0xe3, 0x52, 0xc2, 0xf2, 0x75, 0x2d, 0xbd, 0x28, 0x81, 0xc5, 0xdf, 0x4b, 0xd1, 0x42, 0x94,
-	0x55, 0x09, 0xac, 0x11, 0x38, 0x9e, 0x12, 0x80, 0x78, 0x56, 0x05, 0x8f, 0x1a, 0xc3, 0x99, 0x9c,
-	0x2e, 0xe8, 0xf9, 0xc2, 0x5b, 0xf0, 0x64, 0xcc, 0xb2, 0xf6, 0x2e, 0x66, 0x29, 0xac, 0xe4, 0x8e,
-	0xf9, 0x22, 0x09, 0xd7, 0x65, 0x3f, 0xae, 0x40, 0x41, 0xf9, 0x2a, 0xb2, 0x53, 0xef, 0x89, 0x34,
-	0x98, 0x1f, 0x61, 0x6f, 0x21, 0x35, 0x33, 0xc2, 0x7e, 0x02, 0xdb, 0x8e, 0x8a, 0x41, 0xa3, 0x05,
-	0x63, 0x0e, 0xbe, 0x0c, 0xda, 0xa6, 0x59, 0x24, 0x39, 0x84, 0xe6, 0x24, 0x0a, 0x42, 0x6f, 0xce,
-	0x7c, 0x35, 0x39, 0xc4, 0x41, 0xfd, 0x36, 0xcd, 0xa3, 0x45, 0x49, 0xe0, 0xb3, 0x3b, 0xce, 0x5e,
-	0xab, 0x41, 0xbd, 0x82, 0xcc, 0x5f, 0x18, 0x50, 0x55, 0x2f, 0xbb, 0xb3, 0x32, 0x30, 0xde, 0x45,
-	0x06, 0x7b, 0x50, 0x9e, 0xcc, 0x6c, 0x3e, 0xd7, 0x65, 0x08, 0x02, 0xab, 0x31, 0xae, 0xb8, 0x2e,
-	0xc6, 0xfd, 0x16, 0xd4, 0xbd, 0x28, 0x5c, 0x78, 0xdc, 0x0d, 0xb5, 0x97, 0xd6, 0xad, 0x2b, 0x85,
-	0xa1, 0x09, 0x4d, 0x14, 0xdc, 0x01, 0xf3, 0xb9, 0x3d, 0xe3, 0x7f, 0xcc, 0x1c, 0xed, 0x1a, 0x68,
-	0x09, 0x0d, 0xba, 0x86, 0x62, 0xfe, 0x59, 0x05, 0x76, 0x57, 0xbe, 0x04, 0xf8, 0x3f, 0x5c, 0x32,
-	0x15, 0xd3, 0x0a, 0xd9, 0x98, 0xf6, 0x3e, 0xc0, 0xc2, 0xf7, 0x16, 0x5e, 0xc0, 0x9c, 0x13, 0x3d,
-	0x1b, 0x49, 0x61, 0x70, 0x0c, 0x94, 0x7c, 0xa5, 0x20, 0xa3, 0x71, 0x0a, 0x43, 0x3e, 0x89, 0x33,
-	0xbf, 0x8c, 0xbc, 0xbf, 0xb1, 0xfa, 0x05, 0x43, 0x3e, 0xf5, 0x3f, 0x83, 0x87, 0xb1, 0xfd, 0xc6,
-	0x3e, 0x25, 0x27, 0x05, 0x0d, 0xba, 0x8e, 0xd4, 0xf9, 0xcf, 0xe2, 0xbb, 0xe6, 0xa8, 0xc7, 0x50,
-	0xc1, 0xb2, 0x4e, 0x4e, 0x51, 0x33, 0x6a, 0x51, 0x04, 0x72, 0x02, 0x5b, 0xf2, 0x13, 0x8e, 0x28,
-	0x5c, 0x44, 0x3a, 0x82, 0x1d, 0x6c, 0x3c, 0xbe, 0x25, 0xf9, 0x68, 0x7a, 0x11, 0xe9, 0x41, 0x43,
-	0x7d, 0x4e, 0x22, 0x37, 0x29, 0xbd, 0xe5, 0x26, 0x99, 0x55, 0xe4, 0xf7, 0xa0, 0x19, 0xdf, 0x5a,
-	0x6d, 0x54, 0x7e, 0xcb, 0x8d, 0xf2, 0x0b, 0x45, 0x3f, 0x2d, 0xc5, 0x9c, 0x79, 0x15, 0xbd, 0xa9,
-	0x9f, 0xce, 0xb2, 0x76, 0xfe, 0xd2, 0x80, 0x8a, 0xda, 0xa7, 0x0d, 0x15, 0xe9, 0xd1, 0x32, 0x7f,
-	0x9c, 0x3d, 0xa0, 0x0a, 0x26, 0x9d, 0x64, 0x92, 0xa0, 0x07, 0xbf, 0xf1, 0x2c, 0x21, 0x99, 0x4f,
-	0x14, 0xd6, 0xcd, 0x27, 0x92, 0x39, 0x40, 0x29, 0x37, 0x07, 0x38, 0xd9, 0x85, 0xa6, 0xdc, 0xff,
-	0xca, 0x57, 0xde, 0x65, 0xf2, 0xd8, 0x07, 0x52, 0x1f, 0xae, 0xfc, 0xea, 0x3e, 0xd0, 0x81, 0xda,
-	0x64, 0xa6, 0xec, 0x5c, 0x15, 0x51, 0x1a, 0x36, 0x7f, 0x02, 0x35, 0x6d, 0x1f, 0xa2, 0xba, 0xbc,
-	0x4d, 0x26, 0x70, 0x72, 0xe2, 0xbd, 0x07, 0x65, 0x8e, 0x2d, 0x83, 0xfc, 0x30, 0x45, 0x02, 0xa4,
-	0xad, 0x87, 0x47, 0x49, 0x5d, 0x23, 0x11, 0xea, 0x8d, 0xc3, 0x17, 0x48, 0x2c, 0xc5, 0x6f, 0x1c,
-	0x10, 0x36, 0xff, 0xbb, 0x00, 0x15, 0x39, 0xd4, 0xfc, 0x35, 0x36, 0xbe, 0xa4, 0x0f, 0xbb, 0x72,
-	0x7c, 0x9d, 0x6a, 0xe4, 0x94, 0xf9, 0x3e, 0x52, 0xdf, 0x02, 0xa5, 0x7b, 0xbc, 0x81, 0x7b, 0xe3,
-	0xd1, 0xd5, 0x15, 0xeb, 0x26, 0x80, 0x9d, 0xbf, 0x36, 0xa0, 0x99, 0x5b, 0x8a, 0x0d, 0xe0, 0x3d,
-	0x77, 0xe2, 0x06, 0xf0, 0x9e, 0x3b, 0x89, 0xf8, 0x0a, 0x6f, 0x12, 0x5f, 0x31, 0x2b, 0x3e, 0xf2,
-	0x3d, 0xd8, 0x46, 0xa6, 0xd8, 0xbe, 0x4b, 0x6f, 0x78, 0x39, 0x9b, 0xe1, 0x34, 0x8f, 0x60, 0xff,
-	0x0b, 0xf4, 0xbb, 0x53, 0xd1, 0x20, 0x8a, 0x80, 0xab, 0x87, 0x74, 0x1b, 0x15, 0x61, 0xfe, 0x83,
-	0x01, 0x85, 0x41, 0x4f, 0xe4, 0xa0, 0x05, 0x4b, 0xd1, 0x15, 0x24, 0xf0, 0xb7, 0xb6, 0xeb, 0xcc,
-	0xf4, 0x08, 0x50, 0x41, 0xe4, 0x9b, 0x50, 0x5d, 0x44, 0xd7, 0xaf, 0xd8, 0x32, 0x50, 0x81, 0x65,
-	0xcb, 0x1a, 0xf4, 0xac, 0xa1, 0x44, 0x51, 0x4d, 0x13, 0xd1, 0xf5, 0x3a, 0xd6, 0x0f, 0xde, 0xa4,
-	0x41, 0x53, 0x98, 0xce, 0x0f, 0xa1, 0xaa, 0xd6, 0x08, 0x99, 0x70, 0x87, 0xc9, 0x31, 0xa7, 0x2c,
-	0x68, 0x62, 0x58, 0x1c, 0x5f, 0x2d, 0x52, 0x85, 0x91, 0x06, 0xcd, 0xff, 0x31, 0xa0, 0x9e, 0x34,
-	0x4e, 0x4f, 0xa1, 0x1a, 0x30, 0xa9, 0x6a, 0x39, 0x8c, 0x24, 0xc9, 0x17, 0x5d, 0xd6, 0x48, 0x52,
-	0xa8, 0x66, 0x11, 0x2d, 0x4c, 0x5c, 0x5f, 0x89, 0x82, 0x3b, 0x50, 0x9b, 0xe7, 0xb0, 0xe6, 0x4f,
-	0xf1, 0xe5, 0x9f, 0x5c, 0xb3, 0x05, 0xd5, 0xf3, 0xc1, 0x68, 0x3c, 0xb8, 0xfc, 0xbc, 0xf5, 0x80,
-	0xd4, 0xa1, 0x7c, 0x45, 0x7b, 0x7d, 0xda, 0x32, 0xc8, 0x3e, 0x10, 0xfc, 0xfb, 0xb2, 0x7b, 0x75,
-	0x79, 0x3a, 0xa0, 0x17, 0xc7, 0xe3, 0xc1, 0xd5, 0x65, 0xab, 0x40, 0xbe, 0x01, 0xbb, 0x12, 0x7f,
-	0xfa, 0xe2, 0xfc, 0x74, 0x70, 0x7e, 0x7e, 0xd1, 0xbf, 0x1c, 0xb7, 0x8a, 0x64, 0x0f, 0x5a, 0x9a,
-	0xfd, 0x62, 0x78, 0xde, 0x47, 0xe6, 0x92, 0xd8, 0xbc, 0x37, 0x18, 0x0d, 0x5f, 0x8c, 0xfb, 0xad,
-	0xb2, 0xd8, 0x51, 0x01, 0x2f, 0x69, 0x7f, 0x74, 0x75, 0xfe, 0x02, 0x99, 0x2a, 0x04, 0xa0, 0x42,
-	0xfb, 0xf8, 0x39, 0x47, 0xd5, 0x64, 0xb0, 0x2d, 0xee, 0xc7, 0x1c, 0xfd, 0xdd, 0x98, 0x09, 0x55,
-	0x35, 0xea, 0x50, 0xb1, 0x23, 0xf9, 0xa0, 0x51, 0x13, 0x62, 0xff, 0x2f, 0xa4, 0xfc, 0x3f, 0x53,
-	0x7b, 0x16, 0x73, 0xb5, 0xe7, 0x49, 0xe9, 0x0f, 0x0b, 0x8b, 0xeb, 0xeb, 0x0a, 0xfa, 0xe5, 0x6f,
-	0xff, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x59, 0xc6, 0x05, 0xa7, 0x29, 0x00, 0x00,
+	// 4667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0x4b, 0x6c, 0x24, 0x49,
+	0x5a, 0xee, 0x7a, 0x57, 0xfd, 0x2e, 0xdb, 0xe5, 0x68, 0x8f, 0xa7, 0x48, 0x86, 0x99, 0x9e, 0x52,
+	0xef, 0xd0, 0xdb, 0xd3, 0x9b, 0xdb, 0x63, 0x46, 0xc3, 0xec, 0x2e, 0x1a, 0xc6, 0xae, 0xb2, 0xc7,
+	0x45, 0xbb, 0xed, 0x9a, 0xa8, 0xea, 0x59, 0x06, 0x09, 0x35, 0xe9, 0xcc, 0x70, 0x39, 0xb6, 0xb3,
+	0x32, 0x6b, 0xf2, 0xe1, 0xb1, 0xe1, 0xb6, 0xd2, 0xf2, 0xd0, 0x0a, 0x8e, 0x70, 0xdb, 0x0b, 0x42,
+	0x5c, 0x10, 0x47, 0x2e, 0x20, 0x21, 0x81, 0x38, 0x23, 0xed, 0x69, 0x8f, 0x1c, 0xf6, 0x86, 0x90,
+	0x90, 0x40, 0x42, 0x42, 0x1c, 0x50, 0x3c, 0x33, 0x32, 0xab, 0xca, 0xee, 0x9e, 0xd5, 0x6a, 0x0f,
+	0x96, 0xea, 0xff, 0xfe, 0x3f, 0x22, 0xe3, 0xf1, 0xc7, 0xff, 0x8a, 0x30, 0x6c, 0xba, 0x61, 0x90,
+	0x44, 0x8e, 0x9b, 0xc4, 0xf6, 0x3c, 0x0a, 0x93, 0xd0, 0x42, 0x6e, 0x98, 0x06, 0x49, 0x74, 0xed,
+	0x86, 0x1e, 0x51, 0xd8, 0xfa, 0x8c, 0xc4, 0xb1, 0x33, 0x25, 0x92, 0x7c, 0x6b, 0x1a, 0x86, 0x53,
+	0x9f, 0x7c, 0x93, 0x53, 0x67, 0xe9, 0xf9, 0x37, 0x13, 0x3a, 0x23, 0x71, 0xe2, 0xcc, 0xe6, 0x42,
+	0xa0, 0xf7, 0x6f, 0x35, 0xd8, 0xc2, 0xd4, 0x75, 0x22, 0x8f, 0x3a, 0x41, 0x5f, 0x7e, 0x00, 0x3d,
+	0x86, 0x8d, 0x4b, 0x12, 0x78, 0x61, 0x74, 0x4c, 0xe3, 0x84, 0x06, 0xd3, 0xb8, 0x5b, 0xba, 0x57,
+	0x79, 0xb0, 0xb6, 0xdb, 0xb4, 0x25, 0x80, 0x0b, 0x7c, 0xf4, 0x0e, 0xc0, 0x59, 0x7a, 0x4d, 0xa2,
+	0xd3, 0xc8, 0x23, 0x51, 0xb7, 0x7c, 0xaf, 0xf4, 0x60, 0x6d, 0xb7, 0x6e, 0x73, 0x0a, 0x1b, 0x1c,
+	0x74, 0x0c, 0xaf, 0x8b, 0x96, 0x9c, 0xec, 0x87, 0xc1, 0x39, 0x8d, 0x66, 0x4e, 0x42, 0xc3, 0xa0,
+	0x5b, 0xe1, 0x8d, 0x90, 0xbd, 0xc0, 0xc1, 0xab, 0x9a, 0xa0, 0x21, 0xec, 0x18, 0xac, 0xc3, 0xd4,
+	0x3f, 0xa7, 0xbe, 0x3f, 0x23, 0x41, 0xd2, 0xad, 0xf2, 0xf1, 0x6e, 0xd9, 0x45, 0x06, 0x5e, 0xd1,
+	0x00, 0x0d, 0x60, 0x3b, 0x1b, 0x66, 0x3f, 0x9c, 0xcd, 0x7d, 0xc2, 0x47, 0x55, 0xe3, 0xa3, 0xea,
+	0xd8, 0x05, 0x1c, 0x2f, 0x95, 0x46, 0x3d, 0x68, 0x78, 0x34, 0x9e, 0xa7, 0x09, 0xe9, 0xd6, 0x79,
+	0xc3, 0xa6, 0x3d, 0x10, 0x34, 0x56, 0x0c, 0xf4, 0x31, 0x6c, 0xc9, 0x9f, 0x98, 0xc4, 0xa1, 0x9f,
+	0xf2, 0xcf, 0x34, 0xe4, 0xe4, 0x07, 0x45, 0x0e, 0x5e, 0x14, 0x36, 0x7a, 0xd8, 0x73, 0x5d, 0x32,
+	0x4f, 0x9c, 0xc0, 0x25, 0xdd, 0x66, 0xbe, 0x87, 0x8c, 0x83, 0x17, 0x85, 0xd1, 0x5b, 0x50, 0x8f,
+	0xc8, 0x79, 0x1a, 0x78, 0xdd, 0x16, 0x6f, 0xd6, 0xb0, 0x31, 0x27, 0xb1, 0x84, 0xd1, 0x43, 0x80,
+	0x98, 0x4e, 0x03, 0x27, 0x49, 0x23, 0x12, 0x77, 0x81, 0xaf, 0x26, 0xd8, 0x63, 0x05, 0x61, 0x83,
+	0x8b, 0x76, 0xa0, 0x4e, 0xa2, 0x28, 0x8c, 0xe2, 0xee, 0xda, 0xbd, 0xca, 0x83, 0x16, 0x96, 0x54,
+	0xb6, 0x3b, 0xfb, 0x8e, 0xfb, 0x22, 0xe4, 0xaa, 0x40, 0x7c, 0xe2, 0xc4, 0xa4, 0xdb, 0xe6, 0x1f,
+	0xdd, 0xb2, 0x8b, 0x0c, 0xbc, 0xa2, 0x01, 0xfa, 0x36, 0x6c, 0x09, 0xce, 0xde, 0x7c, 0x1e, 0xd2,
+	0x20, 0xe1, 0x7b, 0xbc, 0xce, 0x7b, 0x69, 0xdb, 0x06, 0x86, 0x17, 0xc5, 0x7a, 0xc7, 0x80, 0xfa,
+	0x69, 0x14, 0x91, 0xc0, 0xbd, 0x1e, 0x90, 0x73, 0x1a, 0x50, 0xbe, 0x86, 0x08, 0xaa, 0xec, 0xdc,
+	0x74, 0x4b, 0xf7, 0x4a, 0x0f, 0x5a, 0x98, 0xff, 0x46, 0x3d, 0x68, 0x7b, 0xf4, 0x92, 0xc6, 0xf4,
+	0x8c, 0xfa, 0x34, 0xb9, 0xe6, 0x6a, 0xbc, 0x8e, 0x73, 0x58, 0xef, 0xff, 0x7a, 0xd0, 0x90, 0x5a,
+	0xcf, 0xfa, 0x88, 0xfd, 0x74, 0xaa, 0xfa, 0x60, 0xbf, 0xd1, 0x5b, 0xd0, 0x14, 0x43, 0x18, 0x0e,
+	0xe4, 0x31, 0xa8, 0xd8, 0xc3, 0x01, 0xd6, 0x20, 0xfa, 0x06, 0x34, 0x67, 0x24, 0x71, 0x3c, 0x27,
+	0x71, 0xa4, 0xca, 0x6f, 0xa9, 0x53, 0x65, 0x3f, 0x95, 0x0c, 0xac, 0x45, 0xd0, 0xdb, 0x50, 0xa5,
+	0x09, 0x99, 0x75, 0xab, 0x5c, 0x74, 0x5d, 0x8b, 0x0e, 0x13, 0x32, 0xc3, 0x9c, 0x85, 0xf6, 0x60,
+	0x33, 0xbe, 0xa0, 0xf3, 0x39, 0x0d, 0xa6, 0xa7, 0x73, 0x36, 0xb9, 0xb8, 0x5b, 0xe3, 0x1b, 0xf6,
+	0xba, 0x96, 0x1e, 0xe7, 0xf8, 0xb8, 0x28, 0x8f, 0x7a, 0x50, 0x4b, 0x9c, 0x2b, 0x12, 0x77, 0xeb,
+	0xbc, 0x61, 0x5b, 0x37, 0x9c, 0x38, 0x57, 0x58, 0xb0, 0xd0, 0xd7, 0xa1, 0xe1, 0x86, 0xe9, 0x9c,
+	0x75, 0xdf, 0xe0, 0x52, 0x9b, 0x5a, 0xaa, 0xcf, 0x71, 0xac, 0xf8, 0xe8, 0x4d, 0x80, 0x59, 0xe8,
+	0x91, 0xc8, 0x49, 0x98, 0x56, 0x34, 0xb9, 0x56, 0x18, 0x08, 0xb2, 0x01, 0x25, 0x24, 0x9a, 0xc5,
+	0x7b, 0x81, 0xd7, 0x0f, 0x03, 0x8f, 0x8a, 0x41, 0xb7, 0xf8, 0x32, 0x2e, 0xe1, 0xb0, 0x8d, 0x11,
+	0x7a, 0x39, 0x0a, 0x7d, 0xea, 0x5e, 0x77, 0x81, 0x4b, 0xe6, 0x30, 0x26, 0x93, 0x38, 0x57, 0xc3,
+	0xc0, 0xf5, 0xd3, 0x98, 0x5e, 0x92, 0xee, 0xda, 0xbd, 0xd2, 0x83, 0x26, 0xce, 0x61, 0x6c, 0x5c,
+	0x71, 0x12, 0x46, 0x64, 0xc2, 0xe7, 0xda, 0xe6, 0x12, 0x06, 0x82, 0xbe, 0x05, 0xed, 0x38, 0x3d,
+	0x8b, 0xdd, 0x88, 0xf2, 0x75, 0x91, 0x1a, 0xf6, 0x5a, 0xb6, 0x8c, 0x06, 0x13, 0xe7, 0x44, 0xd1,
+	0x63, 0x68, 0x9d, 0x29, 0xad, 0xed, 0x6e, 0xc8, 0xb3, 0xa8, 0xda, 0x65, 0xfa, 0x9c, 0x09, 0xb1,
+	0x16, 0x6e, 0x14, 0x7e, 0xe9, 0xf1, 0x63, 0xb8, 0x59, 0x68, 0xd1, 0x57, 0x1c, 0x9c, 0x09, 0xb1,
+	0xe1, 0x39, 0x97, 0x0e, 0xf5, 0x1d, 0xa9, 0x9f, 0x9d, 0xc2, 0xf0, 0xf6, 0x0c, 0x26, 0xce, 0x89,
+	0x5a, 0x7f, 0x52, 0x87, 0xa6, 0xd2, 0x2e, 0xd4, 0x85, 0xc6, 0x25, 0x89, 0x62, 0x36, 0xc3, 0x12,
+	0x57, 0x71, 0x45, 0xa2, 0x7d, 0x68, 0x2b, 0x2f, 0x33, 0xb9, 0x9e, 0x13, 0xae, 0xc1, 0x1b, 0xbb,
+	0x6f, 0x2e, 0x28, 0xa8, 0xdd, 0x37, 0xa4, 0x70, 0xae, 0x0d, 0x7a, 0x0c, 0xf5, 0xf3, 0x90, 0x59,
+	0x68, 0xae, 0xde, 0x1b, 0xbb, 0xdd, 0xc5, 0xd6, 0x87, 0x9c, 0x8f, 0xa5, 0x1c, 0xda, 0x85, 0x3a,
+	0xb9, 0x9a, 0xd3, 0xe8, 0x5a, 0x6a, 0xb9, 0x65, 0x0b, 0xb7, 0x65, 0x2b, 0xb7, 0x65, 0x4f, 0x94,
+	0xdb, 0xc2, 0x52, 0x92, 0xa9, 0x90, 0xc3, 0xed, 0x19, 0xf1, 0xe4, 0xe9, 0xa6, 0x44, 0xe8, 0x7d,
+	0x0b, 0x2f, 0xe1, 0xa0, 0x47, 0xb0, 0x39, 0x8f, 0xa8, 0x4b, 0x83, 0xa9, 0x04, 0xaf, 0xb9, 0x85,
+	0x6e, 0xed, 0x97, 0xbb, 0x25, 0x5c, 0x64, 0x21, 0x0b, 0x9a, 0xbe, 0x13, 0x4c, 0x53, 0x67, 0x4a,
+	0xb8, 0x69, 0x6e, 0x61, 0x4d, 0xb3, 0x2f, 0x93, 0x98, 0x6d, 0x0a, 0x1b, 0x54, 0x98, 0x26, 0x47,
+	0x61, 0xca, 0x95, 0x9c, 0x2d, 0xe4, 0x12, 0x0e, 0xba, 0x0f, 0xc8, 0x8d, 0xae, 0xe7, 0x49, 0xa8,
+	0x7a, 0xef, 0x33, 0xbb, 0x23, 0x94, 0xbd, 0xe9, 0x86, 0x34, 0xe0, 0xab, 0xf6, 0x48, 0x49, 0x0d,
+	0x4c, 0x0b, 0x04, 0xbc, 0xd7, 0x0e, 0x93, 0x32, 0x71, 0xf4, 0x00, 0xd6, 0xd9, 0x90, 0xc9, 0xd3,
+	0xd0, 0xa3, 0xe7, 0x94, 0x44, 0x5c, 0xdb, 0xcb, 0x7c, 0x2e, 0x79, 0x06, 0x3a, 0x84, 0xd7, 0xd5,
+	0x61, 0x3f, 0x8c, 0xc2, 0x59, 0x5f, 0x84, 0x0c, 0x7c, 0x08, 0x6d, 0xbe, 0x3d, 0x6d, 0xdb, 0xc0,
+	0xf0, 0x2a, 0x61, 0xf4, 0x01, 0xec, 0x98, 0xac, 0x51, 0x18, 0x27, 0x8e, 0xcf, 0xbb, 0x59, 0xe7,
+	0x33, 0x59, 0xc1, 0xed, 0x79, 0xd0, 0x36, 0x75, 0x05, 0x6d, 0xc1, 0xfa, 0xe8, 0xe8, 0xf3, 0xf1,
+	0xb0, 0xbf, 0x77, 0xfc, 0xfc, 0x93, 0xd3, 0xd3, 0x41, 0xe7, 0x0e, 0xea, 0x40, 0x7b, 0x30, 0xfc,
+	0x64, 0x38, 0x51, 0x48, 0x09, 0xad, 0x41, 0x63, 0x7c, 0x80, 0x3f, 0x1b, 0xf6, 0x0f, 0x3a, 0x65,
+	0xb4, 0x01, 0xd0, 0xc7, 0xa7, 0xdf, 0x1d, 0x3c, 0x3f, 0x7c, 0x76, 0x32, 0xe8, 0x54, 0x10, 0x82,
+	0x8d, 0x3e, 0xfe, 0x7c, 0x34, 0x39, 0xed, 0x3f, 0xc3, 0xf8, 0xe0, 0xa4, 0xff, 0x79, 0xa7, 0xda,
+	0x7b, 0x17, 0xea, 0x42, 0xa7, 0xd0, 0x26, 0xac, 0x1d, 0x0e, 0x7f, 0xfb, 0x60, 0xf0, 0x7c, 0x84,
+	0x59, 0x73, 0xde, 0xfb, 0xd3, 0x3d, 0xfc, 0xe4, 0x60, 0x22, 0x91, 0xb2, 0xf5, 0xb7, 0x4d, 0xa8,
+	0x32, 0xf3, 0x89, 0xb6, 0xa1, 0x96, 0xd0, 0xc4, 0x57, 0x4e, 0x40, 0x10, 0xe8, 0x1e, 0xac, 0x79,
+	0x24, 0xb3, 0x01, 0x65, 0xce, 0x33, 0x21, 0xf4, 0x0e, 0x6c, 0xcc, 0xa3, 0xd0, 0x25, 0x71, 0x4c,
+	0x83, 0x29, 0xdb, 0x6b, 0xae, 0xe9, 0x2d, 0x5c, 0x40, 0x51, 0x17, 0x6a, 0x7c, 0x33, 0xb8, 0x5a,
+	0x57, 0xf9, 0xee, 0x08, 0x80, 0x79, 0x8e, 0x20, 0x3e, 0xff, 0x92, 0x47, 0x17, 0x4d, 0xcc, 0x7f,
+	0x33, 0x2c, 0x71, 0xa6, 0xc2, 0x04, 0xb7, 0x30, 0xff, 0x8d, 0xde, 0x85, 0x3a, 0x9d, 0x39, 0x53,
+	0xa2, 0x4c, 0xee, 0xdd, 0x9c, 0xfd, 0xb7, 0x87, 0x8c, 0x87, 0xa5, 0x08, 0xb3, 0x6e, 0xae, 0x93,
+	0x90, 0x69, 0x18, 0x51, 0xa2, 0xad, 0x6e, 0x86, 0xb0, 0xe9, 0x4e, 0x23, 0x67, 0x26, 0x0c, 0x6d,
+	0x19, 0x0b, 0x02, 0xbd, 0x01, 0x2d, 0x57, 0x59, 0x5a, 0x69, 0x58, 0x33, 0x00, 0xd9, 0xd0, 0x08,
+	0xa5, 0x4f, 0x59, 0xe3, 0x23, 0xd8, 0xce, 0x8f, 0x40, 0x3a, 0x14, 0x25, 0x84, 0xbe, 0x06, 0xd5,
+	0xf8, 0x45, 0xca, 0x6c, 0x6b, 0x25, 0xe7, 0xd9, 0xb8, 0xf0, 0xf8, 0x45, 0x8a, 0x39, 0x1b, 0xdd,
+	0x2f, 0xea, 0xef, 0x3a, 0x1f, 0x52, 0x1e, 0x64, 0xa7, 0xf0, 0x8c, 0x4e, 0x47, 0x7c, 0x09, 0x37,
+	0xc4, 0x79, 0x51, 0x34, 0xfa, 0x96, 0xec, 0x41, 0x9f, 0x66, 0x61, 0x41, 0xef, 0xda, 0x8b, 0xbe,
+	0x1e, 0xe7, 0x25, 0xad, 0x7f, 0x2a, 0x41, 0x5d, 0x8c, 0x9b, 0xef, 0x83, 0x33, 0xd3, 0x51, 0x00,
+	0xfb, 0xfd, 0x12, 0xfb, 0xff, 0x21, 0x34, 0x2f, 0x9d, 0x88, 0x3a, 0x41, 0x12, 0x77, 0x2b, 0x7c,
+	0xa2, 0x6f, 0x2c, 0x5b, 0x15, 0xfb, 0x33, 0x21, 0x84, 0xb5, 0xb4, 0x75, 0x04, 0x0d, 0x09, 0x2e,
+	0xfd, 0xf4, 0xd7, 0xa1, 0xc6, 0xf7, 0x52, 0x46, 0x0e, 0x4b, 0x77, 0x5b, 0x48, 0x58, 0xff, 0x5a,
+	0x82, 0xca, 0xf8, 0x45, 0xca, 0xdc, 0x9e, 0xec, 0xbd, 0x1f, 0xce, 0xce, 0x42, 0x1e, 0xa8, 0xaf,
+	0xe3, 0x1c, 0xc6, 0xb6, 0x78, 0x1e, 0x85, 0x5e, 0xea, 0x26, 0x32, 0x28, 0x69, 0xe1, 0x0c, 0x40,
+	0xf7, 0xa0, 0x15, 0xa7, 0x91, 0x7b, 0xe1, 0x44, 0x53, 0xa1, 0xc8, 0x15, 0xae, 0xa9, 0x19, 0x88,
+	0xde, 0x84, 0xe6, 0x17, 0xa9, 0x13, 0x24, 0xcc, 0x22, 0x55, 0xb5, 0x80, 0xc6, 0xd8, 0x18, 0xce,
+	0xe8, 0x74, 0xac, 0x3b, 0xa9, 0x09, 0xf7, 0x6c, 0x62, 0x6c, 0x55, 0xcf, 0xe8, 0xf4, 0x53, 0xd5,
+	0x4d, 0x5d, 0xac, 0xaa, 0x01, 0x59, 0x7f, 0x51, 0x82, 0x1a, 0x9f, 0x22, 0xdb, 0xf7, 0x73, 0xea,
+	0x13, 0x63, 0x79, 0x34, 0xcd, 0x78, 0x61, 0x44, 0xa7, 0x34, 0x70, 0x7c, 0x39, 0x15, 0x4d, 0x33,
+	0x05, 0xf7, 0xf5, 0x2c, 0x5a, 0x58, 0x10, 0x2c, 0x3c, 0x9d, 0x11, 0x8f, 0xa6, 0x22, 0x86, 0x6a,
+	0x61, 0x49, 0x31, 0xe9, 0x78, 0xe6, 0xf8, 0xbe, 0x1c, 0xae, 0x20, 0xf8, 0x29, 0xa4, 0x81, 0x1a,
+	0x20, 0xff, 0x6d, 0xfd, 0xa8, 0x0e, 0x1b, 0xf9, 0x08, 0x6a, 0xe9, 0xee, 0x7d, 0x08, 0xd5, 0x24,
+	0x73, 0x9a, 0xf7, 0x57, 0x04, 0x5f, 0x9a, 0xe4, 0xae, 0x93, 0xb7, 0x40, 0xef, 0x40, 0x23, 0x22,
+	0x53, 0x7e, 0xca, 0x98, 0x3e, 0x15, 0x8d, 0xb2, 0x62, 0xa2, 0xef, 0x40, 0x33, 0x26, 0xd1, 0x25,
+	0x75, 0x89, 0x0a, 0xf1, 0xde, 0x5a, 0xf9, 0x15, 0x21, 0x87, 0x75, 0x03, 0xeb, 0xaf, 0xaa, 0xd0,
+	0x90, 0xe8, 0xd2, 0xe1, 0x6b, 0x6b, 0x55, 0x2e, 0x5a, 0xab, 0x47, 0xb0, 0x45, 0xe2, 0x84, 0xce,
+	0x9c, 0x84, 0x78, 0x03, 0xe2, 0xd3, 0x4b, 0x12, 0x5d, 0xcb, 0x35, 0x5e, 0x64, 0xa0, 0xf7, 0xe1,
+	0xae, 0xe3, 0x09, 0xf3, 0xe1, 0xf8, 0x4c, 0x71, 0x47, 0x05, 0x1b, 0xb8, 0x8c, 0x9d, 0x3b, 0xeb,
+	0xb5, 0xc2, 0x59, 0xff, 0x00, 0x76, 0xce, 0xe8, 0x74, 0x6f, 0x49, 0xa7, 0x62, 0x97, 0x56, 0x70,
+	0xd1, 0x31, 0xac, 0x7d, 0x49, 0xe8, 0xf4, 0x22, 0xc1, 0x4e, 0xa2, 0x4d, 0xe8, 0xc3, 0x5b, 0x56,
+	0xcc, 0xfe, 0xae, 0x6e, 0x82, 0xcd, 0xe6, 0x08, 0xc3, 0xba, 0xd2, 0x78, 0xd1, 0x5f, 0x93, 0xf7,
+	0xf7, 0xe8, 0xb6, 0xfe, 0x3e, 0x35, 0x1a, 0xe1, 0x7c, 0x17, 0xd6, 0x00, 0x20, 0xfb, 0x1c, 0x5b,
+	0x83, 0x99, 0x73, 0xf5, 0x09, 0xb7, 0xd1, 0x6c, 0x67, 0xaa, 0x58, 0xd3, 0xb9, 0xf5, 0x29, 0xe7,
+	0xd7, 0xc7, 0x3a, 0x86, 0xb6, 0xf9, 0x11, 0x76, 0xd6, 0x66, 0xce, 0x95, 0x82, 0x64, 0x57, 0x26,
+	0x74, 0x53, 0x6f, 0xbd, 0xf7, 0xa0, 0x6d, 0xaa, 0x28, 0x73, 0xa0, 0xc7, 0xa7, 0xcc, 0x5d, 0x8f,
+	0x86, 0xfd, 0x27, 0xcf, 0x46, 0x9d, 0x3b, 0x45, 0x1f, 0x5b, 0xb2, 0xfe, 0xa5, 0x0c, 0x95, 0x89,
+	0x73, 0xc5, 0x02, 0xcb, 0xc4, 0xb9, 0x62, 0xad, 0xa4, 0x66, 0x29, 0x12, 0x3d, 0x02, 0x48, 0x9c,
+	0x2b, 0x2c, 0x95, 0xbc, 0xbc, 0x44, 0xc9, 0x0d, 0x3e, 0x9b, 0x40, 0xe2, 0x5c, 0xa9, 0x51, 0x70,
+	0x55, 0x6b, 0x62, 0x13, 0x62, 0xbe, 0x6e, 0x4e, 0x22, 0x97, 0x04, 0x89, 0x33, 0x15, 0xba, 0x55,
+	0xc6, 0x06, 0x82, 0x1e, 0x42, 0x2d, 0x4a, 0x7d, 0x7d, 0x4c, 0xb6, 0xcd, 0x84, 0x86, 0xfd, 0xe1,
+	0xd4, 0x27, 0x58, 0x88, 0x58, 0x7f, 0x54, 0x82, 0x86, 0x84, 0xd8, 0x49, 0x94, 0x55, 0x15, 0x3e,
+	0x83, 0x85, 0x93, 0x28, 0x99, 0xdc, 0x78, 0x24, 0x4e, 0xa2, 0x56, 0x4f, 0x10, 0x7c, 0x54, 0x59,
+	0x60, 0x24, 0x4e, 0x88, 0x81, 0xdc, 0x36, 0x6a, 0x0b, 0x43, 0xdb, 0x4c, 0x31, 0x98, 0x61, 0xa5,
+	0x41, 0x42, 0xa2, 0x4b, 0xc7, 0x1f, 0x38, 0xd7, 0xb1, 0x8c, 0xd6, 0x73, 0x18, 0xcf, 0xb5, 0x9c,
+	0xab, 0x11, 0x89, 0x68, 0xe8, 0xc5, 0x32, 0x65, 0x35, 0x10, 0xeb, 0x07, 0x25, 0x68, 0xe9, 0xfc,
+	0x83, 0x6d, 0xfc, 0x3c, 0x22, 0xfc, 0x37, 0xef, 0xad, 0x89, 0x35, 0x8d, 0x0e, 0xa1, 0x43, 0xae,
+	0xe6, 0xc4, 0x4d, 0x88, 0xc7, 0xd6, 0x79, 0xa0, 0xa6, 0x77, 0x73, 0x40, 0xbe, 0xd0, 0x86, 0x9b,
+	0x61, 0x3a, 0xa3, 0x22, 0xfe, 0xaf, 0x62, 0x41, 0x58, 0xbf, 0x0b, 0x2d, 0x9d, 0xd4, 0x30, 0x45,
+	0x39, 0xa3, 0xd3, 0x4f, 0x42, 0xc7, 0x57, 0x8a, 0x22, 0x49, 0xf4, 0x01, 0x34, 0x3d, 0xe2, 0x78,
+	0x3e, 0x0d, 0x5e, 0xe6, 0xe3, 0x5a, 0xd6, 0xfa, 0x8f, 0x12, 0xb4, 0xcd, 0xfc, 0x87, 0x87, 0xf0,
+	0xa1, 0x2b, 0x4a, 0x4b, 0xd2, 0x89, 0x28, 0x1a, 0x3d, 0x86, 0x5a, 0xec, 0x87, 0x89, 0x50, 0x44,
+	0xf6, 0x85, 0x65, 0x19, 0x94, 0x3d, 0xf6, 0xc3, 0x04, 0x0b, 0x41, 0xeb, 0xcf, 0x4a, 0x50, 0x65,
+	0x34, 0xda, 0x80, 0x32, 0xf5, 0x64, 0x87, 0x65, 0xea, 0xf1, 0xae, 0x12, 0x27, 0x4a, 0x5e, 0x62,
+	0xb0, 0x42, 0x10, 0x3d, 0x80, 0x4d, 0x2f, 0x8d, 0xf8, 0x40, 0x9e, 0xd2, 0x20, 0x65, 0x96, 0xa4,
+	0xc2, 0x77, 0xad, 0x08, 0xb3, 0x29, 0xb8, 0xce, 0xdc, 0x71, 0x95, 0xdf, 0x5d, 0xc7, 0x9a, 0xb6,
+	0xfe, 0xbb, 0x04, 0x75, 0x91, 0x76, 0xaf, 0x08, 0x63, 0xb7, 0xa1, 0x7a, 0xe1, 0xc4, 0x17, 0x42,
+	0x41, 0x8f, 0xee, 0x60, 0x4e, 0xa1, 0xfb, 0xac, 0xc4, 0x11, 0x73, 0x2d, 0xce, 0x74, 0xf4, 0xe8,
+	0x0e, 0xce, 0xa1, 0xe8, 0x21, 0x6c, 0x4a, 0xad, 0x1c, 0x48, 0x98, 0xdb, 0xe4, 0xf2, 0x51, 0x09,
+	0x17, 0x19, 0xe8, 0xa1, 0x0c, 0xc4, 0xb4, 0x64, 0x5d, 0x19, 0xfa, 0xa3, 0x12, 0xce, 0xb3, 0xd0,
+	0x23, 0xe8, 0x28, 0x33, 0xa3, 0xc5, 0x79, 0x7a, 0x75, 0x54, 0xc2, 0x0b, 0x9c, 0xfd, 0xba, 0x28,
+	0xd1, 0xec, 0x03, 0x34, 0xd5, 0xe8, 0x7a, 0x3f, 0x5c, 0x87, 0x9a, 0xa8, 0x24, 0xde, 0x87, 0x75,
+	0x91, 0xff, 0xef, 0x79, 0x5e, 0x44, 0xe2, 0x58, 0xce, 0x3e, 0x0f, 0xb2, 0xe0, 0x46, 0x00, 0x87,
+	0xc4, 0x74, 0x6c, 0x19, 0x88, 0xde, 0x85, 0x66, 0x6c, 0x1a, 0x1a, 0x56, 0xd7, 0xe0, 0x5f, 0xd0,
+	0xf6, 0x1c, 0x6b, 0x01, 0xf4, 0x2b, 0xd0, 0xe0, 0x75, 0xbf, 0xe1, 0xa0, 0x5b, 0xcd, 0x8a, 0x3b,
+	0x0a, 0x43, 0x1f, 0x42, 0x4b, 0x17, 0x58, 0xbb, 0xb5, 0x5b, 0x15, 0x22, 0x13, 0x46, 0x6f, 0x43,
+	0x8d, 0x26, 0x64, 0xa6, 0x0a, 0x30, 0x6b, 0x72, 0x08, 0xbc, 0xca, 0x23, 0x38, 0xe8, 0x01, 0x34,
+	0xe6, 0xce, 0xf5, 0x8c, 0xc8, 0x35, 0x5b, 0xdb, 0xdd, 0x90, 0x42, 0x23, 0x81, 0x62, 0xc5, 0x66,
+	0x26, 0x81, 0x29, 0x52, 0x30, 0x7d, 0x42, 0xae, 0x85, 0x9b, 0x6a, 0x63, 0x03, 0x41, 0xbb, 0xb0,
+	0xed, 0xf8, 0x09, 0x89, 0x02, 0x27, 0x21, 0x2c, 0x39, 0x73, 0xdc, 0x64, 0x18, 0x9c, 0x87, 0x32,
+	0x27, 0x5d, 0xca, 0x33, 0x6b, 0x06, 0x90, 0xaf, 0x19, 0x88, 0xe8, 0x0f, 0xeb, 0x55, 0x5e, 0xd3,
+	0xd1, 0x9f, 0xc6, 0x58, 0xc6, 0x64, 0x56, 0x4b, 0x86, 0x03, 0x9e, 0x7c, 0xb6, 0x70, 0x01, 0xb5,
+	0x7e, 0x5c, 0x82, 0xa6, 0xb6, 0xf1, 0x3b, 0x50, 0x67, 0x0b, 0x3f, 0x09, 0xe5, 0xd6, 0x4a, 0x8a,
+	0x0d, 0xc5, 0x91, 0x7b, 0x2e, 0xac, 0xaf, 0x22, 0x79, 0x51, 0x8f, 0x1d, 0x96, 0x8a, 0x2c, 0xea,
+	0x31, 0x3b, 0xa0, 0x2d, 0x75, 0x75, 0xb5, 0xa5, 0xae, 0x2d, 0x58, 0x6a, 0xc3, 0x0f, 0xd4, 0x6f,
+	0xf2, 0x03, 0x3d, 0x68, 0xcb, 0x8f, 0x9f, 0x84, 0x22, 0xc6, 0xe0, 0x93, 0x37, 0x31, 0xeb, 0xfb,
+	0x55, 0x99, 0x6f, 0xde, 0x83, 0x35, 0x5f, 0x18, 0x9a, 0x23, 0x76, 0x32, 0xc5, 0xac, 0x4c, 0x28,
+	0x17, 0x69, 0x73, 0x53, 0x5e, 0x88, 0xb4, 0x1f, 0x65, 0xe9, 0x98, 0x48, 0x3c, 0x90, 0xa1, 0x28,
+	0x0b, 0xc9, 0xd8, 0x3e, 0x6c, 0xe4, 0x0b, 0x7d, 0xba, 0xbe, 0x62, 0x34, 0x2a, 0x94, 0x06, 0x0b,
+	0x2d, 0xd8, 0x92, 0xce, 0xc8, 0x2c, 0x94, 0x4b, 0xc4, 0x7f, 0xb3, 0x79, 0x88, 0x4a, 0x1f, 0x5b,
+	0x0b, 0x95, 0xb0, 0x9a, 0x10, 0xcf, 0x90, 0x85, 0x32, 0xaa, 0xd3, 0xd9, 0x90, 0x19, 0x72, 0x0e,
+	0x45, 0x3d, 0x00, 0x35, 0xb7, 0x0f, 0xde, 0xef, 0x36, 0xf5, 0xf9, 0x34, 0xd0, 0x62, 0xe6, 0xd0,
+	0x5a, 0xc8, 0x1c, 0x98, 0xa2, 0x9c, 0xd1, 0xe9, 0xc4, 0xb9, 0x92, 0xf9, 0xab, 0xa4, 0x18, 0xce,
+	0xac, 0xf7, 0x70, 0x20, 0x75, 0x52, 0x52, 0xd6, 0xee, 0x8d, 0xf9, 0xdf, 0x36, 0xd4, 0x2e, 0x1d,
+	0x3f, 0xd5, 0xae, 0x9d, 0x13, 0xd6, 0x47, 0x2f, 0x95, 0x02, 0x74, 0xa1, 0x21, 0xe3, 0x6d, 0xa5,
+	0x9a, 0x92, 0xb4, 0x7e, 0x5c, 0x81, 0x86, 0x3c, 0xa8, 0xe8, 0x1b, 0x2c, 0x23, 0x49, 0x2e, 0x42,
+	0x4f, 0xc6, 0x18, 0xaf, 0xe5, 0x0f, 0x32, 0xab, 0x93, 0x5d, 0x84, 0x1e, 0x96, 0x42, 0x2c, 0x7d,
+	0xd3, 0xb5, 0x53, 0x95, 0xbe, 0x69, 0x00, 0x59, 0x50, 0x77, 0x66, 0xdc, 0x92, 0x56, 0xf4, 0xf2,
+	0x49, 0x84, 0xb5, 0x74, 0x2f, 0x1c, 0x1a, 0xf0, 0x4a, 0xb7, 0xd0, 0xff, 0x0c, 0x30, 0xcf, 0x51,
+	0x2d, 0x7f, 0x8e, 0x78, 0xbd, 0xd5, 0x23, 0x64, 0x36, 0xe6, 0x67, 0x53, 0x86, 0xd9, 0x39, 0x8c,
+	0xc9, 0xe8, 0x41, 0x3c, 0x21, 0xd7, 0x7c, 0x83, 0xdb, 0x38, 0x87, 0xa1, 0x1d, 0x66, 0xc1, 0x69,
+	0xd0, 0x6d, 0xea, 0x4a, 0x1b, 0xa7, 0xd9, 0xb8, 0x58, 0xc8, 0x2e, 0x86, 0x2d, 0x36, 0x34, 0x03,
+	0xd0, 0x77, 0x60, 0x43, 0x8c, 0x5f, 0xe7, 0xf6, 0xb0, 0x3a, 0xb7, 0x2f, 0x88, 0xa2, 0xf7, 0x00,
+	0xb8, 0xcf, 0xf9, 0x34, 0x0d, 0x13, 0x61, 0x8b, 0x58, 0x19, 0x82, 0x5d, 0x5c, 0x10, 0x6f, 0xa4,
+	0x19, 0xd8, 0x10, 0xea, 0x7d, 0x08, 0x75, 0xb1, 0xe2, 0xe8, 0x2e, 0x6c, 0xee, 0x0d, 0x06, 0xf8,
+	0x60, 0x3c, 0x7e, 0x8e, 0x0f, 0x3e, 0x7d, 0x76, 0x30, 0x9e, 0x74, 0xee, 0x20, 0x80, 0xfa, 0x60,
+	0x88, 0x0f, 0xfa, 0x93, 0x4e, 0x09, 0xad, 0x43, 0xeb, 0xe9, 0xe9, 0xe0, 0x00, 0xef, 0x4d, 0x0e,
+	0x06, 0x9d, 0x72, 0xef, 0xa7, 0x15, 0xd8, 0x5a, 0xbc, 0x95, 0xea, 0x42, 0x83, 0x07, 0x54, 0xc3,
+	0x81, 0x0a, 0x6e, 0x24, 0x99, 0xf7, 0x0f, 0xe5, 0x57, 0xf1, 0x0f, 0x8b, 0x07, 0xaa, 0xb2, 0xf4,
+	0x40, 0x3d, 0x82, 0xcd, 0x88, 0x7c, 0x91, 0x92, 0x38, 0x21, 0x9e, 0x5c, 0xdf, 0x2c, 0xf1, 0x2a,
+	0xb2, 0xd0, 0x6f, 0x40, 0x47, 0xb8, 0x85, 0x71, 0x76, 0xd7, 0x23, 0x02, 0xe6, 0x8e, 0x8d, 0xf3,
+	0x0c, 0xbc, 0x20, 0xc9, 0x0a, 0xa1, 0xdc, 0xc8, 0xe7, 0x3f, 0x27, 0x74, 0x65, 0x09, 0x07, 0x3d,
+	0x85, 0xd7, 0x0b, 0x03, 0xd0, 0x1b, 0xdc, 0x58, 0xbd, 0xc1, 0xab, 0xda, 0x70, 0xbb, 0xa0, 0xe2,
+	0x5a, 0xe2, 0x71, 0x1d, 0x6b, 0x62, 0x13, 0x5a, 0x1a, 0xd0, 0xb6, 0x5e, 0x3d, 0xa0, 0xed, 0xfd,
+	0x71, 0x09, 0xd6, 0xc4, 0x55, 0x26, 0xf9, 0x1e, 0x71, 0x93, 0x9f, 0xcb, 0x06, 0xb3, 0xc2, 0x19,
+	0x9d, 0x2a, 0xb3, 0xbe, 0x65, 0xef, 0xd3, 0x84, 0x1d, 0x95, 0x6c, 0xfd, 0x39, 0xbb, 0xf7, 0x93,
+	0x0a, 0x6c, 0x16, 0x76, 0x06, 0x7d, 0x6c, 0xdc, 0x28, 0x95, 0xf8, 0x37, 0xef, 0x17, 0x77, 0xcf,
+	0x9e, 0x44, 0x4e, 0x10, 0x3b, 0x2e, 0x8f, 0x2e, 0x17, 0x2f, 0x99, 0xde, 0x80, 0x96, 0xbe, 0xcf,
+	0xe3, 0xc3, 0x6e, 0xe3, 0x0c, 0xb0, 0x7e, 0x5a, 0x86, 0xbb, 0x4b, 0xda, 0x1b, 0xee, 0x6c, 0x9c,
+	0xdd, 0x82, 0x99, 0x10, 0xeb, 0x57, 0x87, 0x1d, 0xaa, 0x5f, 0x0d, 0x2c, 0x58, 0x90, 0xca, 0x12,
+	0x0b, 0xd2, 0x83, 0xb6, 0xec, 0x70, 0xc2, 0x43, 0x5c, 0x61, 0xc4, 0x72, 0x18, 0x3a, 0x82, 0x56,
+	0x72, 0x91, 0xce, 0xce, 0x02, 0x87, 0xfa, 0x32, 0xea, 0x7a, 0xf8, 0x32, 0x0b, 0x20, 0x0b, 0x6a,
+	0x59, 0x63, 0xeb, 0x0f, 0x54, 0x05, 0x4a, 0x55, 0x81, 0x4a, 0x59, 0x15, 0x28, 0xab, 0x17, 0x95,
+	0xcd, 0x7a, 0x51, 0x56, 0x5d, 0xaa, 0x14, 0xab, 0x4b, 0xa2, 0x16, 0x55, 0x35, 0x6b, 0x51, 0x66,
+	0xf5, 0xaa, 0x96, 0xaf, 0x5e, 0xf5, 0x46, 0xd0, 0x29, 0x6e, 0x3a, 0x0b, 0x53, 0x68, 0x30, 0x4f,
+	0x93, 0x61, 0xe0, 0x91, 0x2b, 0x99, 0xfe, 0x19, 0xc8, 0xcd, 0x1b, 0xd7, 0x3b, 0x87, 0xce, 0xc2,
+	0x4d, 0xea, 0xcf, 0x41, 0x77, 0x7b, 0x7f, 0x5d, 0x86, 0x35, 0xe3, 0xc6, 0xf5, 0x86, 0x6f, 0x64,
+	0x1e, 0xb9, 0x6c, 0x7a, 0xe4, 0x2c, 0x8b, 0xaa, 0xfc, 0x0c, 0x59, 0x54, 0x75, 0x65, 0x16, 0xa5,
+	0x13, 0xc1, 0x5a, 0x21, 0x11, 0xbc, 0x07, 0x6b, 0x11, 0x89, 0xdd, 0x0b, 0xe2, 0xa5, 0x3e, 0xf1,
+	0xb8, 0xed, 0x6a, 0x62, 0x13, 0xe2, 0x5e, 0x9e, 0x79, 0x92, 0x86, 0xf4, 0xf2, 0x61, 0x42, 0xf2,
+	0x2b, 0xd5, 0x7c, 0x95, 0x95, 0xfa, 0x51, 0x13, 0x3a, 0x0b, 0x4f, 0x0f, 0xf4, 0x72, 0x79, 0xf9,
+	0xe5, 0xf2, 0xf4, 0x05, 0x73, 0xd9, 0xb8, 0x60, 0xce, 0x7d, 0xbc, 0xf2, 0x2a, 0x26, 0xe6, 0x04,
+	0x3a, 0xf3, 0x8b, 0xeb, 0x98, 0xba, 0x8e, 0xaf, 0xab, 0x78, 0xe2, 0x9d, 0x44, 0x6f, 0xe1, 0x9d,
+	0x84, 0x3d, 0x2a, 0x48, 0xe2, 0x85, 0xb6, 0xe8, 0x09, 0x6c, 0x7a, 0x74, 0x4a, 0x13, 0xa3, 0x3b,
+	0xe1, 0x3c, 0xde, 0x5e, 0xec, 0x6e, 0x90, 0x17, 0xc4, 0xc5, 0x96, 0xec, 0xd6, 0x70, 0xee, 0x5c,
+	0x87, 0x69, 0x22, 0x1f, 0x4e, 0x74, 0x97, 0x0c, 0x89, 0xf3, 0xb1, 0x94, 0x43, 0xdf, 0x86, 0xcd,
+	0x82, 0x4b, 0x92, 0x6e, 0x64, 0xd1, 0x77, 0x15, 0x05, 0xf5, 0xae, 0x36, 0x8d, 0x5d, 0xfd, 0x3d,
+	0xd8, 0x11, 0x37, 0x70, 0xae, 0x76, 0x42, 0x72, 0x56, 0x2d, 0x3e, 0xab, 0x07, 0x8b, 0x23, 0xea,
+	0x2f, 0x95, 0xc7, 0x2b, 0xfa, 0xb1, 0x26, 0xd0, 0x29, 0x2e, 0x2b, 0x8f, 0x18, 0x59, 0x5c, 0x29,
+	0xeb, 0x31, 0x2d, 0xac, 0x48, 0xe6, 0xf2, 0xd9, 0xb5, 0xd9, 0x0b, 0x1a, 0x4c, 0x4f, 0xd2, 0xd9,
+	0x19, 0x51, 0xb1, 0x5f, 0x01, 0xb5, 0xfe, 0xb4, 0x04, 0x9b, 0x85, 0xe5, 0x45, 0x1d, 0xa8, 0xa4,
+	0x91, 0xaa, 0xad, 0xb0, 0x9f, 0xbc, 0xf0, 0xe3, 0xc4, 0xf1, 0x97, 0x61, 0xe4, 0xa9, 0x8a, 0x9f,
+	0xa2, 0x99, 0x95, 0xf1, 0xa9, 0x4b, 0x82, 0x98, 0x28, 0x33, 0xdc, 0xc2, 0x06, 0xc2, 0x7a, 0x73,
+	0xa9, 0x27, 0x2d, 0x19, 0xfb, 0x99, 0xab, 0xd0, 0xd7, 0xf2, 0x15, 0x7a, 0xeb, 0x23, 0xd8, 0x59,
+	0xbe, 0x2e, 0x2c, 0x65, 0x4f, 0x32, 0x33, 0xac, 0xad, 0x43, 0x1e, 0xb4, 0xfe, 0xb7, 0x04, 0x75,
+	0xb1, 0xd5, 0xda, 0x29, 0x96, 0x6e, 0x74, 0x8a, 0xac, 0x5f, 0xa1, 0x13, 0x7b, 0xb9, 0xb4, 0x30,
+	0x0f, 0x22, 0x1b, 0x3a, 0x02, 0x38, 0x24, 0x64, 0x44, 0xa2, 0xfd, 0xeb, 0x84, 0x18, 0x21, 0xf3,
+	0x02, 0x0f, 0x3d, 0x86, 0xbb, 0xac, 0x24, 0x51, 0x6c, 0x22, 0x56, 0x61, 0x19, 0x0b, 0xed, 0xc1,
+	0x96, 0xee, 0x45, 0x87, 0x36, 0xb5, 0xd5, 0xa1, 0xcd, 0xa2, 0x74, 0xef, 0xef, 0x4b, 0xb0, 0x59,
+	0x7c, 0x54, 0xb4, 0xda, 0x3e, 0x7c, 0xf5, 0x70, 0x83, 0x85, 0xc9, 0xfc, 0xe3, 0xe3, 0x1b, 0x83,
+	0x0e, 0x43, 0x08, 0xbd, 0x0d, 0x0d, 0x71, 0x8c, 0x62, 0x69, 0x35, 0x1a, 0xf2, 0x9c, 0x61, 0x85,
+	0xf7, 0xfe, 0xa6, 0x04, 0x3b, 0x7c, 0xf4, 0x23, 0x7d, 0x11, 0x7a, 0xe8, 0x50, 0x9f, 0x9d, 0xb8,
+	0xd5, 0x3e, 0xe1, 0x08, 0xb6, 0x9d, 0x24, 0x21, 0x33, 0x76, 0x61, 0xff, 0x54, 0xbc, 0x5e, 0x33,
+	0xde, 0x1e, 0x6c, 0xdb, 0x12, 0xb3, 0x0d, 0x1e, 0x5e, 0xda, 0x02, 0xd9, 0xd0, 0x54, 0x2f, 0x11,
+	0xf4, 0x6b, 0xb2, 0x85, 0xc7, 0x6d, 0x58, 0xcb, 0xf4, 0xfe, 0xb0, 0x06, 0x75, 0x31, 0x05, 0xb4,
+	0xab, 0x4a, 0x26, 0x83, 0x2c, 0x8a, 0x42, 0x72, 0x7e, 0x36, 0xd6, 0x1c, 0x6c, 0x48, 0xdd, 0xec,
+	0x7c, 0xd1, 0xaf, 0xab, 0x37, 0x74, 0x98, 0xc4, 0xec, 0x51, 0x0c, 0xd1, 0xd5, 0x25, 0xb9, 0x6a,
+	0x12, 0xc6, 0x05, 0x31, 0xeb, 0x3f, 0x2b, 0x00, 0x38, 0xf7, 0x95, 0x2c, 0x86, 0x2a, 0x15, 0x63,
+	0xa8, 0x5b, 0x9f, 0x1b, 0xd9, 0xd0, 0x12, 0xbf, 0xc7, 0x54, 0xd5, 0xb7, 0x16, 0xed, 0x63, 0x26,
+	0x72, 0x5b, 0x85, 0x8b, 0x65, 0x6e, 0xec, 0xe7, 0x49, 0x76, 0xf2, 0x33, 0x80, 0x5f, 0x2b, 0x30,
+	0x82, 0x7d, 0xab, 0xce, 0x87, 0xaa, 0xe9, 0x5c, 0xb4, 0xc7, 0xf8, 0xc5, 0x7c, 0x91, 0xc9, 0x7c,
+	0x65, 0xc7, 0xca, 0xd5, 0xeb, 0x92, 0x44, 0x2c, 0x3c, 0x6b, 0x89, 0xf2, 0x94, 0x24, 0x19, 0xe7,
+	0x8b, 0xd4, 0x31, 0x5e, 0x53, 0x28, 0xb2, 0x78, 0xd1, 0xbb, 0xc6, 0xb9, 0x26, 0xc4, 0x0c, 0x8b,
+	0x27, 0x8d, 0xd7, 0x78, 0x4e, 0x88, 0xc7, 0xab, 0x56, 0xeb, 0x38, 0x0f, 0xb2, 0x50, 0xc4, 0x4d,
+	0xe3, 0x24, 0x9c, 0x91, 0x48, 0xde, 0xf9, 0xf0, 0xeb, 0xec, 0x75, 0x5c, 0x84, 0x59, 0xf8, 0x13,
+	0x91, 0x4b, 0x4a, 0xbe, 0x94, 0xd7, 0xd9, 0x92, 0xea, 0xfd, 0x5d, 0x19, 0x36, 0xf2, 0x5a, 0x81,
+	0x3e, 0x66, 0x29, 0xb8, 0xf8, 0x6d, 0xa8, 0xe4, 0x1b, 0x05, 0xe5, 0xb1, 0xb1, 0x21, 0x83, 0x73,
+	0x2d, 0x6e, 0x09, 0xea, 0xff, 0xb9, 0x04, 0x6d, 0xb3, 0x71, 0x56, 0x34, 0x34, 0x6a, 0x53, 0x06,
+	0x72, 0x4b, 0x2c, 0x6f, 0xea, 0x61, 0x65, 0x99, 0x1e, 0xe6, 0xb6, 0xb6, 0xfa, 0x2a, 0x5b, 0x6b,
+	0x41, 0x53, 0xcd, 0x4b, 0xf9, 0x1a, 0x45, 0xf7, 0x7e, 0x52, 0x82, 0x86, 0x7c, 0xf0, 0x98, 0xff,
+	0x42, 0xe9, 0x55, 0xbe, 0xb0, 0x0d, 0x35, 0xd7, 0x77, 0xe8, 0x4c, 0x45, 0xf6, 0x9c, 0x58, 0xf4,
+	0x2a, 0x95, 0x65, 0x5e, 0xe5, 0x57, 0xa1, 0x15, 0xa6, 0x09, 0x8f, 0x7d, 0x95, 0x5d, 0x6c, 0xd9,
+	0xa7, 0x12, 0xc1, 0x19, 0x8f, 0x65, 0xcb, 0x31, 0x89, 0xa8, 0xe3, 0xd3, 0xdf, 0x27, 0x9e, 0x32,
+	0x46, 0x7c, 0x42, 0x6d, 0xbc, 0x84, 0xd3, 0xfb, 0x7e, 0x1d, 0xb6, 0x16, 0x5e, 0x83, 0xfe, 0x0c,
+	0x93, 0x34, 0xbc, 0x48, 0x39, 0xef, 0x45, 0x58, 0x2d, 0x34, 0x0a, 0xe7, 0x61, 0x4c, 0xbc, 0x7d,
+	0xed, 0xfe, 0x33, 0x84, 0xf1, 0x23, 0x3d, 0x02, 0xe9, 0xff, 0x0c, 0x04, 0xbd, 0xa7, 0x43, 0x37,
+	0xe1, 0xeb, 0x7e, 0x69, 0xf1, 0x15, 0x6b, 0x31, 0x76, 0x7b, 0x0c, 0x77, 0xf5, 0xc1, 0xd7, 0xc6,
+	0x48, 0x54, 0x12, 0xdb, 0x78, 0x19, 0xcb, 0xfa, 0xaf, 0xca, 0xab, 0x46, 0x05, 0x6f, 0x43, 0x9d,
+	0x67, 0x4a, 0xea, 0x96, 0xc7, 0xd8, 0x16, 0xc9, 0x40, 0xfb, 0xb0, 0x26, 0x9e, 0xf1, 0xa6, 0xc9,
+	0x3c, 0x55, 0x3e, 0xe3, 0xde, 0xca, 0xe1, 0xdb, 0x42, 0x0e, 0x9b, 0x8d, 0xd0, 0x00, 0xda, 0xf2,
+	0x49, 0xb1, 0xe8, 0xa4, 0xfa, 0x92, 0x9d, 0xe4, 0x5a, 0xa1, 0xdf, 0x82, 0x4d, 0x3d, 0x6b, 0xd9,
+	0x51, 0xed, 0x25, 0x3b, 0x2a, 0x36, 0x64, 0xf5, 0x33, 0xb1, 0xcc, 0xb9, 0x97, 0x6e, 0xab, 0xea,
+	0x67, 0x79, 0x51, 0xeb, 0x87, 0xec, 0x71, 0x8c, 0xe8, 0xa7, 0x0b, 0x75, 0x61, 0x0a, 0x85, 0x35,
+	0x38, 0xba, 0x83, 0x25, 0x8d, 0xac, 0xac, 0x72, 0xa8, 0x2e, 0x90, 0x14, 0x60, 0xd4, 0x23, 0xcb,
+	0xcb, 0xea, 0x91, 0x59, 0xdd, 0xaf, 0x5a, 0xa8, 0xfb, 0xed, 0x6f, 0xc1, 0xa6, 0xe8, 0xff, 0x34,
+	0x92, 0xa7, 0xab, 0x47, 0xf5, 0x19, 0x30, 0x1e, 0x2f, 0x7f, 0xf5, 0x33, 0xc0, 0x2e, 0xd4, 0x7c,
+	0xa9, 0xe7, 0x32, 0x08, 0x56, 0x74, 0xef, 0x7b, 0xd0, 0x54, 0xfa, 0xc1, 0xd2, 0x83, 0x8b, 0xcc,
+	0x0a, 0xf2, 0xdf, 0xcc, 0x48, 0x50, 0x9e, 0x85, 0x8b, 0x2b, 0x56, 0x41, 0xb0, 0x47, 0x13, 0xa2,
+	0x58, 0x9c, 0x45, 0x92, 0x02, 0x90, 0x57, 0xec, 0x9f, 0x71, 0x66, 0x55, 0x5f, 0xb1, 0x73, 0xba,
+	0xf7, 0x3f, 0x65, 0xa8, 0x8b, 0xcb, 0x91, 0x5f, 0x60, 0x2d, 0x09, 0x1d, 0xc0, 0x96, 0xb8, 0x06,
+	0x33, 0x6a, 0x23, 0x52, 0x7d, 0x5f, 0x97, 0xef, 0xc1, 0xcd, 0xb2, 0x09, 0xbb, 0x06, 0xc2, 0x8b,
+	0x2d, 0x96, 0xdd, 0x10, 0x58, 0x7f, 0x5e, 0x82, 0xcd, 0x42, 0x53, 0x26, 0x97, 0x5c, 0xe9, 0xbb,
+	0x53, 0xfe, 0x3b, 0x5b, 0xbe, 0xf2, 0x4d, 0xcb, 0x57, 0xc9, 0x2f, 0x1f, 0x7b, 0xfb, 0xc5, 0x85,
+	0xb4, 0x7e, 0x57, 0x6f, 0x78, 0xfb, 0x95, 0x93, 0xec, 0xed, 0xc2, 0xce, 0x67, 0xfc, 0xdc, 0x1d,
+	0xd2, 0x40, 0x18, 0x5c, 0x55, 0x94, 0x5f, 0xb9, 0x11, 0xbd, 0x1f, 0x54, 0x00, 0xb2, 0xd2, 0x31,
+	0x1b, 0x99, 0x76, 0x75, 0x42, 0x52, 0xd3, 0x4b, 0x4a, 0xd7, 0xe5, 0x97, 0x2f, 0x5d, 0xe7, 0x4e,
+	0x47, 0xa5, 0x58, 0x15, 0x7f, 0xc0, 0x2a, 0xbb, 0xec, 0x36, 0x21, 0x3f, 0xed, 0x16, 0x2e, 0xc2,
+	0xe8, 0x1d, 0xa8, 0x45, 0xfc, 0x81, 0x8a, 0x2a, 0xe5, 0x66, 0x83, 0xb7, 0xf9, 0x23, 0x14, 0xc1,
+	0xce, 0x2b, 0x58, 0xfd, 0x55, 0x14, 0xec, 0x7d, 0x68, 0xf0, 0x67, 0xb8, 0xf2, 0xc2, 0xeb, 0xe6,
+	0x76, 0x4a, 0xd4, 0xfa, 0x08, 0xaa, 0xec, 0xf3, 0x2c, 0x12, 0x74, 0xcd, 0xa7, 0xb0, 0x62, 0x11,
+	0x73, 0x18, 0x53, 0x96, 0x48, 0xbd, 0x3f, 0x28, 0x61, 0xfe, 0xbb, 0x37, 0x86, 0x4e, 0xb1, 0x8e,
+	0xcf, 0xee, 0x4d, 0xbf, 0x60, 0x3f, 0xa4, 0x1d, 0x58, 0x33, 0xe6, 0x8a, 0x05, 0xe7, 0x96, 0x1a,
+	0xd9, 0xbf, 0x97, 0xe1, 0x35, 0xf3, 0xcd, 0xc5, 0xde, 0x34, 0x22, 0x84, 0x2b, 0xc4, 0xe2, 0x9d,
+	0x65, 0x69, 0xd9, 0x9d, 0x65, 0x4e, 0x1f, 0xca, 0x05, 0x7d, 0x30, 0xa2, 0xe9, 0xca, 0x92, 0x68,
+	0xba, 0x50, 0x41, 0xad, 0x2e, 0x56, 0x50, 0x8b, 0x2f, 0x40, 0x6a, 0x4b, 0x5e, 0x80, 0x74, 0xa1,
+	0x31, 0x97, 0xcf, 0x3f, 0xea, 0x9c, 0xad, 0xc8, 0xdc, 0x33, 0x9f, 0xc6, 0x6d, 0x0f, 0x28, 0x9b,
+	0x2f, 0xfb, 0x80, 0x32, 0xaf, 0x38, 0xad, 0x57, 0xa9, 0x7f, 0x7d, 0x01, 0xbf, 0x2c, 0xb6, 0x70,
+	0xf9, 0x92, 0xbf, 0x0f, 0x2d, 0x47, 0x11, 0x72, 0x47, 0x77, 0xec, 0xa5, 0xa2, 0x38, 0x13, 0xbc,
+	0x65, 0x83, 0x2f, 0x01, 0x99, 0x3d, 0xf4, 0x99, 0x0b, 0xf1, 0x5f, 0x7a, 0x73, 0xbf, 0x7a, 0x51,
+	0xf4, 0x1f, 0x4a, 0x50, 0x16, 0x15, 0xcf, 0x39, 0x31, 0xac, 0x8a, 0xa4, 0x18, 0x7e, 0xe1, 0x04,
+	0x9e, 0xaf, 0x2e, 0x0a, 0x25, 0x85, 0xbe, 0x06, 0x8d, 0x79, 0x7a, 0xf6, 0x82, 0x5d, 0xdc, 0x57,
+	0xa4, 0x4a, 0x0f, 0x07, 0xf6, 0x48, 0x40, 0x58, 0xf1, 0x58, 0x4c, 0x76, 0xa6, 0xad, 0x3a, 0x57,
+	0x9c, 0x36, 0x36, 0x10, 0xeb, 0x37, 0xa1, 0x21, 0xdb, 0x30, 0x25, 0xa0, 0x1e, 0xc9, 0x9e, 0x82,
+	0xb5, 0xb1, 0xa6, 0xc5, 0x3b, 0x1c, 0xde, 0x48, 0x2e, 0x9c, 0x22, 0x7b, 0x7f, 0x59, 0x86, 0x56,
+	0x56, 0x2f, 0x7b, 0xc4, 0xee, 0x35, 0x5d, 0xfd, 0x96, 0x66, 0x63, 0x17, 0x65, 0xff, 0x0b, 0x64,
+	0x8f, 0x05, 0x07, 0x2b, 0x11, 0xbe, 0xb8, 0x8a, 0xcb, 0x0a, 0x23, 0xb1, 0xec, 0xbc, 0x80, 0xf6,
+	0xfe, 0xb1, 0xc4, 0x5e, 0x24, 0x8a, 0x36, 0x6b, 0xd0, 0x38, 0x1e, 0x8e, 0x27, 0xc3, 0x93, 0x4f,
+	0x3a, 0x77, 0x50, 0x0b, 0x6a, 0xa7, 0x78, 0x70, 0x80, 0x3b, 0x25, 0xb4, 0x03, 0x88, 0xff, 0x7c,
+	0xde, 0x3f, 0x3d, 0x39, 0x1c, 0xe2, 0xa7, 0x7b, 0x93, 0xe1, 0xe9, 0x49, 0xa7, 0x8c, 0x5e, 0x83,
+	0x2d, 0x81, 0x1f, 0x3e, 0x3b, 0x3e, 0x1c, 0x1e, 0x1f, 0x3f, 0x3d, 0x38, 0x99, 0x74, 0x2a, 0x68,
+	0x1b, 0x3a, 0x4a, 0xfc, 0xe9, 0xe8, 0xf8, 0x80, 0x0b, 0x57, 0x59, 0xe7, 0x83, 0xe1, 0x78, 0xf4,
+	0x6c, 0x72, 0xd0, 0xa9, 0xb1, 0x1e, 0x25, 0xf1, 0x1c, 0x1f, 0x8c, 0x4f, 0x8f, 0x9f, 0x71, 0xa1,
+	0x3a, 0xbb, 0xe7, 0xc3, 0x07, 0xfc, 0x8d, 0x79, 0x83, 0xf5, 0xbe, 0xbf, 0xd7, 0x7f, 0x22, 0xba,
+	0xc2, 0x07, 0xc7, 0x07, 0x7b, 0xe3, 0x83, 0x4e, 0x93, 0x3d, 0x7c, 0xdb, 0x1b, 0x8d, 0x4e, 0x87,
+	0x27, 0x13, 0xfe, 0xb9, 0x56, 0x8f, 0xc0, 0xba, 0xd0, 0x67, 0xf5, 0x2f, 0x41, 0x3d, 0x68, 0xc8,
+	0xe3, 0x2b, 0xf5, 0x37, 0xfb, 0x97, 0x39, 0xc5, 0xd0, 0xd1, 0x45, 0xd9, 0x88, 0x2e, 0x72, 0x3a,
+	0x5c, 0x29, 0xe8, 0xf0, 0x7e, 0xf5, 0x77, 0xca, 0xf3, 0xb3, 0xb3, 0x3a, 0x57, 0xb8, 0x5f, 0xfb,
+	0xff, 0x01, 0x00, 0xa7, 0x86, 0xc1, 0xf4, 0x09, 0x38, 0x00, 0x00,
 }
@@ -47,6 +47,13 @@ const (
 	Message_BLOCK                    Message_MessageType = 19
 	Message_VENDOR_FINALIZED_PAYMENT Message_MessageType = 20
 	Message_ORDER_PAYMENT            Message_MessageType = 21
+	Message_PRICE_QUOTE_REQUEST      Message_MessageType = 22
+	Message_PRICE_QUOTE              Message_MessageType = 23
+	Message_SUBSCRIPTION             Message_MessageType = 24
+	Message_SUBSCRIPTION_CANCEL      Message_MessageType = 25
+	Message_BACKORDER_RELEASE        Message_MessageType = 26
+	Message_APPOINTMENT_CONFIRMATION Message_MessageType = 27
+	Message_APPOINTMENT_RESCHEDULE   Message_MessageType = 28
 	Message_ERROR                    Message_MessageType = 500
 	Message_ORDER_PROCESSING_FAILURE Message_MessageType = 501
 )
@@ -74,6 +81,13 @@ var Message_MessageType_name = map[int32]string{
 	19:  "BLOCK",
 	20:  "VENDOR_FINALIZED_PAYMENT",
 	21:  "ORDER_PAYMENT",
+	22:  "PRICE_QUOTE_REQUEST",
+	23:  "PRICE_QUOTE",
+	24:  "SUBSCRIPTION",
+	25:  "SUBSCRIPTION_CANCEL",
+	26:  "BACKORDER_RELEASE",
+	27:  "APPOINTMENT_CONFIRMATION",
+	28:  "APPOINTMENT_RESCHEDULE",
 	500: "ERROR",
 	501: "ORDER_PROCESSING_FAILURE",
 }
@@ -101,6 +115,13 @@ var Message_MessageType_value = map[string]int32{
 	"BLOCK":                    19,
 	"VENDOR_FINALIZED_PAYMENT": 20,
 	"ORDER_PAYMENT":            21,
+	"PRICE_QUOTE_REQUEST":      22,
+	"PRICE_QUOTE":              23,
+	"SUBSCRIPTION":             24,
+	"SUBSCRIPTION_CANCEL":      25,
+	"BACKORDER_RELEASE":        26,
+	"APPOINTMENT_CONFIRMATION": 27,
+	"APPOINTMENT_RESCHEDULE":   28,
 	"ERROR":                    500,
 	"ORDER_PROCESSING_FAILURE": 501,
 }
@@ -663,60 +684,65 @@ func init() {
 }
 
 var fileDescriptor_33c57e4bae7b9afd = []byte{
-	// 873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xae, 0x63, 0x67, 0x93, 0xbc, 0x64, 0x77, 0x67, 0x87, 0x6d, 0x65, 0x56, 0x6d, 0x89, 0x2c,
-	0x84, 0xc2, 0x25, 0x95, 0xb6, 0x12, 0xe2, 0xea, 0xb5, 0xc7, 0x8b, 0xa9, 0x63, 0x47, 0x13, 0xa7,
-	0x68, 0x7b, 0x89, 0x9c, 0x78, 0x9a, 0x9a, 0x26, 0xb6, 0xb1, 0x1d, 0x4a, 0xb8, 0x22, 0x7e, 0x11,
-	0xbf, 0x87, 0x23, 0xff, 0x00, 0xae, 0x08, 0xcd, 0x78, 0xdc, 0x6c, 0x8a, 0x54, 0xa9, 0xa7, 0xbc,
-	0xf7, 0xbd, 0x2f, 0xef, 0xcd, 0x7c, 0xef, 0xf3, 0xc0, 0xe9, 0x96, 0x95, 0x65, 0xb4, 0x66, 0xe3,
-	0xbc, 0xc8, 0xaa, 0xec, 0xea, 0xf3, 0x75, 0x96, 0xad, 0x37, 0xec, 0x99, 0xc8, 0x96, 0xbb, 0xd7,
-	0xcf, 0xa2, 0x74, 0x2f, 0x4b, 0x5f, 0x7c, 0x58, 0xaa, 0x92, 0x2d, 0x2b, 0xab, 0x68, 0x9b, 0xd7,
-	0x04, 0xe3, 0x2f, 0x0d, 0x3a, 0x93, 0xba, 0x1b, 0xfe, 0x06, 0xfa, 0xb2, 0x71, 0xb8, 0xcf, 0x99,
-	0xae, 0x0c, 0x95, 0xd1, 0xd9, 0xf5, 0xe5, 0x58, 0x96, 0x9b, 0x5f, 0x5e, 0xa3, 0xf7, 0x89, 0x78,
-	0x0c, 0x9d, 0x3c, 0xda, 0x6f, 0xb2, 0x28, 0xd6, 0x5b, 0x43, 0x65, 0xd4, 0xbf, 0xbe, 0x1c, 0xd7,
-	0x63, 0xc7, 0xcd, 0xd8, 0xb1, 0x99, 0xee, 0x69, 0x43, 0xc2, 0x8f, 0xa1, 0x57, 0xb0, 0x9f, 0x76,
-	0xac, 0xac, 0xdc, 0x58, 0x57, 0x87, 0xca, 0xa8, 0x4d, 0x0f, 0x00, 0x7e, 0x0a, 0x90, 0x94, 0x94,
-	0x95, 0x79, 0x96, 0x96, 0x4c, 0xd7, 0x86, 0xca, 0xa8, 0x4b, 0xef, 0x21, 0xc6, 0x1f, 0x2a, 0xf4,
-	0xef, 0x1d, 0x05, 0x77, 0x41, 0x9b, 0xba, 0xfe, 0x2d, 0x7a, 0xc0, 0x23, 0xeb, 0x3b, 0x33, 0x44,
-	0x0a, 0x06, 0x38, 0x71, 0x02, 0xcf, 0x0b, 0x7e, 0x40, 0x2d, 0x3c, 0x80, 0xee, 0xdc, 0x97, 0x99,
-	0x8a, 0x7b, 0xd0, 0x0e, 0xa8, 0x4d, 0x28, 0xd2, 0x30, 0x82, 0x81, 0x08, 0x17, 0x94, 0x7c, 0x4f,
-	0xac, 0x10, 0xb5, 0x0f, 0x88, 0x65, 0xfa, 0x16, 0xf1, 0xd0, 0x09, 0x7e, 0x04, 0x58, 0x22, 0x81,
-	0xef, 0xb8, 0x74, 0x62, 0x86, 0x6e, 0xe0, 0xa3, 0x0e, 0x7e, 0x08, 0x17, 0x35, 0xee, 0xcc, 0x3d,
-	0xc7, 0xf5, 0xbc, 0x09, 0xf1, 0x43, 0xd4, 0xc5, 0x97, 0x80, 0x1a, 0xfa, 0x64, 0xea, 0x11, 0x41,
-	0xee, 0xf1, 0xb6, 0xb6, 0x3b, 0x9b, 0xce, 0x43, 0xb2, 0x08, 0xa6, 0xc4, 0x47, 0x80, 0x31, 0x9c,
-	0x35, 0xc8, 0x7c, 0x6a, 0x9b, 0x21, 0x41, 0x7d, 0x7c, 0x01, 0xa7, 0x0d, 0x66, 0x79, 0xc1, 0x8c,
-	0xa0, 0x01, 0xbf, 0x06, 0x25, 0xce, 0xdc, 0xb7, 0xd1, 0x29, 0x3e, 0x87, 0x7e, 0xe0, 0x38, 0x9e,
-	0xeb, 0x93, 0x85, 0x69, 0xbd, 0x40, 0x67, 0x9c, 0xdf, 0x00, 0x94, 0x78, 0xe6, 0x1d, 0x3a, 0xe7,
-	0xd0, 0x24, 0xb0, 0x09, 0x35, 0xc3, 0x80, 0x2e, 0x4c, 0xdb, 0x46, 0x88, 0x9f, 0xe8, 0x00, 0x51,
-	0x32, 0x09, 0x5e, 0x12, 0x74, 0xc1, 0x55, 0x98, 0x85, 0x01, 0x25, 0x08, 0xf3, 0xf0, 0xc6, 0x0b,
-	0xac, 0x17, 0xe8, 0x33, 0xfc, 0x18, 0xf4, 0x97, 0xc4, 0xb7, 0x03, 0xba, 0x70, 0x5c, 0xdf, 0xf4,
-	0xdc, 0x57, 0xc4, 0x5e, 0x4c, 0xcd, 0x3b, 0x71, 0xb7, 0x4b, 0x31, 0x4f, 0xdc, 0xad, 0x81, 0x1e,
-	0x62, 0x80, 0x36, 0xa1, 0x34, 0xa0, 0xe8, 0x6f, 0x15, 0x3f, 0x01, 0x5d, 0x96, 0x69, 0x60, 0x91,
-	0xd9, 0xcc, 0xf5, 0x6f, 0x17, 0x8e, 0xe9, 0x7a, 0x73, 0x4a, 0xd0, 0x3f, 0xaa, 0x11, 0x43, 0x97,
-	0xa4, 0x3f, 0xb3, 0x4d, 0x96, 0x33, 0x6c, 0x40, 0x47, 0xda, 0x47, 0x78, 0xac, 0x7f, 0xdd, 0x6d,
-	0xbc, 0x45, 0x9b, 0x02, 0x7e, 0x04, 0x27, 0xf9, 0x6e, 0xf9, 0x96, 0xed, 0x85, 0xa5, 0x06, 0x54,
-	0x66, 0xdc, 0x3b, 0x65, 0xb2, 0x4e, 0xa3, 0x6a, 0x57, 0x30, 0xe1, 0x9d, 0x01, 0x3d, 0x00, 0xc6,
-	0x9f, 0x0a, 0x68, 0xd6, 0x9b, 0xa8, 0xe2, 0x34, 0xd9, 0xc9, 0x8d, 0xc5, 0x90, 0x1e, 0x3d, 0x00,
-	0x58, 0x87, 0x4e, 0xb9, 0x5b, 0xfe, 0xc8, 0x56, 0x95, 0xe8, 0xde, 0xa3, 0x4d, 0xca, 0x2b, 0xcd,
-	0xd1, 0xd4, 0xba, 0xd2, 0x1c, 0xe8, 0x5b, 0xe8, 0xbd, 0xff, 0x76, 0x84, 0x2b, 0xfb, 0xd7, 0x57,
-	0xff, 0xb3, 0x79, 0xd8, 0x30, 0xe8, 0x81, 0x8c, 0x9f, 0x82, 0xf6, 0x7a, 0x13, 0xad, 0xf5, 0xb6,
-	0xf8, 0x9e, 0x60, 0xcc, 0x0f, 0x38, 0x76, 0x36, 0xd1, 0x9a, 0x0a, 0xdc, 0xf8, 0x1a, 0x34, 0x9e,
-	0xe1, 0x3e, 0x74, 0x26, 0x64, 0x36, 0x33, 0x6f, 0x09, 0x7a, 0xc0, 0x57, 0x1f, 0xde, 0x09, 0x5f,
-	0x2b, 0xdc, 0xd7, 0x94, 0x98, 0x36, 0x6a, 0x19, 0xff, 0x2a, 0x00, 0xb3, 0x64, 0x9d, 0xb2, 0xd8,
-	0x8e, 0xaa, 0x08, 0x1b, 0x30, 0x28, 0x59, 0x1a, 0xb3, 0x62, 0x5a, 0x4b, 0xa5, 0x08, 0x3d, 0x8e,
-	0x30, 0xfc, 0x15, 0x9c, 0x95, 0xac, 0x48, 0xa2, 0x4d, 0xf2, 0x6b, 0xfd, 0x2f, 0x29, 0xe8, 0x07,
-	0xe8, 0xc7, 0x85, 0xbd, 0xfa, 0x5d, 0x81, 0x8e, 0x95, 0x6d, 0xb7, 0x51, 0x1a, 0x8b, 0xd5, 0x30,
-	0x56, 0xb8, 0xb6, 0x14, 0x56, 0x66, 0x78, 0x04, 0x5a, 0xc5, 0xdf, 0x8d, 0xd6, 0x47, 0xde, 0x0d,
-	0xc1, 0x38, 0xd6, 0x52, 0xfd, 0x04, 0x2d, 0x8d, 0x27, 0xd0, 0xb1, 0x92, 0xd8, 0x4b, 0xca, 0x0a,
-	0x63, 0xd0, 0x56, 0x49, 0x5c, 0xea, 0xca, 0x50, 0x1d, 0xf5, 0xa8, 0x88, 0x8d, 0xe7, 0xd0, 0xbe,
-	0xd9, 0x64, 0xab, 0xb7, 0x7c, 0x8f, 0x45, 0xf4, 0x4e, 0x5c, 0xb7, 0x16, 0xa5, 0x49, 0x31, 0x02,
-	0x75, 0x95, 0xc4, 0x72, 0xef, 0x3c, 0x34, 0xee, 0xa0, 0x4d, 0x8a, 0x22, 0x2b, 0x44, 0xc7, 0x2c,
-	0xae, 0x4d, 0x79, 0x4a, 0x45, 0xcc, 0x25, 0x66, 0xbc, 0x28, 0x2f, 0x21, 0xff, 0x77, 0x84, 0xf1,
-	0x61, 0x59, 0x11, 0x0b, 0x45, 0xa4, 0x69, 0x64, 0x6a, 0xfc, 0xa6, 0xc0, 0x79, 0xc0, 0xe3, 0x69,
-	0xb4, 0xdf, 0xb2, 0xb4, 0x0a, 0x7f, 0x49, 0xeb, 0x29, 0x49, 0x2a, 0xc5, 0x13, 0xf1, 0xfd, 0x0e,
-	0xad, 0xa3, 0x0e, 0xf8, 0x4b, 0x38, 0xad, 0x8a, 0x28, 0x2d, 0xa3, 0x55, 0x95, 0x64, 0xe9, 0xfb,
-	0x09, 0xc7, 0x20, 0x5f, 0xde, 0xbb, 0xa4, 0x7a, 0xe3, 0xa6, 0xf9, 0xae, 0x92, 0x4f, 0xe6, 0x01,
-	0xb8, 0xd1, 0x5e, 0xb5, 0xf2, 0xe5, 0xf2, 0x44, 0x28, 0xfb, 0xfc, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x0b, 0x9f, 0x9a, 0x3b, 0x3d, 0x06, 0x00, 0x00,
+	// 953 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x0e, 0x25, 0xca, 0x92, 0x46, 0xb2, 0xbd, 0xde, 0x38, 0x0e, 0xe3, 0xd7, 0xc9, 0x6b, 0x10,
+	0x45, 0xa1, 0x5e, 0x14, 0xc0, 0x01, 0x8a, 0x5e, 0x69, 0x72, 0xe9, 0xb0, 0xa6, 0x48, 0x66, 0x49,
+	0xa6, 0x70, 0x2e, 0x02, 0x2d, 0x6e, 0x14, 0x36, 0x12, 0xa9, 0x92, 0x54, 0x53, 0xf5, 0x5a, 0xf4,
+	0xd4, 0x5f, 0xd8, 0x43, 0xff, 0x45, 0x7b, 0x2d, 0x8a, 0x5d, 0x92, 0x91, 0x94, 0x02, 0x01, 0x7a,
+	0x9b, 0x79, 0x66, 0x76, 0x3e, 0x9e, 0x99, 0x59, 0x38, 0x5c, 0xb2, 0xa2, 0x88, 0xe6, 0x6c, 0xbc,
+	0xca, 0xb3, 0x32, 0x3b, 0x7f, 0x32, 0xcf, 0xb2, 0xf9, 0x82, 0x3d, 0x17, 0xda, 0xfd, 0xfa, 0xed,
+	0xf3, 0x28, 0xdd, 0xd4, 0xa6, 0xff, 0x7f, 0x6a, 0x2a, 0x93, 0x25, 0x2b, 0xca, 0x68, 0xb9, 0xaa,
+	0x1c, 0xd4, 0xdf, 0x0e, 0xa0, 0x3b, 0xa9, 0xa2, 0xe1, 0xaf, 0x61, 0x50, 0x07, 0x0e, 0x36, 0x2b,
+	0xa6, 0x48, 0x97, 0xd2, 0xe8, 0xe8, 0xea, 0x74, 0x5c, 0x9b, 0xc7, 0x93, 0xad, 0x8d, 0xee, 0x3a,
+	0xe2, 0x31, 0x74, 0x57, 0xd1, 0x66, 0x91, 0x45, 0xb1, 0xd2, 0xba, 0x94, 0x46, 0x83, 0xab, 0xd3,
+	0x71, 0x95, 0x76, 0xdc, 0xa4, 0x1d, 0x6b, 0xe9, 0x86, 0x36, 0x4e, 0xf8, 0x02, 0xfa, 0x39, 0xfb,
+	0x61, 0xcd, 0x8a, 0xd2, 0x8a, 0x95, 0xf6, 0xa5, 0x34, 0xea, 0xd0, 0x2d, 0x80, 0x9f, 0x01, 0x24,
+	0x05, 0x65, 0xc5, 0x2a, 0x4b, 0x0b, 0xa6, 0xc8, 0x97, 0xd2, 0xa8, 0x47, 0x77, 0x10, 0xf5, 0x77,
+	0x19, 0x06, 0x3b, 0xa5, 0xe0, 0x1e, 0xc8, 0x9e, 0xe5, 0xdc, 0xa0, 0x07, 0x5c, 0xd2, 0x5f, 0x6a,
+	0x01, 0x92, 0x30, 0xc0, 0x81, 0xe9, 0xda, 0xb6, 0xfb, 0x1d, 0x6a, 0xe1, 0x21, 0xf4, 0x42, 0xa7,
+	0xd6, 0xda, 0xb8, 0x0f, 0x1d, 0x97, 0x1a, 0x84, 0x22, 0x19, 0x23, 0x18, 0x0a, 0x71, 0x4a, 0xc9,
+	0xb7, 0x44, 0x0f, 0x50, 0x67, 0x8b, 0xe8, 0x9a, 0xa3, 0x13, 0x1b, 0x1d, 0xe0, 0x33, 0xc0, 0x35,
+	0xe2, 0x3a, 0xa6, 0x45, 0x27, 0x5a, 0x60, 0xb9, 0x0e, 0xea, 0xe2, 0x47, 0x70, 0x52, 0xe1, 0x66,
+	0x68, 0x9b, 0x96, 0x6d, 0x4f, 0x88, 0x13, 0xa0, 0x1e, 0x3e, 0x05, 0xd4, 0xb8, 0x4f, 0x3c, 0x9b,
+	0x08, 0xe7, 0x3e, 0x0f, 0x6b, 0x58, 0xbe, 0x17, 0x06, 0x64, 0xea, 0x7a, 0xc4, 0x41, 0x80, 0x31,
+	0x1c, 0x35, 0x48, 0xe8, 0x19, 0x5a, 0x40, 0xd0, 0x00, 0x9f, 0xc0, 0x61, 0x83, 0xe9, 0xb6, 0xeb,
+	0x13, 0x34, 0xe4, 0x6d, 0x50, 0x62, 0x86, 0x8e, 0x81, 0x0e, 0xf1, 0x31, 0x0c, 0x5c, 0xd3, 0xb4,
+	0x2d, 0x87, 0x4c, 0x35, 0xfd, 0x16, 0x1d, 0x71, 0xff, 0x06, 0xa0, 0xc4, 0xd6, 0xee, 0xd0, 0x31,
+	0x87, 0x26, 0xae, 0x41, 0xa8, 0x16, 0xb8, 0x74, 0xaa, 0x19, 0x06, 0x42, 0xbc, 0xa2, 0x2d, 0x44,
+	0xc9, 0xc4, 0x7d, 0x4d, 0xd0, 0x09, 0x67, 0xc1, 0x0f, 0x5c, 0x4a, 0x10, 0xe6, 0xe2, 0xb5, 0xed,
+	0xea, 0xb7, 0xe8, 0x21, 0xbe, 0x00, 0xe5, 0x35, 0x71, 0x0c, 0x97, 0x4e, 0x4d, 0xcb, 0xd1, 0x6c,
+	0xeb, 0x0d, 0x31, 0xa6, 0x9e, 0x76, 0x27, 0x7a, 0x3b, 0x15, 0xf9, 0x44, 0x6f, 0x0d, 0xf4, 0x08,
+	0x3f, 0x86, 0x87, 0x1e, 0xb5, 0x74, 0x32, 0x7d, 0x15, 0xba, 0x01, 0x2f, 0xe3, 0x55, 0x48, 0xfc,
+	0x00, 0x9d, 0xf1, 0x62, 0x77, 0x0c, 0xe8, 0x31, 0xa7, 0xc0, 0x0f, 0xaf, 0x7d, 0x9d, 0x5a, 0x9e,
+	0x20, 0x45, 0xe1, 0x6f, 0x77, 0x91, 0x86, 0xf2, 0x27, 0x9c, 0xda, 0x6b, 0x4d, 0xbf, 0x6d, 0x46,
+	0x63, 0x13, 0xcd, 0x27, 0xe8, 0x9c, 0x17, 0xa7, 0x79, 0x9e, 0x6b, 0x39, 0x01, 0x4f, 0xbe, 0x3f,
+	0x8f, 0xff, 0xe1, 0x73, 0x38, 0xdb, 0xb5, 0x52, 0xe2, 0xeb, 0x2f, 0x89, 0x11, 0xda, 0x04, 0x5d,
+	0x60, 0x80, 0x0e, 0xa1, 0xd4, 0xa5, 0xe8, 0xcf, 0x36, 0x7e, 0x0a, 0x4a, 0xdd, 0x04, 0x75, 0x75,
+	0xe2, 0xfb, 0x96, 0x73, 0x33, 0x35, 0x35, 0xcb, 0x0e, 0x29, 0x41, 0x7f, 0xb5, 0xd5, 0x18, 0x7a,
+	0x24, 0xfd, 0x91, 0x2d, 0xb2, 0x15, 0xc3, 0x2a, 0x74, 0xeb, 0x25, 0x17, 0x97, 0x30, 0xb8, 0xea,
+	0x35, 0x17, 0x40, 0x1b, 0x03, 0x3e, 0x83, 0x83, 0xd5, 0xfa, 0xfe, 0x3d, 0xdb, 0x88, 0xc5, 0x1f,
+	0xd2, 0x5a, 0xe3, 0x1b, 0x5e, 0x24, 0xf3, 0x34, 0x2a, 0xd7, 0x39, 0x13, 0x1b, 0x3e, 0xa4, 0x5b,
+	0x40, 0xfd, 0x43, 0x02, 0x59, 0x7f, 0x17, 0x95, 0xdc, 0xad, 0x8e, 0x64, 0xc5, 0x22, 0x49, 0x9f,
+	0x6e, 0x01, 0xac, 0x40, 0xb7, 0x58, 0xdf, 0x7f, 0xcf, 0x66, 0xa5, 0x88, 0xde, 0xa7, 0x8d, 0xca,
+	0x2d, 0x4d, 0x69, 0xed, 0xca, 0xd2, 0x14, 0xf4, 0x0d, 0xf4, 0x3f, 0x5e, 0xb8, 0xb8, 0x9d, 0xc1,
+	0xd5, 0xf9, 0xbf, 0x8e, 0x31, 0x68, 0x3c, 0xe8, 0xd6, 0x19, 0x3f, 0x03, 0xf9, 0xed, 0x22, 0x9a,
+	0x2b, 0x1d, 0x71, 0xf5, 0x30, 0xe6, 0x05, 0x8e, 0xcd, 0x45, 0x34, 0xa7, 0x02, 0x57, 0xbf, 0x02,
+	0x99, 0x6b, 0x78, 0x00, 0xdd, 0x09, 0xf1, 0x7d, 0xed, 0x86, 0xa0, 0x07, 0x7c, 0x41, 0x83, 0x3b,
+	0x71, 0x7d, 0x12, 0xbf, 0x3e, 0x4a, 0x34, 0x03, 0xb5, 0xd4, 0xbf, 0x25, 0x00, 0x3f, 0x99, 0xa7,
+	0x2c, 0x36, 0xa2, 0x32, 0xc2, 0x2a, 0x0c, 0x0b, 0x96, 0xc6, 0x2c, 0xf7, 0x2a, 0xaa, 0x24, 0xc1,
+	0xc7, 0x1e, 0x86, 0xbf, 0x84, 0xa3, 0x82, 0xe5, 0x49, 0xb4, 0x48, 0x7e, 0xae, 0x5e, 0xd5, 0x84,
+	0x7e, 0x82, 0x7e, 0x9e, 0xd8, 0xf3, 0x5f, 0x25, 0xe8, 0xea, 0xd9, 0x72, 0x19, 0xa5, 0xb1, 0x18,
+	0x0d, 0x63, 0xb9, 0x65, 0xd4, 0xc4, 0xd6, 0x1a, 0x1e, 0x81, 0x5c, 0xf2, 0xdf, 0xad, 0xf5, 0x99,
+	0xdf, 0x4d, 0x78, 0xec, 0x73, 0xd9, 0xfe, 0x0f, 0x5c, 0xaa, 0x4f, 0xa1, 0xab, 0x27, 0xb1, 0x9d,
+	0x14, 0x25, 0xc6, 0x20, 0xcf, 0x92, 0xb8, 0x50, 0xa4, 0xcb, 0xf6, 0xa8, 0x4f, 0x85, 0xac, 0xbe,
+	0x80, 0xce, 0xf5, 0x22, 0x9b, 0xbd, 0xe7, 0x73, 0xcc, 0xa3, 0x0f, 0xa2, 0xdd, 0x8a, 0x94, 0x46,
+	0xc5, 0x08, 0xda, 0xb3, 0x24, 0xae, 0xe7, 0xce, 0x45, 0xf5, 0x0e, 0x3a, 0x24, 0xcf, 0xb3, 0x5c,
+	0x44, 0xcc, 0xe2, 0x6a, 0x29, 0x0f, 0xa9, 0x90, 0x39, 0xc5, 0x8c, 0x1b, 0xeb, 0x26, 0xea, 0x77,
+	0x7b, 0x18, 0x4f, 0x96, 0xe5, 0xb1, 0x60, 0xa4, 0x5e, 0x9a, 0x5a, 0x55, 0x7f, 0x91, 0xe0, 0xd8,
+	0xe5, 0xb2, 0x17, 0x6d, 0x96, 0x2c, 0x2d, 0x83, 0x9f, 0xd2, 0x2a, 0x4b, 0x92, 0xd6, 0xe4, 0x09,
+	0x79, 0x37, 0x42, 0x6b, 0x2f, 0x02, 0xfe, 0x02, 0x0e, 0xcb, 0x3c, 0x4a, 0x8b, 0x68, 0x56, 0x26,
+	0x59, 0xfa, 0x31, 0xc3, 0x3e, 0xc8, 0x87, 0xf7, 0x21, 0x29, 0xdf, 0x59, 0xe9, 0x6a, 0x5d, 0xd6,
+	0x1f, 0xfb, 0x16, 0xb8, 0x96, 0xdf, 0xb4, 0x56, 0xf7, 0xf7, 0x07, 0x82, 0xd9, 0x17, 0xff, 0x0c,
+	0x00, 0xc9, 0xb9, 0x93, 0xf9, 0xe3, 0x06, 0x00, 0x00,
 }
@@ -465,15 +465,17 @@ func (m *Profile_Colors) GetHighlightText() string {
 }
 
 type Profile_Stats struct {
-	FollowerCount        uint32   `protobuf:"varint,1,opt,name=followerCount,proto3" json:"followerCount,omitempty"`
-	FollowingCount       uint32   `protobuf:"varint,2,opt,name=followingCount,proto3" json:"followingCount,omitempty"`
-	ListingCount         uint32   `protobuf:"varint,3,opt,name=listingCount,proto3" json:"listingCount,omitempty"`
-	RatingCount          uint32   `protobuf:"varint,4,opt,name=ratingCount,proto3" json:"ratingCount,omitempty"`
-	PostCount            uint32   `protobuf:"varint,5,opt,name=postCount,proto3" json:"postCount,omitempty"`
-	AverageRating        float32  `protobuf:"fixed32,6,opt,name=averageRating,proto3" json:"averageRating,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	FollowerCount        uint32                  `protobuf:"varint,1,opt,name=followerCount,proto3" json:"followerCount,omitempty"`
+	FollowingCount       uint32                  `protobuf:"varint,2,opt,name=followingCount,proto3" json:"followingCount,omitempty"`
+	ListingCount         uint32                  `protobuf:"varint,3,opt,name=listingCount,proto3" json:"listingCount,omitempty"`
+	RatingCount          uint32                  `protobuf:"varint,4,opt,name=ratingCount,proto3" json:"ratingCount,omitempty"`
+	PostCount            uint32                  `protobuf:"varint,5,opt,name=postCount,proto3" json:"postCount,omitempty"`
+	AverageRating        float32                 `protobuf:"fixed32,6,opt,name=averageRating,proto3" json:"averageRating,omitempty"`
+	RatingAverages       *Profile_RatingAverages `protobuf:"bytes,7,opt,name=ratingAverages,proto3" json:"ratingAverages,omitempty"`
+	RatingHistogram      []uint32                `protobuf:"varint,8,rep,packed,name=ratingHistogram,proto3" json:"ratingHistogram,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *Profile_Stats) Reset()         { *m = Profile_Stats{} }
@@ -543,6 +545,91 @@ func (m *Profile_Stats) GetAverageRating() float32 {
 	return 0
 }
 
+func (m *Profile_Stats) GetRatingAverages() *Profile_RatingAverages {
+	if m != nil {
+		return m.RatingAverages
+	}
+	return nil
+}
+
+func (m *Profile_Stats) GetRatingHistogram() []uint32 {
+	if m != nil {
+		return m.RatingHistogram
+	}
+	return nil
+}
+
+type Profile_RatingAverages struct {
+	Overall              float32  `protobuf:"fixed32,1,opt,name=overall,proto3" json:"overall,omitempty"`
+	Quality              float32  `protobuf:"fixed32,2,opt,name=quality,proto3" json:"quality,omitempty"`
+	Description          float32  `protobuf:"fixed32,3,opt,name=description,proto3" json:"description,omitempty"`
+	DeliverySpeed        float32  `protobuf:"fixed32,4,opt,name=deliverySpeed,proto3" json:"deliverySpeed,omitempty"`
+	CustomerService      float32  `protobuf:"fixed32,5,opt,name=customerService,proto3" json:"customerService,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Profile_RatingAverages) Reset()         { *m = Profile_RatingAverages{} }
+func (m *Profile_RatingAverages) String() string { return proto.CompactTextString(m) }
+func (*Profile_RatingAverages) ProtoMessage()    {}
+func (*Profile_RatingAverages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_744bf7a47b381504, []int{0, 5}
+}
+
+func (m *Profile_RatingAverages) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Profile_RatingAverages.Unmarshal(m, b)
+}
+func (m *Profile_RatingAverages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Profile_RatingAverages.Marshal(b, m, deterministic)
+}
+func (m *Profile_RatingAverages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Profile_RatingAverages.Merge(m, src)
+}
+func (m *Profile_RatingAverages) XXX_Size() int {
+	return xxx_messageInfo_Profile_RatingAverages.Size(m)
+}
+func (m *Profile_RatingAverages) XXX_DiscardUnknown() {
+	xxx_messageInfo_Profile_RatingAverages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Profile_RatingAverages proto.InternalMessageInfo
+
+func (m *Profile_RatingAverages) GetOverall() float32 {
+	if m != nil {
+		return m.Overall
+	}
+	return 0
+}
+
+func (m *Profile_RatingAverages) GetQuality() float32 {
+	if m != nil {
+		return m.Quality
+	}
+	return 0
+}
+
+func (m *Profile_RatingAverages) GetDescription() float32 {
+	if m != nil {
+		return m.Description
+	}
+	return 0
+}
+
+func (m *Profile_RatingAverages) GetDeliverySpeed() float32 {
+	if m != nil {
+		return m.DeliverySpeed
+	}
+	return 0
+}
+
+func (m *Profile_RatingAverages) GetCustomerService() float32 {
+	if m != nil {
+		return m.CustomerService
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Profile)(nil), "Profile")
 	proto.RegisterType((*Profile_Contact)(nil), "Profile.Contact")
@@ -550,6 +637,7 @@ func init() {
 	proto.RegisterType((*Profile_Image)(nil), "Profile.Image")
 	proto.RegisterType((*Profile_Colors)(nil), "Profile.Colors")
 	proto.RegisterType((*Profile_Stats)(nil), "Profile.Stats")
+	proto.RegisterType((*Profile_RatingAverages)(nil), "Profile.RatingAverages")
 }
 
 func init() {
@@ -557,50 +645,57 @@ func init() {
 }
 
 var fileDescriptor_744bf7a47b381504 = []byte{
-	// 716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xcf, 0x6e, 0xc3, 0x44,
-	0x10, 0xc6, 0xe5, 0xfc, 0x6d, 0x36, 0x49, 0x5b, 0x16, 0x54, 0xad, 0x2c, 0x04, 0x51, 0x55, 0x41,
-	0xc4, 0xc1, 0x45, 0xe1, 0x8e, 0x04, 0xed, 0x81, 0x1e, 0x8a, 0x2a, 0xb7, 0x5c, 0xb8, 0xad, 0xed,
-	0x89, 0xbd, 0xc2, 0xf6, 0x5a, 0xbb, 0xeb, 0x94, 0x88, 0x47, 0xe0, 0xc4, 0x8d, 0xb7, 0xe3, 0x55,
-	0xd0, 0xce, 0xae, 0x9d, 0xb8, 0x70, 0xf3, 0xf7, 0x9b, 0x6f, 0x36, 0xe3, 0xcd, 0x37, 0x26, 0xeb,
-	0x46, 0xc9, 0xbd, 0x28, 0x21, 0x6a, 0x94, 0x34, 0x32, 0xfc, 0x32, 0x97, 0x32, 0x2f, 0xe1, 0x1e,
-	0x55, 0xd2, 0xee, 0xef, 0x8d, 0xa8, 0x40, 0x1b, 0x5e, 0x35, 0xde, 0x70, 0x55, 0xc9, 0x0c, 0x14,
-	0x37, 0x52, 0x39, 0x70, 0xfb, 0xd7, 0x92, 0xcc, 0x5f, 0xdc, 0x19, 0xf4, 0x86, 0xcc, 0x1a, 0x00,
-	0xf5, 0xf4, 0xc8, 0x82, 0x4d, 0xb0, 0x5d, 0xc4, 0x5e, 0x59, 0x5e, 0xf0, 0x3a, 0x2b, 0x81, 0x8d,
-	0x1c, 0x77, 0x8a, 0x52, 0x32, 0xa9, 0x79, 0x05, 0x6c, 0x8c, 0x14, 0x9f, 0x69, 0x48, 0x2e, 0x4a,
-	0x99, 0x72, 0x23, 0x64, 0xcd, 0x26, 0xc8, 0x7b, 0x4d, 0x3f, 0x23, 0x53, 0x9e, 0xc8, 0xd6, 0xb0,
-	0x29, 0x16, 0x9c, 0xa0, 0xdf, 0x90, 0x6b, 0x5d, 0x48, 0x65, 0x1e, 0x41, 0xa7, 0x4a, 0x34, 0xd8,
-	0x39, 0x43, 0xc3, 0x7f, 0x38, 0xfe, 0xa2, 0xde, 0xbf, 0xb3, 0xf9, 0x26, 0xd8, 0x5e, 0xc4, 0xf8,
-	0x6c, 0xa7, 0x3b, 0x40, 0x9d, 0x49, 0xc5, 0x2e, 0x90, 0x7a, 0x45, 0x3f, 0x27, 0x8b, 0xfe, 0x65,
-	0xd9, 0x02, 0x4b, 0x27, 0x40, 0xbf, 0x25, 0xeb, 0x5e, 0x3c, 0xd5, 0x7b, 0xc9, 0xc8, 0x26, 0xd8,
-	0x2e, 0x77, 0x24, 0x7a, 0xee, 0x68, 0x3c, 0x34, 0xd0, 0x1d, 0x59, 0xa6, 0xb2, 0x36, 0x3c, 0x35,
-	0xe8, 0x5f, 0xa2, 0xff, 0x3a, 0xf2, 0x97, 0x17, 0x3d, 0xb8, 0x5a, 0x7c, 0x6e, 0xa2, 0x5f, 0x93,
-	0x59, 0x2a, 0x4b, 0xa9, 0x34, 0x5b, 0xa1, 0xfd, 0xea, 0xcc, 0x6e, 0x71, 0xec, 0xcb, 0x74, 0x47,
-	0x56, 0xfc, 0xc0, 0x0d, 0x57, 0x3f, 0x71, 0x5d, 0x80, 0x66, 0x6b, 0xb4, 0x5f, 0xf6, 0xf6, 0xa7,
-	0x8a, 0xe7, 0x10, 0x0f, 0x3c, 0xb6, 0xa7, 0x00, 0x9e, 0x41, 0xd7, 0x73, 0xf9, 0xff, 0x3d, 0xe7,
-	0x1e, 0x7a, 0x47, 0xa6, 0xda, 0x70, 0xa3, 0xd9, 0xd5, 0x07, 0xf3, 0xab, 0xa5, 0xb1, 0x2b, 0xd2,
-	0x3b, 0xb2, 0x4e, 0x84, 0x49, 0xa5, 0xa8, 0x5f, 0xda, 0xe4, 0x37, 0x38, 0xb2, 0x6b, 0xfc, 0x3f,
-	0x86, 0x90, 0x7e, 0x4f, 0x56, 0x25, 0xd7, 0xe6, 0x59, 0x66, 0x62, 0x2f, 0x20, 0x63, 0x9f, 0xe0,
-	0x91, 0x61, 0xe4, 0x32, 0x18, 0x75, 0x19, 0x8c, 0xde, 0xba, 0x0c, 0xc6, 0x03, 0x3f, 0xfd, 0x82,
-	0x90, 0xb4, 0x55, 0x0a, 0xea, 0x54, 0x80, 0x66, 0x74, 0x33, 0xde, 0x2e, 0xe2, 0x33, 0x42, 0x19,
-	0x99, 0x1f, 0x40, 0x69, 0x9b, 0x87, 0x4f, 0x37, 0xc1, 0x76, 0x1d, 0x77, 0x32, 0xfc, 0x33, 0x20,
-	0x73, 0x7f, 0xdf, 0xd6, 0xf5, 0x0e, 0x89, 0x16, 0x06, 0x7c, 0x6a, 0x3b, 0x69, 0xe3, 0x06, 0x15,
-	0x17, 0xa5, 0x4f, 0xad, 0x13, 0x74, 0x43, 0x96, 0x4d, 0x21, 0x6b, 0xf8, 0xb9, 0xad, 0x12, 0x50,
-	0x3e, 0xbb, 0xe7, 0x88, 0x46, 0x64, 0xa6, 0x65, 0x2a, 0x78, 0xc9, 0x26, 0x9b, 0xf1, 0x76, 0xb9,
-	0xbb, 0x39, 0x5d, 0x12, 0xe2, 0x1f, 0xd2, 0x54, 0xb6, 0xb5, 0x89, 0xbd, 0x2b, 0xfc, 0x85, 0xac,
-	0x07, 0x05, 0x9b, 0x52, 0x73, 0x6c, 0xba, 0x79, 0xf0, 0xd9, 0xee, 0x45, 0xab, 0x41, 0xe1, 0xbe,
-	0xb8, 0x79, 0x7a, 0x6d, 0x07, 0x6d, 0x94, 0x94, 0x7b, 0x3f, 0x8c, 0x13, 0xe1, 0x1f, 0x64, 0x8a,
-	0xff, 0x20, 0x1e, 0x27, 0xea, 0x63, 0x7f, 0x9c, 0xa8, 0x8f, 0xb6, 0x45, 0x57, 0xbc, 0xec, 0xdf,
-	0x0d, 0x85, 0x5d, 0x85, 0x0a, 0x32, 0xd1, 0x56, 0xfe, 0x24, 0xaf, 0xac, 0xbb, 0xe4, 0x2a, 0x07,
-	0xbf, 0x91, 0x4e, 0xd8, 0x91, 0xa4, 0x12, 0xb9, 0xa8, 0x79, 0xe9, 0x37, 0xb2, 0xd7, 0xe1, 0xdf,
-	0x01, 0x99, 0xb9, 0x88, 0xda, 0x0b, 0x6e, 0x94, 0xa8, 0xb8, 0xea, 0x26, 0xe8, 0xa4, 0xdd, 0x30,
-	0x0d, 0xa9, 0xac, 0x33, 0x5b, 0x73, 0x83, 0x9c, 0x00, 0x8e, 0x0d, 0xbf, 0x9b, 0xee, 0xeb, 0x60,
-	0x9f, 0x6d, 0x47, 0x21, 0xf2, 0xa2, 0x14, 0x79, 0x61, 0xfc, 0x30, 0x27, 0x60, 0x63, 0xd7, 0x8b,
-	0x37, 0xdb, 0xea, 0xa6, 0x1a, 0xc2, 0xf0, 0x9f, 0x80, 0x4c, 0x5f, 0xbb, 0x98, 0xee, 0x65, 0x59,
-	0xca, 0x77, 0x50, 0x0f, 0xf6, 0xe2, 0x71, 0xbe, 0x75, 0x3c, 0x84, 0xf4, 0x2b, 0x72, 0xe9, 0x80,
-	0xa8, 0x73, 0x67, 0x1b, 0xa1, 0xed, 0x03, 0xa5, 0xb7, 0x64, 0x55, 0x0a, 0x6d, 0x7a, 0xd7, 0x18,
-	0x5d, 0x03, 0x66, 0xc3, 0xa3, 0xf8, 0xc9, 0x32, 0x41, 0xcb, 0x39, 0xb2, 0x6f, 0xd8, 0x48, 0x6d,
-	0x5c, 0x7d, 0x8a, 0xf5, 0x13, 0xb0, 0x13, 0xf3, 0x03, 0x28, 0xbb, 0x97, 0xd8, 0x83, 0x1f, 0xba,
-	0x51, 0x3c, 0x84, 0x3f, 0x4e, 0x7e, 0x1d, 0x35, 0x49, 0x32, 0xc3, 0x05, 0xfa, 0xee, 0xdf, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x5e, 0x37, 0x9d, 0x98, 0xe3, 0x05, 0x00, 0x00,
+	// 828 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x94, 0xdf, 0x8e, 0xe3, 0x34,
+	0x14, 0xc6, 0xd5, 0xf4, 0xcf, 0xcc, 0xb8, 0x93, 0xce, 0x60, 0xd0, 0x62, 0x45, 0x08, 0xaa, 0xd5,
+	0x0a, 0x2a, 0x2e, 0xba, 0xa8, 0xdc, 0x83, 0x96, 0xdd, 0x8b, 0x9d, 0x8b, 0x45, 0x2b, 0xcf, 0x72,
+	0xc3, 0x9d, 0x9b, 0x9c, 0xa6, 0x16, 0x4e, 0x1c, 0x6c, 0xa7, 0x43, 0xc5, 0x23, 0xf0, 0x02, 0xbc,
+	0x08, 0x3c, 0x04, 0x4f, 0x85, 0x7c, 0xec, 0xa4, 0x4d, 0xe1, 0xce, 0xdf, 0xef, 0x7c, 0xc7, 0x39,
+	0x39, 0x3e, 0x36, 0x49, 0x1b, 0xa3, 0x77, 0x52, 0xc1, 0xba, 0x31, 0xda, 0xe9, 0xec, 0x8b, 0x52,
+	0xeb, 0x52, 0xc1, 0x4b, 0x54, 0xdb, 0x76, 0xf7, 0xd2, 0xc9, 0x0a, 0xac, 0x13, 0x55, 0x13, 0x0d,
+	0x77, 0x95, 0x2e, 0xc0, 0x08, 0xa7, 0x4d, 0x00, 0xcf, 0xff, 0x4a, 0xc9, 0xd5, 0xfb, 0xb0, 0x07,
+	0x7d, 0x46, 0x66, 0x0d, 0x80, 0x79, 0x78, 0xc3, 0x46, 0xcb, 0xd1, 0xea, 0x86, 0x47, 0xe5, 0xf9,
+	0x5e, 0xd4, 0x85, 0x02, 0x96, 0x04, 0x1e, 0x14, 0xa5, 0x64, 0x52, 0x8b, 0x0a, 0xd8, 0x18, 0x29,
+	0xae, 0x69, 0x46, 0xae, 0x95, 0xce, 0x85, 0x93, 0xba, 0x66, 0x13, 0xe4, 0xbd, 0xa6, 0x9f, 0x90,
+	0xa9, 0xd8, 0xea, 0xd6, 0xb1, 0x29, 0x06, 0x82, 0xa0, 0x5f, 0x93, 0x7b, 0xbb, 0xd7, 0xc6, 0xbd,
+	0x01, 0x9b, 0x1b, 0xd9, 0x60, 0xe6, 0x0c, 0x0d, 0xff, 0xe1, 0xf8, 0x45, 0xbb, 0x7b, 0x62, 0x57,
+	0xcb, 0xd1, 0xea, 0x9a, 0xe3, 0xda, 0x57, 0x77, 0x80, 0xba, 0xd0, 0x86, 0x5d, 0x23, 0x8d, 0x8a,
+	0x7e, 0x46, 0x6e, 0xfa, 0x9f, 0x65, 0x37, 0x18, 0x3a, 0x01, 0xfa, 0x0d, 0x49, 0x7b, 0xf1, 0x50,
+	0xef, 0x34, 0x23, 0xcb, 0xd1, 0x6a, 0xbe, 0x21, 0xeb, 0x77, 0x1d, 0xe5, 0x43, 0x03, 0xdd, 0x90,
+	0x79, 0xae, 0x6b, 0x27, 0x72, 0x87, 0xfe, 0x39, 0xfa, 0xef, 0xd7, 0xb1, 0x79, 0xeb, 0xd7, 0x21,
+	0xc6, 0xcf, 0x4d, 0xf4, 0x2b, 0x32, 0xcb, 0xb5, 0xd2, 0xc6, 0xb2, 0x5b, 0xb4, 0xdf, 0x9d, 0xd9,
+	0x3d, 0xe6, 0x31, 0x4c, 0x37, 0xe4, 0x56, 0x1c, 0x84, 0x13, 0xe6, 0xad, 0xb0, 0x7b, 0xb0, 0x2c,
+	0x45, 0xfb, 0xa2, 0xb7, 0x3f, 0x54, 0xa2, 0x04, 0x3e, 0xf0, 0xf8, 0x9c, 0x3d, 0x88, 0x02, 0xba,
+	0x9c, 0xc5, 0xff, 0xe7, 0x9c, 0x7b, 0xe8, 0x0b, 0x32, 0xb5, 0x4e, 0x38, 0xcb, 0xee, 0x2e, 0xcc,
+	0x8f, 0x9e, 0xf2, 0x10, 0xa4, 0x2f, 0x48, 0xba, 0x95, 0x2e, 0xd7, 0xb2, 0x7e, 0xdf, 0x6e, 0x7f,
+	0x81, 0x23, 0xbb, 0xc7, 0xf3, 0x18, 0x42, 0xfa, 0x1d, 0xb9, 0x55, 0xc2, 0xba, 0x77, 0xba, 0x90,
+	0x3b, 0x09, 0x05, 0xfb, 0x08, 0xb7, 0xcc, 0xd6, 0x61, 0x06, 0xd7, 0xdd, 0x0c, 0xae, 0x3f, 0x74,
+	0x33, 0xc8, 0x07, 0x7e, 0xfa, 0x39, 0x21, 0x79, 0x6b, 0x0c, 0xd4, 0xb9, 0x04, 0xcb, 0xe8, 0x72,
+	0xbc, 0xba, 0xe1, 0x67, 0x84, 0x32, 0x72, 0x75, 0x00, 0x63, 0xfd, 0x3c, 0x7c, 0xbc, 0x1c, 0xad,
+	0x52, 0xde, 0xc9, 0xec, 0x8f, 0x11, 0xb9, 0x8a, 0xfd, 0xf6, 0xae, 0x27, 0xd8, 0x5a, 0xe9, 0x20,
+	0x4e, 0x6d, 0x27, 0xfd, 0xb8, 0x41, 0x25, 0xa4, 0x8a, 0x53, 0x1b, 0x04, 0x5d, 0x92, 0x79, 0xb3,
+	0xd7, 0x35, 0xfc, 0xd8, 0x56, 0x5b, 0x30, 0x71, 0x76, 0xcf, 0x11, 0x5d, 0x93, 0x99, 0xd5, 0xb9,
+	0x14, 0x8a, 0x4d, 0x96, 0xe3, 0xd5, 0x7c, 0xf3, 0xec, 0xd4, 0x24, 0xc4, 0xaf, 0xf2, 0x5c, 0xb7,
+	0xb5, 0xe3, 0xd1, 0x95, 0xfd, 0x44, 0xd2, 0x41, 0xc0, 0x4f, 0xa9, 0x3b, 0x36, 0x5d, 0x3d, 0xb8,
+	0xf6, 0xf7, 0xa2, 0xb5, 0x60, 0xf0, 0xbe, 0x84, 0x7a, 0x7a, 0xed, 0x0b, 0x6d, 0x8c, 0xd6, 0xbb,
+	0x58, 0x4c, 0x10, 0xd9, 0xef, 0x64, 0x8a, 0x27, 0x88, 0xdb, 0xc9, 0xfa, 0xd8, 0x6f, 0x27, 0xeb,
+	0xa3, 0x4f, 0xb1, 0x95, 0x50, 0xfd, 0xbf, 0xa1, 0xf0, 0x57, 0xa1, 0x82, 0x42, 0xb6, 0x55, 0xdc,
+	0x29, 0x2a, 0xef, 0x56, 0xc2, 0x94, 0x10, 0x6f, 0x64, 0x10, 0xbe, 0x24, 0x6d, 0x64, 0x29, 0x6b,
+	0xa1, 0xe2, 0x8d, 0xec, 0x75, 0xf6, 0xe7, 0x88, 0xcc, 0xc2, 0x88, 0xfa, 0x06, 0x37, 0x46, 0x56,
+	0xc2, 0x74, 0x15, 0x74, 0xd2, 0xdf, 0x30, 0x0b, 0xb9, 0xae, 0x0b, 0x1f, 0x0b, 0x85, 0x9c, 0x00,
+	0x96, 0x0d, 0xbf, 0xb9, 0xee, 0x75, 0xf0, 0x6b, 0x9f, 0xb1, 0x97, 0xe5, 0x5e, 0xc9, 0x72, 0xef,
+	0x62, 0x31, 0x27, 0xe0, 0xc7, 0xae, 0x17, 0x1f, 0x7c, 0x6a, 0xa8, 0x6a, 0x08, 0xb3, 0x7f, 0x12,
+	0x32, 0x7d, 0xec, 0xc6, 0x74, 0xa7, 0x95, 0xd2, 0x4f, 0x60, 0x5e, 0xfb, 0xc6, 0x63, 0x7d, 0x29,
+	0x1f, 0x42, 0xfa, 0x25, 0x59, 0x04, 0x20, 0xeb, 0x32, 0xd8, 0x12, 0xb4, 0x5d, 0x50, 0xfa, 0x9c,
+	0xdc, 0x2a, 0x69, 0x5d, 0xef, 0x1a, 0xa3, 0x6b, 0xc0, 0xfc, 0xf0, 0x18, 0x71, 0xb2, 0x4c, 0xd0,
+	0x72, 0x8e, 0xfc, 0x1f, 0x36, 0xda, 0xba, 0x10, 0x9f, 0x62, 0xfc, 0x04, 0x7c, 0xc5, 0xe2, 0x00,
+	0xc6, 0xdf, 0x4b, 0xcc, 0xc1, 0x87, 0x2e, 0xe1, 0x43, 0x48, 0xbf, 0x27, 0x8b, 0xb0, 0xe5, 0xab,
+	0x80, 0x2d, 0xbe, 0x77, 0xf3, 0xcd, 0xa7, 0xfd, 0x20, 0xf2, 0x41, 0x98, 0x5f, 0xd8, 0xe9, 0x8a,
+	0xdc, 0x05, 0xf2, 0x56, 0x5a, 0xa7, 0x4b, 0x23, 0x2a, 0x76, 0xbd, 0x1c, 0xaf, 0x52, 0x7e, 0x89,
+	0xb3, 0xbf, 0x47, 0x64, 0x31, 0xdc, 0xcc, 0x9f, 0xb7, 0xf6, 0x6b, 0xa5, 0xb0, 0x9f, 0x09, 0xef,
+	0xa4, 0x8f, 0xfc, 0xda, 0x0a, 0x25, 0x5d, 0x38, 0xed, 0x84, 0x77, 0xd2, 0xf7, 0xa5, 0x38, 0x7b,
+	0xbe, 0xc7, 0x18, 0x3d, 0x47, 0xfe, 0xcf, 0x0b, 0x50, 0xf2, 0x00, 0xe6, 0xf8, 0xd8, 0x00, 0x14,
+	0xd8, 0xbb, 0x84, 0x0f, 0xa1, 0x2f, 0x3c, 0x6f, 0xad, 0xd3, 0x15, 0x98, 0x47, 0x30, 0x07, 0x99,
+	0x03, 0xf6, 0x30, 0xe1, 0x97, 0xf8, 0x87, 0xc9, 0xcf, 0x49, 0xb3, 0xdd, 0xce, 0xf0, 0x91, 0xf9,
+	0xf6, 0xdf, 0x01, 0x00, 0xca, 0x8a, 0x48, 0x4a, 0x07, 0x07, 0x00, 0x00,
 }
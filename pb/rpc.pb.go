@@ -0,0 +1,3167 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: rpc.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type CurrencyValue struct {
+	Currency             *CurrencyDefinition `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount               string              `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CurrencyValue) Reset()         { *m = CurrencyValue{} }
+func (m *CurrencyValue) String() string { return proto.CompactTextString(m) }
+func (*CurrencyValue) ProtoMessage()    {}
+func (*CurrencyValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{0}
+}
+
+func (m *CurrencyValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CurrencyValue.Unmarshal(m, b)
+}
+func (m *CurrencyValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CurrencyValue.Marshal(b, m, deterministic)
+}
+func (m *CurrencyValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyValue.Merge(m, src)
+}
+func (m *CurrencyValue) XXX_Size() int {
+	return xxx_messageInfo_CurrencyValue.Size(m)
+}
+func (m *CurrencyValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyValue proto.InternalMessageInfo
+
+func (m *CurrencyValue) GetCurrency() *CurrencyDefinition {
+	if m != nil {
+		return m.Currency
+	}
+	return nil
+}
+
+func (m *CurrencyValue) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type ListingsRequest struct {
+	// Empty to return our own listings
+	PeerID               string   `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	UseCache             bool     `protobuf:"varint,2,opt,name=useCache,proto3" json:"useCache,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListingsRequest) Reset()         { *m = ListingsRequest{} }
+func (m *ListingsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListingsRequest) ProtoMessage()    {}
+func (*ListingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{1}
+}
+
+func (m *ListingsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListingsRequest.Unmarshal(m, b)
+}
+func (m *ListingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListingsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListingsRequest.Merge(m, src)
+}
+func (m *ListingsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListingsRequest.Size(m)
+}
+func (m *ListingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListingsRequest proto.InternalMessageInfo
+
+func (m *ListingsRequest) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *ListingsRequest) GetUseCache() bool {
+	if m != nil {
+		return m.UseCache
+	}
+	return false
+}
+
+type ListingRequest struct {
+	// Empty to return one of our own listings
+	PeerID string `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	// Either the slug or the hash of the listing
+	ListingID            string   `protobuf:"bytes,2,opt,name=listingID,proto3" json:"listingID,omitempty"`
+	UseCache             bool     `protobuf:"varint,3,opt,name=useCache,proto3" json:"useCache,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListingRequest) Reset()         { *m = ListingRequest{} }
+func (m *ListingRequest) String() string { return proto.CompactTextString(m) }
+func (*ListingRequest) ProtoMessage()    {}
+func (*ListingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{2}
+}
+
+func (m *ListingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListingRequest.Unmarshal(m, b)
+}
+func (m *ListingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListingRequest.Marshal(b, m, deterministic)
+}
+func (m *ListingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListingRequest.Merge(m, src)
+}
+func (m *ListingRequest) XXX_Size() int {
+	return xxx_messageInfo_ListingRequest.Size(m)
+}
+func (m *ListingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListingRequest proto.InternalMessageInfo
+
+func (m *ListingRequest) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *ListingRequest) GetListingID() string {
+	if m != nil {
+		return m.ListingID
+	}
+	return ""
+}
+
+func (m *ListingRequest) GetUseCache() bool {
+	if m != nil {
+		return m.UseCache
+	}
+	return false
+}
+
+type ListingSlug struct {
+	Slug                 string   `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListingSlug) Reset()         { *m = ListingSlug{} }
+func (m *ListingSlug) String() string { return proto.CompactTextString(m) }
+func (*ListingSlug) ProtoMessage()    {}
+func (*ListingSlug) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{3}
+}
+
+func (m *ListingSlug) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListingSlug.Unmarshal(m, b)
+}
+func (m *ListingSlug) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListingSlug.Marshal(b, m, deterministic)
+}
+func (m *ListingSlug) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListingSlug.Merge(m, src)
+}
+func (m *ListingSlug) XXX_Size() int {
+	return xxx_messageInfo_ListingSlug.Size(m)
+}
+func (m *ListingSlug) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListingSlug.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListingSlug proto.InternalMessageInfo
+
+func (m *ListingSlug) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+type ListingIndex struct {
+	Listings             []*ListingIndex_Item `protobuf:"bytes,1,rep,name=listings,proto3" json:"listings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListingIndex) Reset()         { *m = ListingIndex{} }
+func (m *ListingIndex) String() string { return proto.CompactTextString(m) }
+func (*ListingIndex) ProtoMessage()    {}
+func (*ListingIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{4}
+}
+
+func (m *ListingIndex) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListingIndex.Unmarshal(m, b)
+}
+func (m *ListingIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListingIndex.Marshal(b, m, deterministic)
+}
+func (m *ListingIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListingIndex.Merge(m, src)
+}
+func (m *ListingIndex) XXX_Size() int {
+	return xxx_messageInfo_ListingIndex.Size(m)
+}
+func (m *ListingIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListingIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListingIndex proto.InternalMessageInfo
+
+func (m *ListingIndex) GetListings() []*ListingIndex_Item {
+	if m != nil {
+		return m.Listings
+	}
+	return nil
+}
+
+type ListingIndex_Item struct {
+	Hash                 string                  `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Slug                 string                  `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title                string                  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Categories           []string                `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	Nsfw                 bool                    `protobuf:"varint,5,opt,name=nsfw,proto3" json:"nsfw,omitempty"`
+	ContractType         string                  `protobuf:"bytes,6,opt,name=contractType,proto3" json:"contractType,omitempty"`
+	Description          string                  `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Thumbnail            *ListingIndex_Thumbnail `protobuf:"bytes,8,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	Price                *CurrencyValue          `protobuf:"bytes,9,opt,name=price,proto3" json:"price,omitempty"`
+	Modifier             float32                 `protobuf:"fixed32,10,opt,name=modifier,proto3" json:"modifier,omitempty"`
+	ShipsTo              []string                `protobuf:"bytes,11,rep,name=shipsTo,proto3" json:"shipsTo,omitempty"`
+	FreeShipping         []string                `protobuf:"bytes,12,rep,name=freeShipping,proto3" json:"freeShipping,omitempty"`
+	Language             string                  `protobuf:"bytes,13,opt,name=language,proto3" json:"language,omitempty"`
+	AverageRating        float32                 `protobuf:"fixed32,14,opt,name=averageRating,proto3" json:"averageRating,omitempty"`
+	RatingCount          uint32                  `protobuf:"varint,15,opt,name=ratingCount,proto3" json:"ratingCount,omitempty"`
+	Moderators           []string                `protobuf:"bytes,16,rep,name=moderators,proto3" json:"moderators,omitempty"`
+	AcceptedCurrencies   []string                `protobuf:"bytes,17,rep,name=acceptedCurrencies,proto3" json:"acceptedCurrencies,omitempty"`
+	CoinType             string                  `protobuf:"bytes,18,opt,name=coinType,proto3" json:"coinType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ListingIndex_Item) Reset()         { *m = ListingIndex_Item{} }
+func (m *ListingIndex_Item) String() string { return proto.CompactTextString(m) }
+func (*ListingIndex_Item) ProtoMessage()    {}
+func (*ListingIndex_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{4, 0}
+}
+
+func (m *ListingIndex_Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListingIndex_Item.Unmarshal(m, b)
+}
+func (m *ListingIndex_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListingIndex_Item.Marshal(b, m, deterministic)
+}
+func (m *ListingIndex_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListingIndex_Item.Merge(m, src)
+}
+func (m *ListingIndex_Item) XXX_Size() int {
+	return xxx_messageInfo_ListingIndex_Item.Size(m)
+}
+func (m *ListingIndex_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListingIndex_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListingIndex_Item proto.InternalMessageInfo
+
+func (m *ListingIndex_Item) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ListingIndex_Item) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *ListingIndex_Item) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ListingIndex_Item) GetCategories() []string {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+func (m *ListingIndex_Item) GetNsfw() bool {
+	if m != nil {
+		return m.Nsfw
+	}
+	return false
+}
+
+func (m *ListingIndex_Item) GetContractType() string {
+	if m != nil {
+		return m.ContractType
+	}
+	return ""
+}
+
+func (m *ListingIndex_Item) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ListingIndex_Item) GetThumbnail() *ListingIndex_Thumbnail {
+	if m != nil {
+		return m.Thumbnail
+	}
+	return nil
+}
+
+func (m *ListingIndex_Item) GetPrice() *CurrencyValue {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *ListingIndex_Item) GetModifier() float32 {
+	if m != nil {
+		return m.Modifier
+	}
+	return 0
+}
+
+func (m *ListingIndex_Item) GetShipsTo() []string {
+	if m != nil {
+		return m.ShipsTo
+	}
+	return nil
+}
+
+func (m *ListingIndex_Item) GetFreeShipping() []string {
+	if m != nil {
+		return m.FreeShipping
+	}
+	return nil
+}
+
+func (m *ListingIndex_Item) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *ListingIndex_Item) GetAverageRating() float32 {
+	if m != nil {
+		return m.AverageRating
+	}
+	return 0
+}
+
+func (m *ListingIndex_Item) GetRatingCount() uint32 {
+	if m != nil {
+		return m.RatingCount
+	}
+	return 0
+}
+
+func (m *ListingIndex_Item) GetModerators() []string {
+	if m != nil {
+		return m.Moderators
+	}
+	return nil
+}
+
+func (m *ListingIndex_Item) GetAcceptedCurrencies() []string {
+	if m != nil {
+		return m.AcceptedCurrencies
+	}
+	return nil
+}
+
+func (m *ListingIndex_Item) GetCoinType() string {
+	if m != nil {
+		return m.CoinType
+	}
+	return ""
+}
+
+type ListingIndex_Thumbnail struct {
+	Tiny                 string   `protobuf:"bytes,1,opt,name=tiny,proto3" json:"tiny,omitempty"`
+	Small                string   `protobuf:"bytes,2,opt,name=small,proto3" json:"small,omitempty"`
+	Medium               string   `protobuf:"bytes,3,opt,name=medium,proto3" json:"medium,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListingIndex_Thumbnail) Reset()         { *m = ListingIndex_Thumbnail{} }
+func (m *ListingIndex_Thumbnail) String() string { return proto.CompactTextString(m) }
+func (*ListingIndex_Thumbnail) ProtoMessage()    {}
+func (*ListingIndex_Thumbnail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{4, 1}
+}
+
+func (m *ListingIndex_Thumbnail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListingIndex_Thumbnail.Unmarshal(m, b)
+}
+func (m *ListingIndex_Thumbnail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListingIndex_Thumbnail.Marshal(b, m, deterministic)
+}
+func (m *ListingIndex_Thumbnail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListingIndex_Thumbnail.Merge(m, src)
+}
+func (m *ListingIndex_Thumbnail) XXX_Size() int {
+	return xxx_messageInfo_ListingIndex_Thumbnail.Size(m)
+}
+func (m *ListingIndex_Thumbnail) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListingIndex_Thumbnail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListingIndex_Thumbnail proto.InternalMessageInfo
+
+func (m *ListingIndex_Thumbnail) GetTiny() string {
+	if m != nil {
+		return m.Tiny
+	}
+	return ""
+}
+
+func (m *ListingIndex_Thumbnail) GetSmall() string {
+	if m != nil {
+		return m.Small
+	}
+	return ""
+}
+
+func (m *ListingIndex_Thumbnail) GetMedium() string {
+	if m != nil {
+		return m.Medium
+	}
+	return ""
+}
+
+type OrderID struct {
+	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderID) Reset()         { *m = OrderID{} }
+func (m *OrderID) String() string { return proto.CompactTextString(m) }
+func (*OrderID) ProtoMessage()    {}
+func (*OrderID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{5}
+}
+
+func (m *OrderID) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderID.Unmarshal(m, b)
+}
+func (m *OrderID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderID.Marshal(b, m, deterministic)
+}
+func (m *OrderID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderID.Merge(m, src)
+}
+func (m *OrderID) XXX_Size() int {
+	return xxx_messageInfo_OrderID.Size(m)
+}
+func (m *OrderID) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderID proto.InternalMessageInfo
+
+func (m *OrderID) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+type OrderQuery struct {
+	States               []OrderState `protobuf:"varint,1,rep,packed,name=states,proto3,enum=OrderState" json:"states,omitempty"`
+	SearchTerm           string       `protobuf:"bytes,2,opt,name=searchTerm,proto3" json:"searchTerm,omitempty"`
+	SortByAscending      bool         `protobuf:"varint,3,opt,name=sortByAscending,proto3" json:"sortByAscending,omitempty"`
+	SortByRead           bool         `protobuf:"varint,4,opt,name=sortByRead,proto3" json:"sortByRead,omitempty"`
+	Limit                int32        `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Exclude              []string     `protobuf:"bytes,6,rep,name=exclude,proto3" json:"exclude,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *OrderQuery) Reset()         { *m = OrderQuery{} }
+func (m *OrderQuery) String() string { return proto.CompactTextString(m) }
+func (*OrderQuery) ProtoMessage()    {}
+func (*OrderQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{6}
+}
+
+func (m *OrderQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderQuery.Unmarshal(m, b)
+}
+func (m *OrderQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderQuery.Marshal(b, m, deterministic)
+}
+func (m *OrderQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderQuery.Merge(m, src)
+}
+func (m *OrderQuery) XXX_Size() int {
+	return xxx_messageInfo_OrderQuery.Size(m)
+}
+func (m *OrderQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderQuery proto.InternalMessageInfo
+
+func (m *OrderQuery) GetStates() []OrderState {
+	if m != nil {
+		return m.States
+	}
+	return nil
+}
+
+func (m *OrderQuery) GetSearchTerm() string {
+	if m != nil {
+		return m.SearchTerm
+	}
+	return ""
+}
+
+func (m *OrderQuery) GetSortByAscending() bool {
+	if m != nil {
+		return m.SortByAscending
+	}
+	return false
+}
+
+func (m *OrderQuery) GetSortByRead() bool {
+	if m != nil {
+		return m.SortByRead
+	}
+	return false
+}
+
+func (m *OrderQuery) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *OrderQuery) GetExclude() []string {
+	if m != nil {
+		return m.Exclude
+	}
+	return nil
+}
+
+type OrderList struct {
+	QueryCount           uint64             `protobuf:"varint,1,opt,name=queryCount,proto3" json:"queryCount,omitempty"`
+	Orders               []*OrderList_Order `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *OrderList) Reset()         { *m = OrderList{} }
+func (m *OrderList) String() string { return proto.CompactTextString(m) }
+func (*OrderList) ProtoMessage()    {}
+func (*OrderList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{7}
+}
+
+func (m *OrderList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderList.Unmarshal(m, b)
+}
+func (m *OrderList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderList.Marshal(b, m, deterministic)
+}
+func (m *OrderList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderList.Merge(m, src)
+}
+func (m *OrderList) XXX_Size() int {
+	return xxx_messageInfo_OrderList.Size(m)
+}
+func (m *OrderList) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderList proto.InternalMessageInfo
+
+func (m *OrderList) GetQueryCount() uint64 {
+	if m != nil {
+		return m.QueryCount
+	}
+	return 0
+}
+
+func (m *OrderList) GetOrders() []*OrderList_Order {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+type OrderList_Order struct {
+	OrderID   string               `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Slug      string               `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Timestamp *timestamp.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Title     string               `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Thumbnail string               `protobuf:"bytes,5,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	Total     *CurrencyValue       `protobuf:"bytes,6,opt,name=total,proto3" json:"total,omitempty"`
+	// The buyer for sales and the vendor for purchases
+	PeerID               string     `protobuf:"bytes,7,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	Handle               string     `protobuf:"bytes,8,opt,name=handle,proto3" json:"handle,omitempty"`
+	ShippingName         string     `protobuf:"bytes,9,opt,name=shippingName,proto3" json:"shippingName,omitempty"`
+	ShippingAddress      string     `protobuf:"bytes,10,opt,name=shippingAddress,proto3" json:"shippingAddress,omitempty"`
+	CoinType             string     `protobuf:"bytes,11,opt,name=coinType,proto3" json:"coinType,omitempty"`
+	PaymentCoin          string     `protobuf:"bytes,12,opt,name=paymentCoin,proto3" json:"paymentCoin,omitempty"`
+	State                OrderState `protobuf:"varint,13,opt,name=state,proto3,enum=OrderState" json:"state,omitempty"`
+	Read                 bool       `protobuf:"varint,14,opt,name=read,proto3" json:"read,omitempty"`
+	Moderated            bool       `protobuf:"varint,15,opt,name=moderated,proto3" json:"moderated,omitempty"`
+	UnreadChatMessages   uint64     `protobuf:"varint,16,opt,name=unreadChatMessages,proto3" json:"unreadChatMessages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *OrderList_Order) Reset()         { *m = OrderList_Order{} }
+func (m *OrderList_Order) String() string { return proto.CompactTextString(m) }
+func (*OrderList_Order) ProtoMessage()    {}
+func (*OrderList_Order) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{7, 0}
+}
+
+func (m *OrderList_Order) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderList_Order.Unmarshal(m, b)
+}
+func (m *OrderList_Order) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderList_Order.Marshal(b, m, deterministic)
+}
+func (m *OrderList_Order) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderList_Order.Merge(m, src)
+}
+func (m *OrderList_Order) XXX_Size() int {
+	return xxx_messageInfo_OrderList_Order.Size(m)
+}
+func (m *OrderList_Order) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderList_Order.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderList_Order proto.InternalMessageInfo
+
+func (m *OrderList_Order) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *OrderList_Order) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *OrderList_Order) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *OrderList_Order) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *OrderList_Order) GetThumbnail() string {
+	if m != nil {
+		return m.Thumbnail
+	}
+	return ""
+}
+
+func (m *OrderList_Order) GetTotal() *CurrencyValue {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *OrderList_Order) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *OrderList_Order) GetHandle() string {
+	if m != nil {
+		return m.Handle
+	}
+	return ""
+}
+
+func (m *OrderList_Order) GetShippingName() string {
+	if m != nil {
+		return m.ShippingName
+	}
+	return ""
+}
+
+func (m *OrderList_Order) GetShippingAddress() string {
+	if m != nil {
+		return m.ShippingAddress
+	}
+	return ""
+}
+
+func (m *OrderList_Order) GetCoinType() string {
+	if m != nil {
+		return m.CoinType
+	}
+	return ""
+}
+
+func (m *OrderList_Order) GetPaymentCoin() string {
+	if m != nil {
+		return m.PaymentCoin
+	}
+	return ""
+}
+
+func (m *OrderList_Order) GetState() OrderState {
+	if m != nil {
+		return m.State
+	}
+	return OrderState_PENDING
+}
+
+func (m *OrderList_Order) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+func (m *OrderList_Order) GetModerated() bool {
+	if m != nil {
+		return m.Moderated
+	}
+	return false
+}
+
+func (m *OrderList_Order) GetUnreadChatMessages() uint64 {
+	if m != nil {
+		return m.UnreadChatMessages
+	}
+	return 0
+}
+
+type OrderConfirmationRequest struct {
+	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Reject               bool     `protobuf:"varint,2,opt,name=reject,proto3" json:"reject,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderConfirmationRequest) Reset()         { *m = OrderConfirmationRequest{} }
+func (m *OrderConfirmationRequest) String() string { return proto.CompactTextString(m) }
+func (*OrderConfirmationRequest) ProtoMessage()    {}
+func (*OrderConfirmationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{8}
+}
+
+func (m *OrderConfirmationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderConfirmationRequest.Unmarshal(m, b)
+}
+func (m *OrderConfirmationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderConfirmationRequest.Marshal(b, m, deterministic)
+}
+func (m *OrderConfirmationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderConfirmationRequest.Merge(m, src)
+}
+func (m *OrderConfirmationRequest) XXX_Size() int {
+	return xxx_messageInfo_OrderConfirmationRequest.Size(m)
+}
+func (m *OrderConfirmationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderConfirmationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderConfirmationRequest proto.InternalMessageInfo
+
+func (m *OrderConfirmationRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *OrderConfirmationRequest) GetReject() bool {
+	if m != nil {
+		return m.Reject
+	}
+	return false
+}
+
+type CaseList struct {
+	QueryCount           uint64           `protobuf:"varint,1,opt,name=queryCount,proto3" json:"queryCount,omitempty"`
+	Cases                []*CaseList_Case `protobuf:"bytes,2,rep,name=cases,proto3" json:"cases,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CaseList) Reset()         { *m = CaseList{} }
+func (m *CaseList) String() string { return proto.CompactTextString(m) }
+func (*CaseList) ProtoMessage()    {}
+func (*CaseList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{9}
+}
+
+func (m *CaseList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CaseList.Unmarshal(m, b)
+}
+func (m *CaseList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CaseList.Marshal(b, m, deterministic)
+}
+func (m *CaseList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CaseList.Merge(m, src)
+}
+func (m *CaseList) XXX_Size() int {
+	return xxx_messageInfo_CaseList.Size(m)
+}
+func (m *CaseList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CaseList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CaseList proto.InternalMessageInfo
+
+func (m *CaseList) GetQueryCount() uint64 {
+	if m != nil {
+		return m.QueryCount
+	}
+	return 0
+}
+
+func (m *CaseList) GetCases() []*CaseList_Case {
+	if m != nil {
+		return m.Cases
+	}
+	return nil
+}
+
+type CaseList_Case struct {
+	CaseID               string               `protobuf:"bytes,1,opt,name=caseID,proto3" json:"caseID,omitempty"`
+	Slug                 string               `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Title                string               `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Thumbnail            string               `protobuf:"bytes,5,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	Total                *CurrencyValue       `protobuf:"bytes,6,opt,name=total,proto3" json:"total,omitempty"`
+	BuyerID              string               `protobuf:"bytes,7,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	BuyerHandle          string               `protobuf:"bytes,8,opt,name=buyerHandle,proto3" json:"buyerHandle,omitempty"`
+	VendorID             string               `protobuf:"bytes,9,opt,name=vendorID,proto3" json:"vendorID,omitempty"`
+	VendorHandle         string               `protobuf:"bytes,10,opt,name=vendorHandle,proto3" json:"vendorHandle,omitempty"`
+	CoinType             string               `protobuf:"bytes,11,opt,name=coinType,proto3" json:"coinType,omitempty"`
+	PaymentCoin          string               `protobuf:"bytes,12,opt,name=paymentCoin,proto3" json:"paymentCoin,omitempty"`
+	BuyerOpened          bool                 `protobuf:"varint,13,opt,name=buyerOpened,proto3" json:"buyerOpened,omitempty"`
+	State                OrderState           `protobuf:"varint,14,opt,name=state,proto3,enum=OrderState" json:"state,omitempty"`
+	Read                 bool                 `protobuf:"varint,15,opt,name=read,proto3" json:"read,omitempty"`
+	UnreadChatMessages   uint64               `protobuf:"varint,16,opt,name=unreadChatMessages,proto3" json:"unreadChatMessages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CaseList_Case) Reset()         { *m = CaseList_Case{} }
+func (m *CaseList_Case) String() string { return proto.CompactTextString(m) }
+func (*CaseList_Case) ProtoMessage()    {}
+func (*CaseList_Case) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{9, 0}
+}
+
+func (m *CaseList_Case) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CaseList_Case.Unmarshal(m, b)
+}
+func (m *CaseList_Case) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CaseList_Case.Marshal(b, m, deterministic)
+}
+func (m *CaseList_Case) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CaseList_Case.Merge(m, src)
+}
+func (m *CaseList_Case) XXX_Size() int {
+	return xxx_messageInfo_CaseList_Case.Size(m)
+}
+func (m *CaseList_Case) XXX_DiscardUnknown() {
+	xxx_messageInfo_CaseList_Case.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CaseList_Case proto.InternalMessageInfo
+
+func (m *CaseList_Case) GetCaseID() string {
+	if m != nil {
+		return m.CaseID
+	}
+	return ""
+}
+
+func (m *CaseList_Case) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *CaseList_Case) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *CaseList_Case) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *CaseList_Case) GetThumbnail() string {
+	if m != nil {
+		return m.Thumbnail
+	}
+	return ""
+}
+
+func (m *CaseList_Case) GetTotal() *CurrencyValue {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+func (m *CaseList_Case) GetBuyerID() string {
+	if m != nil {
+		return m.BuyerID
+	}
+	return ""
+}
+
+func (m *CaseList_Case) GetBuyerHandle() string {
+	if m != nil {
+		return m.BuyerHandle
+	}
+	return ""
+}
+
+func (m *CaseList_Case) GetVendorID() string {
+	if m != nil {
+		return m.VendorID
+	}
+	return ""
+}
+
+func (m *CaseList_Case) GetVendorHandle() string {
+	if m != nil {
+		return m.VendorHandle
+	}
+	return ""
+}
+
+func (m *CaseList_Case) GetCoinType() string {
+	if m != nil {
+		return m.CoinType
+	}
+	return ""
+}
+
+func (m *CaseList_Case) GetPaymentCoin() string {
+	if m != nil {
+		return m.PaymentCoin
+	}
+	return ""
+}
+
+func (m *CaseList_Case) GetBuyerOpened() bool {
+	if m != nil {
+		return m.BuyerOpened
+	}
+	return false
+}
+
+func (m *CaseList_Case) GetState() OrderState {
+	if m != nil {
+		return m.State
+	}
+	return OrderState_PENDING
+}
+
+func (m *CaseList_Case) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+func (m *CaseList_Case) GetUnreadChatMessages() uint64 {
+	if m != nil {
+		return m.UnreadChatMessages
+	}
+	return 0
+}
+
+type CloseDisputeRequest struct {
+	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Resolution           string   `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"`
+	BuyerPercentage      float32  `protobuf:"fixed32,3,opt,name=buyerPercentage,proto3" json:"buyerPercentage,omitempty"`
+	VendorPercentage     float32  `protobuf:"fixed32,4,opt,name=vendorPercentage,proto3" json:"vendorPercentage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloseDisputeRequest) Reset()         { *m = CloseDisputeRequest{} }
+func (m *CloseDisputeRequest) String() string { return proto.CompactTextString(m) }
+func (*CloseDisputeRequest) ProtoMessage()    {}
+func (*CloseDisputeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{10}
+}
+
+func (m *CloseDisputeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloseDisputeRequest.Unmarshal(m, b)
+}
+func (m *CloseDisputeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloseDisputeRequest.Marshal(b, m, deterministic)
+}
+func (m *CloseDisputeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseDisputeRequest.Merge(m, src)
+}
+func (m *CloseDisputeRequest) XXX_Size() int {
+	return xxx_messageInfo_CloseDisputeRequest.Size(m)
+}
+func (m *CloseDisputeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseDisputeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseDisputeRequest proto.InternalMessageInfo
+
+func (m *CloseDisputeRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *CloseDisputeRequest) GetResolution() string {
+	if m != nil {
+		return m.Resolution
+	}
+	return ""
+}
+
+func (m *CloseDisputeRequest) GetBuyerPercentage() float32 {
+	if m != nil {
+		return m.BuyerPercentage
+	}
+	return 0
+}
+
+func (m *CloseDisputeRequest) GetVendorPercentage() float32 {
+	if m != nil {
+		return m.VendorPercentage
+	}
+	return 0
+}
+
+type ChatMessageRequest struct {
+	PeerID  string `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	// An empty message sends a typing indicator
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChatMessageRequest) Reset()         { *m = ChatMessageRequest{} }
+func (m *ChatMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*ChatMessageRequest) ProtoMessage()    {}
+func (*ChatMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{11}
+}
+
+func (m *ChatMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatMessageRequest.Unmarshal(m, b)
+}
+func (m *ChatMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatMessageRequest.Marshal(b, m, deterministic)
+}
+func (m *ChatMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatMessageRequest.Merge(m, src)
+}
+func (m *ChatMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_ChatMessageRequest.Size(m)
+}
+func (m *ChatMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatMessageRequest proto.InternalMessageInfo
+
+func (m *ChatMessageRequest) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *ChatMessageRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *ChatMessageRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type ChatMessageID struct {
+	MessageID            string   `protobuf:"bytes,1,opt,name=messageID,proto3" json:"messageID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChatMessageID) Reset()         { *m = ChatMessageID{} }
+func (m *ChatMessageID) String() string { return proto.CompactTextString(m) }
+func (*ChatMessageID) ProtoMessage()    {}
+func (*ChatMessageID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{12}
+}
+
+func (m *ChatMessageID) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatMessageID.Unmarshal(m, b)
+}
+func (m *ChatMessageID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatMessageID.Marshal(b, m, deterministic)
+}
+func (m *ChatMessageID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatMessageID.Merge(m, src)
+}
+func (m *ChatMessageID) XXX_Size() int {
+	return xxx_messageInfo_ChatMessageID.Size(m)
+}
+func (m *ChatMessageID) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatMessageID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatMessageID proto.InternalMessageInfo
+
+func (m *ChatMessageID) GetMessageID() string {
+	if m != nil {
+		return m.MessageID
+	}
+	return ""
+}
+
+type ChatMessagesRequest struct {
+	PeerID               string   `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	Subject              string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	OffsetID             string   `protobuf:"bytes,3,opt,name=offsetID,proto3" json:"offsetID,omitempty"`
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChatMessagesRequest) Reset()         { *m = ChatMessagesRequest{} }
+func (m *ChatMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*ChatMessagesRequest) ProtoMessage()    {}
+func (*ChatMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{13}
+}
+
+func (m *ChatMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatMessagesRequest.Unmarshal(m, b)
+}
+func (m *ChatMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatMessagesRequest.Marshal(b, m, deterministic)
+}
+func (m *ChatMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatMessagesRequest.Merge(m, src)
+}
+func (m *ChatMessagesRequest) XXX_Size() int {
+	return xxx_messageInfo_ChatMessagesRequest.Size(m)
+}
+func (m *ChatMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatMessagesRequest proto.InternalMessageInfo
+
+func (m *ChatMessagesRequest) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *ChatMessagesRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *ChatMessagesRequest) GetOffsetID() string {
+	if m != nil {
+		return m.OffsetID
+	}
+	return ""
+}
+
+func (m *ChatMessagesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ChatMessageList struct {
+	Messages             []*ChatMessageList_Message `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ChatMessageList) Reset()         { *m = ChatMessageList{} }
+func (m *ChatMessageList) String() string { return proto.CompactTextString(m) }
+func (*ChatMessageList) ProtoMessage()    {}
+func (*ChatMessageList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{14}
+}
+
+func (m *ChatMessageList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatMessageList.Unmarshal(m, b)
+}
+func (m *ChatMessageList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatMessageList.Marshal(b, m, deterministic)
+}
+func (m *ChatMessageList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatMessageList.Merge(m, src)
+}
+func (m *ChatMessageList) XXX_Size() int {
+	return xxx_messageInfo_ChatMessageList.Size(m)
+}
+func (m *ChatMessageList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatMessageList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatMessageList proto.InternalMessageInfo
+
+func (m *ChatMessageList) GetMessages() []*ChatMessageList_Message {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+type ChatMessageList_Message struct {
+	MessageID            string               `protobuf:"bytes,1,opt,name=messageID,proto3" json:"messageID,omitempty"`
+	PeerID               string               `protobuf:"bytes,2,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	Subject              string               `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Message              string               `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Read                 bool                 `protobuf:"varint,5,opt,name=read,proto3" json:"read,omitempty"`
+	Outgoing             bool                 `protobuf:"varint,6,opt,name=outgoing,proto3" json:"outgoing,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ChatMessageList_Message) Reset()         { *m = ChatMessageList_Message{} }
+func (m *ChatMessageList_Message) String() string { return proto.CompactTextString(m) }
+func (*ChatMessageList_Message) ProtoMessage()    {}
+func (*ChatMessageList_Message) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{14, 0}
+}
+
+func (m *ChatMessageList_Message) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatMessageList_Message.Unmarshal(m, b)
+}
+func (m *ChatMessageList_Message) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatMessageList_Message.Marshal(b, m, deterministic)
+}
+func (m *ChatMessageList_Message) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatMessageList_Message.Merge(m, src)
+}
+func (m *ChatMessageList_Message) XXX_Size() int {
+	return xxx_messageInfo_ChatMessageList_Message.Size(m)
+}
+func (m *ChatMessageList_Message) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatMessageList_Message.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatMessageList_Message proto.InternalMessageInfo
+
+func (m *ChatMessageList_Message) GetMessageID() string {
+	if m != nil {
+		return m.MessageID
+	}
+	return ""
+}
+
+func (m *ChatMessageList_Message) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *ChatMessageList_Message) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *ChatMessageList_Message) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ChatMessageList_Message) GetRead() bool {
+	if m != nil {
+		return m.Read
+	}
+	return false
+}
+
+func (m *ChatMessageList_Message) GetOutgoing() bool {
+	if m != nil {
+		return m.Outgoing
+	}
+	return false
+}
+
+func (m *ChatMessageList_Message) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type ChatConversationList struct {
+	Conversations        []*ChatConversationList_Conversation `protobuf:"bytes,1,rep,name=conversations,proto3" json:"conversations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
+}
+
+func (m *ChatConversationList) Reset()         { *m = ChatConversationList{} }
+func (m *ChatConversationList) String() string { return proto.CompactTextString(m) }
+func (*ChatConversationList) ProtoMessage()    {}
+func (*ChatConversationList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{15}
+}
+
+func (m *ChatConversationList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatConversationList.Unmarshal(m, b)
+}
+func (m *ChatConversationList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatConversationList.Marshal(b, m, deterministic)
+}
+func (m *ChatConversationList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatConversationList.Merge(m, src)
+}
+func (m *ChatConversationList) XXX_Size() int {
+	return xxx_messageInfo_ChatConversationList.Size(m)
+}
+func (m *ChatConversationList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatConversationList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatConversationList proto.InternalMessageInfo
+
+func (m *ChatConversationList) GetConversations() []*ChatConversationList_Conversation {
+	if m != nil {
+		return m.Conversations
+	}
+	return nil
+}
+
+type ChatConversationList_Conversation struct {
+	PeerID               string               `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	Unread               uint32               `protobuf:"varint,2,opt,name=unread,proto3" json:"unread,omitempty"`
+	LastMessage          string               `protobuf:"bytes,3,opt,name=lastMessage,proto3" json:"lastMessage,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Outgoing             bool                 `protobuf:"varint,5,opt,name=outgoing,proto3" json:"outgoing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ChatConversationList_Conversation) Reset()         { *m = ChatConversationList_Conversation{} }
+func (m *ChatConversationList_Conversation) String() string { return proto.CompactTextString(m) }
+func (*ChatConversationList_Conversation) ProtoMessage()    {}
+func (*ChatConversationList_Conversation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{15, 0}
+}
+
+func (m *ChatConversationList_Conversation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatConversationList_Conversation.Unmarshal(m, b)
+}
+func (m *ChatConversationList_Conversation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatConversationList_Conversation.Marshal(b, m, deterministic)
+}
+func (m *ChatConversationList_Conversation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatConversationList_Conversation.Merge(m, src)
+}
+func (m *ChatConversationList_Conversation) XXX_Size() int {
+	return xxx_messageInfo_ChatConversationList_Conversation.Size(m)
+}
+func (m *ChatConversationList_Conversation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatConversationList_Conversation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatConversationList_Conversation proto.InternalMessageInfo
+
+func (m *ChatConversationList_Conversation) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *ChatConversationList_Conversation) GetUnread() uint32 {
+	if m != nil {
+		return m.Unread
+	}
+	return 0
+}
+
+func (m *ChatConversationList_Conversation) GetLastMessage() string {
+	if m != nil {
+		return m.LastMessage
+	}
+	return ""
+}
+
+func (m *ChatConversationList_Conversation) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *ChatConversationList_Conversation) GetOutgoing() bool {
+	if m != nil {
+		return m.Outgoing
+	}
+	return false
+}
+
+type ChatMarkAsReadRequest struct {
+	PeerID               string   `protobuf:"bytes,1,opt,name=peerID,proto3" json:"peerID,omitempty"`
+	Subject              string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChatMarkAsReadRequest) Reset()         { *m = ChatMarkAsReadRequest{} }
+func (m *ChatMarkAsReadRequest) String() string { return proto.CompactTextString(m) }
+func (*ChatMarkAsReadRequest) ProtoMessage()    {}
+func (*ChatMarkAsReadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{16}
+}
+
+func (m *ChatMarkAsReadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChatMarkAsReadRequest.Unmarshal(m, b)
+}
+func (m *ChatMarkAsReadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChatMarkAsReadRequest.Marshal(b, m, deterministic)
+}
+func (m *ChatMarkAsReadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChatMarkAsReadRequest.Merge(m, src)
+}
+func (m *ChatMarkAsReadRequest) XXX_Size() int {
+	return xxx_messageInfo_ChatMarkAsReadRequest.Size(m)
+}
+func (m *ChatMarkAsReadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChatMarkAsReadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChatMarkAsReadRequest proto.InternalMessageInfo
+
+func (m *ChatMarkAsReadRequest) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
+	}
+	return ""
+}
+
+func (m *ChatMarkAsReadRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+type CoinRequest struct {
+	Coin                 string   `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CoinRequest) Reset()         { *m = CoinRequest{} }
+func (m *CoinRequest) String() string { return proto.CompactTextString(m) }
+func (*CoinRequest) ProtoMessage()    {}
+func (*CoinRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{17}
+}
+
+func (m *CoinRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CoinRequest.Unmarshal(m, b)
+}
+func (m *CoinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CoinRequest.Marshal(b, m, deterministic)
+}
+func (m *CoinRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoinRequest.Merge(m, src)
+}
+func (m *CoinRequest) XXX_Size() int {
+	return xxx_messageInfo_CoinRequest.Size(m)
+}
+func (m *CoinRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoinRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoinRequest proto.InternalMessageInfo
+
+func (m *CoinRequest) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
+
+type WalletBalance struct {
+	Currency             *CurrencyDefinition `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Confirmed            string              `protobuf:"bytes,2,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Unconfirmed          string              `protobuf:"bytes,3,opt,name=unconfirmed,proto3" json:"unconfirmed,omitempty"`
+	Height               uint32              `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *WalletBalance) Reset()         { *m = WalletBalance{} }
+func (m *WalletBalance) String() string { return proto.CompactTextString(m) }
+func (*WalletBalance) ProtoMessage()    {}
+func (*WalletBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{18}
+}
+
+func (m *WalletBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletBalance.Unmarshal(m, b)
+}
+func (m *WalletBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletBalance.Marshal(b, m, deterministic)
+}
+func (m *WalletBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletBalance.Merge(m, src)
+}
+func (m *WalletBalance) XXX_Size() int {
+	return xxx_messageInfo_WalletBalance.Size(m)
+}
+func (m *WalletBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletBalance proto.InternalMessageInfo
+
+func (m *WalletBalance) GetCurrency() *CurrencyDefinition {
+	if m != nil {
+		return m.Currency
+	}
+	return nil
+}
+
+func (m *WalletBalance) GetConfirmed() string {
+	if m != nil {
+		return m.Confirmed
+	}
+	return ""
+}
+
+func (m *WalletBalance) GetUnconfirmed() string {
+	if m != nil {
+		return m.Unconfirmed
+	}
+	return ""
+}
+
+func (m *WalletBalance) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type WalletAddress struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletAddress) Reset()         { *m = WalletAddress{} }
+func (m *WalletAddress) String() string { return proto.CompactTextString(m) }
+func (*WalletAddress) ProtoMessage()    {}
+func (*WalletAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{19}
+}
+
+func (m *WalletAddress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletAddress.Unmarshal(m, b)
+}
+func (m *WalletAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletAddress.Marshal(b, m, deterministic)
+}
+func (m *WalletAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletAddress.Merge(m, src)
+}
+func (m *WalletAddress) XXX_Size() int {
+	return xxx_messageInfo_WalletAddress.Size(m)
+}
+func (m *WalletAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletAddress proto.InternalMessageInfo
+
+func (m *WalletAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type SpendRequest struct {
+	CurrencyCode         string   `protobuf:"bytes,1,opt,name=currencyCode,proto3" json:"currencyCode,omitempty"`
+	Amount               string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	FeeLevel             string   `protobuf:"bytes,4,opt,name=feeLevel,proto3" json:"feeLevel,omitempty"`
+	Memo                 string   `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	OrderID              string   `protobuf:"bytes,6,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	RequireOrder         bool     `protobuf:"varint,7,opt,name=requireOrder,proto3" json:"requireOrder,omitempty"`
+	SpendAll             bool     `protobuf:"varint,8,opt,name=spendAll,proto3" json:"spendAll,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SpendRequest) Reset()         { *m = SpendRequest{} }
+func (m *SpendRequest) String() string { return proto.CompactTextString(m) }
+func (*SpendRequest) ProtoMessage()    {}
+func (*SpendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{20}
+}
+
+func (m *SpendRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpendRequest.Unmarshal(m, b)
+}
+func (m *SpendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpendRequest.Marshal(b, m, deterministic)
+}
+func (m *SpendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendRequest.Merge(m, src)
+}
+func (m *SpendRequest) XXX_Size() int {
+	return xxx_messageInfo_SpendRequest.Size(m)
+}
+func (m *SpendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendRequest proto.InternalMessageInfo
+
+func (m *SpendRequest) GetCurrencyCode() string {
+	if m != nil {
+		return m.CurrencyCode
+	}
+	return ""
+}
+
+func (m *SpendRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *SpendRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SpendRequest) GetFeeLevel() string {
+	if m != nil {
+		return m.FeeLevel
+	}
+	return ""
+}
+
+func (m *SpendRequest) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *SpendRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *SpendRequest) GetRequireOrder() bool {
+	if m != nil {
+		return m.RequireOrder
+	}
+	return false
+}
+
+func (m *SpendRequest) GetSpendAll() bool {
+	if m != nil {
+		return m.SpendAll
+	}
+	return false
+}
+
+type SpendResponse struct {
+	Txid                 string               `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Amount               string               `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	ConfirmedBalance     string               `protobuf:"bytes,3,opt,name=confirmedBalance,proto3" json:"confirmedBalance,omitempty"`
+	UnconfirmedBalance   string               `protobuf:"bytes,4,opt,name=unconfirmedBalance,proto3" json:"unconfirmedBalance,omitempty"`
+	Currency             *CurrencyDefinition  `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Memo                 string               `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	OrderID              string               `protobuf:"bytes,7,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Timestamp            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SpendResponse) Reset()         { *m = SpendResponse{} }
+func (m *SpendResponse) String() string { return proto.CompactTextString(m) }
+func (*SpendResponse) ProtoMessage()    {}
+func (*SpendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{21}
+}
+
+func (m *SpendResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SpendResponse.Unmarshal(m, b)
+}
+func (m *SpendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SpendResponse.Marshal(b, m, deterministic)
+}
+func (m *SpendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendResponse.Merge(m, src)
+}
+func (m *SpendResponse) XXX_Size() int {
+	return xxx_messageInfo_SpendResponse.Size(m)
+}
+func (m *SpendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpendResponse proto.InternalMessageInfo
+
+func (m *SpendResponse) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *SpendResponse) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *SpendResponse) GetConfirmedBalance() string {
+	if m != nil {
+		return m.ConfirmedBalance
+	}
+	return ""
+}
+
+func (m *SpendResponse) GetUnconfirmedBalance() string {
+	if m != nil {
+		return m.UnconfirmedBalance
+	}
+	return ""
+}
+
+func (m *SpendResponse) GetCurrency() *CurrencyDefinition {
+	if m != nil {
+		return m.Currency
+	}
+	return nil
+}
+
+func (m *SpendResponse) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *SpendResponse) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *SpendResponse) GetTimestamp() *timestamp.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+type Notification struct {
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// JSON encoding of the notification, identical to the websocket payload
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Notification) Reset()         { *m = Notification{} }
+func (m *Notification) String() string { return proto.CompactTextString(m) }
+func (*Notification) ProtoMessage()    {}
+func (*Notification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{22}
+}
+
+func (m *Notification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Notification.Unmarshal(m, b)
+}
+func (m *Notification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Notification.Marshal(b, m, deterministic)
+}
+func (m *Notification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Notification.Merge(m, src)
+}
+func (m *Notification) XXX_Size() int {
+	return xxx_messageInfo_Notification.Size(m)
+}
+func (m *Notification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Notification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Notification proto.InternalMessageInfo
+
+func (m *Notification) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Notification) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Notification) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CurrencyValue)(nil), "CurrencyValue")
+	proto.RegisterType((*ListingsRequest)(nil), "ListingsRequest")
+	proto.RegisterType((*ListingRequest)(nil), "ListingRequest")
+	proto.RegisterType((*ListingSlug)(nil), "ListingSlug")
+	proto.RegisterType((*ListingIndex)(nil), "ListingIndex")
+	proto.RegisterType((*ListingIndex_Item)(nil), "ListingIndex.Item")
+	proto.RegisterType((*ListingIndex_Thumbnail)(nil), "ListingIndex.Thumbnail")
+	proto.RegisterType((*OrderID)(nil), "OrderID")
+	proto.RegisterType((*OrderQuery)(nil), "OrderQuery")
+	proto.RegisterType((*OrderList)(nil), "OrderList")
+	proto.RegisterType((*OrderList_Order)(nil), "OrderList.Order")
+	proto.RegisterType((*OrderConfirmationRequest)(nil), "OrderConfirmationRequest")
+	proto.RegisterType((*CaseList)(nil), "CaseList")
+	proto.RegisterType((*CaseList_Case)(nil), "CaseList.Case")
+	proto.RegisterType((*CloseDisputeRequest)(nil), "CloseDisputeRequest")
+	proto.RegisterType((*ChatMessageRequest)(nil), "ChatMessageRequest")
+	proto.RegisterType((*ChatMessageID)(nil), "ChatMessageID")
+	proto.RegisterType((*ChatMessagesRequest)(nil), "ChatMessagesRequest")
+	proto.RegisterType((*ChatMessageList)(nil), "ChatMessageList")
+	proto.RegisterType((*ChatMessageList_Message)(nil), "ChatMessageList.Message")
+	proto.RegisterType((*ChatConversationList)(nil), "ChatConversationList")
+	proto.RegisterType((*ChatConversationList_Conversation)(nil), "ChatConversationList.Conversation")
+	proto.RegisterType((*ChatMarkAsReadRequest)(nil), "ChatMarkAsReadRequest")
+	proto.RegisterType((*CoinRequest)(nil), "CoinRequest")
+	proto.RegisterType((*WalletBalance)(nil), "WalletBalance")
+	proto.RegisterType((*WalletAddress)(nil), "WalletAddress")
+	proto.RegisterType((*SpendRequest)(nil), "SpendRequest")
+	proto.RegisterType((*SpendResponse)(nil), "SpendResponse")
+	proto.RegisterType((*Notification)(nil), "Notification")
+}
+
+func init() {
+	proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1)
+}
+
+var fileDescriptor_77a6da22d6a3feb1 = []byte{
+	// 1983 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x3b, 0x93, 0x1c, 0x49,
+	0xf1, 0x8f, 0x99, 0x9d, 0x67, 0xce, 0x63, 0xf7, 0x5a, 0xba, 0xfd, 0xf7, 0x7f, 0x50, 0x1c, 0xab,
+	0x96, 0x82, 0x5b, 0x14, 0x71, 0x2d, 0x58, 0x4e, 0x11, 0x10, 0x71, 0xce, 0x6a, 0xf6, 0x4e, 0xb7,
+	0x81, 0xee, 0x41, 0xef, 0xf2, 0x08, 0x2c, 0x6a, 0xbb, 0x73, 0x66, 0x1a, 0xfa, 0xa5, 0xae, 0xea,
+	0x45, 0xeb, 0xe2, 0x81, 0x43, 0x04, 0x16, 0x7c, 0x00, 0x02, 0xf7, 0x2c, 0xbe, 0x01, 0x16, 0x36,
+	0x1e, 0x38, 0x7c, 0x00, 0x7c, 0x3c, 0x22, 0xeb, 0xd1, 0x53, 0x3d, 0x3b, 0x73, 0x3a, 0x09, 0x0b,
+	0xaf, 0xf2, 0x97, 0xd9, 0x55, 0x95, 0x59, 0xbf, 0xcc, 0xac, 0x6a, 0x18, 0x96, 0x45, 0xe8, 0x17,
+	0x65, 0x2e, 0xf2, 0xd9, 0x7e, 0x98, 0x67, 0xa2, 0x64, 0xa1, 0xe0, 0x1a, 0x18, 0xb2, 0x22, 0xd6,
+	0xc3, 0x71, 0x5e, 0x46, 0x58, 0x1a, 0xc5, 0xd7, 0x96, 0x79, 0xbe, 0x4c, 0xf0, 0xb1, 0x94, 0xae,
+	0xaa, 0xc5, 0x63, 0x4c, 0x0b, 0x71, 0xa3, 0x95, 0x5f, 0xdf, 0x54, 0x8a, 0x38, 0x45, 0x2e, 0x58,
+	0x5a, 0x28, 0x03, 0xef, 0x27, 0x30, 0x99, 0x57, 0x65, 0x89, 0x59, 0x78, 0xf3, 0x23, 0x96, 0x54,
+	0xe8, 0x3c, 0x86, 0x41, 0xa8, 0x01, 0xb7, 0x75, 0xd4, 0x3a, 0x1e, 0x9d, 0xdc, 0xf1, 0x8d, 0xc5,
+	0x19, 0x2e, 0xe2, 0x2c, 0x16, 0x71, 0x9e, 0x05, 0xb5, 0x91, 0x73, 0x08, 0x3d, 0x96, 0xe6, 0x55,
+	0x26, 0xdc, 0xf6, 0x51, 0xeb, 0x78, 0x18, 0x68, 0xc9, 0xfb, 0x10, 0xf6, 0x9f, 0xc7, 0x5c, 0xc4,
+	0xd9, 0x92, 0x07, 0xf8, 0xa2, 0x42, 0x2e, 0xc8, 0xb4, 0x40, 0x2c, 0xcf, 0xcf, 0xe4, 0xcc, 0xc3,
+	0x40, 0x4b, 0xce, 0x0c, 0x06, 0x15, 0xc7, 0x39, 0x0b, 0x57, 0x28, 0x27, 0x19, 0x04, 0xb5, 0xec,
+	0x5d, 0xc1, 0x54, 0x4f, 0xf3, 0xaa, 0x59, 0xee, 0xc1, 0x30, 0x51, 0x96, 0xe7, 0x67, 0x7a, 0x2f,
+	0x6b, 0xa0, 0xb1, 0xc6, 0xde, 0xc6, 0x1a, 0xf7, 0x61, 0xa4, 0xd7, 0xb8, 0x48, 0xaa, 0xa5, 0xe3,
+	0x40, 0x87, 0x27, 0xd5, 0x52, 0x4f, 0x2f, 0xc7, 0xde, 0xdf, 0xba, 0x30, 0xd6, 0x36, 0xe7, 0x59,
+	0x84, 0x2f, 0x1d, 0x1f, 0x06, 0x7a, 0x72, 0xee, 0xb6, 0x8e, 0xf6, 0x8e, 0x47, 0x27, 0x8e, 0x6f,
+	0x1b, 0xf8, 0xe7, 0x02, 0xd3, 0xa0, 0xb6, 0x99, 0xfd, 0xa5, 0x03, 0x1d, 0x82, 0x68, 0xf6, 0x15,
+	0xe3, 0x2b, 0x33, 0x3b, 0x8d, 0xeb, 0x15, 0xdb, 0xeb, 0x15, 0x9d, 0xbb, 0xd0, 0x15, 0xb1, 0x48,
+	0xd4, 0x6e, 0x87, 0x81, 0x12, 0x9c, 0x77, 0x00, 0x42, 0x26, 0x70, 0x99, 0x97, 0x31, 0x72, 0xb7,
+	0x73, 0xb4, 0x77, 0x3c, 0x0c, 0x2c, 0x84, 0x66, 0xca, 0xf8, 0xe2, 0x97, 0x6e, 0x57, 0xba, 0x28,
+	0xc7, 0x8e, 0x07, 0x63, 0xc3, 0xa6, 0xcb, 0x9b, 0x02, 0xdd, 0x9e, 0x9c, 0xb0, 0x81, 0x39, 0x47,
+	0x30, 0x8a, 0x90, 0x87, 0x65, 0x5c, 0xd0, 0xf1, 0xba, 0x7d, 0x69, 0x62, 0x43, 0xce, 0x13, 0x18,
+	0x8a, 0x55, 0x95, 0x5e, 0x65, 0x2c, 0x4e, 0xdc, 0x81, 0x64, 0xc6, 0xff, 0x35, 0x3d, 0xbe, 0x34,
+	0xea, 0x60, 0x6d, 0xe9, 0x3c, 0x84, 0x6e, 0x51, 0xc6, 0x21, 0xba, 0x43, 0xf9, 0xc9, 0xd4, 0x6f,
+	0xd0, 0x2d, 0x50, 0x4a, 0x3a, 0x9d, 0x34, 0x8f, 0xe2, 0x45, 0x8c, 0xa5, 0x0b, 0x47, 0xad, 0xe3,
+	0x76, 0x50, 0xcb, 0x8e, 0x0b, 0x7d, 0xbe, 0x8a, 0x0b, 0x7e, 0x99, 0xbb, 0x23, 0xe9, 0xaf, 0x11,
+	0xc9, 0xb1, 0x45, 0x89, 0x78, 0xb1, 0x8a, 0x8b, 0x22, 0xce, 0x96, 0xee, 0x58, 0xaa, 0x1b, 0x18,
+	0xcd, 0x9c, 0xb0, 0x6c, 0x59, 0xb1, 0x25, 0xba, 0x13, 0xe9, 0x55, 0x2d, 0x3b, 0x0f, 0x61, 0xc2,
+	0xae, 0xb1, 0x64, 0x4b, 0x0c, 0x18, 0xb9, 0xe1, 0x4e, 0xe5, 0xd2, 0x4d, 0x90, 0x42, 0x53, 0xca,
+	0xd1, 0x5c, 0xb2, 0x7c, 0xff, 0xa8, 0x75, 0x3c, 0x09, 0x6c, 0x88, 0x0e, 0x25, 0xcd, 0x23, 0x2c,
+	0x99, 0xc8, 0x4b, 0xee, 0x1e, 0xa8, 0x43, 0x59, 0x23, 0x8e, 0x0f, 0x0e, 0x0b, 0x43, 0x2c, 0x04,
+	0x46, 0xda, 0x7b, 0x3a, 0xbc, 0xb7, 0xa4, 0xdd, 0x16, 0x0d, 0xed, 0x39, 0xcc, 0xe3, 0x4c, 0x1e,
+	0x96, 0xa3, 0xf6, 0x6c, 0xe4, 0xd9, 0x27, 0x30, 0xac, 0xe3, 0x4c, 0xa7, 0x2d, 0xe2, 0xec, 0xc6,
+	0x70, 0x89, 0xc6, 0xc4, 0x1b, 0x9e, 0xb2, 0x24, 0xd1, 0x64, 0x52, 0x02, 0x25, 0x4d, 0x8a, 0x51,
+	0x5c, 0xa5, 0x9a, 0x4e, 0x5a, 0xf2, 0x1e, 0x40, 0xff, 0x33, 0xaa, 0x26, 0xe7, 0x67, 0x14, 0xe7,
+	0x5c, 0x0d, 0xf5, 0x7c, 0x46, 0xf4, 0xfe, 0xda, 0x02, 0x90, 0x56, 0x3f, 0xa8, 0xb0, 0xbc, 0x71,
+	0x1e, 0x40, 0x8f, 0x0b, 0x26, 0x50, 0x11, 0x7f, 0x7a, 0x32, 0xf2, 0xa5, 0xf2, 0x82, 0xb0, 0x40,
+	0xab, 0x28, 0x26, 0x1c, 0x59, 0x19, 0xae, 0x2e, 0xb1, 0x4c, 0xf5, 0x5e, 0x2c, 0xc4, 0x39, 0x86,
+	0x7d, 0x9e, 0x97, 0xe2, 0xe9, 0xcd, 0x29, 0x0f, 0x31, 0x8b, 0x28, 0xfa, 0x2a, 0x2d, 0x37, 0x61,
+	0x39, 0x93, 0x84, 0x02, 0x64, 0x91, 0xdb, 0x91, 0x46, 0x16, 0x42, 0x0e, 0x27, 0x71, 0x1a, 0x0b,
+	0xc9, 0xf9, 0x6e, 0xa0, 0x04, 0xf2, 0x06, 0x5f, 0x86, 0x49, 0x15, 0x11, 0xdf, 0x25, 0x6b, 0xb4,
+	0xe8, 0xfd, 0xb3, 0x03, 0x43, 0xb9, 0x61, 0x22, 0x2f, 0xcd, 0xfe, 0x82, 0xbc, 0x52, 0x87, 0x4b,
+	0x8e, 0x77, 0x02, 0x0b, 0x71, 0x8e, 0xa1, 0xa7, 0xca, 0xad, 0xdb, 0x96, 0x59, 0x7e, 0xe0, 0xd7,
+	0xdf, 0xaa, 0x51, 0xa0, 0xf5, 0xb3, 0xdf, 0x74, 0xa0, 0x2b, 0x91, 0xdd, 0x91, 0xdc, 0x9a, 0xe8,
+	0xdf, 0x85, 0x61, 0x5d, 0x95, 0x65, 0x0c, 0x46, 0x27, 0x33, 0x5f, 0xd5, 0x6d, 0xdf, 0xd4, 0x6d,
+	0xff, 0xd2, 0x58, 0x04, 0x6b, 0xe3, 0x75, 0x89, 0xe8, 0xd8, 0x25, 0xe2, 0x9e, 0x9d, 0xa8, 0x5d,
+	0xa9, 0x69, 0xe6, 0xa3, 0xc8, 0x05, 0x4b, 0xdc, 0xde, 0xf6, 0x7c, 0x94, 0x4a, 0xab, 0xc6, 0xf6,
+	0x1b, 0x35, 0xf6, 0x10, 0x7a, 0x2b, 0x96, 0x45, 0x09, 0xca, 0x0a, 0x30, 0x0c, 0xb4, 0x44, 0x99,
+	0xc8, 0x75, 0xc6, 0x7d, 0xca, 0x52, 0x95, 0xec, 0xc3, 0xa0, 0x81, 0xc9, 0x13, 0xd7, 0xf2, 0x69,
+	0x14, 0x95, 0xc8, 0xb9, 0x4c, 0xf5, 0x61, 0xb0, 0x09, 0x37, 0xf8, 0x3f, 0x6a, 0xf2, 0x9f, 0xb2,
+	0xb1, 0x60, 0x37, 0x29, 0x66, 0x62, 0x9e, 0xc7, 0x99, 0x3b, 0x56, 0x85, 0xca, 0x82, 0x9c, 0xfb,
+	0xd0, 0x95, 0x1c, 0x94, 0xe9, 0xbe, 0xc1, 0x4e, 0xa5, 0xa1, 0x63, 0x28, 0x89, 0x4c, 0x53, 0x55,
+	0x25, 0x69, 0x4c, 0x61, 0xd3, 0x29, 0x8b, 0x91, 0x4c, 0xf2, 0x41, 0xb0, 0x06, 0x28, 0x85, 0xab,
+	0x8c, 0xec, 0xe6, 0x2b, 0x26, 0x3e, 0x41, 0xce, 0xd9, 0x12, 0x29, 0xd5, 0x89, 0x2e, 0x5b, 0x34,
+	0xde, 0x73, 0x70, 0xe5, 0xb2, 0xf3, 0x3c, 0x5b, 0xc4, 0x65, 0xca, 0x64, 0xd3, 0xd4, 0x0d, 0x6c,
+	0x37, 0x3d, 0x0e, 0xa1, 0x57, 0xe2, 0xcf, 0x31, 0x14, 0xba, 0x0d, 0x6a, 0xc9, 0xfb, 0x7b, 0x07,
+	0x06, 0x73, 0xc6, 0xf1, 0x2b, 0x31, 0xf6, 0x21, 0x74, 0x43, 0xc6, 0xd1, 0x10, 0x76, 0xea, 0x9b,
+	0x2f, 0xe5, 0x20, 0x50, 0xca, 0xd9, 0xaf, 0x3b, 0xd0, 0x21, 0x99, 0xd6, 0x24, 0x64, 0xdd, 0x4e,
+	0x95, 0xf4, 0x3f, 0x43, 0x55, 0x17, 0xfa, 0x57, 0xd5, 0x8d, 0xc5, 0x55, 0x23, 0x12, 0x55, 0xe4,
+	0xf0, 0x63, 0x9b, 0xb1, 0x36, 0x44, 0x44, 0xbb, 0xc6, 0x2c, 0xca, 0xe9, 0x63, 0x45, 0xd9, 0x5a,
+	0x26, 0x4a, 0xab, 0xb1, 0xfe, 0x5c, 0x71, 0xb5, 0x81, 0xfd, 0x97, 0x44, 0x35, 0xfb, 0xfb, 0xac,
+	0xc0, 0x0c, 0x23, 0x49, 0xd7, 0x41, 0x60, 0x43, 0x6b, 0x2a, 0x4f, 0x5f, 0x49, 0xe5, 0x7d, 0x8b,
+	0xca, 0xaf, 0x4b, 0xd6, 0x3f, 0xb6, 0xe0, 0xce, 0x3c, 0xc9, 0x39, 0x9e, 0xc5, 0xbc, 0xa8, 0x04,
+	0xbe, 0x9a, 0xa8, 0xef, 0x00, 0x94, 0xc8, 0xf3, 0xa4, 0x92, 0xb7, 0x05, 0x5d, 0xdd, 0xd7, 0x08,
+	0xe5, 0xba, 0xf4, 0xe3, 0x73, 0x2c, 0x43, 0xcc, 0x04, 0x35, 0xdf, 0x3d, 0xd9, 0x5b, 0x37, 0x61,
+	0xe7, 0x11, 0x1c, 0xa8, 0x90, 0x5a, 0xa6, 0x1d, 0x69, 0x7a, 0x0b, 0xf7, 0x7e, 0x06, 0x8e, 0xb5,
+	0xef, 0x57, 0xdd, 0x07, 0xe9, 0xde, 0x50, 0x5d, 0xd5, 0xd9, 0x34, 0x0c, 0x8c, 0x48, 0x9a, 0x54,
+	0xcd, 0xa1, 0xbb, 0xa1, 0x11, 0xbd, 0xf7, 0x60, 0x62, 0xad, 0xa0, 0x2e, 0x95, 0xa9, 0x11, 0xf4,
+	0xfc, 0x6b, 0xc0, 0xbb, 0x81, 0x3b, 0x76, 0x20, 0xdf, 0x7c, 0x47, 0x33, 0x18, 0xe4, 0x8b, 0x05,
+	0x47, 0x71, 0x7e, 0xa6, 0xb7, 0x54, 0xcb, 0xeb, 0xfe, 0xd6, 0xb1, 0xfa, 0x9b, 0xf7, 0xa7, 0x36,
+	0xec, 0x5b, 0x6b, 0xcb, 0xca, 0xf0, 0x3e, 0x0c, 0x52, 0x73, 0xda, 0xea, 0x4e, 0xea, 0xfa, 0x1b,
+	0x36, 0xbe, 0x1e, 0x07, 0xb5, 0xe5, 0xec, 0x1f, 0x2d, 0xe8, 0x6b, 0xf4, 0xcb, 0xdd, 0xb5, 0xfc,
+	0x6a, 0xef, 0xf2, 0x6b, 0x6f, 0x67, 0xa4, 0x3b, 0x8d, 0x48, 0xd7, 0xbc, 0xed, 0x5a, 0xbc, 0xa5,
+	0x28, 0x54, 0x62, 0x99, 0xd3, 0x65, 0xa0, 0x27, 0xf1, 0x5a, 0x6e, 0x96, 0x9e, 0xfe, 0x6b, 0x94,
+	0x1e, 0xef, 0x0f, 0x6d, 0xb8, 0x4b, 0x51, 0x98, 0xe7, 0xd9, 0x35, 0x96, 0x5c, 0x96, 0x62, 0x19,
+	0xae, 0x8f, 0x61, 0x12, 0x5a, 0x98, 0x89, 0x99, 0xe7, 0x6f, 0xb3, 0xf6, 0x6d, 0x20, 0x68, 0x7e,
+	0x38, 0xfb, 0x73, 0x0b, 0xc6, 0xb6, 0x7e, 0x27, 0x03, 0x0e, 0xa1, 0xa7, 0xf2, 0x4f, 0x46, 0x70,
+	0x12, 0x68, 0x89, 0x4a, 0x41, 0xc2, 0xb8, 0x39, 0x28, 0x1d, 0x45, 0x1b, 0x6a, 0xfa, 0xdf, 0x79,
+	0x9d, 0xd2, 0x6b, 0x47, 0xb5, 0xdb, 0x8c, 0xaa, 0x77, 0x0e, 0x6f, 0x4b, 0x82, 0xb0, 0xf2, 0x17,
+	0xa7, 0x9c, 0x6e, 0x53, 0x6f, 0x4c, 0x61, 0x7a, 0x44, 0x51, 0x55, 0x33, 0x13, 0x38, 0xd0, 0xa1,
+	0x52, 0x68, 0xae, 0xa6, 0x34, 0xf6, 0x7e, 0xdf, 0x82, 0xc9, 0x8f, 0x59, 0x92, 0xa0, 0x78, 0xca,
+	0x12, 0x96, 0x85, 0x6f, 0xf0, 0xda, 0xbc, 0x07, 0xc3, 0x50, 0xb5, 0x54, 0x8c, 0xcc, 0x23, 0xaf,
+	0x06, 0x28, 0x8c, 0x55, 0xb6, 0xd6, 0xeb, 0x30, 0x5a, 0x90, 0xbc, 0xc0, 0x60, 0xbc, 0x5c, 0xa9,
+	0x6c, 0x9a, 0x04, 0x5a, 0xf2, 0xbe, 0x69, 0x76, 0x66, 0xee, 0x20, 0x2e, 0xf4, 0x99, 0x1a, 0x9a,
+	0xda, 0xa7, 0x45, 0xef, 0x5f, 0x2d, 0x18, 0x5f, 0x14, 0x98, 0xd5, 0xb1, 0xa2, 0xf7, 0x95, 0xde,
+	0xdf, 0x3c, 0x8f, 0x50, 0xdb, 0x37, 0xb0, 0x5d, 0xaf, 0x64, 0x7b, 0x99, 0xbd, 0xc6, 0x32, 0x74,
+	0x6c, 0x0b, 0xc4, 0xe7, 0x78, 0x8d, 0x89, 0xce, 0x9d, 0x5a, 0xa6, 0xe0, 0xa6, 0x98, 0xe6, 0xba,
+	0x65, 0xca, 0xb1, 0x5d, 0xac, 0x7b, 0xcd, 0x62, 0xed, 0xc1, 0xb8, 0xc4, 0x17, 0x55, 0x5c, 0xa2,
+	0x6c, 0x1f, 0x32, 0x7b, 0x06, 0x41, 0x03, 0xa3, 0xd5, 0x38, 0xf9, 0x74, 0x9a, 0xa8, 0xc7, 0xdd,
+	0x20, 0xa8, 0x65, 0xef, 0x8b, 0x36, 0x4c, 0xb4, 0xc3, 0xbc, 0xc8, 0x33, 0x2e, 0x93, 0x57, 0xbc,
+	0x8c, 0xa3, 0xfa, 0xdd, 0xf1, 0x32, 0x8e, 0x76, 0x7a, 0xf8, 0x08, 0x0e, 0xea, 0xf0, 0xeb, 0x63,
+	0xd7, 0xae, 0xde, 0xc2, 0x55, 0xe3, 0xba, 0x65, 0xad, 0xbc, 0xdf, 0xa2, 0x69, 0xd0, 0xa7, 0xfb,
+	0x55, 0xe8, 0x63, 0x02, 0xd7, 0xdb, 0x1e, 0xb8, 0x7e, 0x33, 0x70, 0x8d, 0x9c, 0x1b, 0xbc, 0x4e,
+	0xcd, 0xf9, 0x08, 0xc6, 0x9f, 0xe6, 0x22, 0x5e, 0xc4, 0xa1, 0xaa, 0x07, 0x53, 0x68, 0xd7, 0xe1,
+	0x6a, 0xc7, 0x91, 0x0c, 0x20, 0x5d, 0x1a, 0xf4, 0xe5, 0x8a, 0xc6, 0x84, 0x45, 0x4c, 0x30, 0x19,
+	0x9c, 0x71, 0x20, 0xc7, 0x27, 0xbf, 0x6a, 0xd7, 0xbf, 0x3f, 0x2e, 0xb0, 0xbc, 0x8e, 0x65, 0x8c,
+	0x46, 0xcf, 0x50, 0x68, 0x90, 0x3b, 0x07, 0xfe, 0xc6, 0x5f, 0x96, 0xd9, 0xa4, 0xf1, 0x2a, 0x77,
+	0xde, 0x03, 0x58, 0xdb, 0x3b, 0xfb, 0x7e, 0xf3, 0x6f, 0xca, 0x6c, 0xea, 0x5f, 0xc4, 0xcb, 0x0c,
+	0x23, 0x63, 0xf0, 0x2e, 0x4c, 0xe6, 0x25, 0x32, 0x81, 0x06, 0x18, 0x98, 0x2f, 0x66, 0x63, 0xdf,
+	0xfe, 0x4b, 0xf2, 0x6d, 0x98, 0xfc, 0xb0, 0x88, 0xb6, 0x1a, 0x1e, 0xde, 0x0a, 0xd2, 0x87, 0xf4,
+	0x4f, 0xca, 0x79, 0x02, 0x93, 0x33, 0x4c, 0x70, 0xfd, 0x49, 0x63, 0xc6, 0x5d, 0x9f, 0x9d, 0x7c,
+	0xd1, 0x86, 0xb1, 0xba, 0xf8, 0xe8, 0x10, 0x3c, 0x80, 0xc1, 0x33, 0x14, 0x12, 0x72, 0x06, 0xbe,
+	0x7e, 0xbf, 0xce, 0x26, 0x6a, 0x44, 0x24, 0x3d, 0x2d, 0x62, 0x6d, 0x74, 0xc1, 0x12, 0xe4, 0xce,
+	0xc8, 0x5f, 0x3f, 0x5f, 0x67, 0xb0, 0x7e, 0xc1, 0x39, 0xef, 0xc2, 0xf8, 0x19, 0x8a, 0xcf, 0xab,
+	0x32, 0x5c, 0x31, 0xfe, 0x65, 0x86, 0xa7, 0xb2, 0xc0, 0x13, 0xfb, 0xd4, 0xb2, 0xff, 0xef, 0xef,
+	0xba, 0xde, 0xef, 0xf4, 0xfe, 0x7b, 0x30, 0xfe, 0xa8, 0x4a, 0x16, 0x71, 0x92, 0xa8, 0x29, 0xde,
+	0x52, 0x53, 0x68, 0x8c, 0x6e, 0x85, 0x3b, 0x3f, 0x7d, 0x0c, 0xa3, 0x39, 0x11, 0x3e, 0xd9, 0xf4,
+	0x79, 0x57, 0xc8, 0x7e, 0xd7, 0xa2, 0x2f, 0x38, 0x9a, 0x88, 0xdd, 0x87, 0xfe, 0x33, 0x14, 0x84,
+	0x58, 0x1f, 0x8f, 0xd5, 0x83, 0x40, 0xc7, 0xcb, 0x93, 0xf1, 0x9a, 0xdf, 0x0e, 0xc3, 0xb0, 0x7e,
+	0x40, 0x38, 0x1f, 0xc0, 0xd8, 0xbe, 0x27, 0x3a, 0x77, 0xfd, 0x2d, 0xd7, 0xc6, 0x9d, 0x9b, 0xfa,
+	0x37, 0x6d, 0x6a, 0xc5, 0x84, 0xd9, 0xd4, 0x09, 0x8c, 0x2e, 0x30, 0x8b, 0x4c, 0x87, 0xbb, 0xe3,
+	0xdf, 0xbe, 0xdc, 0xcd, 0xa6, 0x7e, 0xf3, 0x3e, 0xf6, 0x44, 0xb2, 0x5f, 0xcb, 0xdc, 0xb9, 0x6b,
+	0xab, 0xeb, 0x0c, 0x38, 0xd8, 0xbc, 0xf5, 0x38, 0xa7, 0x70, 0x40, 0xce, 0xd9, 0x4d, 0xdb, 0xd9,
+	0xb1, 0xcd, 0xd9, 0xdb, 0x5b, 0xfb, 0xbf, 0xf3, 0x01, 0xc0, 0xba, 0x4d, 0x3a, 0x87, 0xfe, 0xd6,
+	0xbe, 0xb9, 0xd3, 0xf7, 0xdf, 0xd6, 0xad, 0xcf, 0x78, 0xff, 0x48, 0xe6, 0xa5, 0xa9, 0x64, 0x63,
+	0xdf, 0x6a, 0x9e, 0xb3, 0xa9, 0xdf, 0x6c, 0x93, 0xca, 0xd6, 0xb4, 0xa6, 0xed, 0xb6, 0x46, 0xfb,
+	0x0d, 0xe8, 0xca, 0x62, 0xed, 0x4c, 0x7c, 0xbb, 0x4b, 0xcd, 0xa6, 0x46, 0x54, 0x35, 0xfc, 0xe4,
+	0xfb, 0x70, 0xc7, 0x2e, 0x51, 0x66, 0x5b, 0xef, 0xc3, 0xf0, 0xa2, 0xba, 0xa2, 0xdf, 0x7e, 0x57,
+	0xb8, 0x33, 0x44, 0x13, 0xdf, 0xfe, 0xf4, 0x5b, 0xad, 0xa7, 0x9d, 0x9f, 0xb6, 0x8b, 0xab, 0xab,
+	0x9e, 0x34, 0xfb, 0xce, 0x7f, 0x06, 0x00, 0x3c, 0x73, 0x62, 0xc7, 0xc8, 0x16, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ListingServiceClient is the client API for ListingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ListingServiceClient interface {
+	GetListings(ctx context.Context, in *ListingsRequest, opts ...grpc.CallOption) (*ListingIndex, error)
+	GetListing(ctx context.Context, in *ListingRequest, opts ...grpc.CallOption) (*SignedListing, error)
+	CreateListing(ctx context.Context, in *Listing, opts ...grpc.CallOption) (*ListingSlug, error)
+	UpdateListing(ctx context.Context, in *Listing, opts ...grpc.CallOption) (*empty.Empty, error)
+	DeleteListing(ctx context.Context, in *ListingSlug, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type listingServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewListingServiceClient(cc *grpc.ClientConn) ListingServiceClient {
+	return &listingServiceClient{cc}
+}
+
+func (c *listingServiceClient) GetListings(ctx context.Context, in *ListingsRequest, opts ...grpc.CallOption) (*ListingIndex, error) {
+	out := new(ListingIndex)
+	err := c.cc.Invoke(ctx, "/ListingService/GetListings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) GetListing(ctx context.Context, in *ListingRequest, opts ...grpc.CallOption) (*SignedListing, error) {
+	out := new(SignedListing)
+	err := c.cc.Invoke(ctx, "/ListingService/GetListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) CreateListing(ctx context.Context, in *Listing, opts ...grpc.CallOption) (*ListingSlug, error) {
+	out := new(ListingSlug)
+	err := c.cc.Invoke(ctx, "/ListingService/CreateListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) UpdateListing(ctx context.Context, in *Listing, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ListingService/UpdateListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *listingServiceClient) DeleteListing(ctx context.Context, in *ListingSlug, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ListingService/DeleteListing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListingServiceServer is the server API for ListingService service.
+type ListingServiceServer interface {
+	GetListings(context.Context, *ListingsRequest) (*ListingIndex, error)
+	GetListing(context.Context, *ListingRequest) (*SignedListing, error)
+	CreateListing(context.Context, *Listing) (*ListingSlug, error)
+	UpdateListing(context.Context, *Listing) (*empty.Empty, error)
+	DeleteListing(context.Context, *ListingSlug) (*empty.Empty, error)
+}
+
+// UnimplementedListingServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedListingServiceServer struct {
+}
+
+func (*UnimplementedListingServiceServer) GetListings(ctx context.Context, req *ListingsRequest) (*ListingIndex, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListings not implemented")
+}
+func (*UnimplementedListingServiceServer) GetListing(ctx context.Context, req *ListingRequest) (*SignedListing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListing not implemented")
+}
+func (*UnimplementedListingServiceServer) CreateListing(ctx context.Context, req *Listing) (*ListingSlug, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateListing not implemented")
+}
+func (*UnimplementedListingServiceServer) UpdateListing(ctx context.Context, req *Listing) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateListing not implemented")
+}
+func (*UnimplementedListingServiceServer) DeleteListing(ctx context.Context, req *ListingSlug) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteListing not implemented")
+}
+
+func RegisterListingServiceServer(s *grpc.Server, srv ListingServiceServer) {
+	s.RegisterService(&_ListingService_serviceDesc, srv)
+}
+
+func _ListingService_GetListings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).GetListings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ListingService/GetListings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).GetListings(ctx, req.(*ListingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_GetListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).GetListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ListingService/GetListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).GetListing(ctx, req.(*ListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_CreateListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Listing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).CreateListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ListingService/CreateListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).CreateListing(ctx, req.(*Listing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_UpdateListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Listing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).UpdateListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ListingService/UpdateListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).UpdateListing(ctx, req.(*Listing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ListingService_DeleteListing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListingSlug)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ListingServiceServer).DeleteListing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ListingService/DeleteListing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ListingServiceServer).DeleteListing(ctx, req.(*ListingSlug))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ListingService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ListingService",
+	HandlerType: (*ListingServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetListings",
+			Handler:    _ListingService_GetListings_Handler,
+		},
+		{
+			MethodName: "GetListing",
+			Handler:    _ListingService_GetListing_Handler,
+		},
+		{
+			MethodName: "CreateListing",
+			Handler:    _ListingService_CreateListing_Handler,
+		},
+		{
+			MethodName: "UpdateListing",
+			Handler:    _ListingService_UpdateListing_Handler,
+		},
+		{
+			MethodName: "DeleteListing",
+			Handler:    _ListingService_DeleteListing_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc.proto",
+}
+
+// OrderServiceClient is the client API for OrderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type OrderServiceClient interface {
+	GetOrder(ctx context.Context, in *OrderID, opts ...grpc.CallOption) (*OrderRespApi, error)
+	GetSales(ctx context.Context, in *OrderQuery, opts ...grpc.CallOption) (*OrderList, error)
+	GetPurchases(ctx context.Context, in *OrderQuery, opts ...grpc.CallOption) (*OrderList, error)
+	ConfirmOrder(ctx context.Context, in *OrderConfirmationRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	FulfillOrder(ctx context.Context, in *OrderFulfillment, opts ...grpc.CallOption) (*empty.Empty, error)
+	CancelOrder(ctx context.Context, in *OrderID, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type orderServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewOrderServiceClient(cc *grpc.ClientConn) OrderServiceClient {
+	return &orderServiceClient{cc}
+}
+
+func (c *orderServiceClient) GetOrder(ctx context.Context, in *OrderID, opts ...grpc.CallOption) (*OrderRespApi, error) {
+	out := new(OrderRespApi)
+	err := c.cc.Invoke(ctx, "/OrderService/GetOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetSales(ctx context.Context, in *OrderQuery, opts ...grpc.CallOption) (*OrderList, error) {
+	out := new(OrderList)
+	err := c.cc.Invoke(ctx, "/OrderService/GetSales", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetPurchases(ctx context.Context, in *OrderQuery, opts ...grpc.CallOption) (*OrderList, error) {
+	out := new(OrderList)
+	err := c.cc.Invoke(ctx, "/OrderService/GetPurchases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ConfirmOrder(ctx context.Context, in *OrderConfirmationRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/OrderService/ConfirmOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) FulfillOrder(ctx context.Context, in *OrderFulfillment, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/OrderService/FulfillOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CancelOrder(ctx context.Context, in *OrderID, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/OrderService/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrderServiceServer is the server API for OrderService service.
+type OrderServiceServer interface {
+	GetOrder(context.Context, *OrderID) (*OrderRespApi, error)
+	GetSales(context.Context, *OrderQuery) (*OrderList, error)
+	GetPurchases(context.Context, *OrderQuery) (*OrderList, error)
+	ConfirmOrder(context.Context, *OrderConfirmationRequest) (*empty.Empty, error)
+	FulfillOrder(context.Context, *OrderFulfillment) (*empty.Empty, error)
+	CancelOrder(context.Context, *OrderID) (*empty.Empty, error)
+}
+
+// UnimplementedOrderServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedOrderServiceServer struct {
+}
+
+func (*UnimplementedOrderServiceServer) GetOrder(ctx context.Context, req *OrderID) (*OrderRespApi, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (*UnimplementedOrderServiceServer) GetSales(ctx context.Context, req *OrderQuery) (*OrderList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSales not implemented")
+}
+func (*UnimplementedOrderServiceServer) GetPurchases(ctx context.Context, req *OrderQuery) (*OrderList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchases not implemented")
+}
+func (*UnimplementedOrderServiceServer) ConfirmOrder(ctx context.Context, req *OrderConfirmationRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmOrder not implemented")
+}
+func (*UnimplementedOrderServiceServer) FulfillOrder(ctx context.Context, req *OrderFulfillment) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrder not implemented")
+}
+func (*UnimplementedOrderServiceServer) CancelOrder(ctx context.Context, req *OrderID) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+
+func RegisterOrderServiceServer(s *grpc.Server, srv OrderServiceServer) {
+	s.RegisterService(&_OrderService_serviceDesc, srv)
+}
+
+func _OrderService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OrderService/GetOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrder(ctx, req.(*OrderID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OrderService/GetSales",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetSales(ctx, req.(*OrderQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetPurchases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetPurchases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OrderService/GetPurchases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetPurchases(ctx, req.(*OrderQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ConfirmOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderConfirmationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ConfirmOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OrderService/ConfirmOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ConfirmOrder(ctx, req.(*OrderConfirmationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_FulfillOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderFulfillment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).FulfillOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OrderService/FulfillOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).FulfillOrder(ctx, req.(*OrderFulfillment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OrderService/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CancelOrder(ctx, req.(*OrderID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _OrderService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "OrderService",
+	HandlerType: (*OrderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOrder",
+			Handler:    _OrderService_GetOrder_Handler,
+		},
+		{
+			MethodName: "GetSales",
+			Handler:    _OrderService_GetSales_Handler,
+		},
+		{
+			MethodName: "GetPurchases",
+			Handler:    _OrderService_GetPurchases_Handler,
+		},
+		{
+			MethodName: "ConfirmOrder",
+			Handler:    _OrderService_ConfirmOrder_Handler,
+		},
+		{
+			MethodName: "FulfillOrder",
+			Handler:    _OrderService_FulfillOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _OrderService_CancelOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc.proto",
+}
+
+// CaseServiceClient is the client API for CaseService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CaseServiceClient interface {
+	GetCase(ctx context.Context, in *OrderID, opts ...grpc.CallOption) (*CaseRespApi, error)
+	GetCases(ctx context.Context, in *OrderQuery, opts ...grpc.CallOption) (*CaseList, error)
+	CloseDispute(ctx context.Context, in *CloseDisputeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type caseServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCaseServiceClient(cc *grpc.ClientConn) CaseServiceClient {
+	return &caseServiceClient{cc}
+}
+
+func (c *caseServiceClient) GetCase(ctx context.Context, in *OrderID, opts ...grpc.CallOption) (*CaseRespApi, error) {
+	out := new(CaseRespApi)
+	err := c.cc.Invoke(ctx, "/CaseService/GetCase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caseServiceClient) GetCases(ctx context.Context, in *OrderQuery, opts ...grpc.CallOption) (*CaseList, error) {
+	out := new(CaseList)
+	err := c.cc.Invoke(ctx, "/CaseService/GetCases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *caseServiceClient) CloseDispute(ctx context.Context, in *CloseDisputeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/CaseService/CloseDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CaseServiceServer is the server API for CaseService service.
+type CaseServiceServer interface {
+	GetCase(context.Context, *OrderID) (*CaseRespApi, error)
+	GetCases(context.Context, *OrderQuery) (*CaseList, error)
+	CloseDispute(context.Context, *CloseDisputeRequest) (*empty.Empty, error)
+}
+
+// UnimplementedCaseServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedCaseServiceServer struct {
+}
+
+func (*UnimplementedCaseServiceServer) GetCase(ctx context.Context, req *OrderID) (*CaseRespApi, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCase not implemented")
+}
+func (*UnimplementedCaseServiceServer) GetCases(ctx context.Context, req *OrderQuery) (*CaseList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCases not implemented")
+}
+func (*UnimplementedCaseServiceServer) CloseDispute(ctx context.Context, req *CloseDisputeRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseDispute not implemented")
+}
+
+func RegisterCaseServiceServer(s *grpc.Server, srv CaseServiceServer) {
+	s.RegisterService(&_CaseService_serviceDesc, srv)
+}
+
+func _CaseService_GetCase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseServiceServer).GetCase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CaseService/GetCase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseServiceServer).GetCase(ctx, req.(*OrderID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaseService_GetCases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseServiceServer).GetCases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CaseService/GetCases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseServiceServer).GetCases(ctx, req.(*OrderQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CaseService_CloseDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CaseServiceServer).CloseDispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CaseService/CloseDispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CaseServiceServer).CloseDispute(ctx, req.(*CloseDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CaseService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "CaseService",
+	HandlerType: (*CaseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCase",
+			Handler:    _CaseService_GetCase_Handler,
+		},
+		{
+			MethodName: "GetCases",
+			Handler:    _CaseService_GetCases_Handler,
+		},
+		{
+			MethodName: "CloseDispute",
+			Handler:    _CaseService_CloseDispute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc.proto",
+}
+
+// ChatServiceClient is the client API for ChatService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ChatServiceClient interface {
+	SendMessage(ctx context.Context, in *ChatMessageRequest, opts ...grpc.CallOption) (*ChatMessageID, error)
+	GetMessages(ctx context.Context, in *ChatMessagesRequest, opts ...grpc.CallOption) (*ChatMessageList, error)
+	GetConversations(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ChatConversationList, error)
+	MarkAsRead(ctx context.Context, in *ChatMarkAsReadRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+}
+
+type chatServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewChatServiceClient(cc *grpc.ClientConn) ChatServiceClient {
+	return &chatServiceClient{cc}
+}
+
+func (c *chatServiceClient) SendMessage(ctx context.Context, in *ChatMessageRequest, opts ...grpc.CallOption) (*ChatMessageID, error) {
+	out := new(ChatMessageID)
+	err := c.cc.Invoke(ctx, "/ChatService/SendMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetMessages(ctx context.Context, in *ChatMessagesRequest, opts ...grpc.CallOption) (*ChatMessageList, error) {
+	out := new(ChatMessageList)
+	err := c.cc.Invoke(ctx, "/ChatService/GetMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetConversations(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ChatConversationList, error) {
+	out := new(ChatConversationList)
+	err := c.cc.Invoke(ctx, "/ChatService/GetConversations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) MarkAsRead(ctx context.Context, in *ChatMarkAsReadRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/ChatService/MarkAsRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChatServiceServer is the server API for ChatService service.
+type ChatServiceServer interface {
+	SendMessage(context.Context, *ChatMessageRequest) (*ChatMessageID, error)
+	GetMessages(context.Context, *ChatMessagesRequest) (*ChatMessageList, error)
+	GetConversations(context.Context, *empty.Empty) (*ChatConversationList, error)
+	MarkAsRead(context.Context, *ChatMarkAsReadRequest) (*empty.Empty, error)
+}
+
+// UnimplementedChatServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedChatServiceServer struct {
+}
+
+func (*UnimplementedChatServiceServer) SendMessage(ctx context.Context, req *ChatMessageRequest) (*ChatMessageID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMessage not implemented")
+}
+func (*UnimplementedChatServiceServer) GetMessages(ctx context.Context, req *ChatMessagesRequest) (*ChatMessageList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessages not implemented")
+}
+func (*UnimplementedChatServiceServer) GetConversations(ctx context.Context, req *empty.Empty) (*ChatConversationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversations not implemented")
+}
+func (*UnimplementedChatServiceServer) MarkAsRead(ctx context.Context, req *ChatMarkAsReadRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAsRead not implemented")
+}
+
+func RegisterChatServiceServer(s *grpc.Server, srv ChatServiceServer) {
+	s.RegisterService(&_ChatService_serviceDesc, srv)
+}
+
+func _ChatService_SendMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).SendMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ChatService/SendMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).SendMessage(ctx, req.(*ChatMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ChatService/GetMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetMessages(ctx, req.(*ChatMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ChatService/GetConversations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetConversations(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_MarkAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatMarkAsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).MarkAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ChatService/MarkAsRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).MarkAsRead(ctx, req.(*ChatMarkAsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ChatService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ChatService",
+	HandlerType: (*ChatServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendMessage",
+			Handler:    _ChatService_SendMessage_Handler,
+		},
+		{
+			MethodName: "GetMessages",
+			Handler:    _ChatService_GetMessages_Handler,
+		},
+		{
+			MethodName: "GetConversations",
+			Handler:    _ChatService_GetConversations_Handler,
+		},
+		{
+			MethodName: "MarkAsRead",
+			Handler:    _ChatService_MarkAsRead_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc.proto",
+}
+
+// WalletServiceClient is the client API for WalletService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WalletServiceClient interface {
+	GetBalance(ctx context.Context, in *CoinRequest, opts ...grpc.CallOption) (*WalletBalance, error)
+	GetAddress(ctx context.Context, in *CoinRequest, opts ...grpc.CallOption) (*WalletAddress, error)
+	Spend(ctx context.Context, in *SpendRequest, opts ...grpc.CallOption) (*SpendResponse, error)
+}
+
+type walletServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWalletServiceClient(cc *grpc.ClientConn) WalletServiceClient {
+	return &walletServiceClient{cc}
+}
+
+func (c *walletServiceClient) GetBalance(ctx context.Context, in *CoinRequest, opts ...grpc.CallOption) (*WalletBalance, error) {
+	out := new(WalletBalance)
+	err := c.cc.Invoke(ctx, "/WalletService/GetBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetAddress(ctx context.Context, in *CoinRequest, opts ...grpc.CallOption) (*WalletAddress, error) {
+	out := new(WalletAddress)
+	err := c.cc.Invoke(ctx, "/WalletService/GetAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) Spend(ctx context.Context, in *SpendRequest, opts ...grpc.CallOption) (*SpendResponse, error) {
+	out := new(SpendResponse)
+	err := c.cc.Invoke(ctx, "/WalletService/Spend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletServiceServer is the server API for WalletService service.
+type WalletServiceServer interface {
+	GetBalance(context.Context, *CoinRequest) (*WalletBalance, error)
+	GetAddress(context.Context, *CoinRequest) (*WalletAddress, error)
+	Spend(context.Context, *SpendRequest) (*SpendResponse, error)
+}
+
+// UnimplementedWalletServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedWalletServiceServer struct {
+}
+
+func (*UnimplementedWalletServiceServer) GetBalance(ctx context.Context, req *CoinRequest) (*WalletBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
+}
+func (*UnimplementedWalletServiceServer) GetAddress(ctx context.Context, req *CoinRequest) (*WalletAddress, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddress not implemented")
+}
+func (*UnimplementedWalletServiceServer) Spend(ctx context.Context, req *SpendRequest) (*SpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Spend not implemented")
+}
+
+func RegisterWalletServiceServer(s *grpc.Server, srv WalletServiceServer) {
+	s.RegisterService(&_WalletService_serviceDesc, srv)
+}
+
+func _WalletService_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WalletService/GetBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetBalance(ctx, req.(*CoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WalletService/GetAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetAddress(ctx, req.(*CoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_Spend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).Spend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/WalletService/Spend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).Spend(ctx, req.(*SpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _WalletService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "WalletService",
+	HandlerType: (*WalletServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBalance",
+			Handler:    _WalletService_GetBalance_Handler,
+		},
+		{
+			MethodName: "GetAddress",
+			Handler:    _WalletService_GetAddress_Handler,
+		},
+		{
+			MethodName: "Spend",
+			Handler:    _WalletService_Spend_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc.proto",
+}
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NotificationServiceClient interface {
+	// Subscribe streams every notification broadcast by the node
+	// from the time of the call
+	Subscribe(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (NotificationService_SubscribeClient, error)
+}
+
+type notificationServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNotificationServiceClient(cc *grpc.ClientConn) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) Subscribe(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (NotificationService_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NotificationService_serviceDesc.Streams[0], "/NotificationService/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &notificationServiceSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NotificationService_SubscribeClient interface {
+	Recv() (*Notification, error)
+	grpc.ClientStream
+}
+
+type notificationServiceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *notificationServiceSubscribeClient) Recv() (*Notification, error) {
+	m := new(Notification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// NotificationServiceServer is the server API for NotificationService service.
+type NotificationServiceServer interface {
+	// Subscribe streams every notification broadcast by the node
+	// from the time of the call
+	Subscribe(*empty.Empty, NotificationService_SubscribeServer) error
+}
+
+// UnimplementedNotificationServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedNotificationServiceServer struct {
+}
+
+func (*UnimplementedNotificationServiceServer) Subscribe(req *empty.Empty, srv NotificationService_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+
+func RegisterNotificationServiceServer(s *grpc.Server, srv NotificationServiceServer) {
+	s.RegisterService(&_NotificationService_serviceDesc, srv)
+}
+
+func _NotificationService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotificationServiceServer).Subscribe(m, &notificationServiceSubscribeServer{stream})
+}
+
+type NotificationService_SubscribeServer interface {
+	Send(*Notification) error
+	grpc.ServerStream
+}
+
+type notificationServiceSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *notificationServiceSubscribeServer) Send(m *Notification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _NotificationService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _NotificationService_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpc.proto",
+}
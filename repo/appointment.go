@@ -0,0 +1,37 @@
+package repo
+
+import "time"
+
+// AppointmentState is the state of a booked appointment
+type AppointmentState string
+
+const (
+	// AppointmentReserved is a funded booking the vendor has not confirmed
+	AppointmentReserved AppointmentState = "reserved"
+	// AppointmentConfirmed is a booking confirmed or rescheduled by the vendor
+	AppointmentConfirmed AppointmentState = "confirmed"
+	// AppointmentCancelled is a booking released by a refund
+	AppointmentCancelled AppointmentState = "cancelled"
+)
+
+// Appointment is a slot of a service listing booked by an order. Vendor is
+// set on the appointments of sales and PeerID is the other party.
+type Appointment struct {
+	OrderID         string           `json:"orderId"`
+	Vendor          bool             `json:"vendor"`
+	Slug            string           `json:"slug"`
+	Title           string           `json:"title"`
+	SlotID          string           `json:"slotId"`
+	Start           time.Time        `json:"start"`
+	DurationMinutes uint32           `json:"durationMinutes"`
+	Quantity        uint64           `json:"quantity"`
+	Location        string           `json:"location"`
+	PeerID          string           `json:"peerId"`
+	State           AppointmentState `json:"state"`
+	Timestamp       time.Time        `json:"timestamp"`
+}
+
+// End returns the time the appointment ends
+func (a Appointment) End() time.Time {
+	return a.Start.Add(time.Duration(a.DurationMinutes) * time.Minute)
+}
@@ -11,12 +11,25 @@ type CheckoutCurrency struct {
 	Divisibility int    `json:"divisibility"`
 }
 
+// CheckoutShipping is the shipping charged for the items sent with one
+// service. Rate is flat, weight or quantity.
+type CheckoutShipping struct {
+	Option   string `json:"option"`
+	Service  string `json:"service"`
+	Rate     string `json:"rate"`
+	Quantity uint64 `json:"quantity"`
+	Grams    uint64 `json:"grams,omitempty"`
+	Price    string `json:"price"`
+}
+
 type CheckoutBreakdown struct {
-	BasePrice       string `json:"basePrice"`
-	Coupon          string `json:"coupon"`
-	OptionSurcharge string `json:"optionSurcharge"`
-	Quantity        string `json:"quantity"`
-	ShippingPrice   string `json:"shippingPrice"`
-	Tax             string `json:"tax"`
-	TotalPrice      string `json:"totalPrice"`
+	BasePrice       string             `json:"basePrice"`
+	Coupon          string             `json:"coupon"`
+	OptionSurcharge string             `json:"optionSurcharge"`
+	Quantity        string             `json:"quantity"`
+	ShippingPrice   string             `json:"shippingPrice"`
+	Shipping        []CheckoutShipping `json:"shipping"`
+	Tax             string             `json:"tax"`
+	TaxInclusive    bool               `json:"taxInclusive"`
+	TotalPrice      string             `json:"totalPrice"`
 }
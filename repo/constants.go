@@ -7,6 +7,8 @@ const (
 	DisputeTotalDurationHours int = 45 * 24
 
 	NotifierTypeBuyerDisputeTimeout           NotificationType = "buyerDisputeTimeout"
+	NotifierTypeBackorderReleaseNotification  NotificationType = "backorderRelease"
+	NotifierTypeAppointmentNotification       NotificationType = "appointment"
 	NotifierTypeBuyerDisputeExpiry            NotificationType = "buyerDisputeExpiry"
 	NotifierTypeChatMessage                   NotificationType = "chatMessage"
 	NotifierTypeChatRead                      NotificationType = "chatRead"
@@ -32,6 +34,8 @@ const (
 	NotifierTypeProcessingErrorNotification   NotificationType = "processingError"
 	NotifierTypeRefundNotification            NotificationType = "refund"
 	NotifierTypeStatusUpdateNotification      NotificationType = "statusUpdate"
+	NotifierTypeSubscriptionNotification      NotificationType = "subscription"
+	NotifierTypeSubscriptionPayment           NotificationType = "subscriptionPayment"
 	NotifierTypeTestNotification              NotificationType = "testNotification"
 	NotifierTypeUnfollowNotification          NotificationType = "unfollow"
 	NotifierTypeVendorDisputeTimeout          NotificationType = "vendorDisputeTimeout"
@@ -0,0 +1,49 @@
+package repo
+
+import "time"
+
+// CrowdfundState is the state of a crowdfund campaign
+type CrowdfundState string
+
+const (
+	// CrowdfundFunding campaigns are accepting pledges below their goal
+	CrowdfundFunding CrowdfundState = "funding"
+	// CrowdfundFunded campaigns have reached their goal before the deadline
+	// and are still accepting pledges
+	CrowdfundFunded CrowdfundState = "funded"
+	// CrowdfundSuccessful campaigns reached their goal and are past the
+	// deadline
+	CrowdfundSuccessful CrowdfundState = "successful"
+	// CrowdfundFailed campaigns passed the deadline below their goal. Their
+	// pledges are refunded.
+	CrowdfundFailed CrowdfundState = "failed"
+)
+
+// Ended indicates whether the campaign is past its deadline
+func (s CrowdfundState) Ended() bool {
+	return s == CrowdfundSuccessful || s == CrowdfundFailed
+}
+
+// GoalReached indicates whether the pledges have reached the goal, after
+// which the vendor may fulfill them
+func (s CrowdfundState) GoalReached() bool {
+	return s == CrowdfundFunded || s == CrowdfundSuccessful
+}
+
+// CrowdfundProgress is the progress of a crowdfund listing towards its
+// funding goal, published in the listing index
+type CrowdfundProgress struct {
+	Goal     *CurrencyValue  `json:"goal"`
+	Pledged  *CurrencyValue  `json:"pledged"`
+	Backers  uint32          `json:"backers"`
+	Deadline time.Time       `json:"deadline"`
+	State    CrowdfundState  `json:"state"`
+	Tiers    []CrowdfundTier `json:"tiers,omitempty"`
+}
+
+// CrowdfundTier counts the units pledged for one of the skus of a
+// crowdfund listing
+type CrowdfundTier struct {
+	Name    string `json:"name"`
+	Pledges uint64 `json:"pledges"`
+}
@@ -0,0 +1,16 @@
+package repo
+
+import "time"
+
+// CryptoPayout is a payment sent by the node to fulfill an order item of a
+// cryptocurrency listing. Amount is in the base units of the coin. Txid is
+// empty while the payment is being sent.
+type CryptoPayout struct {
+	OrderID   string    `json:"orderId"`
+	Item      int       `json:"item"`
+	Coin      string    `json:"coin"`
+	Amount    string    `json:"amount"`
+	Address   string    `json:"address"`
+	Txid      string    `json:"txid"`
+	Timestamp time.Time `json:"timestamp"`
+}
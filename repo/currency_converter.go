@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"strings"
 )
 
 var (
@@ -26,6 +25,37 @@ func NewEquivalentConverter() *CurrencyConverter {
 	return &CurrencyConverter{reserveCode: "EQL", reserveRater: equivRater{}}
 }
 
+// fixedRater returns the rates of a map, keyed by the mainnet currency codes
+type fixedRater map[string]float64
+
+func (r fixedRater) GetExchangeRate(code string) (float64, error) {
+	rate, ok := r[code]
+	if !ok {
+		return 0.0, fmt.Errorf("no fixed rate for (%s)", code)
+	}
+	return rate, nil
+}
+
+// recordingRater remembers each rate returned by the rater it wraps
+type recordingRater struct {
+	rater    rater
+	recorded map[string]float64
+}
+
+func (r recordingRater) GetExchangeRate(code string) (float64, error) {
+	rate, err := r.rater.GetExchangeRate(code)
+	if err == nil {
+		r.recorded[code] = rate
+	}
+	return rate, err
+}
+
+// NewFixedRateConverter returns a currency converter using only the rates,
+// such as those recorded by a converter from Recording
+func NewFixedRateConverter(reserveCode string, rates map[string]float64) (*CurrencyConverter, error) {
+	return NewCurrencyConverter(reserveCode, fixedRater(rates))
+}
+
 // CurrencyConverter is suitable for converting a currency from one CurrencyDefinition
 // to another, accounting for their differing divisibility as well as their differing
 // exchange rate as provided by the rater. The rater can represent all rates for the
@@ -47,9 +77,23 @@ func NewCurrencyConverter(reserveCode string, rater rater) (*CurrencyConverter,
 	return cc, nil
 }
 
+// ReserveCode returns the code of the reserve currency the rates are in
+func (c CurrencyConverter) ReserveCode() string {
+	return c.reserveCode
+}
+
+// Recording returns a converter with the same rates which records every rate
+// it uses in the returned map
+func (c CurrencyConverter) Recording() (*CurrencyConverter, map[string]float64) {
+	recorded := make(map[string]float64)
+	return &CurrencyConverter{
+		reserveCode:  c.reserveCode,
+		reserveRater: recordingRater{rater: c.reserveRater, recorded: recorded},
+	}, recorded
+}
+
 func (c CurrencyConverter) getExchangeRate(code string) (float64, error) {
-	// TODO: remove hack once ExchangeRates can be made aware of testnet currencies
-	r, err := c.reserveRater.GetExchangeRate(strings.TrimPrefix(code, "T"))
+	r, err := c.reserveRater.GetExchangeRate(MainnetCurrencyCode(code))
 	if err != nil {
 		return 0.0, fmt.Errorf("get rate for (%s): %s", code, err.Error())
 	}
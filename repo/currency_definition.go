@@ -337,6 +337,17 @@ func TestnetCurrencies() *CurrencyDictionary {
 	return validatedTestnetCurrencies
 }
 
+// MainnetCurrencyCode returns the code of the mainnet coin for a testnet coin
+// and any other code unchanged. Exchange rates are only kept for mainnet
+// coins.
+func MainnetCurrencyCode(code string) string {
+	def, err := TestnetCurrencies().Lookup(code)
+	if err != nil {
+		return code
+	}
+	return strings.TrimPrefix(def.Code.String(), "T")
+}
+
 // FiatCurrencies returns the mainnet crypto currency definition singleton
 func FiatCurrencies() *CurrencyDictionary {
 	return validatedFiatCurrencies
@@ -233,6 +233,21 @@ func TestCurrencyDictionaryValid(t *testing.T) {
 	}
 }
 
+func TestMainnetCurrencyCode(t *testing.T) {
+	for code, expected := range map[string]string{
+		"TBTC": "BTC",
+		"tzec": "ZEC",
+		"BTC":  "BTC",
+		"TRY":  "TRY",
+		"THB":  "THB",
+		"USD":  "USD",
+	} {
+		if actual := repo.MainnetCurrencyCode(code); actual != expected {
+			t.Errorf("expected the mainnet code of %s to be %s, got %s", code, expected, actual)
+		}
+	}
+}
+
 func TestNilCodeCollision(t *testing.T) {
 	subject := repo.NilCurrencyCode
 	if _, err := repo.AllCurrencies().Lookup(subject.String()); err == nil {
@@ -2,6 +2,7 @@ package repo
 
 import (
 	"database/sql"
+	"errors"
 	"math/big"
 
 	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
@@ -13,6 +14,13 @@ import (
 	btc "github.com/btcsuite/btcutil"
 )
 
+// ErrIncorrectPassword is returned when the password does not decrypt the
+// database
+var ErrIncorrectPassword = errors.New("incorrect database password")
+
+// DatabasePasswordMinLength is the length a new database password must have
+const DatabasePasswordMinLength = 8
+
 type Datastore interface {
 	Config() Config
 	Followers() FollowerStore
@@ -30,6 +38,13 @@ type Datastore interface {
 	TxMetadata() TransactionMetadataStore
 	ModeratedStores() ModeratedStore
 	Messages() MessageStore
+	PendingSpends() PendingSpendStore
+	Outbox() OutboxStore
+	ExchangeRates() ExchangeRateStore
+	Subscriptions() SubscriptionStore
+	DigitalGoods() DigitalGoodsStore
+	CryptoPayouts() CryptoPayoutStore
+	Appointments() AppointmentStore
 	Ping() error
 	Close()
 }
@@ -59,6 +74,17 @@ type Config interface {
 
 	// Returns true if the database has failed to decrypt properly ex) wrong pw
 	IsEncrypted() bool
+
+	// Change the password of the encrypted database. The new password is
+	// verified before returning and the old one restored if it fails.
+	Rekey(oldPassword, newPassword string) error
+
+	// Return the last authenticator code counter accepted for the TOTP
+	// secret with the given ID, or zero if no code was accepted
+	GetTOTPCounter(secretID string) (int64, error)
+
+	// Save the last authenticator code counter accepted for the secret
+	PutTOTPCounter(secretID string, counter int64) error
 }
 
 type FollowerStore interface {
@@ -214,6 +240,9 @@ type PurchaseStore interface {
 	// Return the number of purchases in the database
 	Count() int
 
+	// Return the number of purchases in each order state
+	CountByState() (map[pb.OrderState]int, error)
+
 	// GetPurchasesForDisputeTimeoutNotification returns []*PurchaseRecord including
 	// each record which needs buyerDisputeTimeout Notifications to be generated.
 	GetPurchasesForDisputeTimeoutNotification() ([]*PurchaseRecord, error)
@@ -262,6 +291,9 @@ type SaleStore interface {
 	// Return the number of sales in the database
 	Count() int
 
+	// Return the number of sales in each order state
+	CountByState() (map[pb.OrderState]int, error)
+
 	// GetSalesForDisputeTimeoutNotification returns []*SaleRecord including
 	// each record which needs Notifications to be generated.
 	GetSalesForDisputeTimeoutNotification() ([]*SaleRecord, error)
@@ -451,3 +483,137 @@ type MessageStore interface {
 	// with GetAllErrored
 	MarkAsResolved(OrderMessage) error
 }
+
+// PendingSpendStore is the pendingspends table interface
+type PendingSpendStore interface {
+	Queryable
+
+	// Put a spend awaiting approval
+	Put(spend PendingSpend) error
+
+	// Get a pending spend by its ID
+	Get(id string) (*PendingSpend, error)
+
+	// GetAll returns the pending spends, oldest first
+	GetAll() ([]PendingSpend, error)
+
+	// Delete a pending spend once it is approved or cancelled
+	Delete(id string) error
+}
+
+// OutboxStore is the outbox table interface
+type OutboxStore interface {
+	Queryable
+
+	// Put an offline message which has been stored for its recipient
+	Put(msg OutboxMessage) error
+
+	// Get an outbox message by its pointer ID
+	Get(pointerID string) (*OutboxMessage, error)
+
+	// GetAll returns the outbox messages with the given status, or all
+	// messages if the status is empty, newest first
+	GetAll(status OutboxStatus) ([]OutboxMessage, error)
+
+	// SetStatus updates the status of a message
+	SetStatus(pointerID string, status OutboxStatus, t time.Time) error
+
+	// SetDeleteFailure records the number of failed attempts to delete the
+	// stored copy of a message and the last error
+	SetDeleteFailure(pointerID string, attempts int, lastError string) error
+}
+
+// ExchangeRateStore is the exchangerates table interface. Rates are the
+// price of one reserve coin in each currency.
+type ExchangeRateStore interface {
+	Queryable
+
+	// Put records the rates of the currencies at a time
+	Put(rates map[string]float64, t time.Time) error
+
+	// GetAt returns the last rate of the currency recorded at or before the
+	// time, and when it was recorded
+	GetAt(code string, t time.Time) (float64, time.Time, error)
+}
+
+// SubscriptionStore is the subscriptions table interface
+type SubscriptionStore interface {
+	Queryable
+
+	// Put inserts or updates a subscription
+	Put(sub Subscription) error
+
+	// Get a subscription by its ID
+	Get(id string) (*Subscription, error)
+
+	// GetAll returns the subscriptions, newest first
+	GetAll() ([]Subscription, error)
+
+	// GetDue returns the active buyer subscriptions whose next order is at
+	// or before the time
+	GetDue(t time.Time) ([]Subscription, error)
+}
+
+// DigitalGoodsStore is the interface to the files and license key pools
+// used to fulfill digital goods automatically
+type DigitalGoodsStore interface {
+	Queryable
+
+	// PutFile inserts or replaces the file of a listing variant
+	PutFile(file DigitalFile) error
+
+	// GetFile returns the file of a listing variant
+	GetFile(slug string, variant int) (*DigitalFile, error)
+
+	// GetFiles returns the files of a listing without their content
+	GetFiles(slug string) ([]DigitalFile, error)
+
+	// AddLicenseKeys adds keys to the pool of a listing variant. Keys
+	// already in the pool are skipped.
+	AddLicenseKeys(slug string, variant int, keys []string) error
+
+	// AssignLicenseKeys returns count keys from the pool for the order. Keys
+	// already assigned to the order are returned first. If the pool runs
+	// out, no keys are assigned and ErrLicenseKeysDepleted is returned.
+	AssignLicenseKeys(slug string, variant int, orderID string, count int) ([]string, error)
+
+	// GetLicenseKeyPools returns the pools of a listing by variant
+	GetLicenseKeyPools(slug string) ([]LicenseKeyPool, error)
+}
+
+// CryptoPayoutStore is the interface to the payments sent to fulfill
+// cryptocurrency listings automatically
+type CryptoPayoutStore interface {
+	Queryable
+
+	// Put records a payout
+	Put(payout CryptoPayout) error
+
+	// Delete removes the payout of an order item
+	Delete(orderID string, item int) error
+
+	// GetByOrderID returns the payouts of an order by item
+	GetByOrderID(orderID string) ([]CryptoPayout, error)
+
+	// GetSince returns the payouts of the coin made at or after the time
+	GetSince(coin string, t time.Time) ([]CryptoPayout, error)
+}
+
+// AppointmentStore is the interface to the slots of service listings booked
+// by the node's sales and purchases
+type AppointmentStore interface {
+	Queryable
+
+	// Put inserts or updates an appointment
+	Put(appointment Appointment) error
+
+	// Get returns the appointment of a sale, if vendor is set, or a purchase
+	Get(orderID string, vendor bool) (Appointment, error)
+
+	// GetAll returns the appointments of the node by start time
+	GetAll() ([]Appointment, error)
+
+	// GetBookedQuantity returns the quantity of a slot booked by the sales
+	// which were not cancelled
+	GetBookedQuantity(slug, slotID string) (uint64, error)
+}
@@ -0,0 +1,99 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const appointmentColumns = "orderID, vendor, slug, title, slotID, start, duration, quantity, location, peerID, state, timestamp"
+
+type AppointmentsDB struct {
+	modelStore
+}
+
+func NewAppointmentStore(db *sql.DB, lock *sync.Mutex) repo.AppointmentStore {
+	return &AppointmentsDB{modelStore{db, lock}}
+}
+
+func (a *AppointmentsDB) Put(appointment repo.Appointment) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	stmt, err := a.PrepareQuery("insert or replace into appointments(" + appointmentColumns + ") values(?,?,?,?,?,?,?,?,?,?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare appointment sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(appointment.OrderID, appointment.Vendor, appointment.Slug, appointment.Title, appointment.SlotID,
+		appointment.Start.Unix(), appointment.DurationMinutes, appointment.Quantity, appointment.Location,
+		appointment.PeerID, string(appointment.State), appointment.Timestamp.Unix())
+	if err != nil {
+		return fmt.Errorf("commit appointment: %s", err.Error())
+	}
+	return nil
+}
+
+func (a *AppointmentsDB) Get(orderID string, vendor bool) (repo.Appointment, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	rows, err := a.db.Query("select "+appointmentColumns+" from appointments where orderID=? and vendor=?", orderID, vendor)
+	if err != nil {
+		return repo.Appointment{}, err
+	}
+	defer rows.Close()
+	appointments, err := scanAppointments(rows)
+	if err != nil {
+		return repo.Appointment{}, err
+	}
+	if len(appointments) == 0 {
+		return repo.Appointment{}, sql.ErrNoRows
+	}
+	return appointments[0], nil
+}
+
+func (a *AppointmentsDB) GetAll() ([]repo.Appointment, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	rows, err := a.db.Query("select " + appointmentColumns + " from appointments order by start, orderID")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanAppointments(rows)
+}
+
+func (a *AppointmentsDB) GetBookedQuantity(slug, slotID string) (uint64, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	var quantity sql.NullInt64
+	err := a.db.QueryRow("select sum(quantity) from appointments where vendor=1 and slug=? and slotID=? and state!=?",
+		slug, slotID, string(repo.AppointmentCancelled)).Scan(&quantity)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(quantity.Int64), nil
+}
+
+func scanAppointments(rows *sql.Rows) ([]repo.Appointment, error) {
+	var ret []repo.Appointment
+	for rows.Next() {
+		var (
+			appointment      repo.Appointment
+			state            string
+			start, timestamp int64
+		)
+		if err := rows.Scan(&appointment.OrderID, &appointment.Vendor, &appointment.Slug, &appointment.Title,
+			&appointment.SlotID, &start, &appointment.DurationMinutes, &appointment.Quantity, &appointment.Location,
+			&appointment.PeerID, &state, &timestamp); err != nil {
+			return nil, err
+		}
+		appointment.State = repo.AppointmentState(state)
+		appointment.Start = time.Unix(start, 0)
+		appointment.Timestamp = time.Unix(timestamp, 0)
+		ret = append(ret, appointment)
+	}
+	return ret, rows.Err()
+}
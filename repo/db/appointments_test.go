@@ -0,0 +1,94 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewAppointmentStore() (repo.AppointmentStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewAppointmentStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestAppointmentsDB(t *testing.T) {
+	appointmentDB, teardown, err := buildNewAppointmentStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	start := time.Unix(1500000000, 0)
+	for _, appointment := range []repo.Appointment{
+		{OrderID: "order1", Vendor: true, Slug: "repair", SlotID: "mon", Start: start.Add(time.Hour), DurationMinutes: 60, Quantity: 2, State: repo.AppointmentReserved},
+		{OrderID: "order2", Vendor: true, Slug: "repair", SlotID: "mon", Start: start.Add(time.Hour), DurationMinutes: 60, Quantity: 1, State: repo.AppointmentConfirmed},
+		{OrderID: "order3", Vendor: true, Slug: "repair", SlotID: "mon", Start: start.Add(time.Hour), DurationMinutes: 60, Quantity: 4, State: repo.AppointmentCancelled},
+		{OrderID: "order4", Vendor: true, Slug: "repair", SlotID: "tue", Start: start.Add(25 * time.Hour), DurationMinutes: 60, Quantity: 1, State: repo.AppointmentReserved},
+		// a purchase of the same slot doesn't reserve the node's own capacity
+		{OrderID: "order5", Vendor: false, Slug: "repair", SlotID: "mon", Start: start, DurationMinutes: 30, Quantity: 1, State: repo.AppointmentConfirmed},
+	} {
+		if err := appointmentDB.Put(appointment); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	booked, err := appointmentDB.GetBookedQuantity("repair", "mon")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if booked != 3 {
+		t.Errorf("expected 3 booked, got %d", booked)
+	}
+	booked, err = appointmentDB.GetBookedQuantity("repair", "wed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if booked != 0 {
+		t.Errorf("expected an unbooked slot, got %d", booked)
+	}
+
+	appointment, err := appointmentDB.Get("order1", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if appointment.Slug != "repair" || appointment.Quantity != 2 || !appointment.Start.Equal(start.Add(time.Hour)) || appointment.State != repo.AppointmentReserved {
+		t.Errorf("unexpected appointment %+v", appointment)
+	}
+	if _, err := appointmentDB.Get("order1", false); err == nil {
+		t.Error("expected no purchase appointment for order1")
+	}
+
+	appointment.State = repo.AppointmentConfirmed
+	appointment.SlotID = "tue"
+	if err := appointmentDB.Put(appointment); err != nil {
+		t.Fatal(err)
+	}
+	if booked, _ = appointmentDB.GetBookedQuantity("repair", "tue"); booked != 3 {
+		t.Errorf("expected the rescheduled booking to move slot, got %d booked", booked)
+	}
+
+	all, err := appointmentDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 5 || all[0].OrderID != "order5" {
+		t.Errorf("expected 5 appointments by start time, got %+v", all)
+	}
+}
@@ -0,0 +1,80 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+const cryptoPayoutColumns = "orderID, item, coin, amount, address, txid, timestamp"
+
+type CryptoPayoutsDB struct {
+	modelStore
+}
+
+func NewCryptoPayoutStore(db *sql.DB, lock *sync.Mutex) repo.CryptoPayoutStore {
+	return &CryptoPayoutsDB{modelStore{db, lock}}
+}
+
+func (c *CryptoPayoutsDB) Put(payout repo.CryptoPayout) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stmt, err := c.PrepareQuery("insert or replace into cryptopayouts(" + cryptoPayoutColumns + ") values(?,?,?,?,?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare crypto payout sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(payout.OrderID, payout.Item, payout.Coin, payout.Amount, payout.Address, payout.Txid, payout.Timestamp.Unix())
+	if err != nil {
+		return fmt.Errorf("commit crypto payout: %s", err.Error())
+	}
+	return nil
+}
+
+func (c *CryptoPayoutsDB) Delete(orderID string, item int) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cryptopayouts where orderID=? and item=?", orderID, item)
+	return err
+}
+
+func (c *CryptoPayoutsDB) GetByOrderID(orderID string) ([]repo.CryptoPayout, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	rows, err := c.db.Query("select "+cryptoPayoutColumns+" from cryptopayouts where orderID=? order by item", orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanCryptoPayouts(rows)
+}
+
+func (c *CryptoPayoutsDB) GetSince(coin string, t time.Time) ([]repo.CryptoPayout, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	rows, err := c.db.Query("select "+cryptoPayoutColumns+" from cryptopayouts where coin=? and timestamp>=? order by timestamp", coin, t.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanCryptoPayouts(rows)
+}
+
+func scanCryptoPayouts(rows *sql.Rows) ([]repo.CryptoPayout, error) {
+	var ret []repo.CryptoPayout
+	for rows.Next() {
+		var (
+			payout    repo.CryptoPayout
+			timestamp int64
+		)
+		if err := rows.Scan(&payout.OrderID, &payout.Item, &payout.Coin, &payout.Amount, &payout.Address, &payout.Txid, &timestamp); err != nil {
+			return nil, err
+		}
+		payout.Timestamp = time.Unix(timestamp, 0)
+		ret = append(ret, payout)
+	}
+	return ret, rows.Err()
+}
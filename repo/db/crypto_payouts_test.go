@@ -0,0 +1,76 @@
+package db_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewCryptoPayoutStore() (repo.CryptoPayoutStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewCryptoPayoutStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestCryptoPayoutsDB(t *testing.T) {
+	payoutDB, teardown, err := buildNewCryptoPayoutStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Unix(1500000000, 0)
+	for _, payout := range []repo.CryptoPayout{
+		{OrderID: "order1", Item: 1, Coin: "BTC", Amount: "2000", Address: "addr2", Txid: "tx2", Timestamp: now},
+		{OrderID: "order1", Item: 0, Coin: "BTC", Amount: "1000", Address: "addr1", Txid: "tx1", Timestamp: now},
+		{OrderID: "order2", Item: 0, Coin: "LTC", Amount: "5000", Address: "addr3", Txid: "tx3", Timestamp: now},
+		{OrderID: "order3", Item: 0, Coin: "BTC", Amount: "7000", Address: "addr4", Txid: "tx4", Timestamp: now.Add(-48 * time.Hour)},
+	} {
+		if err := payoutDB.Put(payout); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	payouts, err := payoutDB.GetByOrderID("order1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(payouts) != 2 || payouts[0].Txid != "tx1" || payouts[1].Txid != "tx2" || !payouts[0].Timestamp.Equal(now) {
+		t.Errorf("expected the payouts of order1 by item, got %v", payouts)
+	}
+
+	payouts, err = payoutDB.GetSince("BTC", now.Add(-24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(payouts) != 2 {
+		t.Errorf("expected the two BTC payouts of the last day, got %v", payouts)
+	}
+
+	if err := payoutDB.Delete("order1", 1); err != nil {
+		t.Fatal(err)
+	}
+	payouts, err = payoutDB.GetByOrderID("order1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(payouts) != 1 || payouts[0].Item != 0 {
+		t.Errorf("expected only item 0 of order1 after deleting item 1, got %v", payouts)
+	}
+}
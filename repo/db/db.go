@@ -2,8 +2,10 @@ package db
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"path"
+	"strings"
 	"sync"
 	"time"
 
@@ -38,6 +40,13 @@ type SQLiteDatastore struct {
 	txMetadata      repo.TransactionMetadataStore
 	moderatedStores repo.ModeratedStore
 	messages        repo.MessageStore
+	pendingSpends   repo.PendingSpendStore
+	outbox          repo.OutboxStore
+	exchangeRates   repo.ExchangeRateStore
+	subscriptions   repo.SubscriptionStore
+	digitalGoods    repo.DigitalGoodsStore
+	cryptoPayouts   repo.CryptoPayoutStore
+	appointments    repo.AppointmentStore
 	db              *sql.DB
 	lock            *sync.Mutex
 }
@@ -87,6 +96,13 @@ func NewSQLiteDatastore(db *sql.DB, l *sync.Mutex, coinType wallet.CoinType) *SQ
 		txMetadata:      NewTransactionMetadataStore(db, l),
 		moderatedStores: NewModeratedStore(db, l),
 		messages:        NewMessageStore(db, l),
+		pendingSpends:   NewPendingSpendStore(db, l),
+		outbox:          NewOutboxStore(db, l),
+		exchangeRates:   NewExchangeRateStore(db, l),
+		subscriptions:   NewSubscriptionStore(db, l),
+		digitalGoods:    NewDigitalGoodsStore(db, l),
+		cryptoPayouts:   NewCryptoPayoutStore(db, l),
+		appointments:    NewAppointmentStore(db, l),
 		db:              db,
 		lock:            l,
 	}
@@ -105,6 +121,29 @@ func (d *SQLiteDatastore) Ping() error {
 	return d.db.Ping()
 }
 
+// IntegrityCheck runs sqlite's integrity check and returns the problems it
+// finds. An intact database returns no problems.
+func (d *SQLiteDatastore) IntegrityCheck() ([]string, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	rows, err := d.db.Query("pragma integrity_check;")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var problems []string
+	for rows.Next() {
+		var result string
+		if err := rows.Scan(&result); err != nil {
+			return nil, err
+		}
+		if result != "ok" {
+			problems = append(problems, result)
+		}
+	}
+	return problems, rows.Err()
+}
+
 func (d *SQLiteDatastore) Close() {
 	d.db.Close()
 }
@@ -194,6 +233,34 @@ func (d *SQLiteDatastore) Messages() repo.MessageStore {
 	return d.messages
 }
 
+func (d *SQLiteDatastore) PendingSpends() repo.PendingSpendStore {
+	return d.pendingSpends
+}
+
+func (d *SQLiteDatastore) Outbox() repo.OutboxStore {
+	return d.outbox
+}
+
+func (d *SQLiteDatastore) ExchangeRates() repo.ExchangeRateStore {
+	return d.exchangeRates
+}
+
+func (d *SQLiteDatastore) Subscriptions() repo.SubscriptionStore {
+	return d.subscriptions
+}
+
+func (d *SQLiteDatastore) DigitalGoods() repo.DigitalGoodsStore {
+	return d.digitalGoods
+}
+
+func (d *SQLiteDatastore) CryptoPayouts() repo.CryptoPayoutStore {
+	return d.cryptoPayouts
+}
+
+func (d *SQLiteDatastore) Appointments() repo.AppointmentStore {
+	return d.appointments
+}
+
 func (d *SQLiteDatastore) Copy(dbPath string, password string) error {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -321,6 +388,24 @@ func (c *ConfigDB) GetCreationDate() (time.Time, error) {
 	return time.Parse(time.RFC3339, string(creationDate))
 }
 
+func (c *ConfigDB) GetTOTPCounter(secretID string) (int64, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var counter int64
+	err := c.db.QueryRow("select value from config where key=?", "totpCounter:"+secretID).Scan(&counter)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return counter, err
+}
+
+func (c *ConfigDB) PutTOTPCounter(secretID string, counter int64) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("insert or replace into config(key, value) values(?,?)", "totpCounter:"+secretID, counter)
+	return err
+}
+
 func (c *ConfigDB) IsEncrypted() bool {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -328,3 +413,60 @@ func (c *ConfigDB) IsEncrypted() bool {
 	_, err := c.db.Exec(pwdCheck) // Fails if wrong password is entered
 	return err != nil
 }
+
+// Rekey changes the password of the encrypted database in place. The old
+// password is checked first and the new one on a separate connection once
+// the database has been rekeyed. If the new password does not open the
+// database the old one is restored.
+func (c *ConfigDB) Rekey(oldPassword, newPassword string) error {
+	if oldPassword == "" {
+		return errors.New("the database is not encrypted")
+	}
+	if newPassword == "" {
+		return errors.New("the new password is empty")
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var (
+		seq          int
+		name, dbPath string
+	)
+	if err := c.db.QueryRow("pragma database_list;").Scan(&seq, &name, &dbPath); err != nil {
+		return err
+	}
+	if err := checkPassword(dbPath, oldPassword); err != nil {
+		return repo.ErrIncorrectPassword
+	}
+	if _, err := c.db.Exec("pragma rekey = '" + escapePassword(newPassword) + "';"); err != nil {
+		return err
+	}
+	if err := checkPassword(dbPath, newPassword); err != nil {
+		if _, rErr := c.db.Exec("pragma rekey = '" + escapePassword(oldPassword) + "';"); rErr != nil {
+			return fmt.Errorf("restoring the old password failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return fmt.Errorf("verifying the new password: %s", err.Error())
+	}
+	return nil
+}
+
+// checkPassword opens the database file with the password on a new
+// connection and reads from it
+func checkPassword(dbPath, password string) error {
+	conn, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	// The key only applies to the connection it was set on
+	conn.SetMaxOpenConns(1)
+	if _, err := conn.Exec("pragma key = '" + escapePassword(password) + "';"); err != nil {
+		return err
+	}
+	_, err = conn.Exec("select count(*) from sqlite_master;")
+	return err
+}
+
+func escapePassword(password string) string {
+	return strings.Replace(password, "'", "''", -1)
+}
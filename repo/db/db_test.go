@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/OpenBazaar/openbazaar-go/repo"
 	"github.com/OpenBazaar/openbazaar-go/schema"
 	"github.com/OpenBazaar/wallet-interface"
 )
@@ -137,3 +138,84 @@ func TestEncryptedDb(t *testing.T) {
 		t.Error("IsEncrypted returned incorrectly")
 	}
 }
+
+func TestRekey(t *testing.T) {
+	repoPath := schema.GenerateTempPath()
+	if err := os.MkdirAll(path.Join(repoPath, "datastore"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(repoPath)
+	testDB, err := Create(repoPath, "old password", false, wallet.Bitcoin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer testDB.Close()
+	var cipherVersion string
+	if err := testDB.db.QueryRow("pragma cipher_version;").Scan(&cipherVersion); err != nil || cipherVersion == "" {
+		t.Skip("sqlite is built without sqlcipher")
+	}
+	if err := testDB.Config().Init("mnemonic", []byte("identity key"), "old password", time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := testDB.Config().Rekey("wrong password", "new password"); err != repo.ErrIncorrectPassword {
+		t.Errorf("expected ErrIncorrectPassword, got %v", err)
+	}
+	if err := testDB.Config().Rekey("old password", "it's new"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The open connection keeps working with the new key
+	if mnemonic, err := testDB.Config().GetMnemonic(); err != nil || mnemonic != "mnemonic" {
+		t.Errorf("expected the mnemonic to be readable after rekeying, got %q (%v)", mnemonic, err)
+	}
+	dbPath := path.Join(repoPath, "datastore", "mainnet.db")
+	if err := checkPassword(dbPath, "old password"); err == nil {
+		t.Error("expected the old password to be rejected")
+	}
+	if err := checkPassword(dbPath, "it's new"); err != nil {
+		t.Errorf("expected the new password to open the database, got %v", err)
+	}
+	if err := testDB.Config().Rekey("it's new", ""); err == nil {
+		t.Error("expected an empty password to be rejected")
+	}
+}
+
+func TestTOTPCounter(t *testing.T) {
+	testDB, teardown, err := buildNewDatastore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	if counter, err := testDB.Config().GetTOTPCounter("secret"); err != nil || counter != 0 {
+		t.Errorf("expected no counter for an unused secret, got %d (%v)", counter, err)
+	}
+	for _, expected := range []int64{52000000, 52000001} {
+		if err := testDB.Config().PutTOTPCounter("secret", expected); err != nil {
+			t.Fatal(err)
+		}
+		if counter, err := testDB.Config().GetTOTPCounter("secret"); err != nil || counter != expected {
+			t.Errorf("expected counter %d, got %d (%v)", expected, counter, err)
+		}
+	}
+	if counter, err := testDB.Config().GetTOTPCounter("other"); err != nil || counter != 0 {
+		t.Errorf("expected no counter for another secret, got %d (%v)", counter, err)
+	}
+}
+
+func TestIntegrityCheck(t *testing.T) {
+	datastore, teardown, err := buildNewDatastore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	problems, err := datastore.IntegrityCheck()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(problems) != 0 {
+		t.Errorf("expected a new database to be intact, got %v", problems)
+	}
+}
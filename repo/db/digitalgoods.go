@@ -0,0 +1,170 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type DigitalGoodsDB struct {
+	modelStore
+}
+
+func NewDigitalGoodsStore(db *sql.DB, lock *sync.Mutex) repo.DigitalGoodsStore {
+	return &DigitalGoodsDB{modelStore{db, lock}}
+}
+
+func (d *DigitalGoodsDB) PutFile(file repo.DigitalFile) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	stmt, err := d.PrepareQuery("insert or replace into digitalfiles(slug, variant, filename, content, timestamp) values(?,?,?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare digital file sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(file.Slug, file.Variant, file.Filename, file.Content, file.Timestamp.Unix())
+	if err != nil {
+		return fmt.Errorf("commit digital file: %s", err.Error())
+	}
+	return nil
+}
+
+func (d *DigitalGoodsDB) GetFile(slug string, variant int) (*repo.DigitalFile, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	var (
+		file      = repo.DigitalFile{Slug: slug, Variant: variant}
+		timestamp int64
+	)
+	err := d.db.QueryRow("select filename, content, timestamp from digitalfiles where slug=? and variant=?", slug, variant).Scan(&file.Filename, &file.Content, &timestamp)
+	if err != nil {
+		return nil, err
+	}
+	file.Size = len(file.Content)
+	file.Timestamp = time.Unix(timestamp, 0)
+	return &file, nil
+}
+
+func (d *DigitalGoodsDB) GetFiles(slug string) ([]repo.DigitalFile, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	rows, err := d.db.Query("select variant, filename, length(content), timestamp from digitalfiles where slug=? order by variant", slug)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []repo.DigitalFile
+	for rows.Next() {
+		var (
+			file      = repo.DigitalFile{Slug: slug}
+			timestamp int64
+		)
+		if err := rows.Scan(&file.Variant, &file.Filename, &file.Size, &timestamp); err != nil {
+			return nil, err
+		}
+		file.Timestamp = time.Unix(timestamp, 0)
+		ret = append(ret, file)
+	}
+	return ret, rows.Err()
+}
+
+func (d *DigitalGoodsDB) AddLicenseKeys(slug string, variant int, keys []string) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	tx, err := d.BeginTransaction()
+	if err != nil {
+		return fmt.Errorf("begin license key transaction: %s", err.Error())
+	}
+	stmt, err := tx.Prepare("insert or ignore into licensekeys(slug, variant, licenseKey, timestamp) values(?,?,?,?)")
+	if err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("prepare license key sql: (%s) w rollback error: (%s)", err.Error(), rErr.Error())
+		}
+		return fmt.Errorf("prepare license key sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	now := time.Now().Unix()
+	for _, key := range keys {
+		if _, err := stmt.Exec(slug, variant, key, now); err != nil {
+			if rErr := tx.Rollback(); rErr != nil {
+				return fmt.Errorf("add license key: (%s) w rollback error: (%s)", err.Error(), rErr.Error())
+			}
+			return fmt.Errorf("add license key: %s", err.Error())
+		}
+	}
+	return tx.Commit()
+}
+
+func (d *DigitalGoodsDB) AssignLicenseKeys(slug string, variant int, orderID string, count int) ([]string, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	tx, err := d.BeginTransaction()
+	if err != nil {
+		return nil, fmt.Errorf("begin license key transaction: %s", err.Error())
+	}
+	rollback := func(err error) ([]string, error) {
+		if rErr := tx.Rollback(); rErr != nil {
+			return nil, fmt.Errorf("assign license keys: (%s) w rollback error: (%s)", err.Error(), rErr.Error())
+		}
+		return nil, err
+	}
+
+	// Assigned keys are listed first so a retried order gets the same keys
+	rows, err := tx.Query("select keyID, licenseKey, orderID from licensekeys where slug=? and variant=? and (orderID=? or orderID='') order by orderID desc, keyID limit ?", slug, variant, orderID, count)
+	if err != nil {
+		return rollback(err)
+	}
+	var (
+		keys       []string
+		unassigned []int64
+	)
+	for rows.Next() {
+		var (
+			keyID         int64
+			key, assignee string
+		)
+		if err := rows.Scan(&keyID, &key, &assignee); err != nil {
+			rows.Close()
+			return rollback(err)
+		}
+		keys = append(keys, key)
+		if assignee == "" {
+			unassigned = append(unassigned, keyID)
+		}
+	}
+	rows.Close()
+	if len(keys) < count {
+		return rollback(repo.ErrLicenseKeysDepleted)
+	}
+	for _, keyID := range unassigned {
+		if _, err := tx.Exec("update licensekeys set orderID=? where keyID=?", orderID, keyID); err != nil {
+			return rollback(err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (d *DigitalGoodsDB) GetLicenseKeyPools(slug string) ([]repo.LicenseKeyPool, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	rows, err := d.db.Query("select variant, sum(case when orderID='' then 1 else 0 end), sum(case when orderID='' then 0 else 1 end) from licensekeys where slug=? group by variant order by variant", slug)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []repo.LicenseKeyPool
+	for rows.Next() {
+		pool := repo.LicenseKeyPool{Slug: slug}
+		if err := rows.Scan(&pool.Variant, &pool.Available, &pool.Assigned); err != nil {
+			return nil, err
+		}
+		ret = append(ret, pool)
+	}
+	return ret, rows.Err()
+}
@@ -0,0 +1,108 @@
+package db_test
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewDigitalGoodsStore() (repo.DigitalGoodsStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewDigitalGoodsStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestDigitalGoodsDB_Files(t *testing.T) {
+	goodsDB, teardown, err := buildNewDigitalGoodsStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	file := repo.DigitalFile{
+		Slug:      "ebook",
+		Variant:   1,
+		Filename:  "ebook.epub",
+		Content:   []byte("chapter one"),
+		Timestamp: time.Unix(1500000000, 0),
+	}
+	if err := goodsDB.PutFile(file); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := goodsDB.GetFile("ebook", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Filename != file.Filename || !bytes.Equal(got.Content, file.Content) || got.Size != len(file.Content) || !got.Timestamp.Equal(file.Timestamp) {
+		t.Errorf("expected %v, got %v", file, got)
+	}
+	if _, err := goodsDB.GetFile("ebook", 0); err == nil {
+		t.Error("expected an error for a variant without a file")
+	}
+
+	files, err := goodsDB.GetFiles("ebook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].Size != len(file.Content) || files[0].Content != nil {
+		t.Errorf("expected the file without its content, got %v", files)
+	}
+}
+
+func TestDigitalGoodsDB_LicenseKeys(t *testing.T) {
+	goodsDB, teardown, err := buildNewDigitalGoodsStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	if err := goodsDB.AddLicenseKeys("app", 0, []string{"KEY-1", "KEY-2", "KEY-3", "KEY-1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := goodsDB.AssignLicenseKeys("app", 0, "order1", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[0] != "KEY-1" || keys[1] != "KEY-2" {
+		t.Errorf("expected the two oldest keys, got %v", keys)
+	}
+
+	keys, err = goodsDB.AssignLicenseKeys("app", 0, "order1", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 2 || keys[0] != "KEY-1" || keys[1] != "KEY-2" {
+		t.Errorf("expected the keys already assigned to the order, got %v", keys)
+	}
+
+	if _, err := goodsDB.AssignLicenseKeys("app", 0, "order2", 2); err != repo.ErrLicenseKeysDepleted {
+		t.Errorf("expected %v, got %v", repo.ErrLicenseKeysDepleted, err)
+	}
+
+	pools, err := goodsDB.GetLicenseKeyPools("app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pools) != 1 || pools[0].Available != 1 || pools[0].Assigned != 2 {
+		t.Errorf("expected one key left and two assigned, got %v", pools)
+	}
+}
@@ -0,0 +1,58 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type ExchangeRatesDB struct {
+	modelStore
+}
+
+func NewExchangeRateStore(db *sql.DB, lock *sync.Mutex) repo.ExchangeRateStore {
+	return &ExchangeRatesDB{modelStore{db, lock}}
+}
+
+func (e *ExchangeRatesDB) Put(rates map[string]float64, t time.Time) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	tx, err := e.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare("insert or replace into exchangerates(code, rate, timestamp) values(?,?,?)")
+	if err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return fmt.Errorf("prepare exchange rates sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	for code, rate := range rates {
+		if _, err := stmt.Exec(code, rate, t.Unix()); err != nil {
+			if rErr := tx.Rollback(); rErr != nil {
+				return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+			}
+			return fmt.Errorf("commit exchange rate: %s", err.Error())
+		}
+	}
+	return tx.Commit()
+}
+
+func (e *ExchangeRatesDB) GetAt(code string, t time.Time) (float64, time.Time, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	var (
+		rate      float64
+		timestamp int64
+	)
+	err := e.db.QueryRow("select rate, timestamp from exchangerates where code=? and timestamp<=? order by timestamp desc limit 1", code, t.Unix()).Scan(&rate, &timestamp)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return rate, time.Unix(timestamp, 0), nil
+}
@@ -0,0 +1,71 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewExchangeRateStore() (repo.ExchangeRateStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewExchangeRateStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestExchangeRatesDB_GetAt(t *testing.T) {
+	ratesDB, teardown, err := buildNewExchangeRateStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	first := time.Unix(1500000000, 0)
+	second := first.Add(time.Hour)
+	if err := ratesDB.Put(map[string]float64{"USD": 9000, "EUR": 8000}, first); err != nil {
+		t.Fatal(err)
+	}
+	if err := ratesDB.Put(map[string]float64{"USD": 9100}, second); err != nil {
+		t.Fatal(err)
+	}
+
+	rate, at, err := ratesDB.GetAt("USD", second.Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rate != 9000 || !at.Equal(first) {
+		t.Errorf("expected the first rate, got %f at %s", rate, at)
+	}
+
+	rate, at, err = ratesDB.GetAt("USD", second.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rate != 9100 || !at.Equal(second) {
+		t.Errorf("expected the second rate, got %f at %s", rate, at)
+	}
+
+	if rate, _, err = ratesDB.GetAt("EUR", second); err != nil || rate != 8000 {
+		t.Errorf("expected the last recorded EUR rate, got %f (%v)", rate, err)
+	}
+
+	if _, _, err = ratesDB.GetAt("USD", first.Add(-time.Minute)); err != sql.ErrNoRows {
+		t.Errorf("expected no rate before the history starts, got %v", err)
+	}
+}
@@ -0,0 +1,133 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type OutboxDB struct {
+	modelStore
+}
+
+func NewOutboxStore(db *sql.DB, lock *sync.Mutex) repo.OutboxStore {
+	return &OutboxDB{modelStore{db, lock}}
+}
+
+func (o *OutboxDB) Put(msg repo.OutboxMessage) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	stmt, err := o.PrepareQuery("insert or replace into outbox(pointerID, recipient, messageType, location, digest, status, timestamp, statusTimestamp, deleteAttempts, lastError) values(?,?,?,?,?,?,?,?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare outbox sql: %s", err.Error())
+	}
+	defer stmt.Close()
+
+	var timestamp, statusTimestamp int64
+	if msg.Timestamp != nil {
+		timestamp = msg.Timestamp.Unix()
+	}
+	if msg.StatusTimestamp != nil {
+		statusTimestamp = msg.StatusTimestamp.Unix()
+	}
+	_, err = stmt.Exec(msg.PointerID, msg.Recipient, msg.MessageType, msg.Location, msg.Digest, string(msg.Status), timestamp, statusTimestamp, msg.DeleteAttempts, msg.LastError)
+	if err != nil {
+		return fmt.Errorf("commit outbox message: %s", err.Error())
+	}
+	return nil
+}
+
+func (o *OutboxDB) Get(pointerID string) (*repo.OutboxMessage, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	rows, err := o.db.Query("select pointerID, recipient, messageType, location, digest, status, timestamp, statusTimestamp, deleteAttempts, lastError from outbox where pointerID=?", pointerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	msgs, err := scanOutboxMessages(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &msgs[0], nil
+}
+
+func (o *OutboxDB) GetAll(status repo.OutboxStatus) ([]repo.OutboxMessage, error) {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if status == "" {
+		rows, err = o.db.Query("select pointerID, recipient, messageType, location, digest, status, timestamp, statusTimestamp, deleteAttempts, lastError from outbox order by timestamp desc")
+	} else {
+		rows, err = o.db.Query("select pointerID, recipient, messageType, location, digest, status, timestamp, statusTimestamp, deleteAttempts, lastError from outbox where status=? order by timestamp desc", string(status))
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanOutboxMessages(rows)
+}
+
+func (o *OutboxDB) SetStatus(pointerID string, status repo.OutboxStatus, t time.Time) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	res, err := o.db.Exec("update outbox set status=?, statusTimestamp=? where pointerID=?", string(status), t.Unix(), pointerID)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (o *OutboxDB) SetDeleteFailure(pointerID string, attempts int, lastError string) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	res, err := o.db.Exec("update outbox set deleteAttempts=?, lastError=? where pointerID=?", attempts, lastError, pointerID)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func scanOutboxMessages(rows *sql.Rows) ([]repo.OutboxMessage, error) {
+	var ret []repo.OutboxMessage
+	for rows.Next() {
+		var (
+			msg                        repo.OutboxMessage
+			status                     string
+			timestamp, statusTimestamp int64
+		)
+		if err := rows.Scan(&msg.PointerID, &msg.Recipient, &msg.MessageType, &msg.Location, &msg.Digest, &status, &timestamp, &statusTimestamp, &msg.DeleteAttempts, &msg.LastError); err != nil {
+			return nil, err
+		}
+		msg.Status = repo.OutboxStatus(status)
+		msg.Timestamp = repo.NewAPITime(time.Unix(timestamp, 0))
+		if statusTimestamp != 0 {
+			msg.StatusTimestamp = repo.NewAPITime(time.Unix(statusTimestamp, 0))
+		}
+		ret = append(ret, msg)
+	}
+	return ret, rows.Err()
+}
@@ -0,0 +1,127 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewOutboxStore() (repo.OutboxStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewOutboxStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestOutboxDB_PutGet(t *testing.T) {
+	outboxDB, teardown, err := buildNewOutboxStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	msg := repo.OutboxMessage{
+		PointerID:   "QmPointer",
+		Recipient:   "QmRecipient",
+		MessageType: "ORDER",
+		Location:    "/ipfs/QmLocation",
+		Digest:      "abcd",
+		Status:      repo.OutboxPending,
+		Timestamp:   repo.NewAPITime(time.Unix(1500000000, 0)),
+	}
+	if err := outboxDB.Put(msg); err != nil {
+		t.Fatal(err)
+	}
+	ret, err := outboxDB.Get("QmPointer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.Recipient != msg.Recipient || ret.MessageType != msg.MessageType || ret.Location != msg.Location ||
+		ret.Digest != msg.Digest || ret.Status != msg.Status || ret.StatusTimestamp != nil {
+		t.Errorf("Expected %v, got %v", msg, *ret)
+	}
+	if !ret.Timestamp.Equal(msg.Timestamp.Time) {
+		t.Errorf("Expected timestamp %s, got %s", msg.Timestamp, ret.Timestamp)
+	}
+
+	if _, err := outboxDB.Get("QmUnknown"); err != sql.ErrNoRows {
+		t.Errorf("Expected sql.ErrNoRows for an unknown message, got %v", err)
+	}
+}
+
+func TestOutboxDB_GetAllSetStatus(t *testing.T) {
+	outboxDB, teardown, err := buildNewOutboxStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	for i, id := range []string{"newer", "older"} {
+		err := outboxDB.Put(repo.OutboxMessage{
+			PointerID: id,
+			Status:    repo.OutboxPending,
+			Timestamp: repo.NewAPITime(time.Unix(int64(1500000000-i), 0)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	msgs, err := outboxDB.GetAll("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 2 || msgs[0].PointerID != "newer" || msgs[1].PointerID != "older" {
+		t.Errorf("Expected the messages newest first, got %v", msgs)
+	}
+
+	ackedAt := time.Unix(1500000100, 0)
+	if err := outboxDB.SetStatus("older", repo.OutboxAcked, ackedAt); err != nil {
+		t.Fatal(err)
+	}
+	if err := outboxDB.SetStatus("unknown", repo.OutboxAcked, ackedAt); err != sql.ErrNoRows {
+		t.Errorf("Expected sql.ErrNoRows for an unknown message, got %v", err)
+	}
+	msgs, err = outboxDB.GetAll(repo.OutboxAcked)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 1 || msgs[0].PointerID != "older" || !msgs[0].StatusTimestamp.Equal(ackedAt) {
+		t.Errorf("Expected only the acked message, got %v", msgs)
+	}
+	msgs, err = outboxDB.GetAll(repo.OutboxPending)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(msgs) != 1 || msgs[0].PointerID != "newer" {
+		t.Errorf("Expected only the pending message, got %v", msgs)
+	}
+	if err := outboxDB.SetDeleteFailure("newer", 2, "unpin failed"); err != nil {
+		t.Fatal(err)
+	}
+	if err := outboxDB.SetDeleteFailure("unknown", 1, "unpin failed"); err != sql.ErrNoRows {
+		t.Errorf("Expected sql.ErrNoRows for an unknown message, got %v", err)
+	}
+	ret, err := outboxDB.Get("newer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.DeleteAttempts != 2 || ret.LastError != "unpin failed" || ret.Status != repo.OutboxPending {
+		t.Errorf("Expected the delete failure to be recorded, got %v", *ret)
+	}
+}
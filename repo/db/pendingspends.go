@@ -0,0 +1,90 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type PendingSpendsDB struct {
+	modelStore
+}
+
+func NewPendingSpendStore(db *sql.DB, lock *sync.Mutex) repo.PendingSpendStore {
+	return &PendingSpendsDB{modelStore{db, lock}}
+}
+
+func (p *PendingSpendsDB) Put(spend repo.PendingSpend) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	stmt, err := p.PrepareQuery("insert into pendingspends(spendID, coin, amount, address, memo, orderID, request, timestamp) values(?,?,?,?,?,?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare pending spend sql: %s", err.Error())
+	}
+	defer stmt.Close()
+
+	var timestamp int64
+	if spend.Timestamp != nil {
+		timestamp = spend.Timestamp.Unix()
+	}
+	_, err = stmt.Exec(spend.ID, spend.Coin, spend.Amount, spend.Address, spend.Memo, spend.OrderID, spend.Request, timestamp)
+	if err != nil {
+		return fmt.Errorf("commit pending spend: %s", err.Error())
+	}
+	return nil
+}
+
+func (p *PendingSpendsDB) Get(id string) (*repo.PendingSpend, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	rows, err := p.db.Query("select spendID, coin, amount, address, memo, orderID, request, timestamp from pendingspends where spendID=?", id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	spends, err := scanPendingSpends(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(spends) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &spends[0], nil
+}
+
+func (p *PendingSpendsDB) GetAll() ([]repo.PendingSpend, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	rows, err := p.db.Query("select spendID, coin, amount, address, memo, orderID, request, timestamp from pendingspends order by timestamp asc")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanPendingSpends(rows)
+}
+
+func (p *PendingSpendsDB) Delete(id string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	_, err := p.db.Exec("delete from pendingspends where spendID=?", id)
+	return err
+}
+
+func scanPendingSpends(rows *sql.Rows) ([]repo.PendingSpend, error) {
+	var ret []repo.PendingSpend
+	for rows.Next() {
+		var (
+			spend     repo.PendingSpend
+			timestamp int64
+		)
+		if err := rows.Scan(&spend.ID, &spend.Coin, &spend.Amount, &spend.Address, &spend.Memo, &spend.OrderID, &spend.Request, &timestamp); err != nil {
+			return nil, err
+		}
+		spend.Timestamp = repo.NewAPITime(time.Unix(timestamp, 0))
+		ret = append(ret, spend)
+	}
+	return ret, rows.Err()
+}
@@ -0,0 +1,108 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewPendingSpendStore() (repo.PendingSpendStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewPendingSpendStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestPendingSpendsDB_PutGet(t *testing.T) {
+	spendDB, teardown, err := buildNewPendingSpendStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	spend := repo.PendingSpend{
+		ID:        "abc",
+		Coin:      "TBTC",
+		Amount:    "100000",
+		Address:   "mnUnqvEAuoFGx4H3JvNTqnbnzEdPNHQPzg",
+		Memo:      "rent",
+		OrderID:   "order",
+		Timestamp: repo.NewAPITime(time.Unix(1500000000, 0)),
+		Request:   []byte(`{"amount":"100000"}`),
+	}
+	if err := spendDB.Put(spend); err != nil {
+		t.Fatal(err)
+	}
+	ret, err := spendDB.Get("abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.Coin != spend.Coin || ret.Amount != spend.Amount || ret.Address != spend.Address ||
+		ret.Memo != spend.Memo || ret.OrderID != spend.OrderID || string(ret.Request) != string(spend.Request) {
+		t.Errorf("Expected %v, got %v", spend, *ret)
+	}
+	if !ret.Timestamp.Equal(spend.Timestamp.Time) {
+		t.Errorf("Expected timestamp %s, got %s", spend.Timestamp, ret.Timestamp)
+	}
+
+	if _, err := spendDB.Get("xyz"); err != sql.ErrNoRows {
+		t.Errorf("Expected sql.ErrNoRows for an unknown spend, got %v", err)
+	}
+	if err := spendDB.Put(spend); err == nil {
+		t.Error("Expected an error putting a duplicate spend")
+	}
+}
+
+func TestPendingSpendsDB_GetAllDelete(t *testing.T) {
+	spendDB, teardown, err := buildNewPendingSpendStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	for i, id := range []string{"second", "first"} {
+		err := spendDB.Put(repo.PendingSpend{
+			ID:        id,
+			Coin:      "TBTC",
+			Amount:    "1",
+			Timestamp: repo.NewAPITime(time.Unix(int64(1500000000-i), 0)),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	spends, err := spendDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spends) != 2 || spends[0].ID != "first" || spends[1].ID != "second" {
+		t.Errorf("Expected the spends oldest first, got %v", spends)
+	}
+
+	if err := spendDB.Delete("first"); err != nil {
+		t.Fatal(err)
+	}
+	spends, err = spendDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spends) != 1 || spends[0].ID != "second" {
+		t.Errorf("Expected only the second spend to remain, got %v", spends)
+	}
+}
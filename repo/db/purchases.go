@@ -401,6 +401,12 @@ func (p *PurchasesDB) Count() int {
 	return count
 }
 
+func (p *PurchasesDB) CountByState() (map[pb.OrderState]int, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return countByState(p.db, "purchases")
+}
+
 func (p *PurchasesDB) GetPurchasesForDisputeExpiryNotification() ([]*repo.PurchaseRecord, error) {
 	p.lock.Lock()
 	defer p.lock.Unlock()
@@ -55,6 +55,29 @@ func TestPurchasesDB_Count(t *testing.T) {
 	}
 }
 
+func TestPurchasesDB_CountByState(t *testing.T) {
+	purdb, teardown, err := buildNewPurchaseStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	contract := factory.NewContract()
+	for i, state := range []pb.OrderState{pb.OrderState_PENDING, pb.OrderState_PENDING, pb.OrderState_COMPLETED} {
+		if err := purdb.Put("orderID"+strconv.Itoa(i), *contract, state, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	counts, err := purdb.CountByState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[pb.OrderState]int{pb.OrderState_PENDING: 2, pb.OrderState_COMPLETED: 1}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Errorf("expected %v, got %v", expected, counts)
+	}
+}
+
 func TestPutPurchase(t *testing.T) {
 	purdb, teardown, err := buildNewPurchaseStore()
 	if err != nil {
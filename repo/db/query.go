@@ -1,6 +1,7 @@
 package db
 
 import (
+	"database/sql"
 	"strconv"
 	"strings"
 
@@ -105,3 +106,22 @@ func filterQuery(q query) (stm string, args []interface{}) {
 	}
 	return stm, args
 }
+
+// countByState returns the number of orders in each state of an order table.
+// The caller holds the lock of the store.
+func countByState(db *sql.DB, table string) (map[pb.OrderState]int, error) {
+	rows, err := db.Query("select state, count(*) from " + table + " group by state")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	counts := make(map[pb.OrderState]int)
+	for rows.Next() {
+		var state, count int
+		if err := rows.Scan(&state, &count); err != nil {
+			return nil, err
+		}
+		counts[pb.OrderState(state)] = count
+	}
+	return counts, rows.Err()
+}
@@ -349,6 +349,12 @@ func (s *SalesDB) Count() int {
 	return count
 }
 
+func (s *SalesDB) CountByState() (map[pb.OrderState]int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return countByState(s.db, "sales")
+}
+
 func (s *SalesDB) GetUnfunded() ([]repo.UnfundedOrder, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -97,9 +97,15 @@ func (s *SettingsDB) Update(settings repo.SettingsData) error {
 	if settings.StoreModerators == nil {
 		settings.StoreModerators = current.StoreModerators
 	}
+	if settings.StoreTaxes == nil {
+		settings.StoreTaxes = current.StoreTaxes
+	}
 	if settings.MisPaymentBuffer == nil {
 		settings.MisPaymentBuffer = current.MisPaymentBuffer
 	}
+	if settings.PriceQuoteMinutes == nil {
+		settings.PriceQuoteMinutes = current.PriceQuoteMinutes
+	}
 	if settings.SMTPSettings == nil {
 		settings.SMTPSettings = current.SMTPSettings
 	}
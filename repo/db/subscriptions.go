@@ -0,0 +1,135 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/golang/protobuf/proto"
+)
+
+const subscriptionColumns = "subscriptionID, role, peerID, status, agreement, purchaseData, autoPayCap, periodsCompleted, nextOrder, lastOrderID, timestamp"
+
+type SubscriptionsDB struct {
+	modelStore
+}
+
+func NewSubscriptionStore(db *sql.DB, lock *sync.Mutex) repo.SubscriptionStore {
+	return &SubscriptionsDB{modelStore{db, lock}}
+}
+
+func (s *SubscriptionsDB) Put(sub repo.Subscription) error {
+	var (
+		agreement, purchaseData []byte
+		nextOrder, timestamp    int64
+		err                     error
+	)
+	if sub.Agreement != nil {
+		if agreement, err = proto.Marshal(sub.Agreement); err != nil {
+			return err
+		}
+	}
+	if sub.PurchaseData != nil {
+		if purchaseData, err = json.Marshal(sub.PurchaseData); err != nil {
+			return err
+		}
+	}
+	if sub.NextOrder != nil {
+		nextOrder = sub.NextOrder.Unix()
+	}
+	if sub.Timestamp != nil {
+		timestamp = sub.Timestamp.Unix()
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	stmt, err := s.PrepareQuery("insert or replace into subscriptions(" + subscriptionColumns + ") values(?,?,?,?,?,?,?,?,?,?,?)")
+	if err != nil {
+		return fmt.Errorf("prepare subscription sql: %s", err.Error())
+	}
+	defer stmt.Close()
+	_, err = stmt.Exec(sub.ID, string(sub.Role), sub.PeerID, string(sub.Status), agreement, purchaseData, sub.AutoPayCap, sub.PeriodsCompleted, nextOrder, sub.LastOrderID, timestamp)
+	if err != nil {
+		return fmt.Errorf("commit subscription: %s", err.Error())
+	}
+	return nil
+}
+
+func (s *SubscriptionsDB) Get(id string) (*repo.Subscription, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	rows, err := s.db.Query("select "+subscriptionColumns+" from subscriptions where subscriptionID=?", id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	subs, err := scanSubscriptions(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(subs) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &subs[0], nil
+}
+
+func (s *SubscriptionsDB) GetAll() ([]repo.Subscription, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	rows, err := s.db.Query("select " + subscriptionColumns + " from subscriptions order by timestamp desc")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanSubscriptions(rows)
+}
+
+func (s *SubscriptionsDB) GetDue(t time.Time) ([]repo.Subscription, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	rows, err := s.db.Query("select "+subscriptionColumns+" from subscriptions where role=? and status=? and nextOrder<=? order by nextOrder", string(repo.SubscriptionBuyer), string(repo.SubscriptionActive), t.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanSubscriptions(rows)
+}
+
+func scanSubscriptions(rows *sql.Rows) ([]repo.Subscription, error) {
+	var ret []repo.Subscription
+	for rows.Next() {
+		var (
+			sub                     repo.Subscription
+			role, status            string
+			agreement, purchaseData []byte
+			nextOrder, timestamp    int64
+		)
+		if err := rows.Scan(&sub.ID, &role, &sub.PeerID, &status, &agreement, &purchaseData, &sub.AutoPayCap, &sub.PeriodsCompleted, &nextOrder, &sub.LastOrderID, &timestamp); err != nil {
+			return nil, err
+		}
+		sub.Role = repo.SubscriptionRole(role)
+		sub.Status = repo.SubscriptionStatus(status)
+		if len(agreement) > 0 {
+			sub.Agreement = new(pb.SignedSubscriptionAgreement)
+			if err := proto.Unmarshal(agreement, sub.Agreement); err != nil {
+				return nil, err
+			}
+		}
+		if len(purchaseData) > 0 {
+			sub.PurchaseData = new(repo.PurchaseData)
+			if err := json.Unmarshal(purchaseData, sub.PurchaseData); err != nil {
+				return nil, err
+			}
+		}
+		if nextOrder != 0 {
+			sub.NextOrder = repo.NewAPITime(time.Unix(nextOrder, 0))
+		}
+		sub.Timestamp = repo.NewAPITime(time.Unix(timestamp, 0))
+		ret = append(ret, sub)
+	}
+	return ret, rows.Err()
+}
@@ -0,0 +1,115 @@
+package db_test
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/repo/db"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func buildNewSubscriptionStore() (repo.SubscriptionStore, func(), error) {
+	appSchema := schema.MustNewCustomSchemaManager(schema.SchemaContext{
+		DataPath:        schema.GenerateTempPath(),
+		TestModeEnabled: true,
+	})
+	if err := appSchema.BuildSchemaDirectories(); err != nil {
+		return nil, nil, err
+	}
+	if err := appSchema.InitializeDatabase(); err != nil {
+		return nil, nil, err
+	}
+	database, err := appSchema.OpenDatabase()
+	if err != nil {
+		return nil, nil, err
+	}
+	return db.NewSubscriptionStore(database, new(sync.Mutex)), appSchema.DestroySchemaDirectories, nil
+}
+
+func TestSubscriptionsDB_PutGet(t *testing.T) {
+	subDB, teardown, err := buildNewSubscriptionStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	sub := repo.Subscription{
+		ID:               "sub1",
+		Role:             repo.SubscriptionBuyer,
+		PeerID:           "QmVendor",
+		Status:           repo.SubscriptionActive,
+		AutoPayCap:       "100000",
+		PeriodsCompleted: 1,
+		NextOrder:        repo.NewAPITime(time.Unix(1500086400, 0)),
+		LastOrderID:      "QmOrder",
+		Timestamp:        repo.NewAPITime(time.Unix(1500000000, 0)),
+		Agreement: &pb.SignedSubscriptionAgreement{
+			Agreement: &pb.SubscriptionAgreement{SubscriptionID: "sub1", ListingSlug: "box", IntervalDays: 1, Periods: 3},
+			Signature: []byte("sig"),
+		},
+		PurchaseData: &repo.PurchaseData{PaymentCoin: "TBTC", Items: []repo.Item{{ListingHash: "QmListing", Quantity: "1"}}},
+	}
+	if err := subDB.Put(sub); err != nil {
+		t.Fatal(err)
+	}
+	ret, err := subDB.Get("sub1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.Role != sub.Role || ret.PeerID != sub.PeerID || ret.Status != sub.Status || ret.AutoPayCap != sub.AutoPayCap ||
+		ret.PeriodsCompleted != sub.PeriodsCompleted || ret.LastOrderID != sub.LastOrderID {
+		t.Errorf("Expected %v, got %v", sub, *ret)
+	}
+	if !ret.NextOrder.Equal(sub.NextOrder.Time) || !ret.Timestamp.Equal(sub.Timestamp.Time) {
+		t.Errorf("Expected times %s and %s, got %s and %s", sub.NextOrder, sub.Timestamp, ret.NextOrder, ret.Timestamp)
+	}
+	if ret.Agreement.Agreement.ListingSlug != "box" || ret.Agreement.Agreement.Periods != 3 {
+		t.Errorf("Expected the agreement to be stored, got %v", ret.Agreement)
+	}
+	if ret.PurchaseData.PaymentCoin != "TBTC" || len(ret.PurchaseData.Items) != 1 || ret.PurchaseData.Items[0].ListingHash != "QmListing" {
+		t.Errorf("Expected the purchase data to be stored, got %v", ret.PurchaseData)
+	}
+
+	if _, err := subDB.Get("missing"); err != sql.ErrNoRows {
+		t.Errorf("Expected sql.ErrNoRows, got %v", err)
+	}
+}
+
+func TestSubscriptionsDB_GetDue(t *testing.T) {
+	subDB, teardown, err := buildNewSubscriptionStore()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer teardown()
+
+	now := time.Unix(1500000000, 0)
+	for _, sub := range []repo.Subscription{
+		{ID: "due", Role: repo.SubscriptionBuyer, Status: repo.SubscriptionActive, NextOrder: repo.NewAPITime(now.Add(-time.Hour)), Timestamp: repo.NewAPITime(now)},
+		{ID: "later", Role: repo.SubscriptionBuyer, Status: repo.SubscriptionActive, NextOrder: repo.NewAPITime(now.Add(time.Hour)), Timestamp: repo.NewAPITime(now)},
+		{ID: "cancelled", Role: repo.SubscriptionBuyer, Status: repo.SubscriptionCancelled, NextOrder: repo.NewAPITime(now.Add(-time.Hour)), Timestamp: repo.NewAPITime(now)},
+		{ID: "vendor", Role: repo.SubscriptionVendor, Status: repo.SubscriptionActive, Timestamp: repo.NewAPITime(now)},
+	} {
+		if err := subDB.Put(sub); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	due, err := subDB.GetDue(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(due) != 1 || due[0].ID != "due" {
+		t.Errorf("Expected only the due subscription, got %v", due)
+	}
+	all, err := subDB.GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 4 {
+		t.Errorf("Expected 4 subscriptions, got %d", len(all))
+	}
+}
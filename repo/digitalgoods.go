@@ -0,0 +1,30 @@
+package repo
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrLicenseKeysDepleted is returned when a license key pool holds fewer
+// unassigned keys than were ordered
+var ErrLicenseKeysDepleted = errors.New("not enough license keys left in the pool")
+
+// DigitalFile is the file sent to every buyer of a listing variant. Each copy
+// is encrypted to the buyer's identity key before it is added to IPFS.
+type DigitalFile struct {
+	Slug      string    `json:"slug"`
+	Variant   int       `json:"variant"`
+	Filename  string    `json:"filename"`
+	Size      int       `json:"size"`
+	Content   []byte    `json:"-"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// LicenseKeyPool counts the license keys of a listing variant. Each key is
+// sent to a single buyer.
+type LicenseKeyPool struct {
+	Slug      string `json:"slug"`
+	Variant   int    `json:"variant"`
+	Available int    `json:"available"`
+	Assigned  int    `json:"assigned"`
+}
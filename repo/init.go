@@ -17,7 +17,7 @@ import (
 	"github.com/tyler-smith/go-bip39"
 )
 
-const RepoVersion = "34"
+const RepoVersion = "42"
 
 var log = logging.MustGetLogger("repo")
 var ErrRepoExists = errors.New("IPFS configuration file exists. Reinitializing would overwrite your keys. Use -f to force overwrite.")
@@ -165,9 +165,11 @@ func addConfigExtensions(repoRoot string) error {
 	}
 	var (
 		a = schema.APIConfig{
-			Enabled:     true,
-			AllowedIPs:  []string{},
-			HTTPHeaders: nil,
+			Enabled:       true,
+			AllowedIPs:    []string{},
+			HTTPHeaders:   nil,
+			GRPCAddress:   schema.GRPCDefaultAddress,
+			PublicGateway: schema.DefaultPublicGatewayConfig(),
 		}
 
 		ds = schema.DataSharing{
@@ -58,6 +58,8 @@ const (
 	AboutMaxCharacters = 10000
 	// URLMaxCharacters - max length for URL
 	URLMaxCharacters = 2000
+	// MaxAvailabilitySlots - max bookable slots of a service listing
+	MaxAvailabilitySlots = 500
 	// MaxCountryCodes - max country codes
 	MaxCountryCodes = 255
 	// DefaultEscrowTimeout - escrow timeout in hours
@@ -68,6 +70,8 @@ const (
 	PriceModifierMin = -99.99
 	// PriceModifierMax = max price modifier
 	PriceModifierMax = 1000.00
+	// MaxSubscriptionIntervalDays - max days between subscription orders
+	MaxSubscriptionIntervalDays = 366
 )
 
 type option struct {
@@ -101,22 +105,25 @@ type Item struct {
 	Memo           string         `json:"memo"`
 	Coupons        []string       `json:"coupons"`
 	PaymentAddress string         `json:"paymentAddress"`
+	SlotID         string         `json:"slotId"`
 }
 
 // PurchaseData represents purchase request metadata
 type PurchaseData struct {
-	ShipTo               string  `json:"shipTo"`
-	Address              string  `json:"address"`
-	City                 string  `json:"city"`
-	State                string  `json:"state"`
-	PostalCode           string  `json:"postalCode"`
-	CountryCode          string  `json:"countryCode"`
-	AddressNotes         string  `json:"addressNotes"`
-	Moderator            string  `json:"moderator"`
-	Items                []Item  `json:"items"`
-	AlternateContactInfo string  `json:"alternateContactInfo"`
-	RefundAddress        *string `json:"refundAddress"` //optional, can be left out of json
-	PaymentCoin          string  `json:"paymentCoin"`
+	ShipTo               string      `json:"shipTo"`
+	Address              string      `json:"address"`
+	City                 string      `json:"city"`
+	State                string      `json:"state"`
+	PostalCode           string      `json:"postalCode"`
+	CountryCode          string      `json:"countryCode"`
+	AddressNotes         string      `json:"addressNotes"`
+	Moderator            string      `json:"moderator"`
+	Items                []Item      `json:"items"`
+	AlternateContactInfo string      `json:"alternateContactInfo"`
+	RefundAddress        *string     `json:"refundAddress"` //optional, can be left out of json
+	PaymentCoin          string      `json:"paymentCoin"`
+	PriceQuote           *PriceQuote `json:"priceQuote"` //optional, the vendor's quote from POST /ob/pricequote
+	SubscriptionID       string      `json:"-"`          //set on the orders of a subscription
 }
 
 // IndividualListingContainer is a wrapper for a single listing
@@ -566,7 +573,8 @@ func (l *Listing) GetPrice() (*CurrencyValue, error) {
 			Amount:   big.NewInt(0),
 			Currency: NewUnknownCryptoDefinition(l.GetCryptoCurrencyCode(), 0),
 		}, nil
-	case pb.Listing_Metadata_DIGITAL_GOOD.String(), pb.Listing_Metadata_PHYSICAL_GOOD.String(), pb.Listing_Metadata_SERVICE.String():
+	case pb.Listing_Metadata_DIGITAL_GOOD.String(), pb.Listing_Metadata_PHYSICAL_GOOD.String(), pb.Listing_Metadata_SERVICE.String(),
+		pb.Listing_Metadata_CROWD_FUND.String():
 		switch l.GetVersion() {
 		case 5:
 			return NewCurrencyValueFromProtobuf(l.listingProto.Item.BigPrice, l.listingProto.Item.PriceCurrency)
@@ -595,6 +603,47 @@ func (l *Listing) SetModerators(mods []string) error {
 	return nil
 }
 
+// SetTaxes sets the taxes of the listing
+func (l *Listing) SetTaxes(taxes []*pb.Listing_Tax) error {
+	l.listingProto.Taxes = taxes
+	return nil
+}
+
+// UsesStoreTaxes indicates whether the taxes are copied from the vendor's
+// tax table
+func (l *Listing) UsesStoreTaxes() bool {
+	return l.listingProto.StoreTaxes
+}
+
+// IsTaxInclusive indicates whether the prices and shipping include the taxes
+func (l *Listing) IsTaxInclusive() bool {
+	return l.listingProto.TaxInclusive
+}
+
+// GetSubscription returns the subscription terms, or nil when the listing
+// is not sold as a subscription
+func (l *Listing) GetSubscription() *pb.Listing_Subscription {
+	return l.listingProto.Subscription
+}
+
+// GetBackorder returns the backorder terms, or nil when orders beyond the
+// stock are rejected
+func (l *Listing) GetBackorder() *pb.Listing_Backorder {
+	return l.listingProto.Backorder
+}
+
+// GetCrowdfund returns the funding goal and deadline of a CROWD_FUND
+// listing, or nil for other listings
+func (l *Listing) GetCrowdfund() *pb.Listing_Crowdfund {
+	return l.listingProto.Crowdfund
+}
+
+// GetAvailability returns the bookable slots of a SERVICE listing, or nil
+// for listings which are not booked
+func (l *Listing) GetAvailability() *pb.Listing_Availability {
+	return l.listingProto.Availability
+}
+
 // GetTermsAndConditions return the terms for the listings purchase contract
 func (l *Listing) GetTermsAndConditions() string {
 	return l.listingProto.TermsAndConditions
@@ -1099,6 +1148,9 @@ func (l *Listing) GetShippingRegions() ([]string, []string) {
 		for _, shipRegion := range shipOption.Regions {
 			shipsTo[shipRegion.String()] = struct{}{}
 			for _, shipService := range shipOption.Services {
+				if len(shipService.WeightRates) > 0 || len(shipService.QuantityRates) > 0 {
+					continue
+				}
 				servicePrice, ok := new(big.Int).SetString(shipService.BigPrice, 10)
 				if ok && servicePrice.Cmp(big.NewInt(0)) == 0 {
 					freeShippingTo[shipRegion.String()] = struct{}{}
@@ -1516,25 +1568,8 @@ func (l *Listing) ValidateListing(testnet bool) (err error) {
 	}
 
 	// Taxes
-	if len(l.listingProto.Taxes) > MaxListItems {
-		return fmt.Errorf("number of taxes is greater than the max of %d", MaxListItems)
-	}
-	for _, tax := range l.listingProto.Taxes {
-		if tax.TaxType == "" {
-			return errors.New("tax type must be specified")
-		}
-		if len(tax.TaxType) > WordMaxCharacters {
-			return fmt.Errorf("tax type length must be less than the max of %d", WordMaxCharacters)
-		}
-		if len(tax.TaxRegions) == 0 {
-			return errors.New("tax must specify at least one region")
-		}
-		if len(tax.TaxRegions) > MaxCountryCodes {
-			return fmt.Errorf("number of tax regions is greater than the max of %d", MaxCountryCodes)
-		}
-		if tax.Percentage == 0 || tax.Percentage > 100 {
-			return errors.New("tax percentage must be between 0 and 100")
-		}
+	if err := ValidateTaxes(l.listingProto.Taxes); err != nil {
+		return err
 	}
 
 	// Coupons
@@ -1593,6 +1628,55 @@ func (l *Listing) ValidateListing(testnet bool) (err error) {
 		return fmt.Errorf("refund policy length must be less than the max of %d", PolicyMaxCharacters)
 	}
 
+	// Subscription
+	if subscription := l.listingProto.Subscription; subscription != nil {
+		if l.listingProto.Metadata.ContractType == pb.Listing_Metadata_CRYPTOCURRENCY {
+			return errors.New("cryptocurrency listings cannot be sold as subscriptions")
+		}
+		if subscription.IntervalDays == 0 {
+			return errors.New("subscription interval must be at least one day")
+		}
+		if subscription.IntervalDays > MaxSubscriptionIntervalDays {
+			return fmt.Errorf("subscription interval is longer than the max of %d days", MaxSubscriptionIntervalDays)
+		}
+	}
+
+	// Backorder
+	if backorder := l.listingProto.Backorder; backorder != nil {
+		if l.listingProto.Metadata.ContractType == pb.Listing_Metadata_CRYPTOCURRENCY {
+			return errors.New("cryptocurrency listings cannot be backordered")
+		}
+		if backorder.Limit == 0 {
+			return errors.New("backorder limit must be at least one")
+		}
+		if backorder.Preorder && backorder.ExpectedShipDate == nil {
+			return errors.New("preorders must have an expected ship date")
+		}
+		if len(l.listingProto.Item.Skus) == 0 {
+			return errors.New("backordered listings must set the quantity of their skus")
+		}
+		inventory, err := l.GetInventory()
+		if err != nil {
+			return err
+		}
+		// A negative quantity means unlimited stock on other listings
+		for _, count := range inventory {
+			if count.Sign() < 0 {
+				return errors.New("backordered listings cannot have a negative sku quantity")
+			}
+		}
+	}
+
+	// Crowdfund
+	if err := l.validateCrowdfund(testnet); err != nil {
+		return err
+	}
+
+	// Availability
+	if err := l.validateAvailability(); err != nil {
+		return err
+	}
+
 	// Type-specific validations
 	if l.listingProto.Metadata.ContractType == pb.Listing_Metadata_PHYSICAL_GOOD {
 		err := l.validatePhysicalListing()
@@ -1635,6 +1719,89 @@ func (l *Listing) ValidateListing(testnet bool) (err error) {
 	return nil
 }
 
+func (l *Listing) validateCrowdfund(testnet bool) error {
+	crowdfund := l.listingProto.Crowdfund
+	if l.listingProto.Metadata.ContractType != pb.Listing_Metadata_CROWD_FUND {
+		if crowdfund != nil {
+			return errors.New("only crowdfund listings can set a funding goal")
+		}
+		return nil
+	}
+	if crowdfund == nil {
+		return errors.New("crowdfund listings must set a funding goal and deadline")
+	}
+	if l.listingProto.Subscription != nil || l.listingProto.Backorder != nil {
+		return errors.New("crowdfund listings cannot be sold as subscriptions or backordered")
+	}
+	if goal, ok := new(big.Int).SetString(crowdfund.BigGoal, 10); !ok || goal.Sign() <= 0 {
+		return errors.New("crowdfund goal must be a positive amount")
+	}
+	if crowdfund.Deadline == nil {
+		return errors.New("missing required field: crowdfund deadline")
+	}
+	deadline := time.Unix(crowdfund.Deadline.Seconds, 0)
+	if deadline.Before(time.Now()) {
+		return errors.New("crowdfund deadline must be in the future")
+	}
+	if deadline.After(time.Unix(l.listingProto.Metadata.Expiry.Seconds, 0)) {
+		return errors.New("crowdfund deadline must not be after the listing expiration")
+	}
+	// Pledges must be refundable before the vendor can claim the escrow
+	escrowTimeout := time.Duration(l.listingProto.Metadata.EscrowTimeoutHours) * time.Hour
+	if !testnet && deadline.After(time.Now().Add(escrowTimeout)) {
+		return fmt.Errorf("crowdfund deadline must be within the escrow timeout of %d hours", l.listingProto.Metadata.EscrowTimeoutHours)
+	}
+	if len(l.listingProto.Moderators) == 0 {
+		return errors.New("crowdfund listings must have a moderator to hold the pledges in escrow")
+	}
+	return nil
+}
+
+func (l *Listing) validateAvailability() error {
+	availability := l.listingProto.Availability
+	if availability == nil {
+		return nil
+	}
+	if l.listingProto.Metadata.ContractType != pb.Listing_Metadata_SERVICE {
+		return errors.New("only service listings can have bookable slots")
+	}
+	if l.listingProto.Subscription != nil || l.listingProto.Backorder != nil {
+		return errors.New("service listings with bookable slots cannot be sold as subscriptions or backordered")
+	}
+	if len(availability.Location) > SentenceMaxCharacters {
+		return fmt.Errorf("availability location is longer than the max of %d", SentenceMaxCharacters)
+	}
+	if len(availability.Slots) == 0 {
+		return errors.New("availability must have at least one slot")
+	}
+	if len(availability.Slots) > MaxAvailabilitySlots {
+		return fmt.Errorf("number of slots is greater than the max of %d", MaxAvailabilitySlots)
+	}
+	ids := make(map[string]bool)
+	for _, slot := range availability.Slots {
+		if slot.Id == "" {
+			return errors.New("slot id must not be empty")
+		}
+		if len(slot.Id) > WordMaxCharacters {
+			return fmt.Errorf("slot id is longer than the max of %d", WordMaxCharacters)
+		}
+		if ids[slot.Id] {
+			return fmt.Errorf("duplicate slot id %s", slot.Id)
+		}
+		ids[slot.Id] = true
+		if slot.Start == nil {
+			return fmt.Errorf("missing required field: slot %s start", slot.Id)
+		}
+		if slot.DurationMinutes == 0 {
+			return fmt.Errorf("slot %s must have a duration", slot.Id)
+		}
+		if slot.Capacity == 0 {
+			return fmt.Errorf("slot %s must have a capacity", slot.Id)
+		}
+	}
+	return nil
+}
+
 func (l *Listing) validatePhysicalListing() error {
 	if len(l.listingProto.Item.Condition) > SentenceMaxCharacters {
 		return fmt.Errorf("'Condition' length must be less than the max of %d", SentenceMaxCharacters)
@@ -1710,12 +1877,46 @@ func (l *Listing) validatePhysicalListing() error {
 			if _, ok := new(big.Int).SetString(option.BigPrice, 10); !ok {
 				return errors.New("invalid shipping service price amount")
 			}
+			if err := l.validateShippingRates(option); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
+// validateShippingRates checks the weight or quantity rates of a shipping
+// service
+func (l *Listing) validateShippingRates(service *pb.Listing_ShippingOption_Service) error {
+	if len(service.WeightRates) > 0 && len(service.QuantityRates) > 0 {
+		return errors.New("shipping service must not be priced both by weight and by quantity")
+	}
+	if len(service.WeightRates) > MaxListItems || len(service.QuantityRates) > MaxListItems {
+		return fmt.Errorf("number of shipping rates is greater than the max of %d", MaxListItems)
+	}
+	if len(service.WeightRates) > 0 && l.listingProto.Item.Grams <= 0 {
+		return errors.New("item weight must be set to price shipping by weight")
+	}
+	for _, rate := range service.WeightRates {
+		if rate.MaxGrams == 0 {
+			return errors.New("shipping weight rate must have a maximum weight")
+		}
+		if _, ok := new(big.Int).SetString(rate.BigPrice, 10); !ok {
+			return errors.New("invalid shipping weight rate price amount")
+		}
+	}
+	for _, rate := range service.QuantityRates {
+		if rate.MaxQuantity == 0 {
+			return errors.New("shipping quantity rate must have a maximum quantity")
+		}
+		if _, ok := new(big.Int).SetString(rate.BigPrice, 10); !ok {
+			return errors.New("invalid shipping quantity rate price amount")
+		}
+	}
+	return nil
+}
+
 func (l *Listing) ValidateCryptoListing() error {
 	if len(l.listingProto.Metadata.AcceptedCurrencies) != 1 {
 		return errors.New("cryptocurrency listing must only have one accepted currency")
@@ -14,24 +14,37 @@ type (
 
 	// ListingIndexData reprents a single node in the Listing index
 	ListingIndexData struct {
-		Hash               string           `json:"hash"`
-		Slug               string           `json:"slug"`
-		Title              string           `json:"title"`
-		Categories         []string         `json:"categories"`
-		NSFW               bool             `json:"nsfw"`
-		ContractType       string           `json:"contractType"`
-		Description        string           `json:"description"`
-		Thumbnail          ListingThumbnail `json:"thumbnail"`
-		Price              *CurrencyValue   `json:"price"`
-		Modifier           float32          `json:"modifier"`
-		ShipsTo            []string         `json:"shipsTo"`
-		FreeShipping       []string         `json:"freeShipping"`
-		Language           string           `json:"language"`
-		AverageRating      float32          `json:"averageRating"`
-		RatingCount        uint32           `json:"ratingCount"`
-		ModeratorIDs       []string         `json:"moderators"`
-		AcceptedCurrencies []string         `json:"acceptedCurrencies"`
-		CryptoCurrencyCode string           `json:"coinType"`
+		Hash               string             `json:"hash"`
+		Slug               string             `json:"slug"`
+		Title              string             `json:"title"`
+		Categories         []string           `json:"categories"`
+		NSFW               bool               `json:"nsfw"`
+		ContractType       string             `json:"contractType"`
+		Description        string             `json:"description"`
+		Thumbnail          ListingThumbnail   `json:"thumbnail"`
+		Price              *CurrencyValue     `json:"price"`
+		Modifier           float32            `json:"modifier"`
+		ShipsTo            []string           `json:"shipsTo"`
+		FreeShipping       []string           `json:"freeShipping"`
+		Language           string             `json:"language"`
+		AverageRating      float32            `json:"averageRating"`
+		RatingCount        uint32             `json:"ratingCount"`
+		RatingAverages     *RatingAverages    `json:"ratingAverages,omitempty"`
+		RatingHistogram    []uint32           `json:"ratingHistogram,omitempty"`
+		ModeratorIDs       []string           `json:"moderators"`
+		AcceptedCurrencies []string           `json:"acceptedCurrencies"`
+		CryptoCurrencyCode string             `json:"coinType"`
+		Crowdfund          *CrowdfundProgress `json:"crowdfund,omitempty"`
+	}
+
+	// RatingAverages holds the average of each of the scores of a set of
+	// ratings
+	RatingAverages struct {
+		Overall         float32 `json:"overall"`
+		Quality         float32 `json:"quality"`
+		Description     float32 `json:"description"`
+		DeliverySpeed   float32 `json:"deliverySpeed"`
+		CustomerService float32 `json:"customerService"`
 	}
 )
 
@@ -2,11 +2,14 @@ package repo
 
 import (
 	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
 )
@@ -54,20 +57,21 @@ var (
 		migrations.Migration031{},
 		migrations.Migration032{},
 		migrations.Migration033{},
+		migrations.Migration034{},
+		migrations.Migration035{},
+		migrations.Migration036{},
+		migrations.Migration037{},
+		migrations.Migration038{},
+		migrations.Migration039{},
+		migrations.Migration040{},
+		migrations.Migration041{},
 	}
 )
 
 // MigrateUp looks at the currently active migration version
 // and will migrate all the way up (applying all up migrations).
 func MigrateUp(repoPath, dbPassword string, testnet bool) error {
-	version, err := ioutil.ReadFile(path.Join(repoPath, "repover"))
-	if err != nil && !os.IsNotExist(err) {
-		return err
-	} else if err != nil && os.IsNotExist(err) {
-		log.Noticef("missing repo version file, migrating from 0")
-		version = []byte("0")
-	}
-	v, err := strconv.Atoi(strings.Trim(string(version), "\n"))
+	v, err := GetSchemaVersion(repoPath)
 	if err != nil {
 		return err
 	}
@@ -87,3 +91,158 @@ func MigrateUp(repoPath, dbPassword string, testnet bool) error {
 	}
 	return nil
 }
+
+// GetSchemaVersion returns the schema version recorded in the repover file.
+// A repo without the file is at version 0.
+func GetSchemaVersion(repoPath string) (int, error) {
+	version, err := ioutil.ReadFile(path.Join(repoPath, "repover"))
+	if err != nil && !os.IsNotExist(err) {
+		return 0, err
+	} else if err != nil && os.IsNotExist(err) {
+		log.Noticef("missing repo version file, assuming schema version 0")
+		version = []byte("0")
+	}
+	return strconv.Atoi(strings.Trim(string(version), "\n"))
+}
+
+// MigrationStep is a single migration changing the schema from one version
+// to the next
+type MigrationStep struct {
+	From int
+	To   int
+}
+
+// Down returns true if the step reverts a migration
+func (s MigrationStep) Down() bool {
+	return s.To < s.From
+}
+
+func (s MigrationStep) String() string {
+	if s.Down() {
+		return fmt.Sprintf("revert migration %03d changing schema to version %03d", s.To, s.To)
+	}
+	return fmt.Sprintf("run migration %03d changing schema to version %03d", s.From, s.To)
+}
+
+// PlanMigration returns the steps changing the schema from its current
+// version to the target version
+func PlanMigration(repoPath string, target int) ([]MigrationStep, error) {
+	return planMigration(repoPath, target, Migrations)
+}
+
+func planMigration(repoPath string, target int, migrations []Migration) ([]MigrationStep, error) {
+	v, err := GetSchemaVersion(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	if v > len(migrations) {
+		return nil, ErrUnknownSchema
+	}
+	if target < 0 || target > len(migrations) {
+		return nil, fmt.Errorf("target version must be between 0 and %d", len(migrations))
+	}
+	var steps []MigrationStep
+	for x := v; x < target; x++ {
+		steps = append(steps, MigrationStep{From: x, To: x + 1})
+	}
+	for x := v; x > target; x-- {
+		steps = append(steps, MigrationStep{From: x, To: x - 1})
+	}
+	return steps, nil
+}
+
+// MigrateTo runs or reverts migrations until the schema is at the target
+// version. The database, config and repover files are backed up first and
+// restored if a step fails. The path of the backup is returned.
+func MigrateTo(repoPath, dbPassword string, testnet bool, target int) (string, error) {
+	return migrateTo(repoPath, dbPassword, testnet, target, Migrations)
+}
+
+func migrateTo(repoPath, dbPassword string, testnet bool, target int, migrations []Migration) (string, error) {
+	steps, err := planMigration(repoPath, target, migrations)
+	if err != nil || len(steps) == 0 {
+		return "", err
+	}
+	backupPath, err := backupMigrationFiles(repoPath, testnet)
+	if err != nil {
+		return "", fmt.Errorf("backing up before migrating: %s", err)
+	}
+	for _, step := range steps {
+		log.Noticef("%s...", step)
+		if step.Down() {
+			err = migrations[step.To].Down(repoPath, dbPassword, testnet)
+		} else {
+			err = migrations[step.From].Up(repoPath, dbPassword, testnet)
+		}
+		if err != nil {
+			log.Errorf("failed to %s: %s", step, err)
+			if rErr := restoreMigrationFiles(repoPath, backupPath, testnet); rErr != nil {
+				return backupPath, fmt.Errorf("restoring backup failed: (%s) due to (%s)", rErr, err)
+			}
+			return backupPath, fmt.Errorf("failed to %s, backup restored: %s", step, err)
+		}
+	}
+	return backupPath, nil
+}
+
+// migrationFiles are the files changed by migrations relative to the repo
+func migrationFiles(testnet bool) []string {
+	databaseFile := "mainnet.db"
+	if testnet {
+		databaseFile = "testnet.db"
+	}
+	return []string{"repover", "config", path.Join("datastore", databaseFile)}
+}
+
+func backupMigrationFiles(repoPath string, testnet bool) (string, error) {
+	backupPath := path.Join(repoPath, "backups", "migration-"+time.Now().Format("20060102-150405.000000000"))
+	for _, f := range migrationFiles(testnet) {
+		err := copyFile(path.Join(repoPath, f), path.Join(backupPath, f))
+		if err != nil && !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+	return backupPath, nil
+}
+
+// restoreMigrationFiles copies the backup over the repo. Files which did not
+// exist when the backup was made are removed.
+func restoreMigrationFiles(repoPath, backupPath string, testnet bool) error {
+	for _, f := range migrationFiles(testnet) {
+		err := copyFile(path.Join(backupPath, f), path.Join(repoPath, f))
+		if os.IsNotExist(err) {
+			err = os.Remove(path.Join(repoPath, f))
+			if os.IsNotExist(err) {
+				continue
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(path.Dir(dst), os.ModePerm); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, info.Mode())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,118 @@
+package repo
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"testing"
+)
+
+// versionMigration records the schema version like the real migrations and
+// appends to the database file so that restores can be checked
+type versionMigration struct {
+	version int
+	failUp  bool
+}
+
+func (m versionMigration) write(repoPath string, version int) error {
+	dbPath := path.Join(repoPath, "datastore", "mainnet.db")
+	db, err := ioutil.ReadFile(dbPath)
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(dbPath, append(db, []byte(strconv.Itoa(version))...), os.ModePerm); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path.Join(repoPath, "repover"), []byte(strconv.Itoa(version)), os.ModePerm)
+}
+
+func (m versionMigration) Up(repoPath, dbPassword string, testnet bool) error {
+	if m.failUp {
+		// Fail half way through
+		if err := ioutil.WriteFile(path.Join(repoPath, "repover"), []byte("broken"), os.ModePerm); err != nil {
+			return err
+		}
+		return errors.New("migration failed")
+	}
+	return m.write(repoPath, m.version+1)
+}
+
+func (m versionMigration) Down(repoPath, dbPassword string, testnet bool) error {
+	return m.write(repoPath, m.version)
+}
+
+func newMigrationTestRepo(t *testing.T, version int) string {
+	repoPath, err := ioutil.TempDir("", "migration")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(path.Join(repoPath, "datastore"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path.Join(repoPath, "datastore", "mainnet.db"), []byte("db"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path.Join(repoPath, "repover"), []byte(strconv.Itoa(version)), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	return repoPath
+}
+
+func TestMigrateTo(t *testing.T) {
+	migrations := []Migration{versionMigration{version: 0}, versionMigration{version: 1}, versionMigration{version: 2}}
+	repoPath := newMigrationTestRepo(t, 1)
+	defer os.RemoveAll(repoPath)
+
+	steps, err := planMigration(repoPath, 3, migrations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(steps) != 2 || steps[0] != (MigrationStep{1, 2}) || steps[1] != (MigrationStep{2, 3}) {
+		t.Errorf("unexpected plan: %v", steps)
+	}
+	if _, err := planMigration(repoPath, 4, migrations); err == nil {
+		t.Error("expected an error migrating past the last migration")
+	}
+
+	backupPath, err := migrateTo(repoPath, "", false, 3, migrations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := GetSchemaVersion(repoPath); v != 3 {
+		t.Errorf("expected version 3, got %d", v)
+	}
+	if backup, _ := ioutil.ReadFile(path.Join(backupPath, "datastore", "mainnet.db")); string(backup) != "db" {
+		t.Errorf("expected the database to be backed up, got %q", backup)
+	}
+
+	if _, err := migrateTo(repoPath, "", false, 0, migrations); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := GetSchemaVersion(repoPath); v != 0 {
+		t.Errorf("expected version 0, got %d", v)
+	}
+	if db, _ := ioutil.ReadFile(path.Join(repoPath, "datastore", "mainnet.db")); string(db) != "db23210" {
+		t.Errorf("expected the migrations to run in order, got %q", db)
+	}
+}
+
+func TestMigrateToRestoresBackup(t *testing.T) {
+	migrations := []Migration{versionMigration{version: 0}, versionMigration{version: 1, failUp: true}}
+	repoPath := newMigrationTestRepo(t, 0)
+	defer os.RemoveAll(repoPath)
+
+	if _, err := migrateTo(repoPath, "", false, 2, migrations); err == nil {
+		t.Fatal("expected the failed migration to be returned")
+	}
+	if v, err := GetSchemaVersion(repoPath); err != nil || v != 0 {
+		t.Errorf("expected version 0 to be restored, got %d (%v)", v, err)
+	}
+	if db, _ := ioutil.ReadFile(path.Join(repoPath, "datastore", "mainnet.db")); string(db) != "db" {
+		t.Errorf("expected the database to be restored, got %q", db)
+	}
+	if _, err := os.Stat(path.Join(repoPath, "config")); !os.IsNotExist(err) {
+		t.Error("expected files missing before the migration to stay missing")
+	}
+}
@@ -0,0 +1,113 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+const (
+	// MigrationCreatePendingSpendsAM10CreateSQL creates the table of spends awaiting approval
+	MigrationCreatePendingSpendsAM10CreateSQL = "create table pendingspends (spendID text primary key not null, coin text, amount text, address text, memo text, orderID text, request blob, timestamp integer);"
+	// migrationCreatePendingSpendsAM10DeleteSQL drops the pendingspends table
+	migrationCreatePendingSpendsAM10DeleteSQL = "drop table if exists pendingspends;"
+	// migrationCreatePendingSpendsAM10UpVer set the repo Up version
+	migrationCreatePendingSpendsAM10UpVer = 35
+	// migrationCreatePendingSpendsAM10DownVer set the repo Down version
+	migrationCreatePendingSpendsAM10DownVer = 34
+)
+
+// Migration034 creates the pendingspends table
+type Migration034 struct{}
+
+// Up the migration Up code
+func (Migration034) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	var (
+		databaseFilePath string
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(MigrationCreatePendingSpendsAM10CreateSQL); err != nil {
+		if err.Error() == "table pendingspends already exists" {
+			if rErr := tx.Rollback(); rErr != nil {
+				return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+			}
+			return writeRepoVer(repoPath, migrationCreatePendingSpendsAM10UpVer)
+		}
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Bump schema version
+	return writeRepoVer(repoPath, migrationCreatePendingSpendsAM10UpVer)
+}
+
+// Down the migration Down code
+func (Migration034) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	var (
+		databaseFilePath string
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(migrationCreatePendingSpendsAM10DeleteSQL); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Revert schema version
+	return writeRepoVer(repoPath, migrationCreatePendingSpendsAM10DownVer)
+}
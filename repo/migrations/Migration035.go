@@ -0,0 +1,121 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+const (
+	// MigrationCreateOutboxAM11CreateSQL creates the table tracking offline messages stored for their recipients
+	MigrationCreateOutboxAM11CreateSQL = "create table outbox (pointerID text primary key not null, recipient text, messageType text, location text, digest text, status text, timestamp integer, statusTimestamp integer);"
+	// MigrationCreateOutboxAM11CreateIndexSQL indexes the outbox by status
+	MigrationCreateOutboxAM11CreateIndexSQL = "create index index_outbox on outbox (status, timestamp);"
+	// migrationCreateOutboxAM11DeleteSQL drops the outbox table
+	migrationCreateOutboxAM11DeleteSQL = "drop table if exists outbox;"
+	// migrationCreateOutboxAM11UpVer set the repo Up version
+	migrationCreateOutboxAM11UpVer = 36
+	// migrationCreateOutboxAM11DownVer set the repo Down version
+	migrationCreateOutboxAM11DownVer = 35
+)
+
+// Migration035 creates the outbox table
+type Migration035 struct{}
+
+// Up the migration Up code
+func (Migration035) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	var (
+		databaseFilePath string
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(MigrationCreateOutboxAM11CreateSQL); err != nil {
+		if err.Error() == "table outbox already exists" {
+			if rErr := tx.Rollback(); rErr != nil {
+				return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+			}
+			return writeRepoVer(repoPath, migrationCreateOutboxAM11UpVer)
+		}
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if _, err = tx.Exec(MigrationCreateOutboxAM11CreateIndexSQL); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Bump schema version
+	return writeRepoVer(repoPath, migrationCreateOutboxAM11UpVer)
+}
+
+// Down the migration Down code
+func (Migration035) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	var (
+		databaseFilePath string
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(migrationCreateOutboxAM11DeleteSQL); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Revert schema version
+	return writeRepoVer(repoPath, migrationCreateOutboxAM11DownVer)
+}
@@ -0,0 +1,113 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+const (
+	// MigrationCreateExchangeRatesAM12CreateSQL creates the table keeping the history of exchange rates
+	MigrationCreateExchangeRatesAM12CreateSQL = "create table exchangerates (code text not null, rate real, timestamp integer not null, primary key (code, timestamp));"
+	// migrationCreateExchangeRatesAM12DeleteSQL drops the exchangerates table
+	migrationCreateExchangeRatesAM12DeleteSQL = "drop table if exists exchangerates;"
+	// migrationCreateExchangeRatesAM12UpVer set the repo Up version
+	migrationCreateExchangeRatesAM12UpVer = 37
+	// migrationCreateExchangeRatesAM12DownVer set the repo Down version
+	migrationCreateExchangeRatesAM12DownVer = 36
+)
+
+// Migration036 creates the exchangerates table
+type Migration036 struct{}
+
+// Up the migration Up code
+func (Migration036) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	var (
+		databaseFilePath string
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(MigrationCreateExchangeRatesAM12CreateSQL); err != nil {
+		if err.Error() == "table exchangerates already exists" {
+			if rErr := tx.Rollback(); rErr != nil {
+				return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+			}
+			return writeRepoVer(repoPath, migrationCreateExchangeRatesAM12UpVer)
+		}
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Bump schema version
+	return writeRepoVer(repoPath, migrationCreateExchangeRatesAM12UpVer)
+}
+
+// Down the migration Down code
+func (Migration036) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	var (
+		databaseFilePath string
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(migrationCreateExchangeRatesAM12DeleteSQL); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Revert schema version
+	return writeRepoVer(repoPath, migrationCreateExchangeRatesAM12DownVer)
+}
@@ -0,0 +1,93 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration036(t *testing.T) {
+	var (
+		basePath          = schema.GenerateTempPath()
+		testRepoPath, err = schema.OpenbazaarPathTransform(basePath, true)
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appSchema, err := schema.NewCustomSchemaManager(schema.SchemaContext{DataPath: testRepoPath, TestModeEnabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		databasePath = appSchema.DatabasePath()
+		schemaPath   = appSchema.DataPathJoin("repover")
+
+		insertSQL = "insert into exchangerates(code, rate, timestamp) values(?,?,?)"
+	)
+
+	// create schema version file
+	if err = ioutil.WriteFile(schemaPath, []byte("36"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open("sqlite3", databasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if _, err := db.Exec("DROP TABLE IF EXISTS exchangerates;"); err != nil {
+		t.Fatal(err)
+	}
+
+	// execute migration up
+	m := migrations.Migration036{}
+	if err := m.Up(testRepoPath, "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	// assert repo version updated
+	if err = appSchema.VerifySchemaVersion("37"); err != nil {
+		t.Fatal(err)
+	}
+
+	// verify change was applied properly
+	_, err = db.Exec(insertSQL, "USD", 9000.5, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// running up again is harmless
+	if err := m.Up(testRepoPath, "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	// execute migration down
+	if err := m.Down(testRepoPath, "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	// assert repo version reverted
+	if err = appSchema.VerifySchemaVersion("36"); err != nil {
+		t.Fatal(err)
+	}
+
+	// verify change was reverted properly
+	_, err = db.Exec(insertSQL, "EUR", 8000.5, 1)
+	if err == nil {
+		t.Fatal("expected the exchangerates table to be dropped")
+	}
+}
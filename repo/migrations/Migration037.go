@@ -0,0 +1,121 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+const (
+	// MigrationCreateSubscriptionsAM13CreateSQL creates the table of recurring order agreements
+	MigrationCreateSubscriptionsAM13CreateSQL = "create table subscriptions (subscriptionID text primary key not null, role text, peerID text, status text, agreement blob, purchaseData blob, autoPayCap text, periodsCompleted integer, nextOrder integer, lastOrderID text, timestamp integer);"
+	// MigrationCreateSubscriptionsAM13CreateIndexSQL indexes the subscriptions by role, status and next order
+	MigrationCreateSubscriptionsAM13CreateIndexSQL = "create index index_subscriptions on subscriptions (role, status, nextOrder);"
+	// migrationCreateSubscriptionsAM13DeleteSQL drops the subscriptions table
+	migrationCreateSubscriptionsAM13DeleteSQL = "drop table if exists subscriptions;"
+	// migrationCreateSubscriptionsAM13UpVer set the repo Up version
+	migrationCreateSubscriptionsAM13UpVer = 38
+	// migrationCreateSubscriptionsAM13DownVer set the repo Down version
+	migrationCreateSubscriptionsAM13DownVer = 37
+)
+
+// Migration037 creates the subscriptions table
+type Migration037 struct{}
+
+// Up the migration Up code
+func (Migration037) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	var (
+		databaseFilePath string
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(MigrationCreateSubscriptionsAM13CreateSQL); err != nil {
+		if err.Error() == "table subscriptions already exists" {
+			if rErr := tx.Rollback(); rErr != nil {
+				return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+			}
+			return writeRepoVer(repoPath, migrationCreateSubscriptionsAM13UpVer)
+		}
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if _, err = tx.Exec(MigrationCreateSubscriptionsAM13CreateIndexSQL); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Bump schema version
+	return writeRepoVer(repoPath, migrationCreateSubscriptionsAM13UpVer)
+}
+
+// Down the migration Down code
+func (Migration037) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	var (
+		databaseFilePath string
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(migrationCreateSubscriptionsAM13DeleteSQL); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Revert schema version
+	return writeRepoVer(repoPath, migrationCreateSubscriptionsAM13DownVer)
+}
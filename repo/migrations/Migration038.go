@@ -0,0 +1,125 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+const (
+	// MigrationCreateDigitalGoodsAM14FilesCreateSQL creates the table of files sent to buyers of digital goods
+	MigrationCreateDigitalGoodsAM14FilesCreateSQL = "create table digitalfiles (slug text not null, variant integer not null, filename text, content blob, timestamp integer, primary key (slug, variant));"
+	// MigrationCreateDigitalGoodsAM14KeysCreateSQL creates the table of license key pools
+	MigrationCreateDigitalGoodsAM14KeysCreateSQL = "create table licensekeys (keyID integer primary key autoincrement, slug text not null, variant integer not null, licenseKey text not null, orderID text not null default '', timestamp integer, unique (slug, variant, licenseKey));"
+	// MigrationCreateDigitalGoodsAM14KeysCreateIndexSQL indexes the license keys by variant and order
+	MigrationCreateDigitalGoodsAM14KeysCreateIndexSQL = "create index index_licensekeys on licensekeys (slug, variant, orderID);"
+	// migrationCreateDigitalGoodsAM14DeleteSQL drops the digital goods tables
+	migrationCreateDigitalGoodsAM14DeleteSQL = "drop table if exists digitalfiles; drop table if exists licensekeys;"
+	// migrationCreateDigitalGoodsAM14UpVer set the repo Up version
+	migrationCreateDigitalGoodsAM14UpVer = 39
+	// migrationCreateDigitalGoodsAM14DownVer set the repo Down version
+	migrationCreateDigitalGoodsAM14DownVer = 38
+)
+
+// Migration038 creates the digital goods tables
+type Migration038 struct{}
+
+// Up the migration Up code
+func (Migration038) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	var (
+		databaseFilePath string
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(MigrationCreateDigitalGoodsAM14FilesCreateSQL); err != nil {
+		if err.Error() == "table digitalfiles already exists" {
+			if rErr := tx.Rollback(); rErr != nil {
+				return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+			}
+			return writeRepoVer(repoPath, migrationCreateDigitalGoodsAM14UpVer)
+		}
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	for _, stmt := range []string{MigrationCreateDigitalGoodsAM14KeysCreateSQL, MigrationCreateDigitalGoodsAM14KeysCreateIndexSQL} {
+		if _, err = tx.Exec(stmt); err != nil {
+			if rErr := tx.Rollback(); rErr != nil {
+				return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+			}
+			return err
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Bump schema version
+	return writeRepoVer(repoPath, migrationCreateDigitalGoodsAM14UpVer)
+}
+
+// Down the migration Down code
+func (Migration038) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	var (
+		databaseFilePath string
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(migrationCreateDigitalGoodsAM14DeleteSQL); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Revert schema version
+	return writeRepoVer(repoPath, migrationCreateDigitalGoodsAM14DownVer)
+}
@@ -0,0 +1,121 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+const (
+	// MigrationCreateCryptoPayoutsAM15CreateSQL creates the table of payments made to fulfill cryptocurrency listings
+	MigrationCreateCryptoPayoutsAM15CreateSQL = "create table cryptopayouts (orderID text not null, item integer not null, coin text, amount text, address text, txid text, timestamp integer, primary key (orderID, item));"
+	// MigrationCreateCryptoPayoutsAM15CreateIndexSQL indexes the payouts by coin and time
+	MigrationCreateCryptoPayoutsAM15CreateIndexSQL = "create index index_cryptopayouts on cryptopayouts (coin, timestamp);"
+	// migrationCreateCryptoPayoutsAM15DeleteSQL drops the cryptopayouts table
+	migrationCreateCryptoPayoutsAM15DeleteSQL = "drop table if exists cryptopayouts;"
+	// migrationCreateCryptoPayoutsAM15UpVer set the repo Up version
+	migrationCreateCryptoPayoutsAM15UpVer = 40
+	// migrationCreateCryptoPayoutsAM15DownVer set the repo Down version
+	migrationCreateCryptoPayoutsAM15DownVer = 39
+)
+
+// Migration039 creates the cryptopayouts table
+type Migration039 struct{}
+
+// Up the migration Up code
+func (Migration039) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	var (
+		databaseFilePath string
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(MigrationCreateCryptoPayoutsAM15CreateSQL); err != nil {
+		if err.Error() == "table cryptopayouts already exists" {
+			if rErr := tx.Rollback(); rErr != nil {
+				return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+			}
+			return writeRepoVer(repoPath, migrationCreateCryptoPayoutsAM15UpVer)
+		}
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if _, err = tx.Exec(MigrationCreateCryptoPayoutsAM15CreateIndexSQL); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Bump schema version
+	return writeRepoVer(repoPath, migrationCreateCryptoPayoutsAM15UpVer)
+}
+
+// Down the migration Down code
+func (Migration039) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	var (
+		databaseFilePath string
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(migrationCreateCryptoPayoutsAM15DeleteSQL); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Revert schema version
+	return writeRepoVer(repoPath, migrationCreateCryptoPayoutsAM15DownVer)
+}
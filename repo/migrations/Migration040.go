@@ -0,0 +1,121 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+const (
+	// MigrationCreateAppointmentsAM16CreateSQL creates the table of booked slots of service listings
+	MigrationCreateAppointmentsAM16CreateSQL = "create table appointments (orderID text not null, vendor integer not null, slug text, title text, slotID text, start integer, duration integer, quantity integer, location text, peerID text, state text, timestamp integer, primary key (orderID, vendor));"
+	// MigrationCreateAppointmentsAM16CreateIndexSQL indexes the appointments by slot
+	MigrationCreateAppointmentsAM16CreateIndexSQL = "create index index_appointments on appointments (slug, slotID);"
+	// migrationCreateAppointmentsAM16DeleteSQL drops the appointments table
+	migrationCreateAppointmentsAM16DeleteSQL = "drop table if exists appointments;"
+	// migrationCreateAppointmentsAM16UpVer set the repo Up version
+	migrationCreateAppointmentsAM16UpVer = 41
+	// migrationCreateAppointmentsAM16DownVer set the repo Down version
+	migrationCreateAppointmentsAM16DownVer = 40
+)
+
+// Migration040 creates the appointments table
+type Migration040 struct{}
+
+// Up the migration Up code
+func (Migration040) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	var (
+		databaseFilePath string
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(MigrationCreateAppointmentsAM16CreateSQL); err != nil {
+		if err.Error() == "table appointments already exists" {
+			if rErr := tx.Rollback(); rErr != nil {
+				return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+			}
+			return writeRepoVer(repoPath, migrationCreateAppointmentsAM16UpVer)
+		}
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if _, err = tx.Exec(MigrationCreateAppointmentsAM16CreateIndexSQL); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Bump schema version
+	return writeRepoVer(repoPath, migrationCreateAppointmentsAM16UpVer)
+}
+
+// Down the migration Down code
+func (Migration040) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	var (
+		databaseFilePath string
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(migrationCreateAppointmentsAM16DeleteSQL); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Revert schema version
+	return writeRepoVer(repoPath, migrationCreateAppointmentsAM16DownVer)
+}
@@ -0,0 +1,135 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+	"path"
+	"strings"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+const (
+	// migrationAlterOutboxAM17DeleteAttempts alters the table to add the deleteAttempts column
+	migrationAlterOutboxAM17DeleteAttempts = "alter table outbox add deleteAttempts integer not null default 0;"
+	// migrationAlterOutboxAM17LastError alters the table to add the lastError column
+	migrationAlterOutboxAM17LastError = "alter table outbox add lastError text not null default '';"
+	// migrationRenameOutboxAM17 renames the table to copy its rows back on Down
+	migrationRenameOutboxAM17 = "alter table outbox rename to temp_outbox;"
+	// MigrationCreateOutboxAM17CreateSQLDown the outbox create sql without the delete failure columns
+	MigrationCreateOutboxAM17CreateSQLDown = "create table outbox (pointerID text primary key not null, recipient text, messageType text, location text, digest text, status text, timestamp integer, statusTimestamp integer);"
+	// migrationInsertOutboxAM17 copies the rows back on Down
+	migrationInsertOutboxAM17 = "insert into outbox select pointerID, recipient, messageType, location, digest, status, timestamp, statusTimestamp from temp_outbox;"
+	// migrationDeleteOutboxAM17 drops the renamed table
+	migrationDeleteOutboxAM17 = "drop table if exists temp_outbox;"
+	// migrationCreateOutboxAM17IndexSQL indexes the outbox by status
+	migrationCreateOutboxAM17IndexSQL = "create index index_outbox on outbox (status, timestamp);"
+	// migrationAlterOutboxAM17UpVer set the repo Up version
+	migrationAlterOutboxAM17UpVer = 42
+	// migrationAlterOutboxAM17DownVer set the repo Down version
+	migrationAlterOutboxAM17DownVer = 41
+)
+
+// Migration041 records failed attempts to delete the stored copies of
+// outbox messages
+type Migration041 struct{}
+
+// Up the migration Up code
+func (Migration041) Up(repoPath, databasePassword string, testnetEnabled bool) error {
+	var (
+		databaseFilePath string
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	upSequence := strings.Join([]string{
+		migrationAlterOutboxAM17DeleteAttempts,
+		migrationAlterOutboxAM17LastError,
+	}, " ")
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(upSequence); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		if err.Error() == "duplicate column name: deleteAttempts" {
+			return writeRepoVer(repoPath, migrationAlterOutboxAM17UpVer)
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Bump schema version
+	return writeRepoVer(repoPath, migrationAlterOutboxAM17UpVer)
+}
+
+// Down the migration Down code
+func (Migration041) Down(repoPath, databasePassword string, testnetEnabled bool) error {
+	var (
+		databaseFilePath string
+	)
+	if testnetEnabled {
+		databaseFilePath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		databaseFilePath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+
+	db, err := sql.Open("sqlite3", databaseFilePath)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	if databasePassword != "" {
+		p := fmt.Sprintf("pragma key = '%s';", databasePassword)
+		_, err := db.Exec(p)
+		if err != nil {
+			return err
+		}
+	}
+
+	downSequence := strings.Join([]string{
+		migrationRenameOutboxAM17,
+		MigrationCreateOutboxAM17CreateSQLDown,
+		migrationInsertOutboxAM17,
+		migrationDeleteOutboxAM17,
+		migrationCreateOutboxAM17IndexSQL,
+	}, " ")
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	if _, err = tx.Exec(downSequence); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			return fmt.Errorf("rollback failed: (%s) due to (%s)", rErr.Error(), err.Error())
+		}
+		return err
+	}
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	// Revert schema version
+	return writeRepoVer(repoPath, migrationAlterOutboxAM17DownVer)
+}
@@ -0,0 +1,109 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/repo/migrations"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+)
+
+func TestMigration041(t *testing.T) {
+	var (
+		basePath          = schema.GenerateTempPath()
+		testRepoPath, err = schema.OpenbazaarPathTransform(basePath, true)
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	appSchema, err := schema.NewCustomSchemaManager(schema.SchemaContext{DataPath: testRepoPath, TestModeEnabled: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = appSchema.BuildSchemaDirectories(); err != nil {
+		t.Fatal(err)
+	}
+	defer appSchema.DestroySchemaDirectories()
+
+	if err := appSchema.InitializeDatabase(); err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		databasePath = appSchema.DatabasePath()
+		schemaPath   = appSchema.DataPathJoin("repover")
+
+		selectSQL = "select deleteAttempts, lastError from outbox where pointerID=?"
+	)
+
+	// create schema version file
+	if err = ioutil.WriteFile(schemaPath, []byte("41"), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open("sqlite3", databasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// recreate the table as created by Migration035
+	if _, err := db.Exec("drop table if exists outbox;"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(migrations.MigrationCreateOutboxAM17CreateSQLDown); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec("insert into outbox(pointerID, status) values(?,?)", "QmPointer", "pending"); err != nil {
+		t.Fatal(err)
+	}
+
+	// execute migration up
+	m := migrations.Migration041{}
+	if err := m.Up(testRepoPath, "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	// assert repo version updated
+	if err = appSchema.VerifySchemaVersion("42"); err != nil {
+		t.Fatal(err)
+	}
+
+	// verify change was applied properly
+	var (
+		attempts  int
+		lastError string
+	)
+	if err := db.QueryRow(selectSQL, "QmPointer").Scan(&attempts, &lastError); err != nil {
+		t.Fatal(err)
+	}
+	if attempts != 0 || lastError != "" {
+		t.Errorf("expected existing messages to have no failures, got %d %q", attempts, lastError)
+	}
+
+	// running up again is harmless
+	if err := m.Up(testRepoPath, "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	// execute migration down
+	if err := m.Down(testRepoPath, "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	// assert repo version reverted
+	if err = appSchema.VerifySchemaVersion("41"); err != nil {
+		t.Fatal(err)
+	}
+
+	// verify change was reverted properly
+	if err := db.QueryRow(selectSQL, "QmPointer").Scan(&attempts, &lastError); err == nil {
+		t.Fatal("expected the delete failure columns to be dropped")
+	}
+	var status string
+	if err := db.QueryRow("select status from outbox where pointerID=?", "QmPointer").Scan(&status); err != nil || status != "pending" {
+		t.Errorf("expected the message to be kept, got %q %v", status, err)
+	}
+}
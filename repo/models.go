@@ -15,7 +15,9 @@ type SettingsData struct {
 	RefundPolicy        *string            `json:"refundPolicy"`
 	BlockedNodes        *[]string          `json:"blockedNodes"`
 	StoreModerators     *[]string          `json:"storeModerators"`
+	StoreTaxes          *[]StoreTax        `json:"storeTaxes"`
 	MisPaymentBuffer    *float32           `json:"mispaymentBuffer"`
+	PriceQuoteMinutes   *uint32            `json:"priceQuoteMinutes"`
 	SMTPSettings        *SMTPSettings      `json:"smtpSettings"`
 	Version             *string            `json:"version"`
 	PreferredCurrencies *[]string          `json:"preferredCurrencies"`
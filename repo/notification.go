@@ -367,6 +367,30 @@ func (n *Notification) UnmarshalJSON(data []byte) error {
 			return err
 		}
 		n.NotifierData = notifier
+	case NotifierTypeBackorderReleaseNotification:
+		var notifier = BackorderReleaseNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
+	case NotifierTypeAppointmentNotification:
+		var notifier = AppointmentNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
+	case NotifierTypeSubscriptionNotification:
+		var notifier = SubscriptionNotification{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
+	case NotifierTypeSubscriptionPayment:
+		var notifier = SubscriptionPayment{}
+		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
+			return err
+		}
+		n.NotifierData = notifier
 	case NotifierTypeVendorDisputeTimeout:
 		var notifier = VendorDisputeTimeout{}
 		if err := json.Unmarshal(payload.NotifierData, &notifier); err != nil {
@@ -986,6 +1010,66 @@ func (n VendorFinalizedPayment) GetSMTPTitleAndBody() (string, string, bool) {
 	return "", "", false
 }
 
+// SubscriptionNotification represents a notification about a subscription
+// started by a buyer or cancelled by the other party. Status is the new
+// status of the subscription.
+type SubscriptionNotification struct {
+	ID             string             `json:"notificationId"`
+	Type           NotificationType   `json:"type"`
+	SubscriptionID string             `json:"subscriptionId"`
+	PeerID         string             `json:"peerId"`
+	Status         SubscriptionStatus `json:"status"`
+}
+
+func (n SubscriptionNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n SubscriptionNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n SubscriptionNotification) GetID() string { return n.ID }
+func (n SubscriptionNotification) GetType() NotificationType {
+	return NotifierTypeSubscriptionNotification
+}
+func (n SubscriptionNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	if n.Status == SubscriptionCancelled {
+		form := "Subscription \"%s\" has been cancelled."
+		return "Subscription cancelled", fmt.Sprintf(form, n.SubscriptionID), true
+	}
+	form := "You have a new subscriber to your store. Subscription ID: %s"
+	return "New subscription", fmt.Sprintf(form, n.SubscriptionID), true
+}
+
+// SubscriptionPayment represents a notification about the order of a
+// subscription period. AutoPaid is set when the order was paid from the
+// wallet, otherwise the buyer needs to pay the amount to the address.
+type SubscriptionPayment struct {
+	ID             string           `json:"notificationId"`
+	Type           NotificationType `json:"type"`
+	SubscriptionID string           `json:"subscriptionId"`
+	OrderID        string           `json:"orderId"`
+	PaymentAddress string           `json:"paymentAddress"`
+	Amount         *CurrencyValue   `json:"amount"`
+	AutoPaid       bool             `json:"autoPaid"`
+}
+
+func (n SubscriptionPayment) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n SubscriptionPayment) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n SubscriptionPayment) GetID() string             { return n.ID }
+func (n SubscriptionPayment) GetType() NotificationType { return NotifierTypeSubscriptionPayment }
+func (n SubscriptionPayment) GetSMTPTitleAndBody() (string, string, bool) {
+	if n.AutoPaid {
+		form := "Order \"%s\" of subscription \"%s\" has been paid from your wallet."
+		return "Subscription order paid", fmt.Sprintf(form, n.OrderID, n.SubscriptionID), true
+	}
+	form := "Order \"%s\" of subscription \"%s\" is awaiting payment."
+	return "Subscription payment due", fmt.Sprintf(form, n.OrderID, n.SubscriptionID), true
+}
+
 // ModeratorDisputeExpiry represents a notification about an open dispute
 // which will soon be expired and automatically resolved. The Type indicates
 // the age of the dispute case and the CaseID references the cases caseID
@@ -1044,3 +1128,62 @@ func NewNotificationID() string {
 	nId, _ := mh.Cast(encoded)
 	return nId.B58String()
 }
+
+// BackorderReleaseNotification represents a notification that a backordered
+// purchase is in stock and moving to fulfillment
+type BackorderReleaseNotification struct {
+	ID           string           `json:"notificationId"`
+	Type         NotificationType `json:"type"`
+	OrderId      string           `json:"orderId"`
+	Thumbnail    Thumbnail        `json:"thumbnail"`
+	VendorHandle string           `json:"vendorHandle"`
+	VendorID     string           `json:"vendorId"`
+}
+
+func (n BackorderReleaseNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n BackorderReleaseNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n BackorderReleaseNotification) GetID() string { return n.ID }
+func (n BackorderReleaseNotification) GetType() NotificationType {
+	return NotifierTypeBackorderReleaseNotification
+}
+func (n BackorderReleaseNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	form := "Your backordered order \"%s\" is in stock and is being prepared for shipping."
+	return "Backorder in stock", fmt.Sprintf(form, n.OrderId), true
+}
+
+// AppointmentNotification represents a notification that the vendor
+// confirmed or rescheduled the slot booked by a purchase
+type AppointmentNotification struct {
+	ID           string           `json:"notificationId"`
+	Type         NotificationType `json:"type"`
+	OrderId      string           `json:"orderId"`
+	Thumbnail    Thumbnail        `json:"thumbnail"`
+	VendorHandle string           `json:"vendorHandle"`
+	VendorID     string           `json:"vendorId"`
+	Start        time.Time        `json:"start"`
+	Rescheduled  bool             `json:"rescheduled"`
+	Note         string           `json:"note,omitempty"`
+}
+
+func (n AppointmentNotification) Data() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n AppointmentNotification) WebsocketData() ([]byte, error) {
+	return json.MarshalIndent(notificationWrapper{n}, "", "    ")
+}
+func (n AppointmentNotification) GetID() string { return n.ID }
+func (n AppointmentNotification) GetType() NotificationType {
+	return NotifierTypeAppointmentNotification
+}
+func (n AppointmentNotification) GetSMTPTitleAndBody() (string, string, bool) {
+	if n.Rescheduled {
+		form := "Your appointment for order \"%s\" was moved to %s."
+		return "Appointment rescheduled", fmt.Sprintf(form, n.OrderId, n.Start.UTC().Format(time.RFC1123)), true
+	}
+	form := "Your appointment for order \"%s\" on %s is confirmed."
+	return "Appointment confirmed", fmt.Sprintf(form, n.OrderId, n.Start.UTC().Format(time.RFC1123)), true
+}
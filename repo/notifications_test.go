@@ -143,6 +143,31 @@ func createNotificationExamples() []repo.Notifier {
 			Type:    repo.NotifierTypeVendorFinalizedPayment,
 			OrderID: repo.NewNotificationID(),
 		},
+		repo.SubscriptionNotification{
+			ID:             "subscriptionID",
+			Type:           repo.NotifierTypeSubscriptionNotification,
+			SubscriptionID: repo.NewNotificationID(),
+			Status:         repo.SubscriptionCancelled,
+		},
+		repo.SubscriptionPayment{
+			ID:             "subscriptionPaymentID",
+			Type:           repo.NotifierTypeSubscriptionPayment,
+			SubscriptionID: repo.NewNotificationID(),
+			OrderID:        repo.NewNotificationID(),
+			AutoPaid:       true,
+		},
+		repo.BackorderReleaseNotification{
+			ID:      "backorderReleaseID",
+			Type:    repo.NotifierTypeBackorderReleaseNotification,
+			OrderId: repo.NewNotificationID(),
+		},
+		repo.AppointmentNotification{
+			ID:          "appointmentID",
+			Type:        repo.NotifierTypeAppointmentNotification,
+			OrderId:     repo.NewNotificationID(),
+			Start:       time.Unix(1500000000, 0).UTC(),
+			Rescheduled: true,
+		},
 	},
 		createLegacyNotificationExamples()...)
 }
@@ -0,0 +1,36 @@
+package repo
+
+// OutboxStatus is the delivery status of an offline message
+type OutboxStatus string
+
+const (
+	// OutboxPending messages are stored and waiting for the recipient
+	OutboxPending OutboxStatus = "pending"
+	// OutboxAcked messages were acknowledged by the recipient
+	OutboxAcked OutboxStatus = "acked"
+	// OutboxExpired messages were not acknowledged before their pointer expired
+	OutboxExpired OutboxStatus = "expired"
+	// OutboxFailed messages were acked or expired but the stored copy could
+	// not be deleted after repeated attempts
+	OutboxFailed OutboxStatus = "failed"
+)
+
+// OutboxMessage is an offline message stored for a recipient. The stored copy
+// is deleted once the message is acked or expires.
+type OutboxMessage struct {
+	PointerID       string       `json:"pointerId"`
+	Recipient       string       `json:"recipient"`
+	MessageType     string       `json:"messageType"`
+	Location        string       `json:"location"`
+	Status          OutboxStatus `json:"status"`
+	Timestamp       *APITime     `json:"timestamp"`
+	StatusTimestamp *APITime     `json:"statusTimestamp,omitempty"`
+
+	// DeleteAttempts is the number of failed attempts to delete the stored
+	// copy and LastError the reason the last one failed
+	DeleteAttempts int    `json:"deleteAttempts,omitempty"`
+	LastError      string `json:"lastError,omitempty"`
+
+	// Digest is the hex SHA-256 of the ciphertext
+	Digest string `json:"-"`
+}
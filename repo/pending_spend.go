@@ -0,0 +1,16 @@
+package repo
+
+// PendingSpend is a spend held by a wallet spending policy until it is
+// approved or cancelled
+type PendingSpend struct {
+	ID        string   `json:"id"`
+	Coin      string   `json:"coin"`
+	Amount    string   `json:"amount"`
+	Address   string   `json:"address"`
+	Memo      string   `json:"memo"`
+	OrderID   string   `json:"orderId"`
+	Timestamp *APITime `json:"timestamp"`
+
+	// Request is the serialized spend request executed on approval
+	Request []byte `json:"-"`
+}
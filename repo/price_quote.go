@@ -0,0 +1,38 @@
+package repo
+
+import (
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+// PriceQuote is a vendor's signed quote fixing the amount of an order. It is
+// encoded as in orders so clients can pass a quote back unchanged.
+type PriceQuote struct {
+	*pb.SignedPriceQuote
+}
+
+// MarshalJSON encodes the quote as in an order
+func (q PriceQuote) MarshalJSON() ([]byte, error) {
+	if q.SignedPriceQuote == nil {
+		return []byte("null"), nil
+	}
+	s, err := (&jsonpb.Marshaler{}).MarshalToString(q.SignedPriceQuote)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalJSON decodes a quote encoded as in an order
+func (q *PriceQuote) UnmarshalJSON(b []byte) error {
+	q.SignedPriceQuote = new(pb.SignedPriceQuote)
+	return jsonpb.UnmarshalString(string(b), q.SignedPriceQuote)
+}
+
+// GetProtobuf returns the quote, or nil when there is none
+func (q *PriceQuote) GetProtobuf() *pb.SignedPriceQuote {
+	if q == nil {
+		return nil
+	}
+	return q.SignedPriceQuote
+}
@@ -0,0 +1,81 @@
+package repo
+
+import (
+	"encoding/json"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+// SubscriptionRole is the node's side of a subscription
+type SubscriptionRole string
+
+const (
+	// SubscriptionBuyer subscriptions are placed by the node
+	SubscriptionBuyer SubscriptionRole = "buyer"
+	// SubscriptionVendor subscriptions are to the node's listings
+	SubscriptionVendor SubscriptionRole = "vendor"
+)
+
+// SubscriptionStatus is the state of a subscription
+type SubscriptionStatus string
+
+const (
+	// SubscriptionActive subscriptions are sent an order every period
+	SubscriptionActive SubscriptionStatus = "active"
+	// SubscriptionCancelled subscriptions were cancelled by either side
+	SubscriptionCancelled SubscriptionStatus = "cancelled"
+	// SubscriptionCompleted subscriptions have been sent all their periods
+	SubscriptionCompleted SubscriptionStatus = "completed"
+)
+
+// SubscriptionData is the request to subscribe to a listing. The purchase
+// data is ordered now and again every period of the listing.
+type SubscriptionData struct {
+	PurchaseData
+
+	// Periods is the number of orders, including the first
+	Periods uint32 `json:"periods"`
+
+	// AutoPayCap is the largest order amount paid from the wallet without
+	// asking, in base units of the payment coin. Orders are not paid
+	// automatically when it is empty.
+	AutoPayCap string `json:"autoPayCap"`
+}
+
+// Subscription is a recurring order agreement, stored by both the buyer and
+// the vendor
+type Subscription struct {
+	ID               string             `json:"subscriptionId"`
+	Role             SubscriptionRole   `json:"role"`
+	PeerID           string             `json:"peerId"`
+	Status           SubscriptionStatus `json:"status"`
+	AutoPayCap       string             `json:"autoPayCap,omitempty"`
+	PeriodsCompleted uint32             `json:"periodsCompleted"`
+	NextOrder        *APITime           `json:"nextOrder,omitempty"`
+	LastOrderID      string             `json:"lastOrderId,omitempty"`
+	Timestamp        *APITime           `json:"timestamp"`
+
+	Agreement *pb.SignedSubscriptionAgreement `json:"-"`
+
+	// PurchaseData is the buyer's order placed every period
+	PurchaseData *PurchaseData `json:"-"`
+}
+
+// MarshalJSON encodes the subscription with its agreement encoded as in
+// contracts
+func (s Subscription) MarshalJSON() ([]byte, error) {
+	type subscription Subscription
+	agreement := json.RawMessage("null")
+	if s.Agreement != nil {
+		a, err := (&jsonpb.Marshaler{}).MarshalToString(s.Agreement)
+		if err != nil {
+			return nil, err
+		}
+		agreement = json.RawMessage(a)
+	}
+	return json.Marshal(struct {
+		subscription
+		Agreement json.RawMessage `json:"agreement"`
+	}{subscription(s), agreement})
+}
@@ -0,0 +1,157 @@
+package repo
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/OpenBazaar/jsonpb"
+	"github.com/OpenBazaar/openbazaar-go/pb"
+)
+
+// MaxTaxRules is the most rules a tax may have
+const MaxTaxRules = 500
+
+// TaxPercentage returns the percentage of the tax charged when shipping to
+// the address, and false when the tax does not apply there. The most
+// specific rule matching the address applies, then the tax percentage in
+// the tax regions.
+func TaxPercentage(tax *pb.Listing_Tax, shipping *pb.Order_Shipping) (float32, bool) {
+	var (
+		rule        *pb.Listing_Tax_TaxRule
+		specificity = -1
+	)
+	for _, r := range tax.GetRules() {
+		if !taxRuleMatches(r, shipping) {
+			continue
+		}
+		s := 0
+		if r.Country != pb.CountryCode_ALL {
+			s++
+		}
+		if r.State != "" {
+			s += 2
+		}
+		if r.PostalCode != "" {
+			s += 4
+		}
+		if s > specificity {
+			rule, specificity = r, s
+		}
+	}
+	if rule != nil {
+		return rule.Percentage, true
+	}
+	for _, region := range tax.GetTaxRegions() {
+		if region == shipping.GetCountry() {
+			return tax.Percentage, true
+		}
+	}
+	return 0, false
+}
+
+func taxRuleMatches(rule *pb.Listing_Tax_TaxRule, shipping *pb.Order_Shipping) bool {
+	if rule.Country != pb.CountryCode_ALL && rule.Country != shipping.GetCountry() {
+		return false
+	}
+	if rule.State != "" && !strings.EqualFold(strings.TrimSpace(rule.State), strings.TrimSpace(shipping.GetState())) {
+		return false
+	}
+	if rule.PostalCode != "" {
+		postalCode := strings.ToUpper(strings.Replace(shipping.GetPostalCode(), " ", "", -1))
+		pattern := strings.ToUpper(strings.Replace(rule.PostalCode, " ", "", -1))
+		if ok, err := path.Match(pattern, postalCode); err != nil || !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateTaxes checks the taxes of a listing or of the vendor's tax table
+func ValidateTaxes(taxes []*pb.Listing_Tax) error {
+	if len(taxes) > MaxListItems {
+		return fmt.Errorf("number of taxes is greater than the max of %d", MaxListItems)
+	}
+	for _, tax := range taxes {
+		if tax.TaxType == "" {
+			return errors.New("tax type must be specified")
+		}
+		if len(tax.TaxType) > WordMaxCharacters {
+			return fmt.Errorf("tax type length must be less than the max of %d", WordMaxCharacters)
+		}
+		if len(tax.TaxRegions) == 0 && len(tax.Rules) == 0 {
+			return errors.New("tax must specify at least one region")
+		}
+		if len(tax.TaxRegions) > MaxCountryCodes {
+			return fmt.Errorf("number of tax regions is greater than the max of %d", MaxCountryCodes)
+		}
+		if len(tax.TaxRegions) > 0 && (tax.Percentage == 0 || tax.Percentage > 100) {
+			return errors.New("tax percentage must be between 0 and 100")
+		}
+		if len(tax.Rules) > MaxTaxRules {
+			return fmt.Errorf("number of tax rules is greater than the max of %d", MaxTaxRules)
+		}
+		for _, rule := range tax.Rules {
+			if rule.Country == pb.CountryCode_NA {
+				return errors.New("tax rule must specify a country")
+			}
+			if len(rule.State) > WordMaxCharacters {
+				return fmt.Errorf("tax rule state length must be less than the max of %d", WordMaxCharacters)
+			}
+			if len(rule.PostalCode) > WordMaxCharacters {
+				return fmt.Errorf("tax rule postal code length must be less than the max of %d", WordMaxCharacters)
+			}
+			if !validTaxRulePattern(rule.PostalCode) {
+				return errors.New("tax rule postal code pattern is malformed")
+			}
+			if rule.Percentage < 0 || rule.Percentage > 100 {
+				return errors.New("tax rule percentage must be between 0 and 100")
+			}
+		}
+	}
+	return nil
+}
+
+// validTaxRulePattern reports whether a postal code pattern of a tax rule
+// is well formed
+func validTaxRulePattern(pattern string) bool {
+	_, err := path.Match(pattern, "")
+	return err == nil
+}
+
+// StoreTax is a tax of the vendor's tax table, which is copied to the
+// listings using the store taxes. It is encoded like the taxes of a
+// listing.
+type StoreTax struct {
+	*pb.Listing_Tax
+}
+
+// MarshalJSON encodes the tax as in a listing
+func (t StoreTax) MarshalJSON() ([]byte, error) {
+	if t.Listing_Tax == nil {
+		return []byte("null"), nil
+	}
+	s, err := (&jsonpb.Marshaler{}).MarshalToString(t.Listing_Tax)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalJSON decodes a tax encoded as in a listing
+func (t *StoreTax) UnmarshalJSON(b []byte) error {
+	t.Listing_Tax = new(pb.Listing_Tax)
+	return jsonpb.UnmarshalString(string(b), t.Listing_Tax)
+}
+
+// StoreTaxesToProtobuf returns the taxes of the tax table as set on listings
+func StoreTaxesToProtobuf(taxes []StoreTax) []*pb.Listing_Tax {
+	ret := make([]*pb.Listing_Tax, 0, len(taxes))
+	for _, t := range taxes {
+		if t.Listing_Tax != nil {
+			ret = append(ret, t.Listing_Tax)
+		}
+	}
+	return ret
+}
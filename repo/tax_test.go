@@ -0,0 +1,90 @@
+package repo_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/OpenBazaar/openbazaar-go/pb"
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+func TestTaxPercentage(t *testing.T) {
+	tax := &pb.Listing_Tax{
+		TaxType:    "Sales tax",
+		TaxRegions: []pb.CountryCode{pb.CountryCode_UNITED_STATES},
+		Percentage: 5,
+		Rules: []*pb.Listing_Tax_TaxRule{
+			{Country: pb.CountryCode_UNITED_STATES, State: "CA", Percentage: 7.25},
+			{Country: pb.CountryCode_UNITED_STATES, State: "CA", PostalCode: "941*", Percentage: 8.5},
+			{Country: pb.CountryCode_UNITED_STATES, State: "OR", Percentage: 0},
+			{Country: pb.CountryCode_CANADA, PostalCode: "K1A *", Percentage: 13},
+		},
+	}
+	tests := []struct {
+		shipping   *pb.Order_Shipping
+		percentage float32
+		applies    bool
+	}{
+		{&pb.Order_Shipping{Country: pb.CountryCode_UNITED_STATES, State: "ca", PostalCode: "90210"}, 7.25, true},
+		{&pb.Order_Shipping{Country: pb.CountryCode_UNITED_STATES, State: "CA", PostalCode: "94103"}, 8.5, true},
+		{&pb.Order_Shipping{Country: pb.CountryCode_UNITED_STATES, State: "OR", PostalCode: "97201"}, 0, true},
+		{&pb.Order_Shipping{Country: pb.CountryCode_UNITED_STATES, State: "NY", PostalCode: "10001"}, 5, true},
+		{&pb.Order_Shipping{Country: pb.CountryCode_CANADA, PostalCode: "k1a0b1"}, 13, true},
+		{&pb.Order_Shipping{Country: pb.CountryCode_CANADA, PostalCode: "M5V 2T6"}, 0, false},
+		{nil, 0, false},
+	}
+	for i, test := range tests {
+		percentage, applies := repo.TaxPercentage(tax, test.shipping)
+		if applies != test.applies || percentage != test.percentage {
+			t.Errorf("case %d: expected (%v, %v), got (%v, %v)", i, test.percentage, test.applies, percentage, applies)
+		}
+	}
+}
+
+func TestValidateTaxes(t *testing.T) {
+	valid := []*pb.Listing_Tax{
+		{
+			TaxType: "VAT",
+			Rules:   []*pb.Listing_Tax_TaxRule{{Country: pb.CountryCode_GERMANY, Percentage: 19}},
+		},
+	}
+	if err := repo.ValidateTaxes(valid); err != nil {
+		t.Errorf("expected the taxes to be valid: %s", err)
+	}
+
+	invalid := [][]*pb.Listing_Tax{
+		{{TaxType: "VAT"}},
+		{{TaxType: "VAT", Rules: []*pb.Listing_Tax_TaxRule{{Percentage: 19}}}},
+		{{TaxType: "VAT", Rules: []*pb.Listing_Tax_TaxRule{{Country: pb.CountryCode_GERMANY, PostalCode: "[", Percentage: 19}}}},
+		{{TaxType: "VAT", Rules: []*pb.Listing_Tax_TaxRule{{Country: pb.CountryCode_GERMANY, Percentage: 101}}}},
+	}
+	for i, taxes := range invalid {
+		if err := repo.ValidateTaxes(taxes); err == nil {
+			t.Errorf("case %d: expected the taxes to be invalid", i)
+		}
+	}
+}
+
+func TestStoreTaxJSON(t *testing.T) {
+	var settings repo.SettingsData
+	in := `{"storeTaxes":[{"taxType":"Sales tax","taxShipping":true,"rules":[{"country":"UNITED_STATES","state":"CA","percentage":7.25}]}]}`
+	if err := json.Unmarshal([]byte(in), &settings); err != nil {
+		t.Fatal(err)
+	}
+	taxes := repo.StoreTaxesToProtobuf(*settings.StoreTaxes)
+	if len(taxes) != 1 || len(taxes[0].Rules) != 1 || taxes[0].Rules[0].Country != pb.CountryCode_UNITED_STATES {
+		t.Fatalf("unexpected taxes: %v", taxes)
+	}
+
+	out, err := json.Marshal(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded repo.SettingsData
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if (*decoded.StoreTaxes)[0].Rules[0].State != "CA" || !(*decoded.StoreTaxes)[0].TaxShipping {
+		t.Errorf("taxes were not encoded as in listings: %s", out)
+	}
+}
@@ -1,8 +1,12 @@
 package schema
 
 import (
+	"encoding/base32"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math/big"
+	"net/url"
 	"strings"
 	"time"
 )
@@ -18,6 +22,23 @@ type APIConfig struct {
 	SSL           bool
 	SSLCert       string
 	SSLKey        string
+	GRPCEnabled   bool
+	GRPCAddress   string
+	PublicGateway PublicGatewayConfig
+}
+
+// PublicGatewayConfig limits the requests served to the public while the
+// JSON API is disabled. Zero values disable the corresponding limit.
+type PublicGatewayConfig struct {
+	// RequestsPerSecond and Burst size the token bucket of each remote IP
+	RequestsPerSecond float64
+	Burst             int
+	// MaxConcurrentFetches caps the requests which may fetch data from
+	// other peers at the same time
+	MaxConcurrentFetches int
+	// CacheTTL is how long successful GET responses are reused for
+	CacheTTL  string
+	CacheSize int
 }
 
 type TorConfig struct {
@@ -50,6 +71,72 @@ type CoinConfig struct {
 	LowFeeDefault      uint64                 `json:"LowFeeDefault"`
 	TrustedPeer        string                 `json:"TrustedPeer"`
 	WalletOptions      map[string]interface{} `json:"WalletOptions"`
+	SpendingPolicy     *SpendingPolicy        `json:"SpendingPolicy,omitempty"`
+	AutoFulfill        *AutoFulfillPolicy     `json:"AutoFulfill,omitempty"`
+}
+
+// SpendingPolicy restricts the spends made from a wallet. Amounts are in the
+// base units of the coin. Empty values disable the corresponding check.
+type SpendingPolicy struct {
+	// DailyLimit caps the total spent over the last 24 hours
+	DailyLimit string `json:"DailyLimit"`
+	// AllowedAddresses is the only addresses which may be paid, if set
+	AllowedAddresses []string `json:"AllowedAddresses"`
+	// Spends of at least ApprovalThreshold are held until they are approved
+	ApprovalThreshold string `json:"ApprovalThreshold"`
+	// TOTPSecret is the base32 encoded secret of the authenticator app. When
+	// set, held spends are only approved with a valid code.
+	TOTPSecret string `json:"TOTPSecret"`
+}
+
+// SpendingPolicies returns the spending policy of each configured coin
+// keyed by its mainnet currency code
+func (w *WalletsConfig) SpendingPolicies() map[string]*SpendingPolicy {
+	policies := make(map[string]*SpendingPolicy)
+	for code, c := range map[string]*CoinConfig{"BTC": w.BTC, "BCH": w.BCH, "LTC": w.LTC, "ZEC": w.ZEC, "ETH": w.ETH} {
+		if c != nil && c.SpendingPolicy != nil {
+			policies[code] = c.SpendingPolicy
+		}
+	}
+	return policies
+}
+
+// AutoFulfillPolicy enables the automatic fulfillment of cryptocurrency
+// listings selling the coin. Amounts are in the base units of the coin.
+// Empty values disable the corresponding limit.
+type AutoFulfillPolicy struct {
+	// OrderLimit caps the amount paid out for a single order
+	OrderLimit string `json:"OrderLimit"`
+	// DailyLimit caps the total paid out over the last 24 hours
+	DailyLimit string `json:"DailyLimit"`
+	// Confirmations is the number of confirmations the payment of an order
+	// needs before it is paid out. Zero means DefaultAutoFulfillConfirmations.
+	Confirmations uint32 `json:"Confirmations,omitempty"`
+}
+
+// DefaultAutoFulfillConfirmations is the number of confirmations an order
+// payment needs before it is paid out when the policy doesn't set one
+const DefaultAutoFulfillConfirmations = 1
+
+// RequiredConfirmations returns the number of confirmations the payment of an
+// order needs before it is paid out
+func (p *AutoFulfillPolicy) RequiredConfirmations() uint32 {
+	if p.Confirmations == 0 {
+		return DefaultAutoFulfillConfirmations
+	}
+	return p.Confirmations
+}
+
+// AutoFulfillPolicies returns the automatic fulfillment policy of each
+// configured coin keyed by its mainnet currency code
+func (w *WalletsConfig) AutoFulfillPolicies() map[string]*AutoFulfillPolicy {
+	policies := make(map[string]*AutoFulfillPolicy)
+	for code, c := range map[string]*CoinConfig{"BTC": w.BTC, "BCH": w.BCH, "LTC": w.LTC, "ZEC": w.ZEC, "ETH": w.ETH} {
+		if c != nil && c.AutoFulfill != nil {
+			policies[code] = c.AutoFulfill
+		}
+	}
+	return policies
 }
 
 type DataSharing struct {
@@ -57,6 +144,57 @@ type DataSharing struct {
 	PushTo              []string
 }
 
+// S3StorageConfig configures an S3 compatible object store used for
+// offline messages when the node is started with --storage=s3
+type S3StorageConfig struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	Prefix    string
+	AccessKey string
+	SecretKey string
+	// ACL is a canned ACL such as public-read sent with each upload. It can
+	// be left empty if the bucket policy already allows anonymous reads.
+	ACL string
+	// PublicURL replaces the endpoint and bucket in the returned message
+	// URLs, for example when the bucket is served through a CDN
+	PublicURL string
+	// Expiry is how long messages are kept before they are deleted. Zero
+	// keeps them forever.
+	Expiry string
+}
+
+// Exchange rate provider types
+const (
+	// ExchangeRateProviderBuiltin uses the exchange rate service of the
+	// reserve wallet
+	ExchangeRateProviderBuiltin = "builtin"
+	// ExchangeRateProviderHTTP fetches a JSON object of rates from a URL
+	ExchangeRateProviderHTTP = "http"
+	// ExchangeRateProviderFile reads a JSON object of rates from a file
+	ExchangeRateProviderFile = "file"
+)
+
+// ExchangeRatesConfig configures the sources of the exchange rates used to
+// price orders. Each rate is the median of the rates reported by the
+// providers. Durations are strings such as "10m", a MaxAge of "0" never
+// considers rates too old.
+type ExchangeRatesConfig struct {
+	Providers       []ExchangeRateProviderConfig
+	RefreshInterval string
+	MaxAge          string
+	HistoryInterval string
+}
+
+// ExchangeRateProviderConfig is a source of exchange rates. URL is used by
+// http providers and Path, relative to the data directory unless absolute,
+// by file providers.
+type ExchangeRateProviderConfig struct {
+	Type string
+	URL  string
+	Path string
+}
+
 type malformedConfigError struct {
 	path []string
 }
@@ -72,6 +210,16 @@ func (err malformedConfigError) Error() string {
 	return "malformed config"
 }
 
+func DefaultPublicGatewayConfig() PublicGatewayConfig {
+	return PublicGatewayConfig{
+		RequestsPerSecond:    PublicGatewayDefaultRequestsPerSecond,
+		Burst:                PublicGatewayDefaultBurst,
+		MaxConcurrentFetches: PublicGatewayDefaultMaxConcurrentFetches,
+		CacheTTL:             PublicGatewayDefaultCacheTTL,
+		CacheSize:            PublicGatewayDefaultCacheSize,
+	}
+}
+
 func DefaultWalletsConfig() *WalletsConfig {
 	var feeAPI = "https://btc.fees.openbazaar.org"
 	return &WalletsConfig{
@@ -139,9 +287,12 @@ func GetAPIConfig(cfgBytes []byte) (*APIConfig, error) {
 		KeyAuthenticated = "Authenticated"
 		KeyCORS          = "CORS"
 		KeyEnabled       = "Enabled"
+		KeyGRPCAddress   = "GRPCAddress"
+		KeyGRPCEnabled   = "GRPCEnabled"
 		KeyHTTPHeaders   = "HTTPHeaders"
 		KeyJSONAPI       = "JSON-API"
 		KeyPassword      = "Password"
+		KeyPublicGateway = "PublicGateway"
 		KeySSL           = "SSL"
 		KeySSLCert       = "SSLCert"
 		KeySSLKey        = "SSLKey"
@@ -267,6 +418,29 @@ func GetAPIConfig(cfgBytes []byte) (*APIConfig, error) {
 		return nil, malformedConfigKey(KeyJSONAPI, KeySSLKey)
 	}
 
+	// The gRPC settings were added later and are optional
+	var grpcEnabledBool bool
+	if grpcEnabled, ok := api[KeyGRPCEnabled]; ok {
+		grpcEnabledBool, ok = grpcEnabled.(bool)
+		if !ok {
+			return nil, malformedConfigKey(KeyJSONAPI, KeyGRPCEnabled)
+		}
+	}
+	grpcAddressStr := GRPCDefaultAddress
+	if grpcAddress, ok := api[KeyGRPCAddress]; ok {
+		grpcAddressStr, ok = grpcAddress.(string)
+		if !ok {
+			return nil, malformedConfigKey(KeyJSONAPI, KeyGRPCAddress)
+		}
+	}
+
+	publicGateway := DefaultPublicGatewayConfig()
+	if pg, ok := api[KeyPublicGateway]; ok {
+		if err := parsePublicGatewayConfig(pg, &publicGateway); err != nil {
+			return nil, malformedConfigKey(KeyJSONAPI, KeyPublicGateway)
+		}
+	}
+
 	apiConfig := &APIConfig{
 		Authenticated: authenticatedBool,
 		AllowedIPs:    allowedIPstrings,
@@ -278,11 +452,35 @@ func GetAPIConfig(cfgBytes []byte) (*APIConfig, error) {
 		SSL:           sslEnabledBool,
 		SSLCert:       certFileStr,
 		SSLKey:        keyFileStr,
+		GRPCEnabled:   grpcEnabledBool,
+		GRPCAddress:   grpcAddressStr,
+		PublicGateway: publicGateway,
 	}
 
 	return apiConfig, nil
 }
 
+// parsePublicGatewayConfig overlays the configured limits on the defaults
+// already held by cfg. Omitted keys keep their default value.
+func parsePublicGatewayConfig(pg interface{}, cfg *PublicGatewayConfig) error {
+	b, err := json.Marshal(pg)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, cfg); err != nil {
+		return err
+	}
+	if cfg.RequestsPerSecond < 0 || cfg.Burst < 0 || cfg.MaxConcurrentFetches < 0 || cfg.CacheSize < 0 {
+		return errors.New("negative limit")
+	}
+	if cfg.CacheTTL != "" {
+		if _, err := time.ParseDuration(cfg.CacheTTL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func GetWalletsConfig(cfgBytes []byte) (*WalletsConfig, error) {
 	const KeyWallets = "Wallets"
 	var cfgIface map[string]interface{}
@@ -305,9 +503,48 @@ func GetWalletsConfig(cfgBytes []byte) (*WalletsConfig, error) {
 	if err != nil {
 		return nil, err
 	}
+	for code, policy := range wCfg.SpendingPolicies() {
+		if err := policy.validate(); err != nil {
+			return nil, malformedConfigKey(KeyWallets, code, "SpendingPolicy")
+		}
+	}
+	for code, policy := range wCfg.AutoFulfillPolicies() {
+		if err := policy.validate(); err != nil {
+			return nil, malformedConfigKey(KeyWallets, code, "AutoFulfill")
+		}
+	}
 	return wCfg, nil
 }
 
+func (p *SpendingPolicy) validate() error {
+	for _, amount := range []string{p.DailyLimit, p.ApprovalThreshold} {
+		if amount == "" {
+			continue
+		}
+		if v, ok := new(big.Int).SetString(amount, 10); !ok || v.Sign() < 0 {
+			return errors.New("invalid amount")
+		}
+	}
+	if p.TOTPSecret != "" {
+		if _, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.ToUpper(strings.TrimRight(p.TOTPSecret, "="))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *AutoFulfillPolicy) validate() error {
+	for _, amount := range []string{p.OrderLimit, p.DailyLimit} {
+		if amount == "" {
+			continue
+		}
+		if v, ok := new(big.Int).SetString(amount, 10); !ok || v.Sign() < 0 {
+			return errors.New("invalid amount")
+		}
+	}
+	return nil
+}
+
 func GetTorConfig(cfgBytes []byte) (*TorConfig, error) {
 	const (
 		KeyPassword   = "Password"
@@ -425,6 +662,115 @@ func GetDropboxApiToken(cfgBytes []byte) (string, error) {
 	return tokenStr, nil
 }
 
+func GetS3StorageConfig(cfgBytes []byte) (*S3StorageConfig, error) {
+	const (
+		KeyS3Storage = "S3Storage"
+		KeyEndpoint  = "Endpoint"
+		KeyBucket    = "Bucket"
+		KeyExpiry    = "Expiry"
+	)
+	var cfgIface map[string]interface{}
+	err := json.Unmarshal(cfgBytes, &cfgIface)
+	if err != nil {
+		return nil, malformedConfigError{}
+	}
+
+	s3Iface, ok := cfgIface[KeyS3Storage]
+	if !ok {
+		return nil, malformedConfigKey(KeyS3Storage)
+	}
+	b, err := json.Marshal(s3Iface)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &S3StorageConfig{
+		Region: S3StorageDefaultRegion,
+		Expiry: S3StorageDefaultExpiry,
+	}
+	if err := json.Unmarshal(b, cfg); err != nil {
+		return nil, malformedConfigKey(KeyS3Storage)
+	}
+
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") || endpoint.Host == "" {
+		return nil, malformedConfigKey(KeyS3Storage, KeyEndpoint)
+	}
+	if cfg.Bucket == "" {
+		return nil, malformedConfigKey(KeyS3Storage, KeyBucket)
+	}
+	if cfg.Expiry != "" {
+		if d, err := time.ParseDuration(cfg.Expiry); err != nil || d < 0 {
+			return nil, malformedConfigKey(KeyS3Storage, KeyExpiry)
+		}
+	}
+	return cfg, nil
+}
+
+// GetExchangeRatesConfig returns the exchange rates config. Nodes without
+// the section use the built-in provider.
+func GetExchangeRatesConfig(cfgBytes []byte) (*ExchangeRatesConfig, error) {
+	const (
+		KeyExchangeRates   = "ExchangeRates"
+		KeyProviders       = "Providers"
+		KeyRefreshInterval = "RefreshInterval"
+		KeyMaxAge          = "MaxAge"
+		KeyHistoryInterval = "HistoryInterval"
+	)
+	var cfgIface map[string]interface{}
+	err := json.Unmarshal(cfgBytes, &cfgIface)
+	if err != nil {
+		return nil, malformedConfigError{}
+	}
+
+	cfg := &ExchangeRatesConfig{
+		Providers:       []ExchangeRateProviderConfig{{Type: ExchangeRateProviderBuiltin}},
+		RefreshInterval: ExchangeRatesDefaultRefreshInterval,
+		MaxAge:          ExchangeRatesDefaultMaxAge,
+		HistoryInterval: ExchangeRatesDefaultHistoryInterval,
+	}
+	erIface, ok := cfgIface[KeyExchangeRates]
+	if !ok {
+		return cfg, nil
+	}
+	b, err := json.Marshal(erIface)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, cfg); err != nil {
+		return nil, malformedConfigKey(KeyExchangeRates)
+	}
+
+	if len(cfg.Providers) == 0 {
+		return nil, malformedConfigKey(KeyExchangeRates, KeyProviders)
+	}
+	for _, p := range cfg.Providers {
+		switch p.Type {
+		case ExchangeRateProviderBuiltin:
+		case ExchangeRateProviderHTTP:
+			u, err := url.Parse(p.URL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return nil, malformedConfigKey(KeyExchangeRates, KeyProviders, "URL")
+			}
+		case ExchangeRateProviderFile:
+			if p.Path == "" {
+				return nil, malformedConfigKey(KeyExchangeRates, KeyProviders, "Path")
+			}
+		default:
+			return nil, malformedConfigKey(KeyExchangeRates, KeyProviders, "Type")
+		}
+	}
+	for key, value := range map[string]string{
+		KeyRefreshInterval: cfg.RefreshInterval,
+		KeyMaxAge:          cfg.MaxAge,
+		KeyHistoryInterval: cfg.HistoryInterval,
+	} {
+		if d, err := time.ParseDuration(value); err != nil || d < 0 {
+			return nil, malformedConfigKey(KeyExchangeRates, key)
+		}
+	}
+	return cfg, nil
+}
+
 func GetRepublishInterval(cfgBytes []byte) (time.Duration, error) {
 	const KeyRepublishInterval = "RepublishInterval"
 	var cfgIface interface{}
@@ -38,6 +38,21 @@ func TestGetApiConfig(t *testing.T) {
 	if config.SSLKey == "" {
 		t.Error("Expected test SSL key, got ", config.SSLKey)
 	}
+	if !config.GRPCEnabled {
+		t.Error("Expected GRPCEnabled = true")
+	}
+	if config.GRPCAddress != "/ip4/127.0.0.1/tcp/4005" {
+		t.Error("Expected /ip4/127.0.0.1/tcp/4005, got ", config.GRPCAddress)
+	}
+	if config.PublicGateway.Burst != 50 {
+		t.Error("Expected public gateway burst of 50, got ", config.PublicGateway.Burst)
+	}
+	if config.PublicGateway.CacheTTL != "30s" {
+		t.Error("Expected public gateway cache TTL of 30s, got ", config.PublicGateway.CacheTTL)
+	}
+	if config.PublicGateway.RequestsPerSecond != PublicGatewayDefaultRequestsPerSecond {
+		t.Error("Expected default public gateway rate, got ", config.PublicGateway.RequestsPerSecond)
+	}
 	if err != nil {
 		t.Error("GetAPIAuthentication threw an unexpected error")
 	}
@@ -144,11 +159,48 @@ func TestGetWalletsConfig(t *testing.T) {
 	if config.LTC.MaxFee != 200 {
 		t.Error("Expected maxFee to be 200, got ", config.LTC.MaxFee)
 	}
+	policies := config.SpendingPolicies()
+	if len(policies) != 1 || policies["BTC"] == nil {
+		t.Fatal("Expected a spending policy for BTC only, got ", policies)
+	}
+	if policies["BTC"].DailyLimit != "1000000" || policies["BTC"].ApprovalThreshold != "500000" {
+		t.Error("Spending policy amounts do not equal expected values")
+	}
+	if len(policies["BTC"].AllowedAddresses) != 1 || policies["BTC"].TOTPSecret != "JBSWY3DPEHPK3PXP" {
+		t.Error("Spending policy allow-list or TOTP secret does not equal expected value")
+	}
+
+	autoFulfill := config.AutoFulfillPolicies()
+	if len(autoFulfill) != 1 || autoFulfill["BTC"] == nil {
+		t.Fatal("Expected an automatic fulfillment policy for BTC only, got ", autoFulfill)
+	}
+	if autoFulfill["BTC"].OrderLimit != "100000" || autoFulfill["BTC"].DailyLimit != "2000000" {
+		t.Error("Automatic fulfillment limits do not equal expected values")
+	}
+	if autoFulfill["BTC"].RequiredConfirmations() != 3 {
+		t.Error("Automatic fulfillment confirmations do not equal expected value")
+	}
+	if (&AutoFulfillPolicy{}).RequiredConfirmations() != DefaultAutoFulfillConfirmations {
+		t.Error("Expected the default number of confirmations when none is set")
+	}
 
 	_, err = GetWalletsConfig([]byte{})
 	if err == nil {
 		t.Error("GetWalletsConfig didn't throw an error")
 	}
+
+	_, err = GetWalletsConfig([]byte(`{"Wallets": {"BTC": {"SpendingPolicy": {"DailyLimit": "1.5"}}}}`))
+	if err == nil {
+		t.Error("GetWalletsConfig accepted an invalid daily limit")
+	}
+	_, err = GetWalletsConfig([]byte(`{"Wallets": {"BTC": {"SpendingPolicy": {"TOTPSecret": "not base32!"}}}}`))
+	if err == nil {
+		t.Error("GetWalletsConfig accepted an invalid TOTP secret")
+	}
+	_, err = GetWalletsConfig([]byte(`{"Wallets": {"BTC": {"AutoFulfill": {"OrderLimit": "-1"}}}}`))
+	if err == nil {
+		t.Error("GetWalletsConfig accepted an invalid order limit")
+	}
 }
 
 func TestGetDropboxApiToken(t *testing.T) {
@@ -169,6 +221,74 @@ func TestGetDropboxApiToken(t *testing.T) {
 	}
 }
 
+func TestGetS3StorageConfig(t *testing.T) {
+	config, err := GetS3StorageConfig(configFixture())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := S3StorageConfig{
+		Endpoint:  "http://localhost:9000",
+		Region:    S3StorageDefaultRegion,
+		Bucket:    "offline",
+		Prefix:    "messages/",
+		AccessKey: "minio",
+		SecretKey: "minio123",
+		Expiry:    S3StorageDefaultExpiry,
+	}
+	if *config != expected {
+		t.Errorf("expected %+v, got %+v", expected, *config)
+	}
+
+	invalid := []string{
+		`{}`,
+		`{"S3Storage": {"Endpoint": "localhost:9000", "Bucket": "offline"}}`,
+		`{"S3Storage": {"Endpoint": "http://localhost:9000"}}`,
+		`{"S3Storage": {"Endpoint": "http://localhost:9000", "Bucket": "offline", "Expiry": "30 days"}}`,
+	}
+	for _, cfg := range invalid {
+		if _, err := GetS3StorageConfig([]byte(cfg)); err == nil {
+			t.Errorf("expected an error for %s", cfg)
+		}
+	}
+}
+
+func TestGetExchangeRatesConfig(t *testing.T) {
+	config, err := GetExchangeRatesConfig([]byte(`{}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(config.Providers) != 1 || config.Providers[0].Type != ExchangeRateProviderBuiltin {
+		t.Errorf("expected the built-in provider by default, got %+v", config.Providers)
+	}
+	if config.MaxAge != ExchangeRatesDefaultMaxAge {
+		t.Errorf("expected the default max age, got %s", config.MaxAge)
+	}
+
+	config, err = GetExchangeRatesConfig([]byte(`{"ExchangeRates": {"Providers": [{"Type": "builtin"}, {"Type": "http", "URL": "https://example.com/rates"}, {"Type": "file", "Path": "rates.json"}], "MaxAge": "0"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(config.Providers) != 3 || config.Providers[2].Path != "rates.json" {
+		t.Errorf("unexpected providers %+v", config.Providers)
+	}
+	if config.MaxAge != "0" || config.RefreshInterval != ExchangeRatesDefaultRefreshInterval {
+		t.Errorf("unexpected durations %+v", config)
+	}
+
+	invalid := []string{
+		`{"ExchangeRates": {"Providers": []}}`,
+		`{"ExchangeRates": {"Providers": [{"Type": "oracle"}]}}`,
+		`{"ExchangeRates": {"Providers": [{"Type": "http", "URL": "example.com"}]}}`,
+		`{"ExchangeRates": {"Providers": [{"Type": "file"}]}}`,
+		`{"ExchangeRates": {"Providers": [{"Type": "builtin"}], "MaxAge": "an hour"}}`,
+	}
+	for _, cfg := range invalid {
+		if _, err := GetExchangeRatesConfig([]byte(cfg)); err == nil {
+			t.Errorf("expected an error for %s", cfg)
+		}
+	}
+}
+
 func TestGetIPNSExtraConfig(t *testing.T) {
 	ipnsConfig, err := GetIPNSExtraConfig(configFixture())
 	if err != nil {
@@ -269,6 +389,13 @@ func configFixture() []byte {
     }
   },
   "Dropbox-api-token": "dropbox123",
+  "S3Storage": {
+    "Endpoint": "http://localhost:9000",
+    "Bucket": "offline",
+    "Prefix": "messages/",
+    "AccessKey": "minio",
+    "SecretKey": "minio123"
+  },
   "Experimental": {
     "FilestoreEnabled": false,
     "Libp2pStreamMounting": false,
@@ -303,8 +430,14 @@ func configFixture() []byte {
     "Authenticated": true,
     "CORS": "*",
     "Enabled": true,
+    "GRPCAddress": "/ip4/127.0.0.1/tcp/4005",
+    "GRPCEnabled": true,
     "HTTPHeaders": null,
     "Password": "TestPassword",
+    "PublicGateway": {
+      "Burst": 50,
+      "CacheTTL": "30s"
+    },
     "SSL": true,
     "SSLCert": "/path/to/ssl.cert",
     "SSLKey": "/path/to/ssl.key",
@@ -360,7 +493,18 @@ func configFixture() []byte {
       "MediumFeeDefault": 10,
       "LowFeeDefault": 1,
       "TrustedPeer": "",
-      "WalletOptions": null
+      "WalletOptions": null,
+      "SpendingPolicy": {
+        "DailyLimit": "1000000",
+        "AllowedAddresses": ["1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"],
+        "ApprovalThreshold": "500000",
+        "TOTPSecret": "JBSWY3DPEHPK3PXP"
+      },
+      "AutoFulfill": {
+        "OrderLimit": "100000",
+        "DailyLimit": "2000000",
+        "Confirmations": 3
+      }
     },
     "BCH": {
       "Type": "API",
@@ -40,6 +40,19 @@ const (
 	CreateIndexMessagesSQLMessageID         = "create index index_messages_messageID on messages (messageID);"
 	CreateIndexMessagesSQLOrderIDMType      = "create index index_messages_orderIDmType on messages (orderID, message_type);"
 	CreateIndexMessagesSQLPeerIDMType       = "create index index_messages_peerIDmType on messages (peerID, message_type);"
+	CreateTablePendingSpendsSQL             = "create table pendingspends (spendID text primary key not null, coin text, amount text, address text, memo text, orderID text, request blob, timestamp integer);"
+	CreateTableOutboxSQL                    = "create table outbox (pointerID text primary key not null, recipient text, messageType text, location text, digest text, status text, timestamp integer, statusTimestamp integer, deleteAttempts integer not null default 0, lastError text not null default '');"
+	CreateIndexOutboxSQL                    = "create index index_outbox on outbox (status, timestamp);"
+	CreateTableExchangeRatesSQL             = "create table exchangerates (code text not null, rate real, timestamp integer not null, primary key (code, timestamp));"
+	CreateTableSubscriptionsSQL             = "create table subscriptions (subscriptionID text primary key not null, role text, peerID text, status text, agreement blob, purchaseData blob, autoPayCap text, periodsCompleted integer, nextOrder integer, lastOrderID text, timestamp integer);"
+	CreateIndexSubscriptionsSQL             = "create index index_subscriptions on subscriptions (role, status, nextOrder);"
+	CreateTableDigitalFilesSQL              = "create table digitalfiles (slug text not null, variant integer not null, filename text, content blob, timestamp integer, primary key (slug, variant));"
+	CreateTableLicenseKeysSQL               = "create table licensekeys (keyID integer primary key autoincrement, slug text not null, variant integer not null, licenseKey text not null, orderID text not null default '', timestamp integer, unique (slug, variant, licenseKey));"
+	CreateIndexLicenseKeysSQL               = "create index index_licensekeys on licensekeys (slug, variant, orderID);"
+	CreateTableCryptoPayoutsSQL             = "create table cryptopayouts (orderID text not null, item integer not null, coin text, amount text, address text, txid text, timestamp integer, primary key (orderID, item));"
+	CreateIndexCryptoPayoutsSQL             = "create index index_cryptopayouts on cryptopayouts (coin, timestamp);"
+	CreateTableAppointmentsSQL              = "create table appointments (orderID text not null, vendor integer not null, slug text, title text, slotID text, start integer, duration integer, quantity integer, location text, peerID text, state text, timestamp integer, primary key (orderID, vendor));"
+	CreateIndexAppointmentsSQL              = "create index index_appointments on appointments (slug, slotID);"
 	// End SQL Statements
 
 	// Configuration defaults
@@ -58,6 +71,27 @@ const (
 	BootstrapNodeDefault_Johari           = "/ip4/139.59.6.222/tcp/4001/ipfs/QmRDcEDK9gSViAevCHiE6ghkaBCU7rTuQj4BDpmCzRvRYg"
 
 	IPFSCachingRouterDefaultURI = "https://routing.api.openbazaar.org"
+
+	GRPCDefaultAddress = "/ip4/127.0.0.1/tcp/4003"
+
+	PublicGatewayDefaultRequestsPerSecond    = 5
+	PublicGatewayDefaultBurst                = 20
+	PublicGatewayDefaultMaxConcurrentFetches = 10
+	PublicGatewayDefaultCacheTTL             = "1m"
+	PublicGatewayDefaultCacheSize            = 1000
+
+	S3StorageDefaultRegion = "us-east-1"
+	// S3StorageDefaultExpiry matches the lifetime of offline message pointers
+	S3StorageDefaultExpiry = "720h"
+
+	// ExchangeRatesDefaultRefreshInterval is how long fetched rates are used
+	// before they are fetched again
+	ExchangeRatesDefaultRefreshInterval = "1m"
+	// ExchangeRatesDefaultMaxAge is the age past which rates are too old to
+	// price an order
+	ExchangeRatesDefaultMaxAge = "1h"
+	// ExchangeRatesDefaultHistoryInterval is how often rates are recorded
+	ExchangeRatesDefaultHistoryInterval = "1h"
 	// End Configuration defaults
 )
 
@@ -309,6 +309,19 @@ func InitializeDatabaseSQL(encryptionPassword string) string {
 		CreateIndexMessagesSQLMessageID,
 		CreateIndexMessagesSQLOrderIDMType,
 		CreateIndexMessagesSQLPeerIDMType,
+		CreateTablePendingSpendsSQL,
+		CreateTableOutboxSQL,
+		CreateIndexOutboxSQL,
+		CreateTableExchangeRatesSQL,
+		CreateTableSubscriptionsSQL,
+		CreateIndexSubscriptionsSQL,
+		CreateTableDigitalFilesSQL,
+		CreateTableLicenseKeysSQL,
+		CreateIndexLicenseKeysSQL,
+		CreateTableCryptoPayoutsSQL,
+		CreateIndexCryptoPayoutsSQL,
+		CreateTableAppointmentsSQL,
+		CreateIndexAppointmentsSQL,
 	}
 	return strings.Join(initializeStatement, " ")
 }
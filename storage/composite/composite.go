@@ -0,0 +1,123 @@
+package composite
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	ma "gx/ipfs/QmTZBfrPJmjWsCvHEtX5FE6KimVJhsJg5sBbqEFYf4UZtL/go-multiaddr"
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
+	"github.com/op/go-logging"
+)
+
+var log = logging.MustGetLogger("compositestorage")
+
+// Backend is a storage option used by the composite storage
+type Backend struct {
+	Name    string
+	Storage sto.OfflineMessagingStorage
+}
+
+// BackendError is the error of a single backend
+type BackendError struct {
+	Name string
+	Err  error
+}
+
+// StoreError lists the backends which failed to store or delete a message
+type StoreError struct {
+	Failures []BackendError
+}
+
+func (e *StoreError) Error() string {
+	parts := make([]string, 0, len(e.Failures))
+	for _, f := range e.Failures {
+		parts = append(parts, f.Name+": "+f.Err.Error())
+	}
+	return "offline message storage failed in " + strings.Join(parts, "; ")
+}
+
+// CompositeStorage stores each message in all of its backends so that the
+// message can still be delivered when some of them are unavailable
+type CompositeStorage struct {
+	backends []Backend
+}
+
+// NewCompositeStorage returns a storage using the backends in order of
+// preference
+func NewCompositeStorage(backends ...Backend) (*CompositeStorage, error) {
+	if len(backends) == 0 {
+		return nil, errors.New("no offline message storage backends")
+	}
+	return &CompositeStorage{backends: backends}, nil
+}
+
+// Store returns the first location the message was stored in. Failures of
+// the other backends are logged.
+func (c *CompositeStorage) Store(peerID peer.ID, ciphertext []byte) (ma.Multiaddr, error) {
+	addrs, err := c.StoreAll(peerID, ciphertext)
+	if len(addrs) == 0 {
+		return nil, err
+	}
+	if err != nil {
+		log.Warning(err)
+	}
+	return addrs[0], nil
+}
+
+// StoreAll stores the message in every backend at once and returns the
+// locations in the order of the backends. A *StoreError lists the backends
+// which failed.
+func (c *CompositeStorage) StoreAll(peerID peer.ID, ciphertext []byte) ([]ma.Multiaddr, error) {
+	addrs := make([]ma.Multiaddr, len(c.backends))
+	errs := make([]error, len(c.backends))
+	var wg sync.WaitGroup
+	for i, b := range c.backends {
+		wg.Add(1)
+		go func(i int, b Backend) {
+			defer wg.Done()
+			addrs[i], errs[i] = b.Storage.Store(peerID, ciphertext)
+		}(i, b)
+	}
+	wg.Wait()
+
+	var (
+		stored   []ma.Multiaddr
+		storeErr StoreError
+	)
+	for i, b := range c.backends {
+		if errs[i] != nil {
+			storeErr.Failures = append(storeErr.Failures, BackendError{Name: b.Name, Err: errs[i]})
+			continue
+		}
+		stored = append(stored, addrs[i])
+	}
+	if len(storeErr.Failures) > 0 {
+		return stored, &storeErr
+	}
+	return stored, nil
+}
+
+// Delete deletes the message from the backend the address belongs to
+func (c *CompositeStorage) Delete(addr ma.Multiaddr, digest string) error {
+	var deleteErr StoreError
+	for _, b := range c.backends {
+		deleter, ok := b.Storage.(sto.OfflineMessageDeleter)
+		if !ok {
+			continue
+		}
+		err := deleter.Delete(addr, digest)
+		switch {
+		case err == nil:
+			return nil
+		case err != sto.ErrNotStored:
+			deleteErr.Failures = append(deleteErr.Failures, BackendError{Name: b.Name, Err: err})
+		}
+	}
+	if len(deleteErr.Failures) > 0 {
+		return &deleteErr
+	}
+	return sto.ErrNotStored
+}
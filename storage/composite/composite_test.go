@@ -0,0 +1,111 @@
+package composite
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	ma "gx/ipfs/QmTZBfrPJmjWsCvHEtX5FE6KimVJhsJg5sBbqEFYf4UZtL/go-multiaddr"
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
+)
+
+type mockStorage struct {
+	url     string
+	err     error
+	deleted []string
+}
+
+func (m *mockStorage) Store(peerID peer.ID, ciphertext []byte) (ma.Multiaddr, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return sto.NewURLMultiaddr(m.url)
+}
+
+func (m *mockStorage) Delete(addr ma.Multiaddr, digest string) error {
+	if url, ok := sto.URLFromMultiaddr(addr); !ok || url != m.url {
+		return sto.ErrNotStored
+	}
+	m.deleted = append(m.deleted, digest)
+	return nil
+}
+
+func TestCompositeStorageStoreAll(t *testing.T) {
+	first := &mockStorage{url: "https://first.example.com/message"}
+	broken := &mockStorage{err: errors.New("token expired")}
+	second := &mockStorage{url: "https://second.example.com/message"}
+	c, err := NewCompositeStorage(
+		Backend{Name: "first", Storage: first},
+		Backend{Name: "dropbox", Storage: broken},
+		Backend{Name: "second", Storage: second},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addrs, err := c.StoreAll("", []byte("ciphertext"))
+	if len(addrs) != 2 {
+		t.Fatalf("expected two locations, got %v", addrs)
+	}
+	for i, expected := range []string{first.url, second.url} {
+		if url, _ := sto.URLFromMultiaddr(addrs[i]); url != expected {
+			t.Errorf("expected location %d to be %s, got %s", i, expected, url)
+		}
+	}
+	storeErr, ok := err.(*StoreError)
+	if !ok || len(storeErr.Failures) != 1 || storeErr.Failures[0].Name != "dropbox" {
+		t.Fatalf("expected the dropbox failure to be reported, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "dropbox: token expired") {
+		t.Errorf("expected the error to name the backend, got %s", err)
+	}
+
+	addr, err := c.Store("", []byte("ciphertext"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if url, _ := sto.URLFromMultiaddr(addr); url != first.url {
+		t.Errorf("expected the first location, got %s", url)
+	}
+
+	c, err = NewCompositeStorage(Backend{Name: "dropbox", Storage: broken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Store("", []byte("ciphertext")); err == nil {
+		t.Error("expected an error when no backend stored the message")
+	}
+	if _, err := NewCompositeStorage(); err == nil {
+		t.Error("expected an error without backends")
+	}
+}
+
+func TestCompositeStorageDelete(t *testing.T) {
+	first := &mockStorage{url: "https://first.example.com/message"}
+	second := &mockStorage{url: "https://second.example.com/message"}
+	c, err := NewCompositeStorage(Backend{Name: "first", Storage: first}, Backend{Name: "second", Storage: second})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addr, err := sto.NewURLMultiaddr(second.url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Delete(addr, "digest"); err != nil {
+		t.Fatal(err)
+	}
+	if len(first.deleted) != 0 || len(second.deleted) != 1 {
+		t.Errorf("expected only the second backend to delete the message, got %v and %v", first.deleted, second.deleted)
+	}
+
+	addr, err = sto.NewURLMultiaddr("https://unknown.example.com/message")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Delete(addr, "digest"); err != sto.ErrNotStored {
+		t.Errorf("expected ErrNotStored for an unknown location, got %v", err)
+	}
+}
@@ -0,0 +1,351 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	ma "gx/ipfs/QmTZBfrPJmjWsCvHEtX5FE6KimVJhsJg5sBbqEFYf4UZtL/go-multiaddr"
+	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
+	"github.com/op/go-logging"
+)
+
+var log = logging.MustGetLogger("s3storage")
+
+const (
+	// How often expired messages are deleted
+	expirySweepInterval = time.Hour
+
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	amzDateFormat    = "20060102T150405Z"
+)
+
+// S3Storage stores offline messages in a bucket of an S3 compatible object
+// store. Requests are signed with AWS signature version 4 and objects are
+// addressed path-style so that self-hosted stores such as MinIO work
+// without DNS setup. The bucket must allow anonymous reads of the objects,
+// either through its policy or the configured ACL.
+type S3Storage struct {
+	endpoint  *url.URL
+	region    string
+	bucket    string
+	prefix    string
+	accessKey string
+	secretKey string
+	acl       string
+	publicURL string
+	expiry    time.Duration
+
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewS3Storage returns the storage for the config. A nil client uses a
+// default client with a timeout.
+func NewS3Storage(cfg schema.S3StorageConfig, client *http.Client) (*S3Storage, error) {
+	endpoint, err := url.Parse(strings.TrimRight(cfg.Endpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Bucket == "" {
+		return nil, errors.New("s3 bucket not set")
+	}
+	var expiry time.Duration
+	if cfg.Expiry != "" {
+		expiry, err = time.ParseDuration(cfg.Expiry)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if client == nil {
+		client = &http.Client{Timeout: time.Second * 30}
+	}
+	region := cfg.Region
+	if region == "" {
+		region = schema.S3StorageDefaultRegion
+	}
+	return &S3Storage{
+		endpoint:  endpoint,
+		region:    region,
+		bucket:    cfg.Bucket,
+		prefix:    cfg.Prefix,
+		accessKey: cfg.AccessKey,
+		secretKey: cfg.SecretKey,
+		acl:       cfg.ACL,
+		publicURL: strings.TrimRight(cfg.PublicURL, "/"),
+		expiry:    expiry,
+		client:    client,
+		now:       time.Now,
+	}, nil
+}
+
+// Store uploads the ciphertext named by its hash and returns the multiaddr
+// of its public URL
+func (s *S3Storage) Store(peerID peer.ID, ciphertext []byte) (ma.Multiaddr, error) {
+	hash := sha256.Sum256(ciphertext)
+	key := s.prefix + hex.EncodeToString(hash[:])
+
+	req, err := http.NewRequest("PUT", s.objectURL(key), bytes.NewReader(ciphertext))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if s.acl != "" {
+		req.Header.Set("x-amz-acl", s.acl)
+	}
+	resp, err := s.do(req, ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return sto.NewURLMultiaddr(s.publicObjectURL(key))
+}
+
+// Delete deletes the message once it is no longer needed
+func (s *S3Storage) Delete(addr ma.Multiaddr, digest string) error {
+	key := s.prefix + digest
+	if url, ok := sto.URLFromMultiaddr(addr); !ok || url != s.publicObjectURL(key) {
+		return sto.ErrNotStored
+	}
+	return s.delete(key)
+}
+
+// Run deletes expired messages periodically. It returns straight away if
+// messages do not expire.
+func (s *S3Storage) Run() {
+	if s.expiry <= 0 {
+		return
+	}
+	tick := time.NewTicker(expirySweepInterval)
+	defer tick.Stop()
+	for {
+		n, err := s.DeleteExpired()
+		if err != nil {
+			log.Errorf("deleting expired offline messages: %s", err)
+		} else if n > 0 {
+			log.Infof("deleted %d expired offline messages", n)
+		}
+		<-tick.C
+	}
+}
+
+// DeleteExpired deletes the messages under the prefix which are older than
+// the expiry and returns how many were deleted
+func (s *S3Storage) DeleteExpired() (int, error) {
+	if s.expiry <= 0 {
+		return 0, nil
+	}
+	cutoff := s.now().Add(-s.expiry)
+	deleted := 0
+	token := ""
+	for {
+		list, err := s.list(token)
+		if err != nil {
+			return deleted, err
+		}
+		for _, obj := range list.Contents {
+			if !obj.LastModified.Before(cutoff) {
+				continue
+			}
+			if err := s.delete(obj.Key); err != nil {
+				return deleted, err
+			}
+			deleted++
+		}
+		if !list.IsTruncated || list.NextContinuationToken == "" {
+			return deleted, nil
+		}
+		token = list.NextContinuationToken
+	}
+}
+
+type listBucketResult struct {
+	Contents []struct {
+		Key          string
+		LastModified time.Time
+	}
+	IsTruncated           bool
+	NextContinuationToken string
+}
+
+func (s *S3Storage) list(continuationToken string) (*listBucketResult, error) {
+	query := url.Values{}
+	query.Set("list-type", "2")
+	query.Set("prefix", s.prefix)
+	if continuationToken != "" {
+		query.Set("continuation-token", continuationToken)
+	}
+	req, err := http.NewRequest("GET", s.objectURL("")+"?"+canonicalQuery(query), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(req, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	list := new(listBucketResult)
+	if err := xml.NewDecoder(resp.Body).Decode(list); err != nil {
+		return nil, fmt.Errorf("reading bucket listing: %s", err)
+	}
+	return list, nil
+}
+
+func (s *S3Storage) delete(key string) error {
+	req, err := http.NewRequest("DELETE", s.objectURL(key), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// do signs and sends the request. Responses other than 2xx are returned as
+// errors carrying the S3 error code.
+func (s *S3Storage) do(req *http.Request, payload []byte) (*http.Response, error) {
+	s.sign(req, payload, s.now())
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 == 2 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	var s3Err struct {
+		Code    string
+		Message string
+	}
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1<<16))
+	if xml.Unmarshal(body, &s3Err) == nil && s3Err.Code != "" {
+		return nil, fmt.Errorf("s3 %s %s: %s: %s", req.Method, req.URL.Path, s3Err.Code, s3Err.Message)
+	}
+	return nil, fmt.Errorf("s3 %s %s: %s", req.Method, req.URL.Path, resp.Status)
+}
+
+func (s *S3Storage) objectURL(key string) string {
+	if key == "" {
+		return s.endpoint.String() + "/" + uriEncode(s.bucket, false)
+	}
+	return s.endpoint.String() + "/" + uriEncode(s.bucket, false) + "/" + uriEncode(key, false)
+}
+
+func (s *S3Storage) publicObjectURL(key string) string {
+	if s.publicURL != "" {
+		return s.publicURL + "/" + uriEncode(key, false)
+	}
+	return s.objectURL(key)
+}
+
+// sign adds the AWS signature version 4 headers to the request. Every
+// header already set on the request is signed along with the host.
+func (s *S3Storage) sign(req *http.Request, payload []byte, now time.Time) {
+	payloadHash := sha256.Sum256(payload)
+	amzDate := now.UTC().Format(amzDateFormat)
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", hex.EncodeToString(payloadHash[:]))
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonicalHeaders bytes.Buffer
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		headers["x-amz-content-sha256"],
+	}, "\n")
+
+	scope := strings.Join([]string{amzDate[:8], s.region, "s3", "aws4_request"}, "/")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{signingAlgorithm, amzDate, scope, hex.EncodeToString(requestHash[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), amzDate[:8])
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, s.accessKey, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// canonicalQuery returns the query sorted by key with every key and value
+// encoded as required by the signature
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		values := append([]string(nil), query[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode percent-encodes everything but the unreserved characters. The
+// slash is kept unless encodeSlash is set.
+func uriEncode(s string, encodeSlash bool) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			buf.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			buf.WriteByte(c)
+		default:
+			fmt.Fprintf(&buf, "%%%02X", c)
+		}
+	}
+	return buf.String()
+}
@@ -13,6 +13,7 @@ import (
 	"path"
 
 	"github.com/OpenBazaar/openbazaar-go/ipfs"
+	sto "github.com/OpenBazaar/openbazaar-go/storage"
 	"github.com/ipfs/go-ipfs/core"
 )
 
@@ -68,3 +69,23 @@ func (s *SelfHostedStorage) Store(peerID peer.ID, ciphertext []byte) (ma.Multiad
 	}
 	return maAddr, nil
 }
+
+// Delete removes the message from the outbox and unpins it. Copies held by
+// the push nodes are left to expire there.
+func (s *SelfHostedStorage) Delete(addr ma.Multiaddr, digest string) error {
+	if _, ok := sto.URLFromMultiaddr(addr); ok {
+		return sto.ErrNotStored
+	}
+	id, err := addr.ValueForProtocol(ma.P_IPFS)
+	if err != nil {
+		return err
+	}
+	if err := ipfs.UnPinDir(s.ipfsNode, id); err != nil {
+		return err
+	}
+	err = os.Remove(path.Join(s.repoPath, "outbox", digest))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
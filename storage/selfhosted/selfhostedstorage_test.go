@@ -1,9 +1,11 @@
 package selfhosted
 
 import (
+	ma "gx/ipfs/QmTZBfrPJmjWsCvHEtX5FE6KimVJhsJg5sBbqEFYf4UZtL/go-multiaddr"
 	"gx/ipfs/QmTbxNB1NwDesLmKTscr4udL2tVP7MaxvXnD1D9yX7g3PN/go-cid"
 	"gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
 	"os"
+	"path"
 	"testing"
 
 	"github.com/ipfs/go-ipfs/core/mock"
@@ -32,3 +34,54 @@ func TestSelfHostedStorage_Store(t *testing.T) {
 	}
 	os.RemoveAll("./outbox")
 }
+
+func TestSelfHostedStorage_Delete(t *testing.T) {
+	ctx, err := coremock.NewMockNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.Mkdir("./outbox", os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll("./outbox")
+	storage := NewSelfHostedStorage("./", ctx, []peer.ID{}, func(peerID string, cids []cid.Cid) error { return nil })
+	pid, err := peer.IDB58Decode("QmNp85zy9RLrQ5oQD4hPyS39ezrrXpcaa7R4Y9kxdWQLLQ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := storage.Store(pid, []byte("hello world"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"
+	if _, err := os.Stat(path.Join("./outbox", digest)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := storage.Delete(addr, digest); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path.Join("./outbox", digest)); !os.IsNotExist(err) {
+		t.Error("expected the outbox file to be removed")
+	}
+	pinned, err := ctx.Pinning.CheckIfPinned(mustDecodeCid(t, addr))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pinned[0].Pinned() {
+		t.Error("expected the message to be unpinned")
+	}
+}
+
+func mustDecodeCid(t *testing.T, addr ma.Multiaddr) cid.Cid {
+	v, err := addr.ValueForProtocol(ma.P_IPFS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := cid.Decode(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
@@ -1,8 +1,15 @@
 package net
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
 	ma "gx/ipfs/QmTZBfrPJmjWsCvHEtX5FE6KimVJhsJg5sBbqEFYf4UZtL/go-multiaddr"
 	peer "gx/ipfs/QmYVXrKrKHDC9FobgmcmshCDyWwdrfwfanNQN4oxJ9Fk3h/go-libp2p-peer"
+	mh "gx/ipfs/QmerPMzPk1mJVowm8KgmoknWa4yCYvvugMPsgWmDNUvDLW/go-multihash"
+
+	"github.com/btcsuite/btcutil/base58"
 )
 
 type OfflineMessagingStorage interface {
@@ -17,8 +24,115 @@ type OfflineMessagingStorage interface {
 	   Some storage possibilities include:
 	   IPFS Seeding -> assumes this node remains online all the time
 	   Dropbox -> go dropbox drivers are available
+	   S3 -> any S3 compatible object store
 	   Custom Options -> create your own free or paid service.
 
 	   Note all messages are encrypted before passed in here. */
 	Store(peerID peer.ID, ciphertext []byte) (ma.Multiaddr, error)
 }
+
+// ErrNotStored is returned by Delete when the address is not a location of
+// the storage
+var ErrNotStored = errors.New("message not stored in this storage")
+
+// OfflineMessageDeleter is implemented by storage options which can delete a
+// stored message once the recipient has acknowledged it or its pointer has
+// expired. The digest is the hex SHA-256 of the ciphertext passed to Store.
+type OfflineMessageDeleter interface {
+	Delete(addr ma.Multiaddr, digest string) error
+}
+
+// MultiLocationStorage is implemented by storage options which store each
+// message in several locations. A pointer is published for every location
+// returned. The error reports the locations which failed, even when others
+// succeeded.
+type MultiLocationStorage interface {
+	StoreAll(peerID peer.ID, ciphertext []byte) ([]ma.Multiaddr, error)
+}
+
+// P_URL is the code of the multiaddr protocol used in pointers to messages
+// stored by HTTP(S) backends. It is in the multicodec private use range and
+// carries the base58 encoded URL, as in /ob-url/<url>, so that URLs of any
+// length fit without widening the parsing of /ipfs/ addresses.
+const P_URL = 0x300000
+
+func init() {
+	err := ma.AddProtocol(ma.Protocol{
+		Name:       "ob-url",
+		Code:       P_URL,
+		VCode:      ma.CodeToVarint(P_URL),
+		Size:       ma.LengthPrefixedVarSize,
+		Transcoder: ma.NewTranscoderFromFunctions(urlStringToBytes, urlBytesToString, validateURL),
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func urlStringToBytes(s string) ([]byte, error) {
+	b := base58.Decode(s)
+	if err := validateURL(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func urlBytesToString(b []byte) (string, error) {
+	if err := validateURL(b); err != nil {
+		return "", err
+	}
+	return base58.Encode(b), nil
+}
+
+func validateURL(b []byte) error {
+	url := string(b)
+	if !strings.HasPrefix(url, "https://") && !strings.HasPrefix(url, "http://") {
+		return fmt.Errorf("invalid message URL: %q", url)
+	}
+	return nil
+}
+
+// NewURLMultiaddr returns the multiaddr used in pointers to a message which
+// can be downloaded from the URL
+func NewURLMultiaddr(url string) (ma.Multiaddr, error) {
+	if err := validateURL([]byte(url)); err != nil {
+		return nil, err
+	}
+	b := ma.CodeToVarint(P_URL)
+	b = append(b, ma.CodeToVarint(len(url))...)
+	b = append(b, url...)
+	return ma.NewMultiaddrBytes(b)
+}
+
+// URLFromMultiaddr returns the URL of a message stored by an HTTP(S) backend
+// and false if the message is stored on IPFS. The /ipfs/<multihash>/https/
+// form used by the Dropbox storage is also understood.
+func URLFromMultiaddr(addr ma.Multiaddr) (string, bool) {
+	protocols := addr.Protocols()
+	if len(protocols) == 1 && protocols[0].Code == P_URL {
+		enc, err := addr.ValueForProtocol(P_URL)
+		if err != nil {
+			return "", false
+		}
+		return string(base58.Decode(enc)), true
+	}
+	if len(protocols) != 2 || protocols[0].Code != ma.P_IPFS || protocols[1].Code != ma.P_HTTPS {
+		return "", false
+	}
+	enc, err := addr.ValueForProtocol(ma.P_IPFS)
+	if err != nil {
+		return "", false
+	}
+	m, err := mh.FromB58String(enc)
+	if err != nil {
+		return "", false
+	}
+	d, err := mh.Decode(m)
+	if err != nil {
+		return "", false
+	}
+	if validateURL(d.Digest) != nil {
+		return "", false
+	}
+	return string(d.Digest), true
+}
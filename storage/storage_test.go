@@ -0,0 +1,53 @@
+package net
+
+import (
+	"strings"
+	"testing"
+
+	ma "gx/ipfs/QmTZBfrPJmjWsCvHEtX5FE6KimVJhsJg5sBbqEFYf4UZtL/go-multiaddr"
+
+	"github.com/btcsuite/btcutil/base58"
+)
+
+func TestURLMultiaddr(t *testing.T) {
+	for _, url := range []string{
+		"https://example.com/0123456789ab",
+		"https://s3.example.com/offline/" + strings.Repeat("ab", 32),
+		"https://www.dropbox.com/s/" + strings.Repeat("x", 15) + "/" + strings.Repeat("ab", 64) + "?dl=1",
+	} {
+		addr, err := NewURLMultiaddr(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := "/ob-url/" + base58.Encode([]byte(url)); addr.String() != expected {
+			t.Errorf("expected %s, got %s", expected, addr)
+		}
+
+		// Pointers are stored as strings and sent to other peers as bytes
+		fromString, err := ma.NewMultiaddr(addr.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		fromBytes, err := ma.NewMultiaddrBytes(addr.Bytes())
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, a := range []ma.Multiaddr{addr, fromString, fromBytes} {
+			if got, ok := URLFromMultiaddr(a); !ok || got != url {
+				t.Errorf("expected %s from %s, got %s", url, a, got)
+			}
+		}
+	}
+
+	if _, err := NewURLMultiaddr("ftp://example.com/message"); err == nil {
+		t.Error("expected a URL which is not HTTP(S) to be rejected")
+	}
+
+	ipfsAddr, err := ma.NewMultiaddr("/ipfs/QmNLei78zWmzUdbeRB3CiUfAizWUrbeeZh5K1rhAQKCh51")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := URLFromMultiaddr(ipfsAddr); ok {
+		t.Error("expected IPFS addresses not to be URLs")
+	}
+}
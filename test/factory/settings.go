@@ -19,7 +19,9 @@ func MustNewValidSettings() repo.SettingsData {
 		"refundPolicy": "Refund policy.",
 		"blockedNodes": [],
 		"storeModerators": [],
+		"storeTaxes": [],
 		"mispaymentBuffer": 1,
+		"priceQuoteMinutes": 15,
 		"smtpSettings"  : {
 			"notifications": false,
 			"openBazaarName": "",
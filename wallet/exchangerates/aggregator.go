@@ -0,0 +1,292 @@
+package exchangerates
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+	"github.com/OpenBazaar/openbazaar-go/schema"
+	"github.com/OpenBazaar/wallet-interface"
+	"github.com/op/go-logging"
+)
+
+var log = logging.MustGetLogger("ExchangeRates")
+
+// unitsPerCoin is the number of base units of the reserve coin
+const unitsPerCoin = 100000000
+
+var (
+	// ErrNoProviders is returned when no exchange rate provider is available
+	ErrNoProviders = errors.New("no exchange rate providers available")
+
+	// ErrStaleRates is returned when the providers have failed for longer
+	// than the max age of the rates
+	ErrStaleRates = errors.New("exchange rates are too old")
+)
+
+// Config configures an Aggregator. A zero MaxAge never considers the last
+// rates too old and a zero HistoryInterval records every refresh. Store
+// may be nil to keep no history.
+type Config struct {
+	ReserveCode     string
+	Providers       []Provider
+	RefreshInterval time.Duration
+	MaxAge          time.Duration
+	HistoryInterval time.Duration
+	Store           repo.ExchangeRateStore
+}
+
+// Aggregator implements wallet.ExchangeRates over a set of providers. Each
+// rate is the median of the rates reported by the providers. The last rates
+// are used when all providers fail, until they are older than the max age.
+type Aggregator struct {
+	cfg Config
+	now func() time.Time
+
+	lock        sync.Mutex
+	rates       map[string]float64
+	updated     time.Time
+	attempted   time.Time
+	lastErr     error
+	lastHistory time.Time
+}
+
+// NewAggregator returns an Aggregator for the config
+func NewAggregator(cfg Config) (*Aggregator, error) {
+	if len(cfg.Providers) == 0 {
+		return nil, ErrNoProviders
+	}
+	if cfg.ReserveCode == "" {
+		return nil, errors.New("reserve code must be specified")
+	}
+	return &Aggregator{cfg: cfg, now: time.Now}, nil
+}
+
+// NewAggregatorFromConfig returns an Aggregator for the providers of the
+// config. The built-in provider is skipped when the wallet's exchange rates
+// are disabled, and the paths of file providers are relative to repoPath.
+func NewAggregatorFromConfig(cfg *schema.ExchangeRatesConfig, reserveCode string, builtin wallet.ExchangeRates, repoPath string, client *http.Client, store repo.ExchangeRateStore) (*Aggregator, error) {
+	var providers []Provider
+	for _, p := range cfg.Providers {
+		switch p.Type {
+		case schema.ExchangeRateProviderBuiltin:
+			if builtin == nil {
+				log.Warning("built-in exchange rate provider is disabled")
+				continue
+			}
+			providers = append(providers, NewWalletProvider(builtin))
+		case schema.ExchangeRateProviderHTTP:
+			providers = append(providers, NewHTTPProvider(p.URL, client))
+		case schema.ExchangeRateProviderFile:
+			path := p.Path
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(repoPath, path)
+			}
+			providers = append(providers, NewFileProvider(path))
+		default:
+			return nil, fmt.Errorf("unknown exchange rate provider type (%s)", p.Type)
+		}
+	}
+	aggCfg := Config{
+		ReserveCode: reserveCode,
+		Providers:   providers,
+		Store:       store,
+	}
+	for _, d := range []struct {
+		value string
+		dest  *time.Duration
+	}{
+		{cfg.RefreshInterval, &aggCfg.RefreshInterval},
+		{cfg.MaxAge, &aggCfg.MaxAge},
+		{cfg.HistoryInterval, &aggCfg.HistoryInterval},
+	} {
+		parsed, err := time.ParseDuration(d.value)
+		if err != nil {
+			return nil, err
+		}
+		*d.dest = parsed
+	}
+	return NewAggregator(aggCfg)
+}
+
+// Start refreshes the rates every refresh interval so the history is kept
+// while no rates are requested. It does not return.
+func (a *Aggregator) Start() {
+	interval := a.cfg.RefreshInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	t := time.NewTicker(interval)
+	for ; true; <-t.C {
+		if _, err := a.GetAllRates(false); err != nil {
+			log.Warningf("refreshing exchange rates: %s", err.Error())
+		}
+	}
+}
+
+// GetExchangeRate returns the rate of the currency, refreshing the rates
+// when they are older than the refresh interval
+func (a *Aggregator) GetExchangeRate(currencyCode string) (float64, error) {
+	rates, err := a.GetAllRates(true)
+	if err != nil {
+		return 0, err
+	}
+	return rateFor(rates, currencyCode)
+}
+
+// GetLatestRate refreshes the rates and returns the rate of the currency
+func (a *Aggregator) GetLatestRate(currencyCode string) (float64, error) {
+	rates, err := a.GetAllRates(false)
+	if err != nil {
+		return 0, err
+	}
+	return rateFor(rates, currencyCode)
+}
+
+// GetAllRates returns the rates by currency code. The cached rates are
+// returned when cacheOK is set and they are newer than the refresh interval.
+func (a *Aggregator) GetAllRates(cacheOK bool) (map[string]float64, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	now := a.now()
+	if cacheOK && a.rates != nil && now.Sub(a.attempted) < a.cfg.RefreshInterval {
+		return a.cachedRates(now)
+	}
+
+	a.attempted = now
+	rates, err := a.fetch()
+	if err != nil {
+		a.lastErr = err
+		if a.rates == nil {
+			return nil, err
+		}
+		log.Warningf("using exchange rates from %s: %s", a.updated.Format(time.RFC3339), err.Error())
+		return a.cachedRates(now)
+	}
+	a.lastErr = nil
+	a.rates, a.updated = rates, now
+
+	if a.cfg.Store != nil && now.Sub(a.lastHistory) >= a.cfg.HistoryInterval {
+		if err := a.cfg.Store.Put(rates, now); err != nil {
+			log.Errorf("recording exchange rates: %s", err.Error())
+		} else {
+			a.lastHistory = now
+		}
+	}
+	return copyRates(rates), nil
+}
+
+// cachedRates returns the last rates unless they are older than the max age
+func (a *Aggregator) cachedRates(now time.Time) (map[string]float64, error) {
+	if age := now.Sub(a.updated); a.cfg.MaxAge > 0 && age > a.cfg.MaxAge {
+		err := fmt.Errorf("%s: last updated %s ago", ErrStaleRates.Error(), age.Round(time.Second))
+		if a.lastErr != nil {
+			err = fmt.Errorf("%s: %s", err.Error(), a.lastErr.Error())
+		}
+		return nil, err
+	}
+	return copyRates(a.rates), nil
+}
+
+// UnitsPerCoin returns the number of base units of the reserve coin
+func (a *Aggregator) UnitsPerCoin() int64 {
+	return unitsPerCoin
+}
+
+// GetHistoricalRate returns the last rate of the currency recorded at or
+// before the time
+func (a *Aggregator) GetHistoricalRate(currencyCode string, t time.Time) (float64, error) {
+	if a.cfg.Store == nil {
+		return 0, errors.New("exchange rate history is disabled")
+	}
+	if strings.EqualFold(currencyCode, a.cfg.ReserveCode) {
+		return 1, nil
+	}
+	rate, _, err := a.cfg.Store.GetAt(strings.ToUpper(currencyCode), t)
+	if err != nil {
+		return 0, fmt.Errorf("no rate for (%s) at %s: %s", currencyCode, t.Format(time.RFC3339), err.Error())
+	}
+	return rate, nil
+}
+
+// fetch queries the providers concurrently and returns the median rate of
+// each currency
+func (a *Aggregator) fetch() (map[string]float64, error) {
+	type result struct {
+		name  string
+		rates map[string]float64
+		err   error
+	}
+	results := make(chan result, len(a.cfg.Providers))
+	for _, p := range a.cfg.Providers {
+		go func(p Provider) {
+			rates, err := p.GetAllRates()
+			results <- result{p.Name(), rates, err}
+		}(p)
+	}
+
+	var (
+		reported = make(map[string][]float64)
+		errs     []string
+	)
+	for range a.cfg.Providers {
+		r := <-results
+		if r.err == nil && len(r.rates) == 0 {
+			r.err = errors.New("no rates")
+		}
+		if r.err != nil {
+			log.Debugf("exchange rate provider (%s): %s", r.name, r.err.Error())
+			errs = append(errs, fmt.Sprintf("%s: %s", r.name, r.err.Error()))
+			continue
+		}
+		for code, rate := range r.rates {
+			if rate > 0 {
+				code = strings.ToUpper(code)
+				reported[code] = append(reported[code], rate)
+			}
+		}
+	}
+	if len(reported) == 0 {
+		sort.Strings(errs)
+		return nil, fmt.Errorf("all exchange rate providers failed (%s)", strings.Join(errs, "; "))
+	}
+
+	rates := make(map[string]float64, len(reported)+1)
+	for code, values := range reported {
+		rates[code] = median(values)
+	}
+	rates[strings.ToUpper(a.cfg.ReserveCode)] = 1
+	return rates, nil
+}
+
+func median(values []float64) float64 {
+	sort.Float64s(values)
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2
+	}
+	return values[mid]
+}
+
+func rateFor(rates map[string]float64, currencyCode string) (float64, error) {
+	rate, ok := rates[strings.ToUpper(currencyCode)]
+	if !ok {
+		return 0, fmt.Errorf("no exchange rate for (%s)", currencyCode)
+	}
+	return rate, nil
+}
+
+func copyRates(rates map[string]float64) map[string]float64 {
+	ret := make(map[string]float64, len(rates))
+	for code, rate := range rates {
+		ret[code] = rate
+	}
+	return ret
+}
@@ -0,0 +1,168 @@
+package exchangerates
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OpenBazaar/openbazaar-go/repo"
+)
+
+type staticProvider struct {
+	rates map[string]float64
+	err   error
+}
+
+func (p *staticProvider) Name() string { return "static" }
+
+func (p *staticProvider) GetAllRates() (map[string]float64, error) {
+	return p.rates, p.err
+}
+
+type memoryHistory struct {
+	repo.Queryable
+	records map[int64]map[string]float64
+}
+
+func (h *memoryHistory) Put(rates map[string]float64, t time.Time) error {
+	h.records[t.Unix()] = rates
+	return nil
+}
+
+func (h *memoryHistory) GetAt(code string, t time.Time) (float64, time.Time, error) {
+	var (
+		rate float64
+		at   int64 = -1
+	)
+	for ts, rates := range h.records {
+		if r, ok := rates[code]; ok && ts <= t.Unix() && ts > at {
+			rate, at = r, ts
+		}
+	}
+	if at < 0 {
+		return 0, time.Time{}, errors.New("no rows")
+	}
+	return rate, time.Unix(at, 0), nil
+}
+
+func TestAggregatorMedian(t *testing.T) {
+	agg, err := NewAggregator(Config{
+		ReserveCode: "BTC",
+		Providers: []Provider{
+			&staticProvider{rates: map[string]float64{"USD": 9000, "EUR": 8000}},
+			&staticProvider{rates: map[string]float64{"USD": 9300, "EUR": 8200}},
+			&staticProvider{rates: map[string]float64{"usd": 9100}},
+			&staticProvider{err: errors.New("unreachable")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rates, err := agg.GetAllRates(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rates["USD"] != 9100 {
+		t.Errorf("expected the median USD rate 9100, got %f", rates["USD"])
+	}
+	if rates["EUR"] != 8100 {
+		t.Errorf("expected the median EUR rate 8100, got %f", rates["EUR"])
+	}
+	if rates["BTC"] != 1 {
+		t.Errorf("expected the reserve rate to be 1, got %f", rates["BTC"])
+	}
+	if _, err := agg.GetExchangeRate("JPY"); err == nil {
+		t.Error("expected an error for a currency without rates")
+	}
+}
+
+func TestAggregatorMaxAge(t *testing.T) {
+	provider := &staticProvider{rates: map[string]float64{"USD": 9000}}
+	history := &memoryHistory{records: make(map[int64]map[string]float64)}
+	agg, err := NewAggregator(Config{
+		ReserveCode:     "BTC",
+		Providers:       []Provider{provider},
+		RefreshInterval: time.Minute,
+		MaxAge:          time.Hour,
+		HistoryInterval: 30 * time.Minute,
+		Store:           history,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1500000000, 0)
+	agg.now = func() time.Time { return now }
+
+	if rate, err := agg.GetExchangeRate("USD"); err != nil || rate != 9000 {
+		t.Fatalf("expected 9000, got %f (%v)", rate, err)
+	}
+
+	provider.rates, provider.err = nil, errors.New("unreachable")
+	now = now.Add(50 * time.Minute)
+	if rate, err := agg.GetExchangeRate("USD"); err != nil || rate != 9000 {
+		t.Errorf("expected the last rate within the max age, got %f (%v)", rate, err)
+	}
+
+	now = now.Add(20 * time.Minute)
+	if _, err := agg.GetExchangeRate("USD"); err == nil || !strings.Contains(err.Error(), ErrStaleRates.Error()) {
+		t.Errorf("expected stale rates, got %v", err)
+	}
+
+	provider.rates, provider.err = map[string]float64{"USD": 9500}, nil
+	now = now.Add(time.Minute)
+	if rate, err := agg.GetExchangeRate("USD"); err != nil || rate != 9500 {
+		t.Errorf("expected the rates to recover, got %f (%v)", rate, err)
+	}
+
+	if len(history.records) != 2 {
+		t.Errorf("expected 2 history records, got %d", len(history.records))
+	}
+	rate, err := agg.GetHistoricalRate("USD", time.Unix(1500000000, 0).Add(time.Hour))
+	if err != nil || rate != 9000 {
+		t.Errorf("expected the historical rate 9000, got %f (%v)", rate, err)
+	}
+	if rate, err := agg.GetHistoricalRate("BTC", time.Unix(0, 0)); err != nil || rate != 1 {
+		t.Errorf("expected the reserve historical rate to be 1, got %f (%v)", rate, err)
+	}
+}
+
+func TestProviders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"USD": 9000, "EUR": -1}`)
+	}))
+	defer server.Close()
+
+	rates, err := NewHTTPProvider(server.URL, nil).GetAllRates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rates) != 1 || rates["USD"] != 9000 {
+		t.Errorf("unexpected rates from the http provider: %v", rates)
+	}
+
+	dir, err := ioutil.TempDir("", "exchangerates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(filepath.Join(dir, "rates.json"), []byte(`{"USD": 1.5}`), os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	rates, err = NewFileProvider(filepath.Join(dir, "rates.json")).GetAllRates()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rates["USD"] != 1.5 {
+		t.Errorf("unexpected rates from the file provider: %v", rates)
+	}
+	if _, err := NewFileProvider(filepath.Join(dir, "missing.json")).GetAllRates(); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
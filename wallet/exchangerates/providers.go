@@ -0,0 +1,106 @@
+package exchangerates
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/OpenBazaar/wallet-interface"
+)
+
+// maxRatesSize is the largest response or file of rates which is read
+const maxRatesSize = 1 << 20
+
+// Provider is a source of exchange rates. The rates are the price of one
+// reserve coin in each currency.
+type Provider interface {
+	// Name identifies the provider in logs and errors
+	Name() string
+
+	// GetAllRates returns the current rates by currency code
+	GetAllRates() (map[string]float64, error)
+}
+
+type walletProvider struct {
+	rates wallet.ExchangeRates
+}
+
+// NewWalletProvider returns a provider using the exchange rate service of a
+// wallet
+func NewWalletProvider(rates wallet.ExchangeRates) Provider {
+	return &walletProvider{rates: rates}
+}
+
+func (p *walletProvider) Name() string { return "builtin" }
+
+func (p *walletProvider) GetAllRates() (map[string]float64, error) {
+	return p.rates.GetAllRates(false)
+}
+
+type httpProvider struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPProvider returns a provider fetching a JSON object of rates by
+// currency code from the URL
+func NewHTTPProvider(url string, client *http.Client) Provider {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &httpProvider{url: url, client: client}
+}
+
+func (p *httpProvider) Name() string { return p.url }
+
+func (p *httpProvider) GetAllRates() (map[string]float64, error) {
+	resp, err := p.client.Get(p.url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return decodeRates(resp.Body)
+}
+
+type fileProvider struct {
+	path string
+}
+
+// NewFileProvider returns a provider reading a JSON object of rates by
+// currency code from the file. The file is read each time so it may be
+// edited while the node runs.
+func NewFileProvider(path string) Provider {
+	return &fileProvider{path: path}
+}
+
+func (p *fileProvider) Name() string { return p.path }
+
+func (p *fileProvider) GetAllRates() (map[string]float64, error) {
+	f, err := os.Open(p.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return decodeRates(f)
+}
+
+// decodeRates decodes a JSON object of rates by currency code. Rates which
+// are not positive are dropped.
+func decodeRates(r io.Reader) (map[string]float64, error) {
+	var decoded map[string]float64
+	if err := json.NewDecoder(io.LimitReader(r, maxRatesSize)).Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("decoding rates: %s", err.Error())
+	}
+	rates := make(map[string]float64, len(decoded))
+	for code, rate := range decoded {
+		if rate > 0 {
+			rates[code] = rate
+		}
+	}
+	return rates, nil
+}
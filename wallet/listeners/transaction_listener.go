@@ -302,6 +302,26 @@ func (l *TransactionListener) processSalePayment(txid string, output wallet.Tran
 				}
 			}
 			l.adjustInventory(contract)
+			if core.Node != nil {
+				if err := core.Node.ReserveAppointment(orderId, contract); err != nil {
+					log.Errorf("failed reserving the slot of order (%s): %s", orderId, err.Error())
+				}
+			}
+			if state == pb.OrderState_AWAITING_PAYMENT && contract.VendorOrderConfirmation != nil && core.Node != nil {
+				go func() {
+					if err := core.Node.AutoFulfillOrder(orderId); err != nil {
+						log.Errorf("failed automatically fulfilling order (%s): %s", orderId, err.Error())
+					}
+				}()
+			}
+			if len(contract.VendorListings) == 1 && contract.VendorListings[0].Metadata.GetContractType() == pb.Listing_Metadata_CROWD_FUND && core.Node != nil {
+				slug := contract.VendorListings[0].Slug
+				go func() {
+					if err := core.Node.UpdateCrowdfundProgress(slug); err != nil {
+						log.Errorf("failed updating crowdfund (%s) progress: %s", slug, err.Error())
+					}
+				}()
+			}
 
 			n := repo.OrderNotification{
 				BuyerHandle:   contract.BuyerOrder.BuyerID.Handle,
@@ -463,12 +483,16 @@ func (l *TransactionListener) adjustInventory(contract *pb.RicardianContract) {
 			continue
 		}
 		newCount := new(big.Int).Sub(c, itemQty)
-		if c.Cmp(big.NewInt(0)) < 0 {
+		exceeded := (c.Cmp(big.NewInt(0)) == 0) || (c.Cmp(big.NewInt(0)) > 0 && newCount.Cmp(big.NewInt(0)) < 0)
+		if listing.Backorder != nil {
+			// Backordered listings count the units owed as negative stock
+			exceeded = newCount.Cmp(new(big.Int).Neg(new(big.Int).SetUint64(listing.Backorder.Limit))) < 0
+		} else if c.Cmp(big.NewInt(0)) < 0 {
 			newCount = big.NewInt(-1)
 		} else if newCount.Cmp(big.NewInt(0)) < 0 {
 			newCount = big.NewInt(0)
 		}
-		if (c.Cmp(big.NewInt(0)) == 0) || (c.Cmp(big.NewInt(0)) > 0 && new(big.Int).Sub(c, itemQty).Cmp(big.NewInt(0)) < 0) {
+		if exceeded {
 			orderId, err := calcOrderId(contract.BuyerOrder)
 			if err != nil {
 				continue